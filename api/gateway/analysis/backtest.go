@@ -0,0 +1,58 @@
+package analysis
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import "time"
+
+// BacktestJob is a rule backtest stored in the panther-rule-backtests table.
+//
+// The rule body and dedup settings are copied when the job is started,
+// so editing the rule while the backtest is running does not change its results.
+type BacktestJob struct {
+	JobID              string     `json:"jobId"`
+	RuleID             string     `json:"ruleId"`
+	Body               string     `json:"body"`
+	DedupPeriodMinutes int        `json:"dedupPeriodMinutes"`
+	Threshold          int        `json:"threshold,omitempty"`
+	LogType            string     `json:"logType"`
+	StartTime          time.Time  `json:"startTime"`
+	EndTime            time.Time  `json:"endTime"`
+	MaxEvents          int        `json:"maxEvents"`
+	Status             string     `json:"status"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	CreatedBy          string     `json:"createdBy"`
+	FinishedAt         *time.Time `json:"finishedAt,omitempty"`
+	ErrorMessage       string     `json:"errorMessage,omitempty"`
+
+	// Results, updated when the job finishes
+	EventsScanned int      `json:"eventsScanned"`
+	MatchCount    int      `json:"matchCount"`
+	ErrorCount    int      `json:"errorCount"`
+	AlertCount    int      `json:"alertCount"`
+	SampleMatches []string `json:"sampleMatches,omitempty"`
+	Truncated     bool     `json:"truncated"`
+
+	// Unix timestamp when the job is removed from the table (Dynamo TTL)
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+// BacktesterInput is the request format for invoking the panther-rule-backtester Lambda function.
+type BacktesterInput struct {
+	JobID string `json:"jobId"`
+}
@@ -59,6 +59,12 @@ func (o *CreatePolicyReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 422:
+		result := NewCreatePolicyUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewCreatePolicyInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -158,6 +164,39 @@ func (o *CreatePolicyConflict) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewCreatePolicyUnprocessableEntity creates a CreatePolicyUnprocessableEntity with default headers values
+func NewCreatePolicyUnprocessableEntity() *CreatePolicyUnprocessableEntity {
+	return &CreatePolicyUnprocessableEntity{}
+}
+
+/*CreatePolicyUnprocessableEntity handles this case with default header values.
+
+Enabled policy failed its unit tests
+*/
+type CreatePolicyUnprocessableEntity struct {
+	Payload *models.TestFailure
+}
+
+func (o *CreatePolicyUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /policy][%d] createPolicyUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *CreatePolicyUnprocessableEntity) GetPayload() *models.TestFailure {
+	return o.Payload
+}
+
+func (o *CreatePolicyUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.TestFailure)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewCreatePolicyInternalServerError creates a CreatePolicyInternalServerError with default headers values
 func NewCreatePolicyInternalServerError() *CreatePolicyInternalServerError {
 	return &CreatePolicyInternalServerError{}
@@ -59,6 +59,12 @@ func (o *CreateRuleReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return nil, result
+	case 422:
+		result := NewCreateRuleUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewCreateRuleInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -158,6 +164,39 @@ func (o *CreateRuleConflict) readResponse(response runtime.ClientResponse, consu
 	return nil
 }
 
+// NewCreateRuleUnprocessableEntity creates a CreateRuleUnprocessableEntity with default headers values
+func NewCreateRuleUnprocessableEntity() *CreateRuleUnprocessableEntity {
+	return &CreateRuleUnprocessableEntity{}
+}
+
+/*CreateRuleUnprocessableEntity handles this case with default header values.
+
+Enabled rule failed its unit tests
+*/
+type CreateRuleUnprocessableEntity struct {
+	Payload *models.TestFailure
+}
+
+func (o *CreateRuleUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /rule][%d] createRuleUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *CreateRuleUnprocessableEntity) GetPayload() *models.TestFailure {
+	return o.Payload
+}
+
+func (o *CreateRuleUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.TestFailure)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewCreateRuleInternalServerError creates a CreateRuleInternalServerError with default headers values
 func NewCreateRuleInternalServerError() *CreateRuleInternalServerError {
 	return &CreateRuleInternalServerError{}
@@ -59,6 +59,12 @@ func (o *ModifyPolicyReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 422:
+		result := NewModifyPolicyUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewModifyPolicyInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -158,6 +164,39 @@ func (o *ModifyPolicyNotFound) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewModifyPolicyUnprocessableEntity creates a ModifyPolicyUnprocessableEntity with default headers values
+func NewModifyPolicyUnprocessableEntity() *ModifyPolicyUnprocessableEntity {
+	return &ModifyPolicyUnprocessableEntity{}
+}
+
+/*ModifyPolicyUnprocessableEntity handles this case with default header values.
+
+Enabled policy failed its unit tests
+*/
+type ModifyPolicyUnprocessableEntity struct {
+	Payload *models.TestFailure
+}
+
+func (o *ModifyPolicyUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /update][%d] modifyPolicyUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ModifyPolicyUnprocessableEntity) GetPayload() *models.TestFailure {
+	return o.Payload
+}
+
+func (o *ModifyPolicyUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.TestFailure)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewModifyPolicyInternalServerError creates a ModifyPolicyInternalServerError with default headers values
 func NewModifyPolicyInternalServerError() *ModifyPolicyInternalServerError {
 	return &ModifyPolicyInternalServerError{}
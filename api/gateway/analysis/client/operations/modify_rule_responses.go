@@ -59,6 +59,12 @@ func (o *ModifyRuleReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return nil, result
+	case 422:
+		result := NewModifyRuleUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewModifyRuleInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -158,6 +164,39 @@ func (o *ModifyRuleNotFound) readResponse(response runtime.ClientResponse, consu
 	return nil
 }
 
+// NewModifyRuleUnprocessableEntity creates a ModifyRuleUnprocessableEntity with default headers values
+func NewModifyRuleUnprocessableEntity() *ModifyRuleUnprocessableEntity {
+	return &ModifyRuleUnprocessableEntity{}
+}
+
+/*ModifyRuleUnprocessableEntity handles this case with default header values.
+
+Enabled rule failed its unit tests
+*/
+type ModifyRuleUnprocessableEntity struct {
+	Payload *models.TestFailure
+}
+
+func (o *ModifyRuleUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /rule/update][%d] modifyRuleUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ModifyRuleUnprocessableEntity) GetPayload() *models.TestFailure {
+	return o.Payload
+}
+
+func (o *ModifyRuleUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.TestFailure)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewModifyRuleInternalServerError creates a ModifyRuleInternalServerError with default headers values
 func NewModifyRuleInternalServerError() *ModifyRuleInternalServerError {
 	return &ModifyRuleInternalServerError{}
@@ -57,6 +57,10 @@ type ClientService interface {
 
 	DeletePolicies(params *DeletePoliciesParams) (*DeletePoliciesOK, error)
 
+	DiffVersions(params *DiffVersionsParams) (*DiffVersionsOK, error)
+
+	GetBacktest(params *GetBacktestParams) (*GetBacktestOK, error)
+
 	GetEnabledPolicies(params *GetEnabledPoliciesParams) (*GetEnabledPoliciesOK, error)
 
 	GetGlobal(params *GetGlobalParams) (*GetGlobalOK, error)
@@ -71,14 +75,22 @@ type ClientService interface {
 
 	ListRules(params *ListRulesParams) (*ListRulesOK, error)
 
+	ListVersions(params *ListVersionsParams) (*ListVersionsOK, error)
+
 	ModifyGlobal(params *ModifyGlobalParams) (*ModifyGlobalOK, error)
 
 	ModifyPolicy(params *ModifyPolicyParams) (*ModifyPolicyOK, error)
 
 	ModifyRule(params *ModifyRuleParams) (*ModifyRuleOK, error)
 
+	Rollback(params *RollbackParams) (*RollbackOK, error)
+
+	StartBacktest(params *StartBacktestParams) (*StartBacktestAccepted, error)
+
 	Suppress(params *SuppressParams) (*SuppressOK, error)
 
+	SyncAnalysis(params *SyncAnalysisParams) (*SyncAnalysisOK, error)
+
 	TestPolicy(params *TestPolicyParams) (*TestPolicyOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -288,6 +300,74 @@ func (a *Client) DeletePolicies(params *DeletePoliciesParams) (*DeletePoliciesOK
 	panic(msg)
 }
 
+/*
+  DiffVersions compares two versions of a policy, rule or global
+*/
+func (a *Client) DiffVersions(params *DiffVersionsParams) (*DiffVersionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDiffVersionsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DiffVersions",
+		Method:             "GET",
+		PathPattern:        "/version/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DiffVersionsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DiffVersionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DiffVersions: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  GetBacktest gets the status and results of a rule backtest
+*/
+func (a *Client) GetBacktest(params *GetBacktestParams) (*GetBacktestOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetBacktestParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetBacktest",
+		Method:             "GET",
+		PathPattern:        "/rule/backtest",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GetBacktestReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetBacktestOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetBacktest: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   GetEnabledPolicies lists all enabled rules policies for a customer account for backend processing
 */
@@ -526,6 +606,40 @@ func (a *Client) ListRules(params *ListRulesParams) (*ListRulesOK, error) {
 	panic(msg)
 }
 
+/*
+  ListVersions pages through the version history of a policy, rule or global
+*/
+func (a *Client) ListVersions(params *ListVersionsParams) (*ListVersionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListVersionsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListVersions",
+		Method:             "GET",
+		PathPattern:        "/version/list",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListVersionsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListVersionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ListVersions: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ModifyGlobal modifies an existing global
 */
@@ -628,6 +742,74 @@ func (a *Client) ModifyRule(params *ModifyRuleParams) (*ModifyRuleOK, error) {
 	panic(msg)
 }
 
+/*
+  Rollback restores a previous version of a policy, rule or global
+*/
+func (a *Client) Rollback(params *RollbackParams) (*RollbackOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRollbackParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "Rollback",
+		Method:             "POST",
+		PathPattern:        "/version/rollback",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RollbackReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RollbackOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for Rollback: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  StartBacktest replays a rule over historical events of one of its log types
+*/
+func (a *Client) StartBacktest(params *StartBacktestParams) (*StartBacktestAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStartBacktestParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "StartBacktest",
+		Method:             "POST",
+		PathPattern:        "/rule/backtest",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &StartBacktestReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*StartBacktestAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for StartBacktest: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   Suppress suppresses resource patterns across one or more policies
 */
@@ -662,6 +844,40 @@ func (a *Client) Suppress(params *SuppressParams) (*SuppressOK, error) {
 	panic(msg)
 }
 
+/*
+  SyncAnalysis synchronizes policies, rules and globals with a Git repository snapshot
+*/
+func (a *Client) SyncAnalysis(params *SyncAnalysisParams) (*SyncAnalysisOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSyncAnalysisParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SyncAnalysis",
+		Method:             "POST",
+		PathPattern:        "/sync",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SyncAnalysisReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SyncAnalysisOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SyncAnalysis: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   TestPolicy tests a policy against a set of unit tests
 */
@@ -42,6 +42,8 @@ type Config struct {
 	Tags                      []string            `yaml:"Tags"`
 	Tests                     []Test              `yaml:"Tests"`
 	DedupPeriodMinutes        int                 `yaml:"DedupPeriodMinutes"`
+	Threshold                 int                 `yaml:"Threshold"`
+	DigestPeriodMinutes       int                 `yaml:"DigestPeriodMinutes"`
 	Reports                   map[string][]string `yaml:"Reports"`
 }
 
@@ -20,10 +20,20 @@ package analysis
 
 // PolicyEngineInput is the request format for invoking the panther-policy-engine Lambda function.
 type PolicyEngineInput struct {
+	Globals   []Global   `json:"globals,omitempty"`
 	Policies  []Policy   `json:"policies"`
 	Resources []Resource `json:"resources"`
 }
 
+// Global is a Python module which policies and rules can import.
+//
+// Globals in an engine input replace the deployed globals with the same ID, so that unit tests
+// can run with globals which are uploaded at the same time.
+type Global struct {
+	Body string `json:"body"`
+	ID   string `json:"id"`
+}
+
 // Policy is a subset of the policy fields needed for analysis, returns True if compliant.
 type Policy struct {
 	Body          string   `json:"body"`
@@ -61,8 +71,9 @@ type PolicyError struct {
 
 // RulesEngineInput is the request format when doing event-driven log analysis.
 type RulesEngineInput struct {
-	Rules  []Rule  `json:"rules"`
-	Events []Event `json:"events"`
+	Globals []Global `json:"globals,omitempty"`
+	Rules   []Rule   `json:"rules"`
+	Events  []Event  `json:"events"`
 }
 
 // Rule evaluates streaming logs, returning True if an alert should be triggered.
@@ -88,6 +99,7 @@ type RulesEngineOutput struct {
 type EventAnalysis struct {
 	ID         string        `json:"id"`
 	Errored    []PolicyError `json:"errored"`
-	Matched    []string      `json:"matched"`    // set of rule IDs which returned True
-	NotMatched []string      `json:"notMatched"` // set of rule IDs which returned False
+	Matched    []string      `json:"matched"`         // set of rule IDs which returned True
+	NotMatched []string      `json:"notMatched"`      // set of rule IDs which returned False
+	Dedup      string        `json:"dedup,omitempty"` // dedup string of the matched rule
 }
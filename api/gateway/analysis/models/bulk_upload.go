@@ -38,6 +38,9 @@ type BulkUpload struct {
 	// Required: true
 	Data Base64zipfile `json:"data"`
 
+	// override tests
+	OverrideTests OverrideTests `json:"overrideTests,omitempty"`
+
 	// user Id
 	// Required: true
 	UserID UserID `json:"userId"`
@@ -24,6 +24,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -65,6 +67,9 @@ type BulkUploadResult struct {
 	// Minimum: 0
 	NewRules *int64 `json:"newRules"`
 
+	// Enabled policies and rules which failed their unit tests, saved as disabled or, if already enabled, not updated
+	TestFailures []*TestFailure `json:"testFailures"`
+
 	// total globals
 	// Required: true
 	// Minimum: 0
@@ -109,6 +114,10 @@ func (m *BulkUploadResult) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTestFailures(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTotalGlobals(formats); err != nil {
 		res = append(res, err)
 	}
@@ -205,6 +214,31 @@ func (m *BulkUploadResult) validateNewRules(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *BulkUploadResult) validateTestFailures(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TestFailures) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.TestFailures); i++ {
+		if swag.IsZero(m.TestFailures[i]) { // not required
+			continue
+		}
+
+		if m.TestFailures[i] != nil {
+			if err := m.TestFailures[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("testFailures" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *BulkUploadResult) validateTotalGlobals(formats strfmt.Registry) error {
 
 	if err := validate.Required("totalGlobals", "body", m.TotalGlobals); err != nil {
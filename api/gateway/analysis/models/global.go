@@ -39,6 +39,9 @@ type Global struct {
 	// Required: true
 	Body Body `json:"body"`
 
+	// commit sha
+	CommitSha CommitSha `json:"commitSha,omitempty"`
+
 	// created at
 	// Required: true
 	// Format: date-time
@@ -82,6 +85,10 @@ func (m *Global) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCommitSha(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCreatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -132,6 +139,22 @@ func (m *Global) validateBody(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Global) validateCommitSha(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CommitSha) { // not required
+		return nil
+	}
+
+	if err := m.CommitSha.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("commitSha")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *Global) validateCreatedAt(formats strfmt.Registry) error {
 
 	if err := m.CreatedAt.Validate(formats); err != nil {
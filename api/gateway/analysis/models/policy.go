@@ -47,6 +47,9 @@ type Policy struct {
 	// Required: true
 	Body Body `json:"body"`
 
+	// commit sha
+	CommitSha CommitSha `json:"commitSha,omitempty"`
+
 	// compliance status
 	// Required: true
 	ComplianceStatus ComplianceStatus `json:"complianceStatus"`
@@ -64,6 +67,9 @@ type Policy struct {
 	// Required: true
 	Description Description `json:"description"`
 
+	// digest period minutes
+	DigestPeriodMinutes DigestPeriodMinutes `json:"digestPeriodMinutes,omitempty"`
+
 	// display name
 	// Required: true
 	DisplayName DisplayName `json:"displayName"`
@@ -138,6 +144,10 @@ func (m *Policy) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCommitSha(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateComplianceStatus(formats); err != nil {
 		res = append(res, err)
 	}
@@ -154,6 +164,10 @@ func (m *Policy) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDigestPeriodMinutes(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDisplayName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -252,6 +266,22 @@ func (m *Policy) validateBody(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Policy) validateCommitSha(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CommitSha) { // not required
+		return nil
+	}
+
+	if err := m.CommitSha.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("commitSha")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *Policy) validateComplianceStatus(formats strfmt.Registry) error {
 
 	if err := m.ComplianceStatus.Validate(formats); err != nil {
@@ -300,6 +330,22 @@ func (m *Policy) validateDescription(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Policy) validateDigestPeriodMinutes(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DigestPeriodMinutes) { // not required
+		return nil
+	}
+
+	if err := m.DigestPeriodMinutes.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("digestPeriodMinutes")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *Policy) validateDisplayName(formats strfmt.Registry) error {
 
 	if err := m.DisplayName.Validate(formats); err != nil {
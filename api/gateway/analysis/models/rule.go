@@ -39,6 +39,9 @@ type Rule struct {
 	// Required: true
 	Body Body `json:"body"`
 
+	// commit sha
+	CommitSha CommitSha `json:"commitSha,omitempty"`
+
 	// created at
 	// Required: true
 	// Format: date-time
@@ -105,6 +108,9 @@ type Rule struct {
 	// Required: true
 	Tests TestSuite `json:"tests"`
 
+	// threshold
+	Threshold Threshold `json:"threshold,omitempty"`
+
 	// version Id
 	// Required: true
 	VersionID VersionID `json:"versionId"`
@@ -118,6 +124,10 @@ func (m *Rule) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCommitSha(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCreatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -182,6 +192,10 @@ func (m *Rule) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateThreshold(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateVersionID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -204,6 +218,22 @@ func (m *Rule) validateBody(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Rule) validateCommitSha(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CommitSha) { // not required
+		return nil
+	}
+
+	if err := m.CommitSha.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("commitSha")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *Rule) validateCreatedAt(formats strfmt.Registry) error {
 
 	if err := m.CreatedAt.Validate(formats); err != nil {
@@ -408,6 +438,22 @@ func (m *Rule) validateTests(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Rule) validateThreshold(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Threshold) { // not required
+		return nil
+	}
+
+	if err := m.Threshold.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("threshold")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *Rule) validateVersionID(formats strfmt.Registry) error {
 
 	if err := m.VersionID.Validate(formats); err != nil {
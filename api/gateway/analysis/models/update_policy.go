@@ -47,6 +47,9 @@ type UpdatePolicy struct {
 	// description
 	Description Description `json:"description,omitempty"`
 
+	// digest period minutes
+	DigestPeriodMinutes DigestPeriodMinutes `json:"digestPeriodMinutes,omitempty"`
+
 	// display name
 	DisplayName DisplayName `json:"displayName,omitempty"`
 
@@ -58,6 +61,9 @@ type UpdatePolicy struct {
 	// Required: true
 	ID ID `json:"id"`
 
+	// override tests
+	OverrideTests OverrideTests `json:"overrideTests,omitempty"`
+
 	// reference
 	Reference Reference `json:"reference,omitempty"`
 
@@ -105,6 +111,10 @@ func (m *UpdatePolicy) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDigestPeriodMinutes(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDisplayName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -215,6 +225,22 @@ func (m *UpdatePolicy) validateDescription(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *UpdatePolicy) validateDigestPeriodMinutes(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DigestPeriodMinutes) { // not required
+		return nil
+	}
+
+	if err := m.DigestPeriodMinutes.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("digestPeriodMinutes")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *UpdatePolicy) validateDisplayName(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.DisplayName) { // not required
@@ -58,6 +58,9 @@ type UpdateRule struct {
 	// log types
 	LogTypes TypeSet `json:"logTypes,omitempty"`
 
+	// override tests
+	OverrideTests OverrideTests `json:"overrideTests,omitempty"`
+
 	// reference
 	Reference Reference `json:"reference,omitempty"`
 
@@ -77,6 +80,9 @@ type UpdateRule struct {
 	// tests
 	Tests TestSuite `json:"tests,omitempty"`
 
+	// threshold
+	Threshold Threshold `json:"threshold,omitempty"`
+
 	// user Id
 	// Required: true
 	UserID UserID `json:"userId"`
@@ -138,6 +144,10 @@ func (m *UpdateRule) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateThreshold(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUserID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -340,6 +350,22 @@ func (m *UpdateRule) validateTests(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *UpdateRule) validateThreshold(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Threshold) { // not required
+		return nil
+	}
+
+	if err := m.Threshold.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("threshold")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *UpdateRule) validateUserID(formats strfmt.Registry) error {
 
 	if err := m.UserID.Validate(formats); err != nil {
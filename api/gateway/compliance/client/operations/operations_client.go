@@ -45,6 +45,8 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	AddException(params *AddExceptionParams) (*AddExceptionCreated, error)
+
 	DeleteStatus(params *DeleteStatusParams) (*DeleteStatusOK, error)
 
 	DescribeOrg(params *DescribeOrgParams) (*DescribeOrgOK, error)
@@ -53,10 +55,20 @@ type ClientService interface {
 
 	DescribeResource(params *DescribeResourceParams) (*DescribeResourceOK, error)
 
+	ExpireExceptions(params *ExpireExceptionsParams) (*ExpireExceptionsOK, error)
+
+	GetComplianceReport(params *GetComplianceReportParams) (*GetComplianceReportOK, error)
+
+	GetComplianceTrend(params *GetComplianceTrendParams) (*GetComplianceTrendOK, error)
+
 	GetOrgOverview(params *GetOrgOverviewParams) (*GetOrgOverviewOK, error)
 
 	GetStatus(params *GetStatusParams) (*GetStatusOK, error)
 
+	ListExceptions(params *ListExceptionsParams) (*ListExceptionsOK, error)
+
+	RecordTrendSnapshot(params *RecordTrendSnapshotParams) (*RecordTrendSnapshotOK, error)
+
 	SetStatus(params *SetStatusParams) (*SetStatusCreated, error)
 
 	UpdateMetadata(params *UpdateMetadataParams) (*UpdateMetadataOK, error)
@@ -64,6 +76,40 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  AddException grants a time boxed exception for a single policy resource pair
+*/
+func (a *Client) AddException(params *AddExceptionParams) (*AddExceptionCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAddExceptionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "AddException",
+		Method:             "POST",
+		PathPattern:        "/exception",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AddExceptionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AddExceptionCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for AddException: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   DeleteStatus deletes the status associated with one or more policies or resources
 */
@@ -200,6 +246,108 @@ func (a *Client) DescribeResource(params *DescribeResourceParams) (*DescribeReso
 	panic(msg)
 }
 
+/*
+  ExpireExceptions removes expired exceptions invoked hourly on a schedule
+*/
+func (a *Client) ExpireExceptions(params *ExpireExceptionsParams) (*ExpireExceptionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExpireExceptionsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ExpireExceptions",
+		Method:             "POST",
+		PathPattern:        "/exception/expire",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ExpireExceptionsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ExpireExceptionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ExpireExceptions: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  GetComplianceReport gets the pass fail status of every control in a compliance framework
+*/
+func (a *Client) GetComplianceReport(params *GetComplianceReportParams) (*GetComplianceReportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetComplianceReportParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetComplianceReport",
+		Method:             "GET",
+		PathPattern:        "/report",
+		ProducesMediaTypes: []string{"application/json", "text/csv"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GetComplianceReportReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetComplianceReportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetComplianceReport: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  GetComplianceTrend gets daily compliance snapshots within a date range oldest first
+*/
+func (a *Client) GetComplianceTrend(params *GetComplianceTrendParams) (*GetComplianceTrendOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetComplianceTrendParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetComplianceTrend",
+		Method:             "GET",
+		PathPattern:        "/trend",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GetComplianceTrendReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetComplianceTrendOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetComplianceTrend: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   GetOrgOverview gets account totals and top failing policies resources
 */
@@ -268,6 +416,74 @@ func (a *Client) GetStatus(params *GetStatusParams) (*GetStatusOK, error) {
 	panic(msg)
 }
 
+/*
+  ListExceptions lists policy exceptions including any which expired since the last cleanup
+*/
+func (a *Client) ListExceptions(params *ListExceptionsParams) (*ListExceptionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListExceptionsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListExceptions",
+		Method:             "GET",
+		PathPattern:        "/exceptions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListExceptionsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListExceptionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ListExceptions: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  RecordTrendSnapshot records a snapshot of the current compliance aggregates for today invoked daily on a schedule
+*/
+func (a *Client) RecordTrendSnapshot(params *RecordTrendSnapshotParams) (*RecordTrendSnapshotOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRecordTrendSnapshotParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RecordTrendSnapshot",
+		Method:             "POST",
+		PathPattern:        "/trend",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RecordTrendSnapshotReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RecordTrendSnapshotOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for RecordTrendSnapshot: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   SetStatus sets the compliance status for a batch of resource policy pairs
 */
@@ -45,15 +45,89 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	DryRunRemediation(params *DryRunRemediationParams) (*DryRunRemediationOK, error)
+
+	ListRemediationAudit(params *ListRemediationAuditParams) (*ListRemediationAuditOK, error)
+
 	ListRemediations(params *ListRemediationsParams) (*ListRemediationsOK, error)
 
 	RemediateResource(params *RemediateResourceParams) (*RemediateResourceOK, error)
 
 	RemediateResourceAsync(params *RemediateResourceAsyncParams) (*RemediateResourceAsyncOK, error)
 
+	ReviewRemediation(params *ReviewRemediationParams) (*ReviewRemediationOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  DryRunRemediation returns the changes a remediation would make to a resource without applying them
+*/
+func (a *Client) DryRunRemediation(params *DryRunRemediationParams) (*DryRunRemediationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDryRunRemediationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DryRunRemediation",
+		Method:             "POST",
+		PathPattern:        "/dryrun",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DryRunRemediationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DryRunRemediationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DryRunRemediation: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ListRemediationAudit lists the audit record of every remediation newest first
+*/
+func (a *Client) ListRemediationAudit(params *ListRemediationAuditParams) (*ListRemediationAuditOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListRemediationAuditParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListRemediationAudit",
+		Method:             "GET",
+		PathPattern:        "/audit",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListRemediationAuditReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListRemediationAuditOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ListRemediationAudit: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ListRemediations retrieves available remediations
 */
@@ -156,6 +230,40 @@ func (a *Client) RemediateResourceAsync(params *RemediateResourceAsyncParams) (*
 	panic(msg)
 }
 
+/*
+  ReviewRemediation approves or reject a pending auto remediation
+*/
+func (a *Client) ReviewRemediation(params *ReviewRemediationParams) (*ReviewRemediationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReviewRemediationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ReviewRemediation",
+		Method:             "POST",
+		PathPattern:        "/review",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReviewRemediationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReviewRemediationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReviewRemediation: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
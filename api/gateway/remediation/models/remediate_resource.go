@@ -41,6 +41,9 @@ type RemediateResource struct {
 	// resource Id
 	// Required: true
 	ResourceID ResourceID `json:"resourceId"`
+
+	// The user who requested the remediation, empty for automatic remediations. Set from the X-Panther-User-Id header
+	UserID UserID `json:"userId,omitempty"`
 }
 
 // Validate validates this remediate resource
@@ -55,6 +58,10 @@ func (m *RemediateResource) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateUserID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -85,6 +92,22 @@ func (m *RemediateResource) validateResourceID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *RemediateResource) validateUserID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.UserID) { // not required
+		return nil
+	}
+
+	if err := m.UserID.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("userId")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *RemediateResource) MarshalBinary() ([]byte, error) {
 	if m == nil {
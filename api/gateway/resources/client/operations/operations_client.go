@@ -53,6 +53,8 @@ type ClientService interface {
 
 	GetResource(params *GetResourceParams) (*GetResourceOK, error)
 
+	GetResourceHistory(params *GetResourceHistoryParams) (*GetResourceHistoryOK, error)
+
 	ListResources(params *ListResourcesParams) (*ListResourcesOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -194,6 +196,40 @@ func (a *Client) GetResource(params *GetResourceParams) (*GetResourceOK, error)
 	panic(msg)
 }
 
+/*
+  GetResourceHistory gets the configuration history of a resource newest version first
+*/
+func (a *Client) GetResourceHistory(params *GetResourceHistoryParams) (*GetResourceHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetResourceHistoryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetResourceHistory",
+		Method:             "GET",
+		PathPattern:        "/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GetResourceHistoryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetResourceHistoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetResourceHistory: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ListResources lists resources for a customer account
 */
@@ -39,6 +39,9 @@ type AddResourceEntry struct {
 	// Required: true
 	Attributes Attributes `json:"attributes"`
 
+	// change event
+	ChangeEvent *ChangeEvent `json:"changeEvent,omitempty"`
+
 	// id
 	// Required: true
 	ID ResourceID `json:"id"`
@@ -64,6 +67,10 @@ func (m *AddResourceEntry) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateChangeEvent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -95,6 +102,24 @@ func (m *AddResourceEntry) validateAttributes(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *AddResourceEntry) validateChangeEvent(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ChangeEvent) { // not required
+		return nil
+	}
+
+	if m.ChangeEvent != nil {
+		if err := m.ChangeEvent.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("changeEvent")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *AddResourceEntry) validateID(formats strfmt.Registry) error {
 
 	if err := m.ID.Validate(formats); err != nil {
@@ -40,6 +40,9 @@ const (
 
 	// IntegrationTypeAws captures enum value "aws"
 	IntegrationTypeAws IntegrationType = "aws"
+
+	// IntegrationTypeGcp captures enum value "gcp"
+	IntegrationTypeGcp IntegrationType = "gcp"
 )
 
 // for schema
@@ -47,7 +50,7 @@ var integrationTypeEnum []interface{}
 
 func init() {
 	var res []IntegrationType
-	if err := json.Unmarshal([]byte(`["aws"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["aws","gcp"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
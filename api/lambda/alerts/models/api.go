@@ -22,10 +22,28 @@ import "time"
 
 // LambdaInput is the request structure for the alerts-api Lambda function.
 type LambdaInput struct {
-	GetAlert   *GetAlertInput   `json:"getAlert"`
-	ListAlerts *ListAlertsInput `json:"listAlerts"`
+	GetAlert          *GetAlertInput          `json:"getAlert"`
+	ListAlerts        *ListAlertsInput        `json:"listAlerts"`
+	UpdateAlertStatus *UpdateAlertStatusInput `json:"updateAlertStatus"`
+	AssignAlert       *AssignAlertInput       `json:"assignAlert"`
+	AddAlertComment   *AddAlertCommentInput   `json:"addAlertComment"`
 }
 
+// The triage status of an alert
+const (
+	AlertStatusOpen     = "OPEN"
+	AlertStatusTriaged  = "TRIAGED"
+	AlertStatusClosed   = "CLOSED"
+	AlertStatusResolved = "RESOLVED"
+)
+
+// The actions recorded in the history of an alert
+const (
+	AlertActionUpdateStatus = "UPDATE_STATUS"
+	AlertActionAssign       = "ASSIGN"
+	AlertActionAddComment   = "ADD_COMMENT"
+)
+
 // GetAlertInput retrieves details for a single alert.
 //
 // The response will contain by definition all of the events associated with the alert.
@@ -65,6 +83,8 @@ type GetAlertOutput = Alert
 //         "alertIdContains": "string in alert id",
 //         "eventCountMin": "0",
 //         "eventCountMax": "500",
+//         "status": ["OPEN", "TRIAGED"],
+//         "assigneeId": "8304cc90-750d-4b8f-9a63-b90a4543c707",
 //         "sortDir": "ascending",
 //     }
 // }
@@ -87,6 +107,8 @@ type ListAlertsInput struct {
 	AlertIDContains *string    `json:"alertIdContains"`
 	EventCountMin   *int       `json:"eventCountMin" validate:"omitempty,min=0"`
 	EventCountMax   *int       `json:"eventCountMax" validate:"omitempty,min=1"`
+	Status          []*string  `json:"status" validate:"omitempty,dive,oneof=OPEN TRIAGED CLOSED RESOLVED"`
+	AssigneeID      *string    `json:"assigneeId" validate:"omitempty,uuid4"`
 
 	// Sorting
 	SortDir *string `json:"sortDir" validate:"omitempty,oneof=ascending descending"`
@@ -113,13 +135,92 @@ type AlertSummary struct {
 	CreationTime    *time.Time `json:"creationTime" validate:"required"`
 	UpdateTime      *time.Time `json:"updateTime" validate:"required"`
 	EventsMatched   *int       `json:"eventsMatched" validate:"required"`
+	TriggerCount    *int       `json:"triggerCount,omitempty"`
 	Severity        *string    `json:"severity" validate:"required"`
 	Title           *string    `json:"title" validate:"required"`
+	Status          *string    `json:"status" validate:"required"`
+	AssigneeID      *string    `json:"assigneeId,omitempty"`
 }
 
 // Alert contains the details of an alert
 type Alert struct {
 	AlertSummary
-	Events                 []*string `json:"events" validate:"required"`
-	EventsLastEvaluatedKey *string   `json:"eventsLastEvaluatedKey,omitempty"`
+	Events                 []*string            `json:"events" validate:"required"`
+	EventsLastEvaluatedKey *string              `json:"eventsLastEvaluatedKey,omitempty"`
+	Comments               []*AlertComment      `json:"comments"`
+	History                []*AlertHistoryEntry `json:"history"`
+}
+
+// AlertComment is a comment added to an alert by an analyst
+type AlertComment struct {
+	CreatedAt *time.Time `json:"createdAt"`
+	CreatedBy *string    `json:"createdBy"`
+	Comment   *string    `json:"comment"`
+}
+
+// AlertHistoryEntry records a change made to an alert
+type AlertHistoryEntry struct {
+	Timestamp     *time.Time `json:"timestamp"`
+	UserID        *string    `json:"userId"`
+	Action        *string    `json:"action"`
+	PreviousValue *string    `json:"previousValue,omitempty"`
+	NewValue      *string    `json:"newValue,omitempty"`
+}
+
+// UpdateAlertStatusInput changes the triage status of an alert.
+//
+// Example:
+// {
+//     "updateAlertStatus": {
+//         "alertId": "2a1c6d5b1f6a8b9e4d3c2b1a0f9e8d7c",
+//         "status": "TRIAGED",
+//         "userId": "97c4db4e-61d5-40a7-82de-6dd63b199bd2"
+//     }
+// }
+type UpdateAlertStatusInput struct {
+	AlertID *string `json:"alertId" validate:"required,hexadecimal,len=32"`
+	Status  *string `json:"status" validate:"required,oneof=OPEN TRIAGED CLOSED RESOLVED"`
+	UserID  *string `json:"userId" validate:"required,uuid4"`
+}
+
+// UpdateAlertStatusOutput is the updated alert summary
+type UpdateAlertStatusOutput = AlertSummary
+
+// AssignAlertInput assigns an alert to a Panther user.
+//
+// If "assigneeId" is not set, the alert is unassigned.
+// Example:
+// {
+//     "assignAlert": {
+//         "alertId": "2a1c6d5b1f6a8b9e4d3c2b1a0f9e8d7c",
+//         "assigneeId": "8304cc90-750d-4b8f-9a63-b90a4543c707",
+//         "userId": "97c4db4e-61d5-40a7-82de-6dd63b199bd2"
+//     }
+// }
+type AssignAlertInput struct {
+	AlertID    *string `json:"alertId" validate:"required,hexadecimal,len=32"`
+	AssigneeID *string `json:"assigneeId" validate:"omitempty,uuid4"`
+	UserID     *string `json:"userId" validate:"required,uuid4"`
 }
+
+// AssignAlertOutput is the updated alert summary
+type AssignAlertOutput = AlertSummary
+
+// AddAlertCommentInput adds a comment to an alert.
+//
+// Example:
+// {
+//     "addAlertComment": {
+//         "alertId": "2a1c6d5b1f6a8b9e4d3c2b1a0f9e8d7c",
+//         "comment": "False positive, this is our CI account",
+//         "userId": "97c4db4e-61d5-40a7-82de-6dd63b199bd2"
+//     }
+// }
+type AddAlertCommentInput struct {
+	AlertID *string `json:"alertId" validate:"required,hexadecimal,len=32"`
+	Comment *string `json:"comment" validate:"required,min=1,max=10000"`
+	UserID  *string `json:"userId" validate:"required,uuid4"`
+}
+
+// AddAlertCommentOutput is the added comment
+type AddAlertCommentOutput = AlertComment
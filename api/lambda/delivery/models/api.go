@@ -60,6 +60,20 @@ type Alert struct {
 	// Tags is the set of policy tags.
 	Tags []string `json:"tags,omitempty"`
 
+	// LogTypes is the set of log types of the events that triggered a rule alert.
+	LogTypes []string `json:"logTypes,omitempty"`
+
+	// ResourceTypes is the set of resource types that triggered a policy alert.
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+
+	// ResourceIDs is the set of resources which failed the policy during the period of a policy alert digest.
+	ResourceIDs []string `json:"resourceIds,omitempty"`
+
+	// NumResources is the number of resources which failed the policy during the period of a policy alert digest.
+	//
+	// It can be larger than the number of ResourceIDs, which are capped to keep the alert small.
+	NumResources int `json:"numResources,omitempty"`
+
 	// AlertID specifies the alertId that this Alert is associated with.
 	AlertID *string `json:"alertId,omitempty"`
 
@@ -18,6 +18,12 @@ package models
  * along with this program.  If not, see <https://www.gnu.org/licenses/>.
  */
 
+import (
+	"time"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
+)
+
 // LambdaInput is the invocation event expected by the Lambda function.
 //
 // Exactly one action must be specified.
@@ -28,6 +34,16 @@ type LambdaInput struct {
 	DeleteOutput          *DeleteOutputInput          `json:"deleteOutput"`
 	GetOutputs            *GetOutputsInput            `json:"getOutputs"`
 	GetOutputsWithSecrets *GetOutputsWithSecretsInput `json:"getOutputsWithSecrets"`
+	TestRouting           *TestRoutingInput           `json:"testRouting"`
+	PreviewTemplate       *PreviewTemplateInput       `json:"previewTemplate"`
+
+	AddDeliveryFailures    *AddDeliveryFailuresInput    `json:"addDeliveryFailures"`
+	ListDeliveryFailures   *ListDeliveryFailuresInput   `json:"listDeliveryFailures"`
+	ReplayDeliveryFailures *ReplayDeliveryFailuresInput `json:"replayDeliveryFailures"`
+
+	AddDeliveryAttempts *AddDeliveryAttemptsInput `json:"addDeliveryAttempts"`
+	GetDeliveryHistory  *GetDeliveryHistoryInput  `json:"getDeliveryHistory"`
+	GetOutputsHealth    *GetOutputsHealthInput    `json:"getOutputsHealth"`
 }
 
 // AddOutputInput adds a new encrypted alert output to DynamoDB.
@@ -47,6 +63,8 @@ type AddOutputInput struct {
 	DisplayName        *string       `json:"displayName" validate:"required,min=1,excludesall='<>&\""`
 	OutputConfig       *OutputConfig `json:"outputConfig" validate:"required"`
 	DefaultForSeverity []*string     `json:"defaultForSeverity"`
+	RoutingRules       *RoutingRules `json:"routingRules"`
+	MessageTemplate    *string       `json:"messageTemplate" validate:"omitempty,max=10000"`
 }
 
 // AddOutputOutput returns a randomly generated UUID for the output.
@@ -86,6 +104,8 @@ type UpdateOutputInput struct {
 	OutputID           *string       `json:"outputId" validate:"required,uuid4"`
 	OutputConfig       *OutputConfig `json:"outputConfig"`
 	DefaultForSeverity []*string     `json:"defaultForSeverity"`
+	RoutingRules       *RoutingRules `json:"routingRules"`
+	MessageTemplate    *string       `json:"messageTemplate" validate:"omitempty,max=10000"`
 }
 
 // UpdateOutputOutput returns the new updated output
@@ -131,6 +151,271 @@ type GetOutputsWithSecretsInput struct {
 // }
 type GetOutputsOutput = []*AlertOutput
 
+// TestRoutingInput returns the outputs a sample alert would be delivered to.
+//
+// Example:
+// {
+//     "testRouting": {
+//         "alert": {
+//             "analysisId": "AWS.CloudTrail.RootActivity",
+//             "createdAt": "2020-01-01T00:00:00Z",
+//             "type": "RULE",
+//             "severity": "HIGH",
+//             "logTypes": ["AWS.CloudTrail"]
+//         }
+//     }
+// }
+type TestRoutingInput struct {
+	Alert *alertmodels.Alert `json:"alert" validate:"required"`
+}
+
+// TestRoutingOutput contains the outputs the alert would be delivered to, with their secrets redacted
+type TestRoutingOutput = []*AlertOutput
+
+// PreviewTemplateInput renders a message template for a sample alert.
+//
+// If no alert is given, a built-in sample alert is used.
+//
+// Example:
+// {
+//     "previewTemplate": {
+//         "messageTemplate": "{{.Title}} ({{.Severity}}): {{.Link}}"
+//     }
+// }
+type PreviewTemplateInput struct {
+	MessageTemplate *string            `json:"messageTemplate" validate:"required,min=1,max=10000"`
+	Alert           *alertmodels.Alert `json:"alert"`
+}
+
+// PreviewTemplateOutput contains the rendered message
+//
+// Example:
+// {
+//     "message": "New Alert: Sample alert title (HIGH): https://..."
+// }
+type PreviewTemplateOutput struct {
+	Message *string `json:"message"`
+}
+
+// AddDeliveryFailuresInput stores alerts which could not be delivered to their outputs.
+//
+// This is invoked by the alert delivery Lambda function once an alert has permanently
+// failed for an output, either because of a non-retryable error or because it ran out of retries.
+//
+// Example:
+// {
+//     "addDeliveryFailures": {
+//         "failures": [
+//             {
+//                 "outputId": "7d1c5854-f3ea-491c-8a52-0aa0d58cb456",
+//                 "alert": {
+//                     "analysisId": "AWS.CloudTrail.RootActivity",
+//                     "createdAt": "2020-01-01T00:00:00Z",
+//                     "type": "RULE",
+//                     "severity": "HIGH"
+//                 },
+//                 "errorMessage": "request failed: 401 Unauthorized",
+//                 "permanent": true,
+//                 "failedAt": "2020-01-01T00:30:00Z"
+//             }
+//         ]
+//     }
+// }
+type AddDeliveryFailuresInput struct {
+	Failures []*DeliveryFailure `json:"failures" validate:"min=1,dive,required"`
+}
+
+// ListDeliveryFailuresInput lists the stored delivery failures, newest first.
+//
+// If no outputId is given, the failures of every output are returned.
+//
+// Example:
+// {
+//     "listDeliveryFailures": {
+//         "outputId": "7d1c5854-f3ea-491c-8a52-0aa0d58cb456"
+//     }
+// }
+type ListDeliveryFailuresInput struct {
+	OutputID *string `json:"outputId" validate:"omitempty,uuid4"`
+}
+
+// ListDeliveryFailuresOutput contains the stored delivery failures
+type ListDeliveryFailuresOutput = []*DeliveryFailure
+
+// ReplayDeliveryFailuresInput queues stored delivery failures for another delivery attempt.
+//
+// If failureIds are given, only those failures are replayed. Otherwise, every stored failure
+// for the outputId is replayed, or every stored failure for all outputs if outputId is omitted.
+// Each replayed alert is only re-sent to the output it originally failed for. Replayed failures
+// are removed from the store; if delivery fails again, a new failure is recorded.
+//
+// Example:
+// {
+//     "replayDeliveryFailures": {
+//         "outputId": "7d1c5854-f3ea-491c-8a52-0aa0d58cb456"
+//     }
+// }
+type ReplayDeliveryFailuresInput struct {
+	FailureIDs []string `json:"failureIds" validate:"omitempty,max=1000,dive,uuid4"`
+	OutputID   *string  `json:"outputId" validate:"omitempty,uuid4"`
+}
+
+// ReplayDeliveryFailuresOutput contains the IDs of the failures which were queued for delivery
+//
+// Example:
+// {
+//     "failureIds": ["0a1d4c2e-5bd7-4c6f-9a0e-3a4e3d0b2f11"]
+// }
+type ReplayDeliveryFailuresOutput struct {
+	FailureIDs []string `json:"failureIds"`
+}
+
+// DeliveryFailure is an alert which could not be delivered to one of its outputs
+type DeliveryFailure struct {
+	// FailureID uniquely identifies the failure, assigned when it is stored
+	FailureID *string `json:"failureId"`
+
+	// OutputID is the output the alert failed to be delivered to
+	OutputID *string `json:"outputId" validate:"required,uuid4"`
+
+	// Alert is the alert which failed to be delivered
+	Alert *alertmodels.Alert `json:"alert" validate:"required"`
+
+	// ErrorMessage is the error returned by the last delivery attempt
+	ErrorMessage *string `json:"errorMessage"`
+
+	// Permanent indicates the last error was not retryable, e.g. invalid credentials
+	Permanent bool `json:"permanent"`
+
+	// FailedAt is the time of the last delivery attempt
+	FailedAt time.Time `json:"failedAt" validate:"required"`
+}
+
+// AddDeliveryAttemptsInput records the result of alert delivery attempts.
+//
+// This is invoked by the alert delivery Lambda function after each batch of alerts.
+//
+// Example:
+// {
+//     "addDeliveryAttempts": {
+//         "attempts": [
+//             {
+//                 "outputId": "7d1c5854-f3ea-491c-8a52-0aa0d58cb456",
+//                 "analysisId": "AWS.CloudTrail.RootActivity",
+//                 "alertId": "8d1a5a8fe1a8f0de6b3c5b6b7e7a5c1e",
+//                 "success": false,
+//                 "statusCode": 503,
+//                 "latencyMs": 1250,
+//                 "errorMessage": "request failed: 503 Service Unavailable: ",
+//                 "attemptedAt": "2020-01-01T00:30:00Z"
+//             }
+//         ]
+//     }
+// }
+type AddDeliveryAttemptsInput struct {
+	Attempts []*DeliveryAttempt `json:"attempts" validate:"min=1,dive,required"`
+}
+
+// GetDeliveryHistoryInput returns the most recent delivery attempts of an output, newest first.
+//
+// Example:
+// {
+//     "getDeliveryHistory": {
+//         "outputId": "7d1c5854-f3ea-491c-8a52-0aa0d58cb456",
+//         "limit": 25
+//     }
+// }
+type GetDeliveryHistoryInput struct {
+	OutputID *string `json:"outputId" validate:"required,uuid4"`
+	Limit    *int    `json:"limit" validate:"omitempty,min=1,max=1000"` // defaults to 100
+}
+
+// GetDeliveryHistoryOutput contains the delivery attempts of an output
+type GetDeliveryHistoryOutput = []*DeliveryAttempt
+
+// GetOutputsHealthInput summarizes the recent delivery attempts of outputs.
+//
+// If no outputIds are given, the health of every output is returned.
+//
+// Example:
+// {
+//     "getOutputsHealth": {
+//         "outputIds": ["7d1c5854-f3ea-491c-8a52-0aa0d58cb456"]
+//     }
+// }
+type GetOutputsHealthInput struct {
+	OutputIDs []string `json:"outputIds" validate:"omitempty,max=100,dive,uuid4"`
+}
+
+// GetOutputsHealthOutput contains the health of each output
+//
+// Example:
+// [
+//     {
+//         "outputId": "7d1c5854-f3ea-491c-8a52-0aa0d58cb456",
+//         "lastSuccess": "2020-01-01T00:00:00Z",
+//         "lastFailure": "2020-01-01T00:30:00Z",
+//         "lastErrorMessage": "request failed: 503 Service Unavailable: ",
+//         "recentAttempts": 20,
+//         "recentFailures": 5,
+//         "recentFailureRate": 0.25
+//     }
+// ]
+type GetOutputsHealthOutput = []*OutputHealth
+
+// DeliveryAttempt is the result of sending one alert to one output
+type DeliveryAttempt struct {
+	// OutputID is the output the alert was sent to
+	OutputID *string `json:"outputId" validate:"required"`
+
+	// AnalysisID is the rule or policy which triggered the alert
+	AnalysisID string `json:"analysisId" validate:"required"`
+
+	// AlertID is the ID of the alert, only set for rule alerts
+	AlertID *string `json:"alertId,omitempty"`
+
+	// Success indicates whether the alert was delivered
+	Success bool `json:"success"`
+
+	// Permanent indicates a failure will not be retried
+	Permanent bool `json:"permanent"`
+
+	// StatusCode is the HTTP status code returned by the output, if any
+	StatusCode int `json:"statusCode,omitempty"`
+
+	// LatencyMs is how long the attempt took in milliseconds
+	LatencyMs int64 `json:"latencyMs"`
+
+	// ErrorMessage is the error returned by a failed attempt
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+
+	// AttemptedAt is the time the attempt started
+	AttemptedAt time.Time `json:"attemptedAt" validate:"required"`
+}
+
+// OutputHealth summarizes the recent delivery attempts of an output
+type OutputHealth struct {
+	OutputID *string `json:"outputId"`
+
+	// LastSuccess is the time of the last successful delivery, if any in the retained history
+	LastSuccess *time.Time `json:"lastSuccess"`
+
+	// LastFailure is the time of the last failed delivery, if any in the retained history
+	LastFailure *time.Time `json:"lastFailure"`
+
+	// LastErrorMessage is the error of the last failed delivery
+	LastErrorMessage *string `json:"lastErrorMessage"`
+
+	// RecentAttempts is the number of delivery attempts in the last 24 hours
+	RecentAttempts int `json:"recentAttempts"`
+
+	// RecentFailures is the number of failed delivery attempts in the last 24 hours
+	RecentFailures int `json:"recentFailures"`
+
+	// RecentFailureRate is the ratio of failed to total delivery attempts in the last 24 hours
+	RecentFailureRate float64 `json:"recentFailureRate"`
+}
+
 // AlertOutput contains the information for alert output configuration
 type AlertOutput struct {
 
@@ -161,6 +446,12 @@ type AlertOutput struct {
 
 	// DefaultForSeverity defines the alert severities that will be forwarded through this output
 	DefaultForSeverity []*string `json:"defaultForSeverity"`
+
+	// RoutingRules further restrict the alerts that will be forwarded through this output
+	RoutingRules *RoutingRules `json:"routingRules,omitempty"`
+
+	// MessageTemplate is an optional Go text/template replacing the default message body of the output
+	MessageTemplate *string `json:"messageTemplate,omitempty"`
 }
 
 // OutputConfig contains the configuration for the output
@@ -194,6 +485,9 @@ type OutputConfig struct {
 
 	// CustomWebhook contains the configuration for a Custom Webhook alert output
 	CustomWebhook *CustomWebhookConfig `json:"customWebhook,omitempty"`
+
+	// Email contains the configuration for an Email (SMTP) alert output
+	Email *EmailConfig `json:"email,omitempty"`
 }
 
 // SlackConfig defines options for each Slack output.
@@ -253,6 +547,17 @@ type CustomWebhookConfig struct {
 	WebhookURL string `json:"webhookURL" validate:"omitempty,url"`
 }
 
+// EmailConfig defines options for each Email (SMTP) output
+type EmailConfig struct {
+	Host        string   `json:"host" validate:"omitempty,hostname|ip"`
+	Port        int      `json:"port" validate:"omitempty,min=1,max=65535"`
+	TLSMode     string   `json:"tlsMode" validate:"omitempty,oneof=starttls tls"` // defaults to starttls
+	Username    string   `json:"username"`
+	Password    string   `json:"password"`
+	FromAddress string   `json:"fromAddress" validate:"omitempty,email"`
+	ToAddresses []string `json:"toAddresses" validate:"omitempty,min=1,dive,email"`
+}
+
 // DefaultOutputs is the structure holding the information about default outputs for severity
 type DefaultOutputs struct {
 	Severity  *string   `json:"severity"`
@@ -0,0 +1,137 @@
+package models
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"strings"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
+)
+
+const (
+	// RoutingOperatorAnd requires all routing conditions to match
+	RoutingOperatorAnd = "AND"
+	// RoutingOperatorOr requires at least one routing condition to match
+	RoutingOperatorOr = "OR"
+
+	RoutingFieldAnalysisID    = "analysisId"
+	RoutingFieldTags          = "tags"
+	RoutingFieldLogTypes      = "logTypes"
+	RoutingFieldResourceTypes = "resourceTypes"
+	RoutingFieldAlertType     = "alertType"
+)
+
+// RoutingRules define which alerts are forwarded through an output, in addition to its default severities.
+//
+// Example:
+// {
+//     "operator": "AND",
+//     "conditions": [
+//         {"field": "alertType", "values": ["RULE"]},
+//         {"field": "logTypes", "values": ["AWS.CloudTrail", "AWS.VPCFlow"]}
+//     ]
+// }
+//
+// Rules without any conditions are empty: updating an output with empty rules (e.g. {}) removes its routing rules.
+type RoutingRules struct {
+	Operator   string              `json:"operator" validate:"required_with=Conditions,omitempty,oneof=AND OR"`
+	Conditions []*RoutingCondition `json:"conditions" validate:"omitempty,dive,required"`
+}
+
+// RoutingCondition matches an alert if any of the values is found in the given alert field.
+//
+// Tags are compared case-insensitively, all other fields must match exactly.
+type RoutingCondition struct {
+	Field  string   `json:"field" validate:"oneof=analysisId tags logTypes resourceTypes alertType"`
+	Values []string `json:"values" validate:"min=1,dive,required"`
+}
+
+// IsEmpty returns true if there are no routing conditions.
+func (r *RoutingRules) IsEmpty() bool {
+	return r == nil || len(r.Conditions) == 0
+}
+
+// Matches returns true if the alert satisfies the routing rules.
+func (r *RoutingRules) Matches(alert *alertmodels.Alert) bool {
+	for _, condition := range r.Conditions {
+		matched := condition.Matches(alert)
+		if r.Operator == RoutingOperatorOr && matched {
+			return true
+		}
+		if r.Operator != RoutingOperatorOr && !matched {
+			return false
+		}
+	}
+	// No condition matched for OR, every condition matched for AND
+	return r.Operator != RoutingOperatorOr
+}
+
+// Matches returns true if the alert field contains any of the condition values.
+func (c *RoutingCondition) Matches(alert *alertmodels.Alert) bool {
+	switch c.Field {
+	case RoutingFieldAnalysisID:
+		return containsAny(c.Values, []string{alert.AnalysisID}, false)
+	case RoutingFieldTags:
+		return containsAny(c.Values, alert.Tags, true)
+	case RoutingFieldLogTypes:
+		return containsAny(c.Values, alert.LogTypes, false)
+	case RoutingFieldResourceTypes:
+		return containsAny(c.Values, alert.ResourceTypes, false)
+	case RoutingFieldAlertType:
+		return containsAny(c.Values, []string{alert.Type}, false)
+	default:
+		return false
+	}
+}
+
+// ShouldRoute returns true if the alert should be delivered to this output by default,
+// i.e. when the alert does not specify its own outputs.
+//
+// The alert severity must be one of the output default severities, and the alert must satisfy the output
+// routing rules. Either check is skipped if it is not configured, but at least one of them must be.
+func (o *AlertOutput) ShouldRoute(alert *alertmodels.Alert) bool {
+	if len(o.DefaultForSeverity) == 0 && o.RoutingRules.IsEmpty() {
+		return false
+	}
+	if len(o.DefaultForSeverity) > 0 && !containsAny(stringValues(o.DefaultForSeverity), []string{alert.Severity}, false) {
+		return false
+	}
+	return o.RoutingRules.IsEmpty() || o.RoutingRules.Matches(alert)
+}
+
+func containsAny(values, candidates []string, ignoreCase bool) bool {
+	for _, value := range values {
+		for _, candidate := range candidates {
+			if value == candidate || (ignoreCase && strings.EqualFold(value, candidate)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func stringValues(values []*string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != nil {
+			result = append(result, *value)
+		}
+	}
+	return result
+}
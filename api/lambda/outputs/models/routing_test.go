@@ -0,0 +1,94 @@
+package models
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/go-playground/validator.v9"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
+)
+
+func testAlert() *alertmodels.Alert {
+	return &alertmodels.Alert{
+		AnalysisID: "AWS.CloudTrail.RootActivity",
+		Type:       alertmodels.RuleType,
+		Severity:   "HIGH",
+		Tags:       []string{"Identity & Access Management"},
+		LogTypes:   []string{"AWS.CloudTrail"},
+	}
+}
+
+func TestRoutingConditionMatches(t *testing.T) {
+	alert := testAlert()
+	assert.True(t, (&RoutingCondition{Field: RoutingFieldAnalysisID, Values: []string{"AWS.CloudTrail.RootActivity"}}).Matches(alert))
+	assert.True(t, (&RoutingCondition{Field: RoutingFieldTags, Values: []string{"identity & access management"}}).Matches(alert))
+	assert.True(t, (&RoutingCondition{Field: RoutingFieldLogTypes, Values: []string{"AWS.VPCFlow", "AWS.CloudTrail"}}).Matches(alert))
+	assert.True(t, (&RoutingCondition{Field: RoutingFieldAlertType, Values: []string{"RULE"}}).Matches(alert))
+	assert.False(t, (&RoutingCondition{Field: RoutingFieldResourceTypes, Values: []string{"AWS.S3.Bucket"}}).Matches(alert))
+	assert.False(t, (&RoutingCondition{Field: RoutingFieldLogTypes, Values: []string{"aws.cloudtrail"}}).Matches(alert))
+	assert.False(t, (&RoutingCondition{Field: "unknown", Values: []string{"AWS.CloudTrail"}}).Matches(alert))
+}
+
+func TestRoutingRulesMatches(t *testing.T) {
+	alert := testAlert()
+	matching := &RoutingCondition{Field: RoutingFieldAlertType, Values: []string{"RULE"}}
+	notMatching := &RoutingCondition{Field: RoutingFieldAlertType, Values: []string{"POLICY"}}
+
+	assert.True(t, (&RoutingRules{Operator: RoutingOperatorAnd, Conditions: []*RoutingCondition{matching, matching}}).Matches(alert))
+	assert.False(t, (&RoutingRules{Operator: RoutingOperatorAnd, Conditions: []*RoutingCondition{matching, notMatching}}).Matches(alert))
+	assert.True(t, (&RoutingRules{Operator: RoutingOperatorOr, Conditions: []*RoutingCondition{notMatching, matching}}).Matches(alert))
+	assert.False(t, (&RoutingRules{Operator: RoutingOperatorOr, Conditions: []*RoutingCondition{notMatching, notMatching}}).Matches(alert))
+}
+
+func TestRoutingRulesValidation(t *testing.T) {
+	validate := validator.New()
+	condition := &RoutingCondition{Field: RoutingFieldAlertType, Values: []string{"RULE"}}
+
+	assert.NoError(t, validate.Struct(&RoutingRules{Operator: RoutingOperatorOr, Conditions: []*RoutingCondition{condition}}))
+	// empty rules are allowed to remove the routing rules of an output
+	assert.NoError(t, validate.Struct(&RoutingRules{}))
+	assert.True(t, (&RoutingRules{}).IsEmpty())
+	assert.Error(t, validate.Struct(&RoutingRules{Conditions: []*RoutingCondition{condition}}))
+	assert.Error(t, validate.Struct(&RoutingRules{Operator: "XOR", Conditions: []*RoutingCondition{condition}}))
+	assert.Error(t, validate.Struct(&RoutingRules{Operator: RoutingOperatorAnd, Conditions: []*RoutingCondition{{Field: "unknown"}}}))
+}
+
+func TestShouldRoute(t *testing.T) {
+	alert := testAlert()
+	cloudTrailRules := &RoutingRules{
+		Operator:   RoutingOperatorAnd,
+		Conditions: []*RoutingCondition{{Field: RoutingFieldLogTypes, Values: []string{"AWS.CloudTrail"}}},
+	}
+
+	// Neither severities nor routing rules configured
+	assert.False(t, (&AlertOutput{}).ShouldRoute(alert))
+	// Only severities
+	assert.True(t, (&AlertOutput{DefaultForSeverity: aws.StringSlice([]string{"HIGH"})}).ShouldRoute(alert))
+	assert.False(t, (&AlertOutput{DefaultForSeverity: aws.StringSlice([]string{"LOW"})}).ShouldRoute(alert))
+	// Only routing rules
+	assert.True(t, (&AlertOutput{RoutingRules: cloudTrailRules}).ShouldRoute(alert))
+	assert.False(t, (&AlertOutput{RoutingRules: &RoutingRules{}}).ShouldRoute(alert))
+	// Both severities and routing rules have to match
+	assert.True(t, (&AlertOutput{DefaultForSeverity: aws.StringSlice([]string{"HIGH"}), RoutingRules: cloudTrailRules}).ShouldRoute(alert))
+	assert.False(t, (&AlertOutput{DefaultForSeverity: aws.StringSlice([]string{"LOW"}), RoutingRules: cloudTrailRules}).ShouldRoute(alert))
+}
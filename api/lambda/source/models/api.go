@@ -36,6 +36,11 @@ type LambdaInput struct {
 
 	FullScan     *FullScanInput     `json:"fullScan"`
 	UpdateStatus *UpdateStatusInput `json:"updateStatus"`
+
+	PutCustomLog    *PutCustomLogInput    `json:"putCustomLog"`
+	GetCustomLog    *GetCustomLogInput    `json:"getCustomLog"`
+	ListCustomLogs  *ListCustomLogsInput  `json:"listCustomLogs"`
+	DeleteCustomLog *DeleteCustomLogInput `json:"deleteCustomLog"`
 }
 
 //
@@ -44,8 +49,8 @@ type LambdaInput struct {
 
 // CheckIntegrationInput is used to check the health of a potential configuration.
 type CheckIntegrationInput struct {
-	AWSAccountID     *string `genericapi:"redact" json:"awsAccountId" validate:"required,len=12,numeric"`
-	IntegrationType  *string `json:"integrationType" validate:"required,oneof=aws-scan aws-s3"`
+	AWSAccountID     *string `genericapi:"redact" json:"awsAccountId" validate:"omitempty,len=12,numeric"`
+	IntegrationType  *string `json:"integrationType" validate:"required,oneof=aws-scan aws-s3 aws-kinesis gcp-scan"`
 	IntegrationLabel *string `json:"integrationLabel" validate:"required,integrationLabel"`
 
 	// Checks for cloudsec integrations
@@ -56,6 +61,13 @@ type CheckIntegrationInput struct {
 	S3Bucket *string `json:"s3Bucket,omitempty"`
 	S3Prefix *string `json:"s3Prefix,omitempty"`
 	KmsKey   *string `json:"kmsKey,omitempty"`
+
+	// Checks for kinesis integrations
+	KinesisStreamArn *string `json:"kinesisStreamArn,omitempty" validate:"omitempty,kinesisStreamArn"`
+
+	// Checks for GCP integrations
+	GCPProjectID         *string `json:"gcpProjectId,omitempty" validate:"omitempty,gcpProjectId"`
+	GCPServiceAccountKey *string `genericapi:"redact" json:"gcpServiceAccountKey,omitempty" validate:"omitempty,min=1"`
 }
 
 //
@@ -70,9 +82,9 @@ type PutIntegrationInput struct {
 
 // PutIntegrationSettings are all the settings for the new integration.
 type PutIntegrationSettings struct {
-	AWSAccountID       *string   `genericapi:"redact" json:"awsAccountId,omitempty" validate:"required,len=12,numeric"`
+	AWSAccountID       *string   `genericapi:"redact" json:"awsAccountId,omitempty" validate:"omitempty,len=12,numeric"`
 	IntegrationLabel   *string   `json:"integrationLabel,omitempty" validate:"required,integrationLabel,excludesall='<>&\""`
-	IntegrationType    *string   `json:"integrationType" validate:"required,oneof=aws-scan aws-s3"`
+	IntegrationType    *string   `json:"integrationType" validate:"required,oneof=aws-scan aws-s3 aws-kinesis gcp-scan"`
 	CWEEnabled         *bool     `json:"cweEnabled,omitempty"`
 	RemediationEnabled *bool     `json:"remediationEnabled,omitempty"`
 	ScanIntervalMins   *int      `json:"scanIntervalMins,omitempty" validate:"omitempty,oneof=60 180 360 720 1440"`
@@ -80,7 +92,14 @@ type PutIntegrationSettings struct {
 	S3Bucket           *string   `json:"s3Bucket,omitempty"`
 	S3Prefix           *string   `json:"s3Prefix,omitempty" validate:"omitempty,min=1"`
 	KmsKey             *string   `json:"kmsKey,omitempty" validate:"omitempty,kmsKeyArn"`
+	KinesisStreamArn   *string   `json:"kinesisStreamArn,omitempty" validate:"omitempty,kinesisStreamArn"`
 	LogTypes           []*string `json:"logTypes,omitempty" validate:"omitempty,min=1"`
+
+	S3PrefixLogTypes []*S3PrefixLogType `json:"s3PrefixLogTypes,omitempty" validate:"omitempty,dive,required"`
+
+	// The service account key is stored in Secrets Manager and never returned by the API
+	GCPProjectID         *string `json:"gcpProjectId,omitempty" validate:"omitempty,gcpProjectId"`
+	GCPServiceAccountKey *string `genericapi:"redact" json:"gcpServiceAccountKey,omitempty" validate:"omitempty,min=1"`
 }
 
 //
@@ -89,7 +108,7 @@ type PutIntegrationSettings struct {
 
 // ListIntegrationsInput allows filtering by the IntegrationType or Enabled fields
 type ListIntegrationsInput struct {
-	IntegrationType *string `json:"integrationType" validate:"omitempty,oneof=aws-scan aws-s3"`
+	IntegrationType *string `json:"integrationType" validate:"omitempty,oneof=aws-scan aws-s3 aws-kinesis gcp-scan"`
 }
 
 // UpdateIntegrationSettingsInput is used to update integration settings.
@@ -103,6 +122,11 @@ type UpdateIntegrationSettingsInput struct {
 	S3Prefix           *string   `json:"s3Prefix,omitempty" validate:"omitempty,min=1"`
 	KmsKey             *string   `json:"kmsKey,omitempty" validate:"omitempty,kmsKeyArn"`
 	LogTypes           []*string `json:"logTypes,omitempty" validate:"omitempty,min=1"`
+
+	S3PrefixLogTypes []*S3PrefixLogType `json:"s3PrefixLogTypes,omitempty" validate:"omitempty,dive,required"`
+
+	// Rotates the service account key of a GCP source, the stored key is kept when omitted
+	GCPServiceAccountKey *string `genericapi:"redact" json:"gcpServiceAccountKey,omitempty" validate:"omitempty,min=1"`
 }
 
 // DeleteIntegrationInput is used to delete a specific item from the database.
@@ -126,7 +150,7 @@ type FullScanInput struct {
 // GetIntegrationTemplateInput allows specification of what resources should be enabled/disabled in the template
 type GetIntegrationTemplateInput struct {
 	AWSAccountID       *string `genericapi:"redact" json:"awsAccountId" validate:"required,len=12,numeric"`
-	IntegrationType    *string `json:"integrationType" validate:"oneof=aws-scan aws-s3"`
+	IntegrationType    *string `json:"integrationType" validate:"oneof=aws-scan aws-s3 aws-kinesis"`
 	IntegrationLabel   *string `json:"integrationLabel" validate:"required,integrationLabel"`
 	RemediationEnabled *bool   `json:"remediationEnabled,omitempty"`
 	CWEEnabled         *bool   `json:"cweEnabled,omitempty"`
@@ -168,3 +192,26 @@ type UpdateStatusInput struct {
 	IntegrationID     string    `json:"integrationId" validate:"required,uuid4"`
 	LastEventReceived time.Time `json:"lastEventReceived" validate:"required"`
 }
+
+//
+// CustomLogs: Used by the UI to manage custom log schemas and by the log processor to load them
+//
+
+// PutCustomLogInput creates or updates a custom log type from its YAML schema.
+type PutCustomLogInput struct {
+	UserID  *string `json:"userId" validate:"required,uuid4"`
+	LogSpec *string `json:"logSpec" validate:"required,min=1"`
+}
+
+// GetCustomLogInput returns a single custom log type.
+type GetCustomLogInput struct {
+	LogType *string `json:"logType" validate:"required,min=1"`
+}
+
+// ListCustomLogsInput returns all custom log types.
+type ListCustomLogsInput struct{}
+
+// DeleteCustomLogInput deletes a custom log type that is not used by any source.
+type DeleteCustomLogInput struct {
+	LogType *string `json:"logType" validate:"required,min=1"`
+}
@@ -55,13 +55,27 @@ type SourceIntegrationMetadata struct {
 	S3Bucket           *string    `json:"s3Bucket,omitempty"`
 	S3Prefix           *string    `json:"s3Prefix,omitempty"`
 	KmsKey             *string    `json:"kmsKey,omitempty"`
+	KinesisStreamArn   *string    `json:"kinesisStreamArn,omitempty"`
 	LogTypes           []*string  `json:"logTypes,omitempty"`
 	LogProcessingRole  *string    `json:"logProcessingRole,omitempty"`
 	StackName          *string    `json:"stackName,omitempty"`
+	GCPProjectID       *string    `json:"gcpProjectId,omitempty"`
+
+	S3PrefixLogTypes []*S3PrefixLogType `json:"s3PrefixLogTypes,omitempty"`
+}
+
+// S3PrefixLogType routes all objects under an S3 prefix to a single log type.
+//
+// Objects matching a rule are parsed only with the parser of its log type instead of being classified.
+// If more than one rule matches an object, the rule with the longest prefix is used.
+type S3PrefixLogType struct {
+	Prefix  *string `json:"prefix" validate:"required"`
+	LogType *string `json:"logType" validate:"required,min=1"`
 }
 
 type SourceIntegrationHealth struct {
 	AWSAccountID    string `json:"awsAccountId"`
+	GCPProjectID    string `json:"gcpProjectId,omitempty"`
 	IntegrationType string `json:"integrationType"`
 
 	// Checks for cloudsec integrations
@@ -73,6 +87,13 @@ type SourceIntegrationHealth struct {
 	ProcessingRoleStatus SourceIntegrationItemStatus `json:"processingRoleStatus,omitempty"`
 	S3BucketStatus       SourceIntegrationItemStatus `json:"s3BucketStatus,omitempty"`
 	KMSKeyStatus         SourceIntegrationItemStatus `json:"kmsKeyStatus,omitempty"`
+
+	// Checks for kinesis integrations
+	KinesisStreamStatus SourceIntegrationItemStatus `json:"kinesisStreamStatus,omitempty"`
+
+	// Checks for GCP integrations
+	ServiceAccountKeyStatus SourceIntegrationItemStatus `json:"serviceAccountKeyStatus,omitempty"`
+	GCPProjectStatus        SourceIntegrationItemStatus `json:"gcpProjectStatus,omitempty"`
 }
 
 type SourceIntegrationItemStatus struct {
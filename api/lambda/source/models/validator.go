@@ -32,6 +32,7 @@ const (
 
 var (
 	integrationLabelValidatorRegex = regexp.MustCompile("^[0-9a-zA-Z- ]+$")
+	gcpProjectIDValidatorRegex     = regexp.MustCompile("^[a-z][a-z0-9-]{4,28}[a-z0-9]$")
 )
 
 // Validator builds a custom struct validator.
@@ -43,6 +44,12 @@ func Validator() (*validator.Validate, error) {
 	if err := result.RegisterValidation("kmsKeyArn", validateKmsKeyArn); err != nil {
 		return nil, err
 	}
+	if err := result.RegisterValidation("kinesisStreamArn", validateKinesisStreamArn); err != nil {
+		return nil, err
+	}
+	if err := result.RegisterValidation("gcpProjectId", validateGCPProjectID); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
@@ -66,3 +73,20 @@ func validateKmsKeyArn(fl validator.FieldLevel) bool {
 	}
 	return true
 }
+
+func validateKinesisStreamArn(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	streamArn, err := arn.Parse(value)
+	if err != nil {
+		return false
+	}
+
+	if streamArn.Service != "kinesis" || !strings.HasPrefix(streamArn.Resource, "stream/") {
+		return false
+	}
+	return true
+}
+
+func validateGCPProjectID(fl validator.FieldLevel) bool {
+	return gcpProjectIDValidatorRegex.MatchString(fl.Field().String())
+}
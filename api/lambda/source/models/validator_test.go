@@ -81,3 +81,68 @@ func TestValidateKmsKey(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestValidateNotKinesisStreamArn(t *testing.T) {
+	validator, err := Validator()
+	require.NoError(t, err)
+	err = validator.Struct(&PutIntegrationInput{
+		PutIntegrationSettings: PutIntegrationSettings{
+			AWSAccountID:     aws.String("123456789012"),
+			IntegrationLabel: aws.String("Test12- "),
+			IntegrationType:  aws.String(IntegrationTypeAWSKinesis),
+			UserID:           aws.String("cb7663c7-80ed-420b-a287-ed7dc50a0bf7"),
+			KinesisStreamArn: aws.String("arn:aws:sqs:us-west-2:123456789012:my-queue"),
+		},
+	})
+
+	errorMsg := "Key: 'PutIntegrationInput.PutIntegrationSettings.KinesisStreamArn' " +
+		"Error:Field validation for 'KinesisStreamArn' failed on the 'kinesisStreamArn' tag"
+	require.EqualError(t, err, errorMsg)
+}
+
+func TestValidateKinesisStreamArn(t *testing.T) {
+	validator, err := Validator()
+	require.NoError(t, err)
+	err = validator.Struct(&PutIntegrationInput{
+		PutIntegrationSettings: PutIntegrationSettings{
+			AWSAccountID:     aws.String("123456789012"),
+			IntegrationLabel: aws.String("Test12- "),
+			IntegrationType:  aws.String(IntegrationTypeAWSKinesis),
+			UserID:           aws.String("cb7663c7-80ed-420b-a287-ed7dc50a0bf7"),
+			KinesisStreamArn: aws.String("arn:aws:kinesis:us-west-2:123456789012:stream/my-stream"),
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestValidateGCPProjectID(t *testing.T) {
+	validator, err := Validator()
+	require.NoError(t, err)
+	err = validator.Struct(&PutIntegrationInput{
+		PutIntegrationSettings: PutIntegrationSettings{
+			IntegrationLabel:     aws.String("Test12- "),
+			IntegrationType:      aws.String(IntegrationTypeGCPScan),
+			UserID:               aws.String("cb7663c7-80ed-420b-a287-ed7dc50a0bf7"),
+			GCPProjectID:         aws.String("my-project-123"),
+			GCPServiceAccountKey: aws.String("{}"),
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestValidateNotGCPProjectID(t *testing.T) {
+	validator, err := Validator()
+	require.NoError(t, err)
+	err = validator.Struct(&PutIntegrationInput{
+		PutIntegrationSettings: PutIntegrationSettings{
+			IntegrationLabel: aws.String("Test12- "),
+			IntegrationType:  aws.String(IntegrationTypeGCPScan),
+			UserID:           aws.String("cb7663c7-80ed-420b-a287-ed7dc50a0bf7"),
+			GCPProjectID:     aws.String("My_Project"),
+		},
+	})
+
+	errorMsg := "Key: 'PutIntegrationInput.PutIntegrationSettings.GCPProjectID' " +
+		"Error:Field validation for 'GCPProjectID' failed on the 'gcpProjectId' tag"
+	require.EqualError(t, err, errorMsg)
+}
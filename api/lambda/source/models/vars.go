@@ -23,6 +23,14 @@ const (
 	IntegrationTypeAWSScan = "aws-scan"
 	// IntegrationTypeAWS3 is the integration type for importing data from customer S3 buckets.
 	IntegrationTypeAWS3 = "aws-s3"
+	// IntegrationTypeAWSKinesis is the integration type for importing data from Kinesis data streams.
+	IntegrationTypeAWSKinesis = "aws-kinesis"
+	// IntegrationTypeGCPScan is the integration type for snapshots in customer GCP projects.
+	IntegrationTypeGCPScan = "gcp-scan"
+
+	// GCPServiceAccountKeySecretPrefix prefixes the Secrets Manager secret holding the key of a gcp-scan source.
+	// The secret name is the prefix followed by the integration ID.
+	GCPServiceAccountKeySecretPrefix = "panther-gcp-scan-"
 
 	// StatusError is the string set in the database when an error occurs in a scan.
 	StatusError = "error"
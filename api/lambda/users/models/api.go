@@ -25,9 +25,20 @@ type User struct {
 	FamilyName *string `json:"familyName"`
 	GivenName  *string `json:"givenName"`
 	ID         *string `json:"id"`
+	Role       *string `json:"role"`
 	Status     *string `json:"status"`
 }
 
+// Role is a named set of permissions which can be assigned to users.
+type Role struct {
+	Name        *string  `json:"name"`
+	Description *string  `json:"description"`
+	Permissions []string `json:"permissions"`
+
+	// Built-in roles (Admin, Analyst, ReadOnly) can not be modified or deleted
+	BuiltIn *bool `json:"builtIn"`
+}
+
 // LambdaInput is the invocation event expected by the Lambda function.
 //
 // Exactly one action must be specified, see comments below for examples.
@@ -38,6 +49,12 @@ type LambdaInput struct {
 	RemoveUser        *RemoveUserInput        `json:"removeUser"`
 	ResetUserPassword *ResetUserPasswordInput `json:"resetUserPassword"`
 	UpdateUser        *UpdateUserInput        `json:"updateUser"`
+
+	BackfillUserRoles *BackfillUserRolesInput `json:"backfillUserRoles"`
+	DeleteRole        *DeleteRoleInput        `json:"deleteRole"`
+	ListRoles         *ListRolesInput         `json:"listRoles"`
+	PutRole           *PutRoleInput           `json:"putRole"`
+	UpdateUserRole    *UpdateUserRoleInput    `json:"updateUserRole"`
 }
 
 // GetUserInput retrieves a user's information based on id.
@@ -61,6 +78,7 @@ type GetUserInput struct {
 //     "familyName": "byers",
 //     "givenName": "austin",
 //     "id": "8304cc90-750d-4b8f-9a63-b90a4543c707",
+//     "role": "Analyst",
 //     "status": "FORCE_CHANGE_PASSWORD"
 // }
 type GetUserOutput = User
@@ -73,6 +91,9 @@ type InviteUserInput struct {
 
 	// RESEND or SUPPRESS the invitation message
 	MessageAction *string `json:"messageAction" validate:"omitempty,oneof=RESEND SUPPRESS"`
+
+	// The role assigned to the new user, ReadOnly by default
+	Role *string `json:"role" validate:"omitempty,roleName"`
 }
 
 // InviteUserOutput returns the new user details.
@@ -110,6 +131,7 @@ type ListUsersInput struct {
 //             "familyName": "byers",
 //             "givenName": "austin",
 //             "id": "8304cc90-750d-4b8f-9a63-b90a4543c707",
+//             "role": "Admin",
 //             "status": "FORCE_CHANGE_PASSWORD"
 //         }
 //    ]
@@ -152,3 +174,101 @@ type UpdateUserInput struct {
 
 // UpdateUserOutput returns the new Panther user details.
 type UpdateUserOutput = User
+
+// BackfillUserRolesInput assigns the Admin role to every user without a role.
+//
+// This runs once when upgrading Panther, so that the users who existed before roles keep their access.
+// Roles which are assigned in the meantime are never overwritten.
+//
+// Example:
+// {
+//     "backfillUserRoles": {}
+// }
+type BackfillUserRolesInput struct{}
+
+// BackfillUserRolesOutput returns the IDs of the users who were assigned the Admin role.
+type BackfillUserRolesOutput struct {
+	IDs []string `json:"ids"`
+}
+
+// DeleteRoleInput deletes a custom role.
+//
+// This will fail if the role is still assigned to any user.
+//
+// Example:
+// {
+//     "deleteRole": {
+//         "name": "Responder"
+//     }
+// }
+type DeleteRoleInput struct {
+	Name *string `json:"name" validate:"required,roleName"`
+}
+
+// DeleteRoleOutput returns the name of the deleted role.
+type DeleteRoleOutput struct {
+	Name *string `json:"name"`
+}
+
+// ListRolesInput lists the built-in and custom roles.
+//
+// Example:
+// {
+//     "listRoles": {}
+// }
+type ListRolesInput struct{}
+
+// ListRolesOutput returns all roles, sorted by name.
+//
+// Example:
+// {
+//     "roles": [
+//         {
+//             "name": "Admin",
+//             "description": "Full access to Panther",
+//             "permissions": ["DestinationModify", "PolicyModify", "RuleModify", "SettingsModify", "SourceModify", "UserModify"],
+//             "builtIn": true
+//         }
+//     ]
+// }
+type ListRolesOutput struct {
+	Roles []*Role `json:"roles"`
+}
+
+// PutRoleInput creates or replaces a custom role.
+//
+// Example:
+// {
+//     "putRole": {
+//         "name": "Responder",
+//         "description": "Triage alerts and manage destinations",
+//         "permissions": ["DestinationModify", "RuleModify"]
+//     }
+// }
+type PutRoleInput struct {
+	Name        *string  `json:"name" validate:"required,roleName"`
+	Description *string  `json:"description" validate:"omitempty,max=1000,excludesall='<>&\""`
+	Permissions []string `json:"permissions" validate:"dive,permission"`
+}
+
+// PutRoleOutput returns the saved role.
+type PutRoleOutput = Role
+
+// UpdateUserRoleInput assigns a built-in or custom role to a user.
+//
+// This will fail if no other user would be left with the UserModify permission.
+//
+// Example:
+// {
+//     "updateUserRole": {
+//         "id": "8304cc90-750d-4b8f-9a63-b90a4543c707",
+//         "role": "Analyst"
+//     }
+// }
+type UpdateUserRoleInput struct {
+	ID   *string `json:"id" validate:"required,uuid4"`
+	Role *string `json:"role" validate:"required,roleName"`
+}
+
+// UpdateUserRoleOutput returns the user with their new role.
+type UpdateUserRoleOutput = User
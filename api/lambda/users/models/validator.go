@@ -18,15 +18,37 @@ package models
  * along with this program.  If not, see <https://www.gnu.org/licenses/>.
  */
 
-import "gopkg.in/go-playground/validator.v9"
+import (
+	"regexp"
+
+	"gopkg.in/go-playground/validator.v9"
+
+	"github.com/panther-labs/panther/pkg/authz"
+)
+
+var roleNameRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9 _.-]{0,63}$`)
 
 // Validator builds a custom struct validator.
 func Validator() *validator.Validate {
 	result := validator.New()
 	result.RegisterStructValidation(atLeastOneUpdate, &UpdateUserInput{})
+	if err := result.RegisterValidation("permission", validatePermission); err != nil {
+		panic(err)
+	}
+	if err := result.RegisterValidation("roleName", validateRoleName); err != nil {
+		panic(err)
+	}
 	return result
 }
 
+func validatePermission(fl validator.FieldLevel) bool {
+	return authz.Has(authz.AllPermissions, authz.Permission(fl.Field().String()))
+}
+
+func validateRoleName(fl validator.FieldLevel) bool {
+	return roleNameRegex.MatchString(fl.Field().String())
+}
+
 func atLeastOneUpdate(sl validator.StructLevel) {
 	in := sl.Current().Interface().(UpdateUserInput)
 	if in.GivenName == nil && in.FamilyName == nil && in.Email == nil {
@@ -52,3 +52,31 @@ func TestUpdateUserAllFields(t *testing.T) {
 		FamilyName: aws.String("family-name"),
 	}))
 }
+
+func TestPutRoleValid(t *testing.T) {
+	assert.NoError(t, Validator().Struct(&PutRoleInput{
+		Name:        aws.String("Incident Responder"),
+		Permissions: []string{"DestinationModify", "RuleModify"},
+	}))
+}
+
+func TestPutRoleNoPermissions(t *testing.T) {
+	assert.NoError(t, Validator().Struct(&PutRoleInput{Name: aws.String("Auditor")}))
+}
+
+func TestPutRoleInvalidPermission(t *testing.T) {
+	assert.Error(t, Validator().Struct(&PutRoleInput{
+		Name:        aws.String("Responder"),
+		Permissions: []string{"RuleModify", "Everything"},
+	}))
+}
+
+func TestPutRoleInvalidName(t *testing.T) {
+	assert.Error(t, Validator().Struct(&PutRoleInput{Name: aws.String("<script>")}))
+	assert.Error(t, Validator().Struct(&PutRoleInput{Name: aws.String("")}))
+}
+
+func TestUpdateUserRoleRequired(t *testing.T) {
+	assert.Error(t, Validator().Struct(&UpdateUserRoleInput{ID: mockID}))
+	assert.NoError(t, Validator().Struct(&UpdateUserRoleInput{ID: mockID, Role: aws.String("ReadOnly")}))
+}
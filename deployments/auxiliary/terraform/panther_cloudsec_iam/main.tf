@@ -129,7 +129,9 @@ resource "aws_iam_role_policy" "panther_get_tags" {
       {
         Effect : "Allow",
         Action : [
+          "cloudfront:ListTagsForResource",
           "dynamodb:ListTagsOfResource",
+          "ecr:ListTagsForResource",
           "kms:ListResourceTags",
           "waf:ListTagsForResource",
           "waf-regional:ListTagsForResource"
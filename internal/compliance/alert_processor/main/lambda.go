@@ -21,7 +21,6 @@ package main
 import (
 	"context"
 
-	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/aws/aws-lambda-go/lambdacontext"
 	jsoniter "github.com/json-iterator/go"
@@ -41,13 +40,17 @@ func main() {
 	lambda.Start(reporterHandler)
 }
 
-func reporterHandler(ctx context.Context, event events.SQSEvent) (err error) {
+func reporterHandler(ctx context.Context, event models.LambdaInput) (err error) {
 	lc, _ := lambdalogger.ConfigureGlobal(ctx, nil)
 	operation := oplog.NewManager("cloudsec", "alert_processor").Start(lc.InvokedFunctionArn).WithMemUsed(lambdacontext.MemoryLimitInMB)
 	defer func() {
 		operation.Stop().Log(err, zap.Int("numEvents", len(event.Records)))
 	}()
 
+	if event.FlushDigests {
+		return processor.FlushDigests()
+	}
+
 	for _, record := range event.Records {
 		var input models.ComplianceNotification
 		if err = jsoniter.UnmarshalFromString(record.Body, &input); err != nil {
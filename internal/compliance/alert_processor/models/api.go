@@ -20,14 +20,28 @@ package models
 
 import (
 	"time"
+
+	"github.com/aws/aws-lambda-go/events"
 )
 
+// LambdaInput is the input to the alert processor: a batch of queued ComplianceNotifications,
+// or a scheduled request to send the alert digests whose period has ended.
+type LambdaInput struct {
+	events.SQSEvent
+
+	//FlushDigests is set by the scheduled event which sends alert digests
+	FlushDigests bool `json:"flushDigests"`
+}
+
 // ComplianceNotification represents the event sent to the AlertProcessor by the compliance engine.
 type ComplianceNotification struct {
 
 	//ResourceID is the ID specific to the resource
 	ResourceID *string `json:"resourceId" validate:"required,min=1"`
 
+	//ResourceType is the type of the resource
+	ResourceType *string `json:"resourceType,omitempty"`
+
 	//PolicyID is the id of the policy that triggered
 	PolicyID *string `json:"policyId" validate:"required,min=1"`
 
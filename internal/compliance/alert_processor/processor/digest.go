@@ -0,0 +1,300 @@
+package processor
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	alertmodel "github.com/panther-labs/panther/api/lambda/delivery/models"
+	"github.com/panther-labs/panther/internal/compliance/alert_processor/models"
+)
+
+// maxDigestResources caps the resources listed in a digest.
+//
+// Resource IDs (ARNs) can be up to 2KB long: this keeps the digest well within the 400KB Dynamo item limit
+// and the alert within the 256KB SQS message limit of the alert delivery queue.
+const maxDigestResources = 100
+
+// digestItem is a row in the digest table.
+//
+// It collects the failures of a policy until its digest period ends and a single alert is sent.
+type digestItem struct {
+	PolicyID      string   `dynamodbav:"policyId"`
+	AlertConfig   []byte   `dynamodbav:"alertConfig"`
+	ResourceIDs   []string `dynamodbav:"resourceIds,stringset"`
+	ResourceTypes []string `dynamodbav:"resourceTypes,stringset,omitempty"`
+	PeriodEndsAt  int64    `dynamodbav:"periodEndsAt"`
+	// The number of failing resources which are not listed because the digest was full
+	NumUnlisted int `dynamodbav:"numUnlisted,omitempty"`
+}
+
+// Record a failing resource in the digest of its policy and return true if it started a new digest.
+//
+// The first failure of a digest sets the alert config and the end of the digest period.
+func addToDigest(event *models.ComplianceNotification, alertConfig []byte, periodEndsAt int64) (bool, error) {
+	item := &digestItem{
+		PolicyID:     *event.PolicyID,
+		AlertConfig:  alertConfig,
+		ResourceIDs:  []string{*event.ResourceID},
+		PeriodEndsAt: periodEndsAt,
+	}
+	if aws.StringValue(event.ResourceType) != "" {
+		item.ResourceTypes = []string{*event.ResourceType}
+	}
+
+	zap.L().Debug("adding resource to alert digest",
+		zap.String("policyId", *event.PolicyID),
+		zap.String("resourceId", *event.ResourceID))
+	return mergeDigest(item)
+}
+
+// Merge resources into a digest, creating it if needed, and return true if the digest was created.
+//
+// Resources are not added to a digest which already lists maxDigestResources, they are only counted.
+func mergeDigest(item *digestItem) (bool, error) {
+	update := expression.
+		Set(expression.Name("alertConfig"),
+			expression.IfNotExists(expression.Name("alertConfig"), expression.Value(item.AlertConfig))).
+		Set(expression.Name("periodEndsAt"),
+			expression.IfNotExists(expression.Name("periodEndsAt"), expression.Value(item.PeriodEndsAt))).
+		Add(expression.Name("resourceIds"), expression.Value(stringSet(item.ResourceIDs)))
+	if len(item.ResourceTypes) > 0 {
+		update = update.Add(expression.Name("resourceTypes"), expression.Value(stringSet(item.ResourceTypes)))
+	}
+	if item.NumUnlisted > 0 {
+		update = update.Add(expression.Name("numUnlisted"), expression.Value(item.NumUnlisted))
+	}
+
+	condition := expression.AttributeNotExists(expression.Name("resourceIds")).
+		Or(expression.Size(expression.Name("resourceIds")).LessThan(expression.Value(maxDigestResources)))
+
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
+	if err != nil {
+		return false, errors.Wrapf(err, "could not build ddb expression for policy: %s", item.PolicyID)
+	}
+
+	response, err := ddbClient.UpdateItem(&dynamodb.UpdateItemInput{
+		TableName:                 aws.String(digestTable),
+		Key:                       map[string]*dynamodb.AttributeValue{"policyId": {S: aws.String(item.PolicyID)}},
+		UpdateExpression:          expr.Update(),
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		ReturnValues:              aws.String(dynamodb.ReturnValueAllOld),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			zap.L().Info("alert digest is full, resources are not listed",
+				zap.String("policyId", item.PolicyID), zap.Strings("resourceIds", item.ResourceIDs))
+			return false, countUnlisted(item)
+		}
+		return false, errors.Wrapf(err, "failed to update alert digest for policy: %s", item.PolicyID)
+	}
+	return len(response.Attributes) == 0, nil
+}
+
+// Count resources which were not added to a full digest, so the alert can report the total.
+func countUnlisted(item *digestItem) error {
+	update := expression.Add(expression.Name("numUnlisted"), expression.Value(len(item.ResourceIDs)+item.NumUnlisted))
+
+	// The digest may have been sent in the meantime
+	condition := expression.AttributeExists(expression.Name("resourceIds"))
+	if len(item.ResourceIDs) == 1 {
+		// A listed resource which fails again is not counted twice
+		condition = condition.And(expression.Not(expression.Contains(expression.Name("resourceIds"), item.ResourceIDs[0])))
+	}
+
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
+	if err != nil {
+		return errors.Wrapf(err, "could not build ddb expression for policy: %s", item.PolicyID)
+	}
+
+	_, err = ddbClient.UpdateItem(&dynamodb.UpdateItemInput{
+		TableName:                 aws.String(digestTable),
+		Key:                       map[string]*dynamodb.AttributeValue{"policyId": {S: aws.String(item.PolicyID)}},
+		UpdateExpression:          expr.Update(),
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to count unlisted resources in alert digest for policy: %s", item.PolicyID)
+	}
+	return nil
+}
+
+// FlushDigests sends a single alert for every digest whose period has ended
+func FlushDigests() error {
+	now := time.Now().Unix()
+	filter := expression.Name("periodEndsAt").LessThanEqual(expression.Value(now))
+	expr, err := expression.NewBuilder().
+		WithFilter(filter).
+		WithProjection(expression.NamesList(expression.Name("policyId"))).
+		Build()
+	if err != nil {
+		return errors.Wrap(err, "could not build ddb expression")
+	}
+
+	input := &dynamodb.ScanInput{
+		TableName:                 aws.String(digestTable),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		FilterExpression:          expr.Filter(),
+		ProjectionExpression:      expr.Projection(),
+	}
+	var policyIDs []string
+	for {
+		page, err := ddbClient.Scan(input)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan alert digests")
+		}
+
+		var items []*digestItem
+		if err = dynamodbattribute.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			return errors.Wrap(err, "failed to unmarshal alert digests")
+		}
+		for _, item := range items {
+			policyIDs = append(policyIDs, item.PolicyID)
+		}
+
+		if len(page.LastEvaluatedKey) == 0 {
+			break
+		}
+		input.ExclusiveStartKey = page.LastEvaluatedKey
+	}
+
+	// A digest which can't be sent must not hold back the others
+	zap.L().Debug("flushing alert digests", zap.Int("count", len(policyIDs)))
+	var firstErr error
+	failed := 0
+	for _, policyID := range policyIDs {
+		if err = flushDigest(policyID, now); err != nil {
+			zap.L().Error("failed to flush alert digest", zap.String("policyId", policyID), zap.Error(err))
+			if firstErr == nil {
+				firstErr = err
+			}
+			failed++
+		}
+	}
+	if firstErr != nil {
+		return errors.Wrapf(firstErr, "failed to flush %d of %d alert digests, first error", failed, len(policyIDs))
+	}
+	return nil
+}
+
+// Remove a digest from the digest table and send its alert.
+//
+// The digest is removed before the alert is sent so failures recorded in the meantime start a new digest
+// instead of being dropped. If the alert can't be sent, the resources are merged back into the table.
+func flushDigest(policyID string, now int64) error {
+	condition := expression.Name("periodEndsAt").LessThanEqual(expression.Value(now))
+	expr, err := expression.NewBuilder().WithCondition(condition).Build()
+	if err != nil {
+		return errors.Wrapf(err, "could not build ddb expression for policy: %s", policyID)
+	}
+
+	response, err := ddbClient.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName:                 aws.String(digestTable),
+		Key:                       map[string]*dynamodb.AttributeValue{"policyId": {S: aws.String(policyID)}},
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		ReturnValues:              aws.String(dynamodb.ReturnValueAllOld),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			zap.L().Debug("alert digest was already sent", zap.String("policyId", policyID))
+			return nil
+		}
+		return errors.Wrapf(err, "failed to remove alert digest for policy: %s", policyID)
+	}
+
+	var item digestItem
+	if err = dynamodbattribute.UnmarshalMap(response.Attributes, &item); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal alert digest for policy: %s", policyID)
+	}
+
+	if err = sendDigest(&item); err != nil {
+		if _, mergeErr := mergeDigest(&item); mergeErr != nil {
+			zap.L().Error("failed to restore alert digest", zap.String("policyId", policyID), zap.Error(mergeErr))
+		}
+		return err
+	}
+	return nil
+}
+
+// Send the alert for a digest through the alert forwarder table
+func sendDigest(item *digestItem) error {
+	var alert alertmodel.Alert
+	if err := jsoniter.Unmarshal(item.AlertConfig, &alert); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal alerting config for policy %s", item.PolicyID)
+	}
+	alert.ResourceIDs = item.ResourceIDs
+	alert.ResourceTypes = item.ResourceTypes
+	alert.NumResources = len(item.ResourceIDs) + item.NumUnlisted
+	sort.Strings(alert.ResourceIDs)
+	sort.Strings(alert.ResourceTypes)
+
+	marshalledAlertConfig, err := jsoniter.Marshal(&alert)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal alerting config for policy %s", item.PolicyID)
+	}
+
+	timeNow := time.Now().Unix()
+	update := expression.
+		Set(expression.Name("lastUpdated"), expression.Value(aws.Int64(timeNow))).
+		Set(expression.Name("alertConfig"), expression.Value(marshalledAlertConfig)).
+		Set(expression.Name("expiresAt"), expression.Value(timeNow+int64(alertSuppressPeriod)))
+	expr, err := expression.NewBuilder().WithUpdate(update).Build()
+	if err != nil {
+		return errors.Wrapf(err, "could not build ddb expression for policy: %s", item.PolicyID)
+	}
+
+	zap.L().Info("sending alert digest",
+		zap.String("policyId", item.PolicyID),
+		zap.Int("numResources", alert.NumResources))
+	_, err = ddbClient.UpdateItem(&dynamodb.UpdateItemInput{
+		TableName:                 aws.String(ddbTable),
+		Key:                       map[string]*dynamodb.AttributeValue{"policyId": {S: aws.String(item.PolicyID)}},
+		UpdateExpression:          expr.Update(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "experienced issue while updating ddb table for policy: %s", item.PolicyID)
+	}
+	return nil
+}
+
+func stringSet(values []string) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{SS: aws.StringSlice(values)}
+}
@@ -0,0 +1,249 @@
+package processor
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	analysismodels "github.com/panther-labs/panther/api/gateway/analysis/models"
+	compliancemodels "github.com/panther-labs/panther/api/gateway/compliance/models"
+	alertmodel "github.com/panther-labs/panther/api/lambda/delivery/models"
+	"github.com/panther-labs/panther/internal/compliance/alert_processor/models"
+	"github.com/panther-labs/panther/pkg/testutils"
+)
+
+func TestHandleEventWithDigest(t *testing.T) {
+	mockDdbClient := &testutils.DynamoDBMock{}
+	ddbClient = mockDdbClient
+	mockRoundTripper := &mockRoundTripper{}
+	httpClient = &http.Client{Transport: mockRoundTripper}
+	digestTable = "digestTable"
+
+	input := &models.ComplianceNotification{
+		ResourceID:      aws.String("test-resource"),
+		ResourceType:    aws.String("AWS.S3.Test"),
+		PolicyID:        aws.String("test-policy"),
+		PolicyVersionID: aws.String("test-version"),
+		ShouldAlert:     aws.Bool(true),
+		Timestamp:       aws.Time(time.Now()),
+	}
+
+	complianceResponse := &compliancemodels.ComplianceStatus{
+		LastUpdated:    compliancemodels.LastUpdated(time.Now()),
+		PolicyID:       "test-policy",
+		PolicySeverity: "INFO",
+		ResourceID:     "test-resource",
+		ResourceType:   "AWS.S3.Test",
+		Status:         compliancemodels.StatusFAIL,
+		Suppressed:     false,
+	}
+
+	policyResponse := &analysismodels.Policy{DigestPeriodMinutes: 60}
+
+	// mock call to compliance-api
+	mockRoundTripper.On("RoundTrip", mock.Anything).Return(generateResponse(complianceResponse, http.StatusOK), nil).Once()
+	// mock call to analysis-api
+	mockRoundTripper.On("RoundTrip", mock.Anything).Return(generateResponse(policyResponse, http.StatusOK), nil).Once()
+	mockDdbClient.On("UpdateItem", mock.Anything).Return(&dynamodb.UpdateItemOutput{}, nil).Once()
+
+	require.NoError(t, Handle(input))
+
+	// The failure is added to the digest instead of the alert forwarder table
+	updateInput := mockDdbClient.Calls[0].Arguments[0].(*dynamodb.UpdateItemInput)
+	assert.Equal(t, "digestTable", *updateInput.TableName)
+	assert.Equal(t, "test-policy", *updateInput.Key["policyId"].S)
+	var sets [][]*string
+	for _, value := range updateInput.ExpressionAttributeValues {
+		if value.SS != nil {
+			sets = append(sets, value.SS)
+		}
+	}
+	assert.ElementsMatch(t, [][]*string{{aws.String("test-resource")}, {aws.String("AWS.S3.Test")}}, sets)
+
+	mockDdbClient.AssertExpectations(t)
+	mockRoundTripper.AssertExpectations(t)
+}
+
+func TestHandleEventWithExistingDigest(t *testing.T) {
+	mockDdbClient := &testutils.DynamoDBMock{}
+	ddbClient = mockDdbClient
+	mockRoundTripper := &mockRoundTripper{}
+	httpClient = &http.Client{Transport: mockRoundTripper}
+	digestTable = "digestTable"
+
+	input := &models.ComplianceNotification{
+		ResourceID:      aws.String("test-resource"),
+		PolicyID:        aws.String("test-policy"),
+		PolicyVersionID: aws.String("test-version"),
+		ShouldAlert:     aws.Bool(true),
+		Timestamp:       aws.Time(time.Now()),
+	}
+	complianceResponse := &compliancemodels.ComplianceStatus{
+		LastUpdated:    compliancemodels.LastUpdated(time.Now()),
+		PolicyID:       "test-policy",
+		PolicySeverity: "INFO",
+		ResourceID:     "test-resource",
+		ResourceType:   "AWS.S3.Test",
+		Status:         compliancemodels.StatusFAIL,
+	}
+	policyResponse := &analysismodels.Policy{DigestPeriodMinutes: 60, AutoRemediationID: "fix-bucket"}
+
+	mockRoundTripper.On("RoundTrip", mock.Anything).Return(generateResponse(complianceResponse, http.StatusOK), nil).Once()
+	mockRoundTripper.On("RoundTrip", mock.Anything).Return(generateResponse(policyResponse, http.StatusOK), nil).Once()
+	// The digest already exists: the failure which started it triggered the remediation
+	mockDdbClient.On("UpdateItem", mock.Anything).Return(&dynamodb.UpdateItemOutput{
+		Attributes: map[string]*dynamodb.AttributeValue{"policyId": {S: aws.String("test-policy")}},
+	}, nil).Once()
+
+	require.NoError(t, Handle(input))
+	mockDdbClient.AssertExpectations(t)
+	mockRoundTripper.AssertExpectations(t)
+}
+
+func TestAddToDigestFull(t *testing.T) {
+	mockDdbClient := &testutils.DynamoDBMock{}
+	ddbClient = mockDdbClient
+	mockDdbClient.On("UpdateItem", mock.Anything).Return(&dynamodb.UpdateItemOutput{},
+		awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "", nil)).Once()
+	mockDdbClient.On("UpdateItem", mock.Anything).Return(&dynamodb.UpdateItemOutput{}, nil).Once()
+
+	created, err := addToDigest(&models.ComplianceNotification{
+		PolicyID:   aws.String("test-policy"),
+		ResourceID: aws.String("test-resource"),
+	}, []byte("{}"), 1)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	updateInput := mockDdbClient.Calls[0].Arguments[0].(*dynamodb.UpdateItemInput)
+	assert.Contains(t, *updateInput.ConditionExpression, "size (")
+
+	// The resource is counted instead, unless it is already listed
+	updateInput = mockDdbClient.Calls[1].Arguments[0].(*dynamodb.UpdateItemInput)
+	assert.Contains(t, *updateInput.UpdateExpression, "ADD")
+	assert.NotContains(t, *updateInput.UpdateExpression, "SET")
+	assert.Contains(t, *updateInput.ConditionExpression, "contains (")
+	mockDdbClient.AssertExpectations(t)
+}
+
+func TestFlushDigests(t *testing.T) {
+	mockDdbClient := &testutils.DynamoDBMock{}
+	ddbClient = mockDdbClient
+	ddbTable = "forwarderTable"
+	digestTable = "digestTable"
+
+	alertConfig, err := jsoniter.Marshal(&alertmodel.Alert{
+		AnalysisID: "test-policy",
+		Severity:   "INFO",
+		Type:       alertmodel.PolicyType,
+	})
+	require.NoError(t, err)
+
+	mockDdbClient.On("Scan", mock.Anything).Return(&dynamodb.ScanOutput{
+		Items: []map[string]*dynamodb.AttributeValue{{"policyId": {S: aws.String("test-policy")}}},
+	}, nil)
+	mockDdbClient.On("DeleteItem", mock.Anything).Return(&dynamodb.DeleteItemOutput{
+		Attributes: map[string]*dynamodb.AttributeValue{
+			"policyId":     {S: aws.String("test-policy")},
+			"alertConfig":  {B: alertConfig},
+			"resourceIds":  {SS: aws.StringSlice([]string{"resource-b", "resource-a"})},
+			"periodEndsAt": {N: aws.String("1")},
+			"numUnlisted":  {N: aws.String("3")},
+		},
+	}, nil)
+	mockDdbClient.On("UpdateItem", mock.Anything).Return(&dynamodb.UpdateItemOutput{}, nil)
+
+	require.NoError(t, FlushDigests())
+
+	// The digest alert lists the failing resources and counts the unlisted ones
+	updateInput := mockDdbClient.Calls[2].Arguments[0].(*dynamodb.UpdateItemInput)
+	assert.Equal(t, "forwarderTable", *updateInput.TableName)
+	var alert alertmodel.Alert
+	for _, value := range updateInput.ExpressionAttributeValues {
+		if value.B != nil {
+			require.NoError(t, jsoniter.Unmarshal(value.B, &alert))
+		}
+	}
+	assert.Equal(t, "test-policy", alert.AnalysisID)
+	assert.Equal(t, []string{"resource-a", "resource-b"}, alert.ResourceIDs)
+	assert.Equal(t, 5, alert.NumResources)
+
+	mockDdbClient.AssertExpectations(t)
+}
+
+func TestFlushDigestsAlreadySent(t *testing.T) {
+	mockDdbClient := &testutils.DynamoDBMock{}
+	ddbClient = mockDdbClient
+
+	mockDdbClient.On("Scan", mock.Anything).Return(&dynamodb.ScanOutput{
+		Items: []map[string]*dynamodb.AttributeValue{{"policyId": {S: aws.String("test-policy")}}},
+	}, nil)
+	mockDdbClient.On("DeleteItem", mock.Anything).Return(&dynamodb.DeleteItemOutput{},
+		awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "", nil))
+
+	// No alert is sent twice
+	require.NoError(t, FlushDigests())
+	mockDdbClient.AssertExpectations(t)
+}
+
+func TestFlushDigestsContinuesAfterError(t *testing.T) {
+	mockDdbClient := &testutils.DynamoDBMock{}
+	ddbClient = mockDdbClient
+
+	alertConfig, err := jsoniter.Marshal(&alertmodel.Alert{AnalysisID: "ok-policy", Type: alertmodel.PolicyType})
+	require.NoError(t, err)
+
+	mockDdbClient.On("Scan", mock.Anything).Return(&dynamodb.ScanOutput{
+		Items: []map[string]*dynamodb.AttributeValue{
+			{"policyId": {S: aws.String("broken-policy")}},
+			{"policyId": {S: aws.String("ok-policy")}},
+		},
+	}, nil)
+	isPolicy := func(policyID string) interface{} {
+		return mock.MatchedBy(func(input *dynamodb.DeleteItemInput) bool {
+			return *input.Key["policyId"].S == policyID
+		})
+	}
+	mockDdbClient.On("DeleteItem", isPolicy("broken-policy")).Return(&dynamodb.DeleteItemOutput{},
+		awserr.New(dynamodb.ErrCodeProvisionedThroughputExceededException, "throttled", nil))
+	mockDdbClient.On("DeleteItem", isPolicy("ok-policy")).Return(&dynamodb.DeleteItemOutput{
+		Attributes: map[string]*dynamodb.AttributeValue{
+			"policyId":     {S: aws.String("ok-policy")},
+			"alertConfig":  {B: alertConfig},
+			"resourceIds":  {SS: aws.StringSlice([]string{"resource-a"})},
+			"periodEndsAt": {N: aws.String("1")},
+		},
+	}, nil)
+	mockDdbClient.On("UpdateItem", mock.Anything).Return(&dynamodb.UpdateItemOutput{}, nil).Once()
+
+	// The second digest is sent even though the first one failed
+	err = FlushDigests()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to flush 1 of 2 alert digests")
+	mockDdbClient.AssertExpectations(t)
+}
@@ -34,14 +34,15 @@ import (
 
 	analysisclient "github.com/panther-labs/panther/api/gateway/analysis/client"
 	analysisoperations "github.com/panther-labs/panther/api/gateway/analysis/client/operations"
+	analysismodels "github.com/panther-labs/panther/api/gateway/analysis/models"
 	complianceclient "github.com/panther-labs/panther/api/gateway/compliance/client"
 	complianceoperations "github.com/panther-labs/panther/api/gateway/compliance/client/operations"
 	compliancemodels "github.com/panther-labs/panther/api/gateway/compliance/models"
 	remediationclient "github.com/panther-labs/panther/api/gateway/remediation/client"
 	remediationoperations "github.com/panther-labs/panther/api/gateway/remediation/client/operations"
 	remediationmodels "github.com/panther-labs/panther/api/gateway/remediation/models"
+	alertmodel "github.com/panther-labs/panther/api/lambda/delivery/models"
 	"github.com/panther-labs/panther/internal/compliance/alert_processor/models"
-	alertmodel "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 	"github.com/panther-labs/panther/pkg/gatewayapi"
 )
 
@@ -55,7 +56,8 @@ var (
 	policyServiceHost      = os.Getenv("POLICY_SERVICE_HOST")
 	policyServicePath      = os.Getenv("POLICY_SERVICE_PATH")
 
-	ddbTable = os.Getenv("TABLE_NAME")
+	ddbTable    = os.Getenv("TABLE_NAME")
+	digestTable = os.Getenv("DIGEST_TABLE")
 
 	awsSession                           = session.Must(session.NewSession())
 	ddbClient  dynamodbiface.DynamoDBAPI = dynamodb.New(awsSession)
@@ -142,17 +144,28 @@ func triggerAlert(event *models.ComplianceNotification) (canRemediate bool, err
 	timeNow := time.Now().Unix()
 	expiresAt := int64(alertSuppressPeriod) + timeNow
 
-	var alertConfig *alertmodel.Alert
-	alertConfig, canRemediate, err = getAlertConfigPolicy(event)
+	alertConfig, policy, err := getAlertConfigPolicy(event)
 	if err != nil {
 		return false, errors.Wrapf(err, "encountered issue when getting policy: %s", *event.PolicyID)
 	}
+	canRemediate = policy.AutoRemediationID != ""
 
 	marshalledAlertConfig, err := jsoniter.Marshal(alertConfig)
 	if err != nil {
 		return false, errors.Wrapf(err, "failed to marshal alerting config for policy %s", *event.PolicyID)
 	}
 
+	// Policies with a digest period group their failures into a single alert, sent by FlushDigests.
+	// Like alerts, remediations are only triggered once per digest: by the failure which started it.
+	if policy.DigestPeriodMinutes > 0 {
+		periodEndsAt := timeNow + int64(policy.DigestPeriodMinutes)*60
+		created, err := addToDigest(event, marshalledAlertConfig, periodEndsAt)
+		if err != nil {
+			return false, err
+		}
+		return canRemediate && created, nil
+	}
+
 	updateExpression := expression.
 		Set(expression.Name("lastUpdated"), expression.Value(aws.Int64(timeNow))).
 		Set(expression.Name("alertConfig"), expression.Value(marshalledAlertConfig)).
@@ -219,27 +232,30 @@ func triggerRemediation(event *models.ComplianceNotification) error {
 	return nil
 }
 
-func getAlertConfigPolicy(event *models.ComplianceNotification) (*alertmodel.Alert, bool, error) {
+func getAlertConfigPolicy(event *models.ComplianceNotification) (*alertmodel.Alert, *analysismodels.Policy, error) {
 	policy, err := policyClient.Operations.GetPolicy(&analysisoperations.GetPolicyParams{
 		PolicyID:   *event.PolicyID,
 		HTTPClient: httpClient,
 	})
 
 	if err != nil {
-		return nil, false, err
-	}
-
-	return &alertmodel.Alert{
-			CreatedAt:           *event.Timestamp,
-			AnalysisDescription: aws.String(string(policy.Payload.Description)),
-			AnalysisID:          *event.PolicyID,
-			AnalysisName:        aws.String(string(policy.Payload.DisplayName)),
-			Version:             event.PolicyVersionID,
-			Runbook:             aws.String(string(policy.Payload.Runbook)),
-			Severity:            string(policy.Payload.Severity),
-			Tags:                policy.Payload.Tags,
-			Type:                alertmodel.PolicyType,
-		},
-		policy.Payload.AutoRemediationID != "", // means we can remediate
-		nil
+		return nil, nil, err
+	}
+
+	alert := &alertmodel.Alert{
+		CreatedAt:           *event.Timestamp,
+		AnalysisDescription: aws.String(string(policy.Payload.Description)),
+		AnalysisID:          *event.PolicyID,
+		AnalysisName:        aws.String(string(policy.Payload.DisplayName)),
+		Version:             event.PolicyVersionID,
+		Runbook:             aws.String(string(policy.Payload.Runbook)),
+		Severity:            string(policy.Payload.Severity),
+		Tags:                policy.Payload.Tags,
+		Type:                alertmodel.PolicyType,
+	}
+	if aws.StringValue(event.ResourceType) != "" {
+		alert.ResourceTypes = []string{*event.ResourceType}
+	}
+
+	return alert, policy.Payload, nil
 }
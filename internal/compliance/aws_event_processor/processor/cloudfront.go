@@ -0,0 +1,89 @@
+package processor
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	schemas "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+)
+
+// CloudFront event names carry the API version as a suffix, e.g. UpdateDistribution2019_03_26
+var cloudFrontVersionRegex = regexp.MustCompile(`\d{4}_\d{2}_\d{2}$`)
+
+func classifyCloudFront(detail gjson.Result, metadata *CloudTrailMetadata) []*resourceChange {
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazoncloudfront.html
+	cloudFrontARN := arn.ARN{
+		Partition: "aws",
+		Service:   "cloudfront",
+		AccountID: metadata.accountID,
+		Resource:  "distribution/",
+	}
+	eventName := cloudFrontVersionRegex.ReplaceAllString(metadata.eventName, "")
+	switch eventName {
+	case "CreateDistribution", "CreateDistributionWithTags":
+		cloudFrontARN.Resource += detail.Get("responseElements.distribution.id").Str
+	case "DeleteDistribution", "UpdateDistribution":
+		cloudFrontARN.Resource += detail.Get("requestParameters.id").Str
+	case "TagResource", "UntagResource":
+		var err error
+		cloudFrontARN, err = arn.Parse(detail.Get("requestParameters.resource").Str)
+		if err != nil {
+			zap.L().Error("cloudfront: error parsing ARN", zap.String("eventName", metadata.eventName), zap.Error(err))
+			return nil
+		}
+		if !strings.HasPrefix(cloudFrontARN.Resource, "distribution/") {
+			// Streaming distributions are not tracked
+			return nil
+		}
+	case "CreateCloudFrontOriginAccessIdentity",
+		"CreateInvalidation",
+		"CreateStreamingDistribution",
+		"CreateStreamingDistributionWithTags",
+		"DeleteCloudFrontOriginAccessIdentity",
+		"DeleteStreamingDistribution",
+		"UpdateCloudFrontOriginAccessIdentity",
+		"UpdateStreamingDistribution":
+		// Normally we would add these as ignored events in process.go to save time, but then we would not have the
+		// version suffix stripping logic applied which we need
+		return nil
+	default:
+		zap.L().Info("cloudfront: encountered unknown event name", zap.String("eventName", metadata.eventName))
+		return nil
+	}
+
+	if cloudFrontARN.Resource == "distribution/" {
+		zap.L().Error("cloudfront: known event name, but failed to parse distribution ID",
+			zap.String("eventName", metadata.eventName))
+		return nil
+	}
+
+	return []*resourceChange{{
+		AwsAccountID: metadata.accountID,
+		Delete:       eventName == "DeleteDistribution",
+		EventName:    metadata.eventName,
+		ResourceID:   cloudFrontARN.String(),
+		ResourceType: schemas.CloudFrontDistributionSchema,
+	}}
+}
@@ -0,0 +1,50 @@
+package processor
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	schemas "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+)
+
+func TestClassifyCloudFront(t *testing.T) {
+	detail := gjson.Parse(`{"requestParameters": {"id": "EDFDVBD632BHDS5"}}`)
+	metadata := &CloudTrailMetadata{region: "us-east-1", accountID: "111111111111", eventName: "UpdateDistribution2019_03_26"}
+
+	changes := classifyCloudFront(detail, metadata)
+	require.Len(t, changes, 1)
+	assert.Equal(t, &resourceChange{
+		AwsAccountID: "111111111111",
+		EventName:    "UpdateDistribution2019_03_26",
+		ResourceID:   "arn:aws:cloudfront::111111111111:distribution/EDFDVBD632BHDS5",
+		ResourceType: schemas.CloudFrontDistributionSchema,
+	}, changes[0])
+}
+
+func TestClassifyCloudFrontIgnored(t *testing.T) {
+	detail := gjson.Parse(`{"requestParameters": {"distributionId": "EDFDVBD632BHDS5"}}`)
+	metadata := &CloudTrailMetadata{region: "us-east-1", accountID: "111111111111", eventName: "CreateInvalidation2019_03_26"}
+
+	assert.Empty(t, classifyCloudFront(detail, metadata))
+}
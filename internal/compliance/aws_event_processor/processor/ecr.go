@@ -0,0 +1,70 @@
+package processor
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	schemas "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+)
+
+func classifyECR(detail gjson.Result, metadata *CloudTrailMetadata) []*resourceChange {
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazonelasticcontainerregistry.html
+	ecrARN := arn.ARN{
+		Partition: "aws",
+		Service:   "ecr",
+		Region:    metadata.region,
+		AccountID: metadata.accountID,
+		Resource:  "repository/",
+	}
+	switch metadata.eventName {
+	case "CreateRepository",
+		"DeleteRepository",
+		"DeleteRepositoryPolicy",
+		"PutImageScanningConfiguration",
+		"PutImageTagMutability",
+		"SetRepositoryPolicy":
+		ecrARN.Resource += detail.Get("requestParameters.repositoryName").Str
+	case "TagResource", "UntagResource":
+		var err error
+		ecrARN, err = arn.Parse(detail.Get("requestParameters.resourceArn").Str)
+		if err != nil {
+			zap.L().Error("ecr: error parsing ARN", zap.String("eventName", metadata.eventName), zap.Error(err))
+			return nil
+		}
+	default:
+		zap.L().Info("ecr: encountered unknown event name", zap.String("eventName", metadata.eventName))
+		return nil
+	}
+
+	if ecrARN.Resource == "repository/" {
+		zap.L().Error("ecr: known event name, but failed to parse repository name", zap.String("eventName", metadata.eventName))
+		return nil
+	}
+
+	return []*resourceChange{{
+		AwsAccountID: metadata.accountID,
+		Delete:       metadata.eventName == "DeleteRepository",
+		EventName:    metadata.eventName,
+		ResourceID:   ecrARN.String(),
+		ResourceType: schemas.EcrRepositorySchema,
+	}}
+}
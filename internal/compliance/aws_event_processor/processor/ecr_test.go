@@ -0,0 +1,65 @@
+package processor
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	schemas "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+)
+
+func TestClassifyECRCreateRepository(t *testing.T) {
+	detail := gjson.Parse(`{"requestParameters": {"repositoryName": "my-repo"}}`)
+	metadata := &CloudTrailMetadata{region: "us-west-2", accountID: "111111111111", eventName: "CreateRepository"}
+
+	changes := classifyECR(detail, metadata)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "arn:aws:ecr:us-west-2:111111111111:repository/my-repo", changes[0].ResourceID)
+	assert.Equal(t, schemas.EcrRepositorySchema, changes[0].ResourceType)
+	assert.False(t, changes[0].Delete)
+}
+
+func TestClassifyECRDeleteRepository(t *testing.T) {
+	detail := gjson.Parse(`{"requestParameters": {"repositoryName": "my-repo"}}`)
+	metadata := &CloudTrailMetadata{region: "us-west-2", accountID: "111111111111", eventName: "DeleteRepository"}
+
+	changes := classifyECR(detail, metadata)
+	require.Len(t, changes, 1)
+	assert.True(t, changes[0].Delete)
+}
+
+func TestClassifyECRTagResource(t *testing.T) {
+	detail := gjson.Parse(`{"requestParameters": {"resourceArn": "arn:aws:ecr:us-west-2:111111111111:repository/my-repo"}}`)
+	metadata := &CloudTrailMetadata{region: "us-west-2", accountID: "111111111111", eventName: "TagResource"}
+
+	changes := classifyECR(detail, metadata)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "arn:aws:ecr:us-west-2:111111111111:repository/my-repo", changes[0].ResourceID)
+}
+
+func TestClassifyECRMissingRepositoryName(t *testing.T) {
+	detail := gjson.Parse(`{"requestParameters": {}}`)
+	metadata := &CloudTrailMetadata{region: "us-west-2", accountID: "111111111111", eventName: "SetRepositoryPolicy"}
+
+	assert.Nil(t, classifyECR(detail, metadata))
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/go-openapi/strfmt"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
@@ -269,6 +270,7 @@ func submitChanges(changes map[string]*resourceChange) error {
 			// we set a delay it will be a fairly uniform delay.
 			requestsByDelay[change.Delay].Entries = append(requestsByDelay[change.Delay].Entries, &poller.ScanEntry{
 				AWSAccountID:     &change.AwsAccountID,
+				ChangeEvent:      changeEvent(change),
 				IntegrationID:    &change.IntegrationID,
 				Region:           region,
 				ResourceID:       resourceID,
@@ -313,3 +315,15 @@ func submitChanges(changes map[string]*resourceChange) error {
 
 	return nil
 }
+
+// changeEvent summarizes the CloudTrail event which triggered a resource scan, to be recorded in the resource history.
+func changeEvent(change *resourceChange) *api.ChangeEvent {
+	result := &api.ChangeEvent{
+		EventID:   change.EventID,
+		EventName: change.EventName,
+	}
+	if eventTime, err := strfmt.ParseDateTime(change.EventTime); err == nil {
+		result.EventTime = eventTime
+	}
+	return result
+}
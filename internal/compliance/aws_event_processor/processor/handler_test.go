@@ -33,12 +33,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/go-openapi/strfmt"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	api "github.com/panther-labs/panther/api/gateway/resources/models"
 	schemas "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
 	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/poller"
 	"github.com/panther-labs/panther/pkg/testutils"
@@ -297,10 +299,17 @@ func TestHandleUpdate(t *testing.T) {
 
 	queueURL = "poller-queue"
 	mockSqsClient := &mockSqs{}
+	eventTime, err := strfmt.ParseDateTime("2019-08-01T04:41:47Z")
+	require.NoError(t, err)
 	expectedRequest := poller.ScanMsg{
 		Entries: []*poller.ScanEntry{
 			{
-				AWSAccountID:     aws.String("111111111111"),
+				AWSAccountID: aws.String("111111111111"),
+				ChangeEvent: &api.ChangeEvent{
+					EventID:   "43258a7e-eef1-44ef-9aff-1e5b4cfd825d",
+					EventName: "PutBucketPublicAccessBlock",
+					EventTime: eventTime,
+				},
 				IntegrationID:    aws.String("ebb4d69f-177b-4eff-a7a6-9251fdc72d21"),
 				ResourceID:       aws.String("arn:aws:s3:::austin-panther"),
 				ResourceType:     aws.String(schemas.S3BucketSchema),
@@ -350,6 +359,7 @@ func TestHandleUpdate(t *testing.T) {
 
 	expectedChange := &resourceChange{
 		AwsAccountID:  "111111111111",
+		EventID:       "43258a7e-eef1-44ef-9aff-1e5b4cfd825d",
 		EventName:     "PutBucketPublicAccessBlock",
 		EventTime:     "2019-08-01T04:41:47Z",
 		IntegrationID: "ebb4d69f-177b-4eff-a7a6-9251fdc72d21",
@@ -31,7 +31,8 @@ type resourceChange struct {
 	AwsAccountID  string `json:"awsAccountId"`  // the 12-digit AWS account ID which owns the resource
 	Delay         int64  `json:"delay"`         // How long in seconds to delay this message in SQS
 	Delete        bool   `json:"delete"`        // True if the resource should be marked deleted (otherwise, update)
-	EventName     string `json:"eventName"`     // CloudTrail event name (for logging only)
+	EventID       string `json:"eventId"`       // CloudTrail event ID (recorded in the resource history)
+	EventName     string `json:"eventName"`     // CloudTrail event name (recorded in the resource history)
 	EventTime     string `json:"eventTime"`     // official CloudTrail RFC3339 timestamp
 	IntegrationID string `json:"integrationId"` // account integration ID
 	Region        string `json:"region"`        // Region (for resource type scans only)
@@ -47,11 +48,14 @@ var (
 	classifiers = map[string]func(gjson.Result, *CloudTrailMetadata) []*resourceChange{
 		"acm.amazonaws.com":                  classifyACM,
 		"cloudformation.amazonaws.com":       classifyCloudFormation,
+		"cloudfront.amazonaws.com":           classifyCloudFront,
 		"cloudtrail.amazonaws.com":           classifyCloudTrail,
 		"config.amazonaws.com":               classifyConfig,
 		"dynamodb.amazonaws.com":             classifyDynamoDB,
 		"ec2.amazonaws.com":                  classifyEC2,
+		"ecr.amazonaws.com":                  classifyECR,
 		"ecs.amazonaws.com":                  classifyECS,
+		"eks.amazonaws.com":                  classifyEKS,
 		"elasticloadbalancing.amazonaws.com": classifyELBV2,
 		"guardduty.amazonaws.com":            classifyGuardDuty,
 		"iam.amazonaws.com":                  classifyIAM,
@@ -61,6 +65,9 @@ var (
 		"rds.amazonaws.com":                  classifyRDS,
 		"redshift.amazonaws.com":             classifyRedshift,
 		"s3.amazonaws.com":                   classifyS3,
+		"secretsmanager.amazonaws.com":       classifySecretsManager,
+		"sns.amazonaws.com":                  classifySNS,
+		"sqs.amazonaws.com":                  classifySQS,
 		"waf.amazonaws.com":                  classifyWAF,
 		"waf-regional.amazonaws.com":         classifyWAFRegional,
 	}
@@ -144,6 +151,18 @@ var (
 		"CreateInternetGateway":  {}, // Currently we don't have an EC2 InternetGateway resource,
 		"DeleteInternetGateway":  {}, // when we do we will need to handle these
 
+		// ecr
+		"BatchCheckLayerAvailability": {},
+		"BatchDeleteImage":            {},
+		"CompleteLayerUpload":         {},
+		"DeleteLifecyclePolicy":       {},
+		"InitiateLayerUpload":         {},
+		"PutImage":                    {},
+		"PutLifecyclePolicy":          {},
+		"StartImageScan":              {},
+		"StartLifecyclePolicyPreview": {},
+		"UploadLayerPart":             {},
+
 		// ecs
 		"DeleteAccountSetting":     {},
 		"DeregisterTaskDefinition": {},
@@ -152,6 +171,14 @@ var (
 		"RegisterTaskDefinition":   {},
 		"UpdateContainerAgent":     {},
 
+		// eks
+		"CreateFargateProfile":   {}, // Node groups and fargate profiles are not tracked as their own resources
+		"CreateNodegroup":        {},
+		"DeleteFargateProfile":   {},
+		"DeleteNodegroup":        {},
+		"UpdateNodegroupConfig":  {},
+		"UpdateNodegroupVersion": {},
+
 		// elbv2
 		"DeleteTargetGroup":           {},
 		"CreateTargetGroup":           {},
@@ -246,6 +273,25 @@ var (
 		"HeadBucket":              {},
 		"PutObject":               {},
 
+		// secretsmanager
+		"ValidateResourcePolicy": {},
+
+		// sns
+		"CheckIfPhoneNumberIsOptedOut": {},
+		"OptInPhoneNumber":             {},
+		"Publish":                      {},
+		"SetSMSAttributes":             {},
+
+		// sqs
+		"ChangeMessageVisibility":      {},
+		"ChangeMessageVisibilityBatch": {},
+		"DeleteMessage":                {},
+		"DeleteMessageBatch":           {},
+		"PurgeQueue":                   {},
+		"ReceiveMessage":               {},
+		"SendMessage":                  {},
+		"SendMessageBatch":             {},
+
 		// waf, waf-regional
 		// TODO get suffixes
 		"DeletePermissionPolicy": {},
@@ -372,6 +418,7 @@ func processCloudTrailLog(detail gjson.Result, metadata *CloudTrailMetadata, cha
 
 	// Process the body
 	newChanges := classifier(detail, metadata)
+	eventID := detail.Get("eventID").Str
 	eventTime := detail.Get("eventTime").Str
 	if len(newChanges) > 0 {
 		readOnly := detail.Get("readOnly")
@@ -386,6 +433,7 @@ func processCloudTrailLog(detail gjson.Result, metadata *CloudTrailMetadata, cha
 
 	// One event could require multiple scans (e.g. a new VPC peering connection between two VPCs)
 	for _, change := range newChanges {
+		change.EventID = eventID
 		change.EventTime = eventTime
 		change.IntegrationID = *integration.IntegrationID
 		zap.L().Info("resource scan required", zap.Any("changeDetail", change))
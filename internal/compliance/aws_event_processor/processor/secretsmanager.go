@@ -0,0 +1,75 @@
+package processor
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	schemas "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+)
+
+func classifySecretsManager(detail gjson.Result, metadata *CloudTrailMetadata) []*resourceChange {
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_awssecretsmanager.html
+	var secretID string
+	switch metadata.eventName {
+	case "CreateSecret":
+		secretID = detail.Get("responseElements.aRN").Str
+	case "CancelRotateSecret",
+		"DeleteResourcePolicy",
+		"DeleteSecret",
+		"PutResourcePolicy",
+		"PutSecretValue",
+		"RestoreSecret",
+		"RotateSecret",
+		"TagResource",
+		"UntagResource",
+		"UpdateSecret",
+		"UpdateSecretVersionStage":
+		secretID = detail.Get("requestParameters.secretId").Str
+	default:
+		zap.L().Info("secretsmanager: encountered unknown event name", zap.String("eventName", metadata.eventName))
+		return nil
+	}
+
+	// Secrets may be referenced by name, but the ARN of a secret carries a random suffix which cannot be
+	// derived from the name. In that case we have to scan the whole region.
+	if _, err := arn.Parse(secretID); err != nil {
+		return []*resourceChange{{
+			AwsAccountID: metadata.accountID,
+			EventName:    metadata.eventName,
+			Region:       metadata.region,
+			ResourceType: schemas.SecretsManagerSecretSchema,
+		}}
+	}
+
+	// Secrets are only removed immediately when recovery is explicitly skipped, otherwise they
+	// remain (with a DeletedDate) until the recovery window elapses.
+	deleted := metadata.eventName == "DeleteSecret" &&
+		detail.Get("requestParameters.forceDeleteWithoutRecovery").Bool()
+
+	return []*resourceChange{{
+		AwsAccountID: metadata.accountID,
+		Delete:       deleted,
+		EventName:    metadata.eventName,
+		ResourceID:   secretID,
+		ResourceType: schemas.SecretsManagerSecretSchema,
+	}}
+}
@@ -0,0 +1,51 @@
+package processor
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	schemas "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+)
+
+func TestClassifySNSUnsubscribe(t *testing.T) {
+	detail := gjson.Parse(`{"requestParameters": {
+"subscriptionArn": "arn:aws:sns:us-west-2:111111111111:my-topic:1234abcd-12ab-34cd-56ef-1234567890ab"
+}}`)
+	metadata := &CloudTrailMetadata{region: "us-west-2", accountID: "111111111111", eventName: "Unsubscribe"}
+
+	changes := classifySNS(detail, metadata)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "arn:aws:sns:us-west-2:111111111111:my-topic", changes[0].ResourceID)
+	assert.Equal(t, schemas.SnsTopicSchema, changes[0].ResourceType)
+	assert.False(t, changes[0].Delete)
+}
+
+func TestClassifySNSDeleteTopic(t *testing.T) {
+	detail := gjson.Parse(`{"requestParameters": {"topicArn": "arn:aws:sns:us-west-2:111111111111:my-topic"}}`)
+	metadata := &CloudTrailMetadata{region: "us-west-2", accountID: "111111111111", eventName: "DeleteTopic"}
+
+	changes := classifySNS(detail, metadata)
+	require.Len(t, changes, 1)
+	assert.True(t, changes[0].Delete)
+}
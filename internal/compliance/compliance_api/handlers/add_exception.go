@@ -0,0 +1,158 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	"github.com/go-openapi/strfmt"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/gateway/compliance/models"
+	usermodels "github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+const (
+	usersAPIFunctionName = "panther-users-api"
+
+	// Exceptions are time-boxed: they must be renewed (and re-approved) at least once a year
+	maxExceptionDuration = 366 * 24 * time.Hour
+)
+
+// AddException grants a time-boxed exception for a single policy/resource pair.
+//
+// The pair is suppressed until the exception expires, at which point it reverts to its real status.
+func AddException(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	input, err := parseAddException(request)
+	if err != nil {
+		return badRequest(err)
+	}
+
+	exists, err := userExists(string(input.ApprovedBy))
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+	if !exists {
+		return badRequest(errors.New("approvedBy: user " + string(input.ApprovedBy) + " does not exist"))
+	}
+
+	now := strfmt.DateTime(time.Now().UTC())
+	exception := &models.PolicyException{
+		ApprovedBy:    input.ApprovedBy,
+		CreatedAt:     &now,
+		CreatedBy:     input.CreatedBy,
+		ExpiresAt:     input.ExpiresAt,
+		Justification: input.Justification,
+		PolicyID:      input.PolicyID,
+		ResourceID:    input.ResourceID,
+	}
+
+	item, err := dynamodbattribute.MarshalMap(exception)
+	if err != nil {
+		zap.L().Error("dynamodbattribute.MarshalMap failed", zap.Error(err))
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+	if _, err = dynamoClient.PutItem(&dynamodb.PutItemInput{Item: item, TableName: &Env.ExceptionsTable}); err != nil {
+		zap.L().Error("dynamoClient.PutItem failed", zap.Error(err))
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	// Suppress the current status right away instead of waiting for the next scan
+	if err = suppressStatus(input.PolicyID, input.ResourceID); err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	return gatewayapi.MarshalResponse(exception, http.StatusCreated)
+}
+
+func parseAddException(request *events.APIGatewayProxyRequest) (*models.AddException, error) {
+	var result models.AddException
+	if err := jsoniter.UnmarshalFromString(request.Body, &result); err != nil {
+		return nil, err
+	}
+
+	if err := result.Validate(nil); err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Time(*result.ExpiresAt)
+	if !expiresAt.After(time.Now()) {
+		return nil, errors.New("expiresAt: must be in the future")
+	}
+	if expiresAt.After(time.Now().Add(maxExceptionDuration)) {
+		return nil, errors.New("expiresAt: must be at most 366 days from now")
+	}
+
+	return &result, nil
+}
+
+// Returns true if the user is known to the users-api
+func userExists(userID string) (bool, error) {
+	input := &usermodels.LambdaInput{
+		GetUser: &usermodels.GetUserInput{ID: &userID},
+	}
+	err := genericapi.Invoke(lambdaClient, usersAPIFunctionName, input, nil)
+	if err == nil {
+		return true, nil
+	}
+	if lambdaErr, ok := err.(*genericapi.LambdaError); ok && aws.StringValue(lambdaErr.ErrorType) == "DoesNotExistError" {
+		return false, nil
+	}
+	zap.L().Error("failed to get user", zap.String("userId", userID), zap.Error(err))
+	return false, err
+}
+
+// Mark an existing compliance status as suppressed (no-op if the pair has not been analyzed yet)
+func suppressStatus(policyID models.PolicyID, resourceID models.ResourceID) error {
+	update := expression.Set(expression.Name("suppressed"), expression.Value(true))
+	condition := expression.AttributeExists(expression.Name("resourceId"))
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
+	if err != nil {
+		zap.L().Error("expression.Build failed", zap.Error(err))
+		return err
+	}
+
+	_, err = dynamoClient.UpdateItem(&dynamodb.UpdateItemInput{
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		Key:                       tableKey(resourceID, policyID),
+		TableName:                 &Env.ComplianceTable,
+		UpdateExpression:          expr.Update(),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+		return nil
+	}
+	if err != nil {
+		zap.L().Error("dynamoClient.UpdateItem failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
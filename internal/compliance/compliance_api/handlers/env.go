@@ -18,10 +18,39 @@ package handlers
  * along with this program.  If not, see <https://www.gnu.org/licenses/>.
  */
 
+import (
+	"net/http"
+
+	"github.com/kelseyhightower/envconfig"
+
+	analysisapi "github.com/panther-labs/panther/api/gateway/analysis/client"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
+)
+
 type envConfig struct {
-	ComplianceTable string `required:"true" split_words:"true"`
-	IndexName       string `required:"true" split_words:"true"`
+	AnalysisAPIHost   string `required:"true" split_words:"true"`
+	AnalysisAPIPath   string `required:"true" split_words:"true"`
+	ComplianceTable   string `required:"true" split_words:"true"`
+	ExceptionsTable   string `required:"true" split_words:"true"`
+	IndexName         string `required:"true" split_words:"true"`
+	ResourcesQueueURL string `required:"true" split_words:"true"`
+	TrendTable        string `required:"true" split_words:"true"`
 }
 
-// Env is the parsed environment variables
-var Env envConfig
+var (
+	// Env is the parsed environment variables
+	Env envConfig
+
+	httpClient     *http.Client
+	analysisClient *analysisapi.PantherAnalysis
+)
+
+// Setup parses the environment and initializes the API clients.
+func Setup() {
+	envconfig.MustProcess("", &Env)
+
+	httpClient = gatewayapi.GatewayClient(awsSession)
+	analysisClient = analysisapi.NewHTTPClientWithConfig(
+		nil, analysisapi.DefaultTransportConfig().
+			WithHost(Env.AnalysisAPIHost).WithBasePath("/"+Env.AnalysisAPIPath))
+}
@@ -0,0 +1,138 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/gateway/compliance/models"
+)
+
+var (
+	lambdaClient lambdaiface.LambdaAPI = lambda.New(awsSession)
+	sqsClient    sqsiface.SQSAPI       = sqs.New(awsSession)
+)
+
+// Build the exceptions table key in the format Dynamo expects.
+//
+// Exceptions are keyed by policy first so every exception for a single policy can be queried at once.
+func exceptionKey(policyID models.PolicyID, resourceID models.ResourceID) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"policyId":   {S: aws.String(string(policyID))},
+		"resourceId": {S: aws.String(string(resourceID))},
+	}
+}
+
+// Returns true if the exception no longer applies at the given time
+func isExpired(exception *models.PolicyException, now time.Time) bool {
+	return !time.Time(*exception.ExpiresAt).After(now)
+}
+
+// Load exceptions from the Dynamo table, optionally limited to a single policy.
+//
+// Results are sorted by policy and then resource.
+func listExceptions(policyID models.PolicyID) ([]*models.PolicyException, error) {
+	result := make([]*models.PolicyException, 0)
+	handler := func(items []map[string]*dynamodb.AttributeValue) error {
+		var page []*models.PolicyException
+		if err := dynamodbattribute.UnmarshalListOfMaps(items, &page); err != nil {
+			return err
+		}
+		result = append(result, page...)
+		return nil
+	}
+
+	var innerErr error
+	if policyID == "" {
+		err := dynamoClient.ScanPages(
+			&dynamodb.ScanInput{TableName: &Env.ExceptionsTable},
+			func(page *dynamodb.ScanOutput, lastPage bool) bool {
+				innerErr = handler(page.Items)
+				return innerErr == nil
+			})
+		if err != nil {
+			zap.L().Error("dynamoClient.ScanPages failed", zap.Error(err))
+			return nil, err
+		}
+	} else {
+		keyCondition := expression.Key("policyId").Equal(expression.Value(policyID))
+		expr, err := expression.NewBuilder().WithKeyCondition(keyCondition).Build()
+		if err != nil {
+			zap.L().Error("expression.Build failed", zap.Error(err))
+			return nil, err
+		}
+
+		err = dynamoClient.QueryPages(
+			&dynamodb.QueryInput{
+				ExpressionAttributeNames:  expr.Names(),
+				ExpressionAttributeValues: expr.Values(),
+				KeyConditionExpression:    expr.KeyCondition(),
+				TableName:                 &Env.ExceptionsTable,
+			},
+			func(page *dynamodb.QueryOutput, lastPage bool) bool {
+				innerErr = handler(page.Items)
+				return innerErr == nil
+			})
+		if err != nil {
+			zap.L().Error("dynamoClient.QueryPages failed", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	if innerErr != nil {
+		zap.L().Error("failed to unmarshal exceptions", zap.Error(innerErr))
+		return nil, innerErr
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].PolicyID != result[j].PolicyID {
+			return result[i].PolicyID < result[j].PolicyID
+		}
+		return result[i].ResourceID < result[j].ResourceID
+	})
+	return result, nil
+}
+
+// Returns the set of resources which currently have an exception for the given policy
+func exceptedResources(policyID models.PolicyID) (map[models.ResourceID]bool, error) {
+	exceptions, err := listExceptions(policyID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := make(map[models.ResourceID]bool, len(exceptions))
+	for _, exception := range exceptions {
+		if !isExpired(exception, now) {
+			result[exception.ResourceID] = true
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,112 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/gateway/compliance/models"
+	processormodels "github.com/panther-labs/panther/internal/compliance/resource_processor/models"
+	"github.com/panther-labs/panther/pkg/awsbatch/dynamodbbatch"
+	"github.com/panther-labs/panther/pkg/awsbatch/sqsbatch"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
+)
+
+// ExpireExceptions queues the resources of expired exceptions for re-analysis and then removes the exceptions.
+//
+// The resource-processor then records the real status of each pair and, because the pair
+// is no longer suppressed, alerts if it is still failing.
+func ExpireExceptions(_ *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	exceptions, err := listExceptions("")
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	now := time.Now()
+	expired := make([]*models.PolicyException, 0)
+	var deletes []*dynamodb.WriteRequest
+	resources := make(map[models.ResourceID]bool)
+	for _, exception := range exceptions {
+		if !isExpired(exception, now) {
+			continue
+		}
+
+		zap.L().Info("policy exception expired",
+			zap.String("policyId", string(exception.PolicyID)),
+			zap.String("resourceId", string(exception.ResourceID)),
+			zap.String("approvedBy", string(exception.ApprovedBy)))
+		expired = append(expired, exception)
+		deletes = append(deletes, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{Key: exceptionKey(exception.PolicyID, exception.ResourceID)},
+		})
+		resources[exception.ResourceID] = true
+	}
+
+	if len(expired) == 0 {
+		return gatewayapi.MarshalResponse(&models.ExceptionList{Exceptions: expired}, http.StatusOK)
+	}
+
+	// Queue the resources first: if the delete fails, the exceptions are still found (and queued again)
+	// on the next run. The resource-processor ignores expired exceptions, so the order does not matter to it.
+	if err = queueResources(resources); err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	batchInput := &dynamodb.BatchWriteItemInput{
+		RequestItems: map[string][]*dynamodb.WriteRequest{Env.ExceptionsTable: deletes},
+	}
+	if err = dynamodbbatch.BatchWriteItem(dynamoClient, maxWriteBackoff, batchInput); err != nil {
+		zap.L().Error("dynamodbbatch.BatchWriteItem failed", zap.Error(err))
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	return gatewayapi.MarshalResponse(&models.ExceptionList{Exceptions: expired}, http.StatusOK)
+}
+
+// Ask the resource-processor to re-analyze resources (which it will look up from the resources-api)
+func queueResources(resources map[models.ResourceID]bool) error {
+	entries := make([]*sqs.SendMessageBatchRequestEntry, 0, len(resources))
+	for resourceID := range resources {
+		body, err := jsoniter.MarshalToString(&processormodels.ResourceLookup{ID: string(resourceID)})
+		if err != nil {
+			zap.L().Error("failed to marshal resource lookup", zap.Error(err))
+			return err
+		}
+		entries = append(entries, &sqs.SendMessageBatchRequestEntry{
+			Id:          aws.String(strconv.Itoa(len(entries))),
+			MessageBody: aws.String(body),
+		})
+	}
+
+	sqsInput := &sqs.SendMessageBatchInput{Entries: entries, QueueUrl: &Env.ResourcesQueueURL}
+	if _, err := sqsbatch.SendMessageBatch(sqsClient, maxWriteBackoff, sqsInput); err != nil {
+		zap.L().Error("sqsbatch.SendMessageBatch failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/panther-labs/panther/api/gateway/compliance/models"
+)
+
+// A compliance framework which policies can be mapped to with their "Reports" field:
+//
+//	Reports:
+//	  CIS:
+//	    - 1.12
+//	    - 1.13
+type framework struct {
+	Title    string
+	Controls []frameworkControl
+}
+
+type frameworkControl struct {
+	ID    string
+	Title string
+}
+
+// Every control of a framework is included in its report, even if no policy is mapped to it.
+//
+// Policies may also be mapped to control IDs which are not listed here (e.g. sub-requirements),
+// those controls are reported without a title.
+var frameworks = map[string]*framework{
+	models.ComplianceReportFrameworkCIS: {
+		Title: "CIS Amazon Web Services Foundations Benchmark v1.2.0",
+		Controls: []frameworkControl{
+			{"1.1", "Avoid the use of the root account"},
+			{"1.2", "Ensure multi-factor authentication (MFA) is enabled for all IAM users that have a console password"},
+			{"1.3", "Ensure credentials unused for 90 days or greater are disabled"},
+			{"1.4", "Ensure access keys are rotated every 90 days or less"},
+			{"1.5", "Ensure IAM password policy requires at least one uppercase letter"},
+			{"1.6", "Ensure IAM password policy requires at least one lowercase letter"},
+			{"1.7", "Ensure IAM password policy requires at least one symbol"},
+			{"1.8", "Ensure IAM password policy requires at least one number"},
+			{"1.9", "Ensure IAM password policy requires minimum length of 14 or greater"},
+			{"1.10", "Ensure IAM password policy prevents password reuse"},
+			{"1.11", "Ensure IAM password policy expires passwords within 90 days or less"},
+			{"1.12", "Ensure no root account access key exists"},
+			{"1.13", "Ensure MFA is enabled for the root account"},
+			{"1.14", "Ensure hardware MFA is enabled for the root account"},
+			{"1.15", "Ensure security questions are registered in the AWS account"},
+			{"1.16", "Ensure IAM policies are attached only to groups or roles"},
+			{"1.17", "Maintain current contact details"},
+			{"1.18", "Ensure security contact information is registered"},
+			{"1.19", "Ensure IAM instance roles are used for AWS resource access from instances"},
+			{"1.20", "Ensure a support role has been created to manage incidents with AWS Support"},
+			{"1.21", "Do not setup access keys during initial user setup for all IAM users that have a console password"},
+			{"1.22", "Ensure IAM policies that allow full administrative privileges are not created"},
+			{"2.1", "Ensure CloudTrail is enabled in all regions"},
+			{"2.2", "Ensure CloudTrail log file validation is enabled"},
+			{"2.3", "Ensure the S3 bucket used to store CloudTrail logs is not publicly accessible"},
+			{"2.4", "Ensure CloudTrail trails are integrated with CloudWatch Logs"},
+			{"2.5", "Ensure AWS Config is enabled in all regions"},
+			{"2.6", "Ensure S3 bucket access logging is enabled on the CloudTrail S3 bucket"},
+			{"2.7", "Ensure CloudTrail logs are encrypted at rest using KMS CMKs"},
+			{"2.8", "Ensure rotation for customer created CMKs is enabled"},
+			{"2.9", "Ensure VPC flow logging is enabled in all VPCs"},
+			{"3.1", "Ensure a log metric filter and alarm exist for unauthorized API calls"},
+			{"3.2", "Ensure a log metric filter and alarm exist for Management Console sign-in without MFA"},
+			{"3.3", "Ensure a log metric filter and alarm exist for usage of the root account"},
+			{"3.4", "Ensure a log metric filter and alarm exist for IAM policy changes"},
+			{"3.5", "Ensure a log metric filter and alarm exist for CloudTrail configuration changes"},
+			{"3.6", "Ensure a log metric filter and alarm exist for AWS Management Console authentication failures"},
+			{"3.7", "Ensure a log metric filter and alarm exist for disabling or scheduled deletion of customer created CMKs"},
+			{"3.8", "Ensure a log metric filter and alarm exist for S3 bucket policy changes"},
+			{"3.9", "Ensure a log metric filter and alarm exist for AWS Config configuration changes"},
+			{"3.10", "Ensure a log metric filter and alarm exist for security group changes"},
+			{"3.11", "Ensure a log metric filter and alarm exist for changes to Network Access Control Lists (NACL)"},
+			{"3.12", "Ensure a log metric filter and alarm exist for changes to network gateways"},
+			{"3.13", "Ensure a log metric filter and alarm exist for route table changes"},
+			{"3.14", "Ensure a log metric filter and alarm exist for VPC changes"},
+			{"4.1", "Ensure no security groups allow ingress from 0.0.0.0/0 to port 22"},
+			{"4.2", "Ensure no security groups allow ingress from 0.0.0.0/0 to port 3389"},
+			{"4.3", "Ensure the default security group of every VPC restricts all traffic"},
+			{"4.4", "Ensure routing tables for VPC peering are least access"},
+		},
+	},
+
+	models.ComplianceReportFrameworkPCI: {
+		Title: "Payment Card Industry Data Security Standard (PCI DSS) v3.2.1",
+		Controls: []frameworkControl{
+			{"1.1", "Establish and implement firewall and router configuration standards"},
+			{"1.2", "Build firewall and router configurations that restrict connections between untrusted networks and the cardholder data environment"},
+			{"1.3", "Prohibit direct public access between the Internet and any system component in the cardholder data environment"},
+			{"1.4", "Install personal firewall software on portable computing devices that connect to the Internet"},
+			{"1.5", "Ensure that security policies and operational procedures for managing firewalls are documented, in use, and known"},
+			{"2.1", "Always change vendor-supplied defaults and remove or disable unnecessary default accounts"},
+			{"2.2", "Develop configuration standards for all system components"},
+			{"2.3", "Encrypt all non-console administrative access using strong cryptography"},
+			{"2.4", "Maintain an inventory of system components that are in scope for PCI DSS"},
+			{"2.5", "Ensure that security policies and operational procedures for managing vendor defaults are documented, in use, and known"},
+			{"2.6", "Shared hosting providers must protect each entity's hosted environment and cardholder data"},
+			{"3.1", "Keep cardholder data storage to a minimum by implementing data retention and disposal policies"},
+			{"3.2", "Do not store sensitive authentication data after authorization"},
+			{"3.3", "Mask PAN when displayed"},
+			{"3.4", "Render PAN unreadable anywhere it is stored"},
+			{"3.5", "Document and implement procedures to protect keys used to secure stored cardholder data"},
+			{"3.6", "Fully document and implement all key-management processes and procedures"},
+			{"3.7", "Ensure that security policies and operational procedures for protecting stored cardholder data are documented, in use, and known"},
+			{"4.1", "Use strong cryptography and security protocols to safeguard sensitive cardholder data during transmission over open, public networks"},
+			{"4.2", "Never send unprotected PANs by end-user messaging technologies"},
+			{"4.3", "Ensure that security policies and operational procedures for encrypting transmissions of cardholder data are documented, in use, and known"},
+			{"5.1", "Deploy anti-virus software on all systems commonly affected by malicious software"},
+			{"5.2", "Ensure that all anti-virus mechanisms are maintained"},
+			{"5.3", "Ensure that anti-virus mechanisms are actively running and cannot be disabled or altered by users"},
+			{"5.4", "Ensure that security policies and operational procedures for protecting systems against malware are documented, in use, and known"},
+			{"6.1", "Establish a process to identify security vulnerabilities and assign a risk ranking to them"},
+			{"6.2", "Ensure that all system components and software are protected from known vulnerabilities by installing vendor-supplied security patches"},
+			{"6.3", "Develop internal and external software applications securely"},
+			{"6.4", "Follow change control processes and procedures for all changes to system components"},
+			{"6.5", "Address common coding vulnerabilities in software-development processes"},
+			{"6.6", "Address new threats and vulnerabilities for public-facing web applications on an ongoing basis"},
+			{"6.7", "Ensure that security policies and operational procedures for developing and maintaining secure systems and applications are documented, in use, and known"},
+			{"7.1", "Limit access to system components and cardholder data to only those individuals whose job requires such access"},
+			{"7.2", "Establish an access control system that restricts access based on a user's need to know"},
+			{"7.3", "Ensure that security policies and operational procedures for restricting access to cardholder data are documented, in use, and known"},
+			{"8.1", "Define and implement policies and procedures to ensure proper user identification management"},
+			{"8.2", "Ensure proper user-authentication management for all users"},
+			{"8.3", "Secure all individual non-console administrative access and all remote access to the cardholder data environment using multi-factor authentication"},
+			{"8.4", "Document and communicate authentication policies and procedures to all users"},
+			{"8.5", "Do not use group, shared, or generic IDs, passwords, or other authentication methods"},
+			{"8.6", "Assign other authentication mechanisms, such as tokens and certificates, to an individual account"},
+			{"8.7", "Restrict all access to any database containing cardholder data"},
+			{"8.8", "Ensure that security policies and operational procedures for identification and authentication are documented, in use, and known"},
+			{"9.1", "Use appropriate facility entry controls to limit and monitor physical access to systems in the cardholder data environment"},
+			{"9.2", "Develop procedures to easily distinguish between onsite personnel and visitors"},
+			{"9.3", "Control physical access for onsite personnel to sensitive areas"},
+			{"9.4", "Implement procedures to identify and authorize visitors"},
+			{"9.5", "Physically secure all media"},
+			{"9.6", "Maintain strict control over the internal or external distribution of any kind of media"},
+			{"9.7", "Maintain strict control over the storage and accessibility of media"},
+			{"9.8", "Destroy media when it is no longer needed for business or legal reasons"},
+			{"9.9", "Protect devices that capture payment card data via direct physical interaction with the card from tampering and substitution"},
+			{"9.10", "Ensure that security policies and operational procedures for restricting physical access to cardholder data are documented, in use, and known"},
+			{"10.1", "Implement audit trails to link all access to system components to each individual user"},
+			{"10.2", "Implement automated audit trails for all system components to reconstruct events"},
+			{"10.3", "Record audit trail entries for all system components for each event"},
+			{"10.4", "Synchronize all critical system clocks and times using time-synchronization technology"},
+			{"10.5", "Secure audit trails so they cannot be altered"},
+			{"10.6", "Review logs and security events for all system components to identify anomalies or suspicious activity"},
+			{"10.7", "Retain audit trail history for at least one year"},
+			{"10.8", "Implement a process for the timely detection and reporting of failures of critical security control systems"},
+			{"10.9", "Ensure that security policies and operational procedures for monitoring all access to network resources and cardholder data are documented, in use, and known"},
+			{"11.1", "Implement processes to test for the presence of wireless access points"},
+			{"11.2", "Run internal and external network vulnerability scans at least quarterly and after any significant change in the network"},
+			{"11.3", "Implement a methodology for penetration testing"},
+			{"11.4", "Use intrusion-detection and/or intrusion-prevention techniques to detect and/or prevent intrusions into the network"},
+			{"11.5", "Deploy a change-detection mechanism to alert personnel to unauthorized modification of critical files"},
+			{"11.6", "Ensure that security policies and operational procedures for security monitoring and testing are documented, in use, and known"},
+			{"12.1", "Establish, publish, maintain, and disseminate a security policy"},
+			{"12.2", "Implement a risk-assessment process"},
+			{"12.3", "Develop usage policies for critical technologies and define proper use of these technologies"},
+			{"12.4", "Ensure that the security policy and procedures clearly define information security responsibilities for all personnel"},
+			{"12.5", "Assign information security management responsibilities to an individual or team"},
+			{"12.6", "Implement a formal security awareness program"},
+			{"12.7", "Screen potential personnel prior to hire to minimize the risk of attacks from internal sources"},
+			{"12.8", "Maintain and implement policies and procedures to manage service providers with whom cardholder data is shared"},
+			{"12.9", "Service providers acknowledge in writing their responsibility for the security of cardholder data"},
+			{"12.10", "Implement an incident response plan"},
+			{"12.11", "Service providers perform reviews at least quarterly to confirm personnel are following security policies"},
+		},
+	},
+
+	models.ComplianceReportFrameworkSOC2: {
+		Title: "SOC 2 Trust Services Criteria (2017) - Common Criteria",
+		Controls: []frameworkControl{
+			{"CC1.1", "The entity demonstrates a commitment to integrity and ethical values"},
+			{"CC1.2", "The board of directors demonstrates independence from management and exercises oversight of internal control"},
+			{"CC1.3", "Management establishes structures, reporting lines, and appropriate authorities and responsibilities"},
+			{"CC1.4", "The entity demonstrates a commitment to attract, develop, and retain competent individuals"},
+			{"CC1.5", "The entity holds individuals accountable for their internal control responsibilities"},
+			{"CC2.1", "The entity obtains or generates and uses relevant, quality information to support the functioning of internal control"},
+			{"CC2.2", "The entity internally communicates information necessary to support the functioning of internal control"},
+			{"CC2.3", "The entity communicates with external parties regarding matters affecting the functioning of internal control"},
+			{"CC3.1", "The entity specifies objectives with sufficient clarity to enable the identification and assessment of risks"},
+			{"CC3.2", "The entity identifies risks to the achievement of its objectives and analyzes risks"},
+			{"CC3.3", "The entity considers the potential for fraud in assessing risks"},
+			{"CC3.4", "The entity identifies and assesses changes that could significantly impact the system of internal control"},
+			{"CC4.1", "The entity selects, develops, and performs ongoing and/or separate evaluations of internal control"},
+			{"CC4.2", "The entity evaluates and communicates internal control deficiencies in a timely manner"},
+			{"CC5.1", "The entity selects and develops control activities that contribute to the mitigation of risks"},
+			{"CC5.2", "The entity selects and develops general control activities over technology"},
+			{"CC5.3", "The entity deploys control activities through policies and procedures"},
+			{"CC6.1", "The entity implements logical access security software, infrastructure, and architectures over protected information assets"},
+			{"CC6.2", "The entity registers and authorizes new users prior to issuing system credentials and granting system access"},
+			{"CC6.3", "The entity authorizes, modifies, or removes access to protected information assets based on roles and least privilege"},
+			{"CC6.4", "The entity restricts physical access to facilities and protected information assets"},
+			{"CC6.5", "The entity discontinues logical and physical protections over physical assets only after data can no longer be recovered"},
+			{"CC6.6", "The entity implements logical access security measures to protect against threats from sources outside its system boundaries"},
+			{"CC6.7", "The entity restricts the transmission, movement, and removal of information to authorized users and processes"},
+			{"CC6.8", "The entity implements controls to prevent or detect and act upon the introduction of unauthorized or malicious software"},
+			{"CC7.1", "The entity uses detection and monitoring procedures to identify configuration changes and newly discovered vulnerabilities"},
+			{"CC7.2", "The entity monitors system components for anomalies indicative of malicious acts, natural disasters, and errors"},
+			{"CC7.3", "The entity evaluates security events to determine whether they could or have resulted in a failure to meet its objectives"},
+			{"CC7.4", "The entity responds to identified security incidents by executing a defined incident response program"},
+			{"CC7.5", "The entity identifies, develops, and implements activities to recover from identified security incidents"},
+			{"CC8.1", "The entity authorizes, designs, develops, tests, approves, and implements changes to infrastructure, data, software, and procedures"},
+			{"CC9.1", "The entity identifies, selects, and develops risk mitigation activities for risks arising from potential business disruptions"},
+			{"CC9.2", "The entity assesses and manages risks associated with vendors and business partners"},
+		},
+	},
+}
+
+// Sort control IDs numerically, segment by segment, so that 1.2 < 1.10 and CC6.1 < CC10.1.
+func controlIDLess(left, right string) bool {
+	leftParts, rightParts := controlIDParts(left), controlIDParts(right)
+	for i := 0; i < len(leftParts) && i < len(rightParts); i++ {
+		if leftParts[i] == rightParts[i] {
+			continue
+		}
+		leftNum, leftErr := strconv.Atoi(leftParts[i])
+		rightNum, rightErr := strconv.Atoi(rightParts[i])
+		if leftErr == nil && rightErr == nil {
+			return leftNum < rightNum
+		}
+		return leftParts[i] < rightParts[i]
+	}
+	return len(leftParts) < len(rightParts)
+}
+
+// Split a control ID into its letter prefix and numeric segments, e.g. "CC6.1" => ["CC", "6", "1"]
+func controlIDParts(id string) []string {
+	prefix := strings.TrimRightFunc(id, func(r rune) bool { return r == '.' || (r >= '0' && r <= '9') })
+	return append([]string{prefix}, strings.Split(strings.TrimPrefix(id, prefix), ".")...)
+}
@@ -0,0 +1,43 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/http"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/panther-labs/panther/api/gateway/compliance/models"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
+)
+
+// ListExceptions returns every policy exception, optionally limited to a single policy.
+func ListExceptions(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	policyID := models.PolicyID(request.QueryStringParameters["policyId"])
+	if err := policyID.Validate(nil); err != nil {
+		return badRequest(err)
+	}
+
+	exceptions, err := listExceptions(policyID)
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	return gatewayapi.MarshalResponse(&models.ExceptionList{Exceptions: exceptions}, http.StatusOK)
+}
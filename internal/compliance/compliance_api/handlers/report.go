@@ -0,0 +1,410 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	"github.com/go-openapi/strfmt"
+	"go.uber.org/zap"
+
+	analysisoperations "github.com/panther-labs/panther/api/gateway/analysis/client/operations"
+	analysismodels "github.com/panther-labs/panther/api/gateway/analysis/models"
+	"github.com/panther-labs/panther/api/gateway/compliance/models"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
+)
+
+const (
+	reportFormatCSV  = "csv"
+	reportFormatJSON = "json"
+)
+
+// Columns of the CSV export: one row per piece of evidence, or a single row for a control without evidence
+var reportCSVHeader = []string{
+	"framework", "control_id", "control_title", "control_status", "policies",
+	"resources_pass", "resources_fail", "resources_error",
+	"policy_id", "resource_id", "resource_type", "integration_id", "resource_status", "error_message", "last_updated",
+}
+
+type getComplianceReportParams struct {
+	Framework     string
+	IntegrationID models.IntegrationID
+	Format        string
+}
+
+// GetComplianceReport returns the pass/fail status of every control in a compliance framework.
+func GetComplianceReport(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	params, err := parseGetComplianceReport(request)
+	if err != nil {
+		return badRequest(err)
+	}
+
+	controlPolicies, err := loadControlPolicies(params.Framework)
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	statuses, err := loadPolicyStatuses(controlPolicies, params.IntegrationID)
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	report := buildComplianceReport(params, controlPolicies, statuses, time.Now().UTC())
+	if params.Format == reportFormatCSV {
+		body, err := reportToCSV(report)
+		if err != nil {
+			zap.L().Error("failed to write report csv", zap.Error(err))
+			return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+		}
+		return &events.APIGatewayProxyResponse{
+			Body: body,
+			Headers: map[string]string{
+				"Content-Disposition": `attachment; filename="` + reportFilename(report) + `"`,
+				"Content-Type":        "text/csv",
+			},
+			StatusCode: http.StatusOK,
+		}
+	}
+
+	return gatewayapi.MarshalResponse(report, http.StatusOK)
+}
+
+func parseGetComplianceReport(request *events.APIGatewayProxyRequest) (*getComplianceReportParams, error) {
+	result := getComplianceReportParams{
+		Framework:     strings.ToUpper(request.QueryStringParameters["framework"]),
+		IntegrationID: models.IntegrationID(request.QueryStringParameters["integrationId"]),
+		Format:        strings.ToLower(request.QueryStringParameters["format"]),
+	}
+
+	if _, ok := frameworks[result.Framework]; !ok {
+		return nil, errors.New("invalid framework: must be one of CIS, PCI, SOC2")
+	}
+
+	if result.IntegrationID != "" {
+		if err := result.IntegrationID.Validate(nil); err != nil {
+			return nil, errors.New("invalid integrationId: " + err.Error())
+		}
+	}
+
+	switch result.Format {
+	case "":
+		result.Format = reportFormatJSON
+	case reportFormatCSV, reportFormatJSON:
+	default:
+		return nil, errors.New("invalid format: must be json or csv")
+	}
+
+	return &result, nil
+}
+
+// Load the enabled policies from the analysis-api and group them by the framework control they are mapped to.
+func loadControlPolicies(frameworkName string) (map[string][]models.PolicyID, error) {
+	result, err := analysisClient.Operations.GetEnabledPolicies(&analysisoperations.GetEnabledPoliciesParams{
+		HTTPClient: httpClient,
+		Type:       string(analysismodels.AnalysisTypePOLICY),
+	})
+	if err != nil {
+		zap.L().Error("failed to load policies from analysis-api", zap.Error(err))
+		return nil, err
+	}
+
+	return mapControlPolicies(frameworkName, result.Payload.Policies), nil
+}
+
+// Group policies by the controls they are mapped to in their "Reports" field.
+//
+// Framework names are matched case-insensitively. Each list of policies is sorted.
+func mapControlPolicies(frameworkName string, policies []*analysismodels.EnabledPolicy) map[string][]models.PolicyID {
+	result := make(map[string][]models.PolicyID)
+	for _, policy := range policies {
+		for reportName, controls := range policy.Reports {
+			if !strings.EqualFold(reportName, frameworkName) {
+				continue
+			}
+			for _, controlID := range controls {
+				controlID = strings.TrimSpace(controlID)
+				if controlID == "" {
+					continue
+				}
+				result[controlID] = append(result[controlID], models.PolicyID(policy.ID))
+			}
+		}
+	}
+
+	for _, policyIDs := range result {
+		sort.Slice(policyIDs, func(i, j int) bool { return policyIDs[i] < policyIDs[j] })
+	}
+	return result
+}
+
+// Load the unsuppressed compliance status of every resource evaluated by the mapped policies.
+func loadPolicyStatuses(
+	controlPolicies map[string][]models.PolicyID,
+	integrationID models.IntegrationID,
+) (map[models.PolicyID][]*models.ComplianceStatus, error) {
+
+	result := make(map[models.PolicyID][]*models.ComplianceStatus)
+	for _, policyIDs := range controlPolicies {
+		for _, policyID := range policyIDs {
+			if _, ok := result[policyID]; ok {
+				continue // policy is mapped to more than one control
+			}
+
+			input, err := buildReportPolicyQuery(policyID, integrationID)
+			if err != nil {
+				return nil, err
+			}
+
+			statuses := make([]*models.ComplianceStatus, 0)
+			err = queryPages(input, func(item *models.ComplianceStatus) error {
+				statuses = append(statuses, item)
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			result[policyID] = statuses
+		}
+	}
+
+	return result, nil
+}
+
+func buildReportPolicyQuery(policyID models.PolicyID, integrationID models.IntegrationID) (*dynamodb.QueryInput, error) {
+	keyCondition := expression.Key("policyId").Equal(expression.Value(policyID))
+
+	// Suppressed resources are excluded from audits, the same as in the org overview
+	filter := expression.Equal(expression.Name("suppressed"), expression.Value(false))
+	if integrationID != "" {
+		filter = filter.And(expression.Equal(expression.Name("integrationId"), expression.Value(integrationID)))
+	}
+
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCondition).WithFilter(filter).Build()
+	if err != nil {
+		zap.L().Error("expression.Build failed", zap.Error(err))
+		return nil, err
+	}
+
+	return &dynamodb.QueryInput{
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		FilterExpression:          expr.Filter(),
+		IndexName:                 &Env.IndexName,
+		KeyConditionExpression:    expr.KeyCondition(),
+		TableName:                 &Env.ComplianceTable,
+	}, nil
+}
+
+// Build the report for every control in the framework, as well as any other control a policy is mapped to.
+func buildComplianceReport(
+	params *getComplianceReportParams,
+	controlPolicies map[string][]models.PolicyID,
+	statuses map[models.PolicyID][]*models.ComplianceStatus,
+	now time.Time,
+) *models.ComplianceReport {
+
+	fw := frameworks[params.Framework]
+	controls := make([]frameworkControl, 0, len(fw.Controls)+len(controlPolicies))
+	known := make(map[string]bool, len(fw.Controls))
+	for _, control := range fw.Controls {
+		controls = append(controls, control)
+		known[control.ID] = true
+	}
+	for controlID := range controlPolicies {
+		if !known[controlID] {
+			controls = append(controls, frameworkControl{ID: controlID})
+		}
+	}
+	sort.SliceStable(controls, func(i, j int) bool { return controlIDLess(controls[i].ID, controls[j].ID) })
+
+	report := &models.ComplianceReport{
+		Controls:      make([]*models.ControlReport, 0, len(controls)),
+		Framework:     aws.String(params.Framework),
+		GeneratedAt:   (*strfmt.DateTime)(&now),
+		IntegrationID: params.IntegrationID,
+		Summary: &models.ControlStatusCount{
+			Error:        aws.Int64(0),
+			Fail:         aws.Int64(0),
+			NotEvaluated: aws.Int64(0),
+			Pass:         aws.Int64(0),
+		},
+		Title: aws.String(fw.Title),
+	}
+
+	for _, control := range controls {
+		controlReport := buildControlReport(control, controlPolicies[control.ID], statuses)
+		report.Controls = append(report.Controls, controlReport)
+
+		switch controlReport.Status {
+		case models.ControlStatusERROR:
+			*report.Summary.Error++
+		case models.ControlStatusFAIL:
+			*report.Summary.Fail++
+		case models.ControlStatusPASS:
+			*report.Summary.Pass++
+		default:
+			*report.Summary.NotEvaluated++
+		}
+	}
+
+	return report
+}
+
+// Summarize a single control.
+//
+// A resource counts once per control with the worst status across the control's policies.
+// Every failing (policy, resource) pair is included as evidence.
+func buildControlReport(
+	control frameworkControl,
+	policyIDs []models.PolicyID,
+	statuses map[models.PolicyID][]*models.ComplianceStatus,
+) *models.ControlReport {
+
+	result := &models.ControlReport{
+		Evidence:  make([]*models.ControlEvidence, 0),
+		ID:        aws.String(control.ID),
+		Policies:  policyIDs,
+		Resources: NewStatusCount(),
+		Title:     aws.String(control.Title),
+	}
+	if result.Policies == nil {
+		result.Policies = make([]models.PolicyID, 0)
+	}
+
+	resources := make(map[models.ResourceID]models.Status)
+	for _, policyID := range policyIDs {
+		for _, item := range statuses[policyID] {
+			if status, ok := resources[item.ResourceID]; !ok || statusPriority(item.Status) > statusPriority(status) {
+				resources[item.ResourceID] = item.Status
+			}
+
+			if item.Status != models.StatusPASS {
+				result.Evidence = append(result.Evidence, &models.ControlEvidence{
+					ErrorMessage:  item.ErrorMessage,
+					IntegrationID: item.IntegrationID,
+					LastUpdated:   item.LastUpdated,
+					PolicyID:      item.PolicyID,
+					ResourceID:    item.ResourceID,
+					ResourceType:  item.ResourceType,
+					Status:        item.Status,
+				})
+			}
+		}
+	}
+
+	for _, status := range resources {
+		updateStatusCount(result.Resources, status)
+	}
+
+	if len(resources) == 0 {
+		result.Status = models.ControlStatusNOTEVALUATED
+	} else {
+		result.Status = models.ControlStatus(countToStatus(result.Resources))
+	}
+
+	sort.Slice(result.Evidence, func(i, j int) bool {
+		left, right := result.Evidence[i], result.Evidence[j]
+		if left.PolicyID != right.PolicyID {
+			return left.PolicyID < right.PolicyID
+		}
+		return left.ResourceID < right.ResourceID
+	})
+	return result
+}
+
+// Higher values are worse
+func statusPriority(status models.Status) int {
+	switch status {
+	case models.StatusPASS:
+		return 0
+	case models.StatusFAIL:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Flatten the report into CSV rows for auditors and spreadsheets
+func reportToCSV(report *models.ComplianceReport) (string, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(reportCSVHeader); err != nil {
+		return "", err
+	}
+
+	for _, control := range report.Controls {
+		policies := make([]string, 0, len(control.Policies))
+		for _, policyID := range control.Policies {
+			policies = append(policies, string(policyID))
+		}
+
+		prefix := []string{
+			*report.Framework,
+			*control.ID,
+			*control.Title,
+			string(control.Status),
+			strings.Join(policies, ";"),
+			strconv.FormatInt(*control.Resources.Pass, 10),
+			strconv.FormatInt(*control.Resources.Fail, 10),
+			strconv.FormatInt(*control.Resources.Error, 10),
+		}
+
+		if len(control.Evidence) == 0 {
+			if err := writer.Write(append(prefix, "", "", "", "", "", "", "")); err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		for _, evidence := range control.Evidence {
+			row := append(append([]string{}, prefix...),
+				string(evidence.PolicyID),
+				string(evidence.ResourceID),
+				string(evidence.ResourceType),
+				string(evidence.IntegrationID),
+				string(evidence.Status),
+				string(evidence.ErrorMessage),
+				strfmt.DateTime(evidence.LastUpdated).String(),
+			)
+			if err := writer.Write(row); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	writer.Flush()
+	return buf.String(), writer.Error()
+}
+
+// For example, "panther-cis-report-2020-04-01.csv"
+func reportFilename(report *models.ComplianceReport) string {
+	return "panther-" + strings.ToLower(*report.Framework) + "-report-" +
+		time.Time(*report.GeneratedAt).Format(dayLayout) + ".csv"
+}
@@ -0,0 +1,310 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/go-openapi/strfmt"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/gateway/compliance/models"
+	"github.com/panther-labs/panther/pkg/awsbatch/dynamodbbatch"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
+)
+
+const (
+	dayLayout = "2006-01-02"
+
+	defaultTrendDays = 90
+	maxTrendDays     = 366
+
+	// Snapshots are kept for two years, long enough for year-over-year audit comparisons
+	trendRetention = 2 * 365 * 24 * time.Hour
+)
+
+// A single daily snapshot as stored in the trend table
+type trendItem struct {
+	Day       string                     `json:"day"`
+	Snapshot  *models.ComplianceSnapshot `json:"snapshot"`
+	ExpiresAt int64                      `json:"expiresAt"`
+}
+
+type getComplianceTrendParams struct {
+	Start, End     time.Time
+	ByIntegration  bool
+	ByPolicy       bool
+	ByResourceType bool
+}
+
+// GetComplianceTrend returns the daily compliance snapshots within a date range.
+func GetComplianceTrend(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	params, err := parseGetComplianceTrend(request)
+	if err != nil {
+		return badRequest(err)
+	}
+
+	snapshots, err := loadSnapshots(params.Start, params.End)
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	// Breakdowns can be large, so they are only returned when requested
+	for _, snapshot := range snapshots {
+		if !params.ByIntegration {
+			snapshot.ByIntegration = nil
+		}
+		if !params.ByPolicy {
+			snapshot.ByPolicy = nil
+		}
+		if !params.ByResourceType {
+			snapshot.ByResourceType = nil
+		}
+	}
+
+	return gatewayapi.MarshalResponse(&models.ComplianceTrend{Snapshots: snapshots}, http.StatusOK)
+}
+
+func parseGetComplianceTrend(request *events.APIGatewayProxyRequest) (*getComplianceTrendParams, error) {
+	var result getComplianceTrendParams
+	var err error
+
+	result.End = time.Now().UTC().Truncate(24 * time.Hour)
+	if raw := request.QueryStringParameters["end"]; raw != "" {
+		if result.End, err = time.Parse(dayLayout, raw); err != nil {
+			return nil, errors.New("invalid end: " + err.Error())
+		}
+	}
+
+	result.Start = result.End.AddDate(0, 0, -(defaultTrendDays - 1))
+	if raw := request.QueryStringParameters["start"]; raw != "" {
+		if result.Start, err = time.Parse(dayLayout, raw); err != nil {
+			return nil, errors.New("invalid start: " + err.Error())
+		}
+	}
+
+	if result.Start.After(result.End) {
+		return nil, errors.New("invalid start: must not be after end")
+	}
+	if result.End.Sub(result.Start) >= maxTrendDays*24*time.Hour {
+		return nil, errors.New("invalid date range: at most 366 days can be requested")
+	}
+
+	if raw := request.QueryStringParameters["include"]; raw != "" {
+		for _, breakdown := range strings.Split(raw, ",") {
+			switch breakdown {
+			case "byIntegration":
+				result.ByIntegration = true
+			case "byPolicy":
+				result.ByPolicy = true
+			case "byResourceType":
+				result.ByResourceType = true
+			default:
+				return nil, errors.New("invalid include: unknown breakdown " + breakdown)
+			}
+		}
+	}
+
+	return &result, nil
+}
+
+// Load the snapshots recorded within the date range (inclusive), sorted oldest first.
+//
+// Days without a snapshot (e.g. before Panther was deployed) are omitted.
+func loadSnapshots(start, end time.Time) ([]*models.ComplianceSnapshot, error) {
+	var keys []map[string]*dynamodb.AttributeValue
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		keys = append(keys, map[string]*dynamodb.AttributeValue{
+			"day": {S: aws.String(day.Format(dayLayout))},
+		})
+	}
+
+	input := &dynamodb.BatchGetItemInput{
+		RequestItems: map[string]*dynamodb.KeysAndAttributes{
+			Env.TrendTable: {Keys: keys},
+		},
+	}
+	output, err := dynamodbbatch.BatchGetItem(dynamoClient, input)
+	if err != nil {
+		zap.L().Error("dynamodbbatch.BatchGetItem failed", zap.Error(err))
+		return nil, err
+	}
+
+	var items []*trendItem
+	if err := dynamodbattribute.UnmarshalListOfMaps(output.Responses[Env.TrendTable], &items); err != nil {
+		zap.L().Error("failed to unmarshal trend items", zap.Error(err))
+		return nil, err
+	}
+
+	// BatchGetItem does not preserve the order of the keys
+	sort.Slice(items, func(i, j int) bool { return items[i].Day < items[j].Day })
+
+	result := make([]*models.ComplianceSnapshot, 0, len(items))
+	for _, item := range items {
+		result = append(result, item.Snapshot)
+	}
+	return result, nil
+}
+
+// RecordTrendSnapshot saves the current compliance aggregates as today's snapshot.
+//
+// This is invoked once a day on a schedule. Re-running it on the same day overwrites the snapshot
+// with the latest aggregates, so each day records the state of the organization at the end of the day.
+func RecordTrendSnapshot(_ *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	now := time.Now().UTC()
+	snapshot, err := buildSnapshot(now.Truncate(24 * time.Hour))
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	item, err := dynamodbattribute.MarshalMap(&trendItem{
+		Day:       now.Format(dayLayout),
+		Snapshot:  snapshot,
+		ExpiresAt: now.Add(trendRetention).Unix(),
+	})
+	if err != nil {
+		zap.L().Error("failed to marshal trend item", zap.Error(err))
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	if _, err = dynamoClient.PutItem(&dynamodb.PutItemInput{Item: item, TableName: &Env.TrendTable}); err != nil {
+		zap.L().Error("dynamoClient.PutItem failed", zap.Error(err))
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	return gatewayapi.MarshalResponse(snapshot, http.StatusOK)
+}
+
+// Scan the compliance table and summarize the organization for a single day.
+func buildSnapshot(day time.Time) (*models.ComplianceSnapshot, error) {
+	input, err := buildGetOrgOverviewQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make(policyMap, 200)
+	resources := make(resourceMap, 1000)
+	resourceIntegrations := make(map[models.ResourceID]models.IntegrationID, 1000)
+
+	err = scanPages(input, func(item *models.ComplianceStatus) error {
+		policy, ok := policies[item.PolicyID]
+		if !ok {
+			policy = &models.PolicySummary{
+				Count:    NewStatusCount(),
+				ID:       item.PolicyID,
+				Severity: item.PolicySeverity,
+			}
+			policies[item.PolicyID] = policy
+		}
+		updateStatusCount(policy.Count, item.Status)
+
+		resource, ok := resources[item.ResourceID]
+		if !ok {
+			resource = &models.ResourceSummary{
+				Count: NewStatusCountBySeverity(),
+				ID:    item.ResourceID,
+				Type:  item.ResourceType,
+			}
+			resources[item.ResourceID] = resource
+			resourceIntegrations[item.ResourceID] = item.IntegrationID
+		}
+		updateStatusCountBySeverity(resource.Count, item.PolicySeverity, item.Status)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summarizeSnapshot(day, policies, resources, resourceIntegrations), nil
+}
+
+// Convert the grouped compliance entries into a snapshot with sorted breakdowns
+func summarizeSnapshot(
+	day time.Time,
+	policies policyMap,
+	resources resourceMap,
+	resourceIntegrations map[models.ResourceID]models.IntegrationID,
+) *models.ComplianceSnapshot {
+
+	snapshot := &models.ComplianceSnapshot{
+		ByIntegration:  make([]*models.IntegrationStatusCount, 0),
+		ByPolicy:       make([]*models.PolicySummary, 0, len(policies)),
+		ByResourceType: make([]*models.ResourceOfType, 0),
+		Day:            models.Day(strfmt.Date(day)),
+		Policies:       NewStatusCountBySeverity(),
+		Resources:      NewStatusCount(),
+	}
+
+	for _, policy := range policies {
+		updateStatusCountBySeverity(snapshot.Policies, policy.Severity, countToStatus(policy.Count))
+		snapshot.ByPolicy = append(snapshot.ByPolicy, policy)
+	}
+
+	byIntegration := make(map[models.IntegrationID]*models.StatusCount)
+	byType := make(map[models.ResourceType]*models.StatusCount)
+	for _, resource := range resources {
+		status := countBySeverityToStatus(resource.Count)
+		updateStatusCount(snapshot.Resources, status)
+
+		integrationID := resourceIntegrations[resource.ID]
+		count, ok := byIntegration[integrationID]
+		if !ok {
+			count = NewStatusCount()
+			byIntegration[integrationID] = count
+		}
+		updateStatusCount(count, status)
+
+		count, ok = byType[resource.Type]
+		if !ok {
+			count = NewStatusCount()
+			byType[resource.Type] = count
+		}
+		updateStatusCount(count, status)
+	}
+
+	for integrationID, count := range byIntegration {
+		snapshot.ByIntegration = append(snapshot.ByIntegration,
+			&models.IntegrationStatusCount{Count: count, IntegrationID: integrationID})
+	}
+	for resourceType, count := range byType {
+		snapshot.ByResourceType = append(snapshot.ByResourceType,
+			&models.ResourceOfType{Count: count, Type: resourceType})
+	}
+
+	// Sort breakdowns so consecutive snapshots can be compared directly
+	sort.Slice(snapshot.ByIntegration, func(i, j int) bool {
+		return snapshot.ByIntegration[i].IntegrationID < snapshot.ByIntegration[j].IntegrationID
+	})
+	sort.Slice(snapshot.ByPolicy, func(i, j int) bool {
+		return snapshot.ByPolicy[i].ID < snapshot.ByPolicy[j].ID
+	})
+	sort.Slice(snapshot.ByResourceType, func(i, j int) bool {
+		return snapshot.ByResourceType[i].Type < snapshot.ByResourceType[j].Type
+	})
+
+	return snapshot
+}
@@ -79,6 +79,12 @@ func itemsToUpdate(input *models.UpdateMetadata) ([]*dynamodb.WriteRequest, *eve
 		return nil, &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
 	}
 
+	// Resources with an active exception stay suppressed regardless of the suppression patterns
+	excepted, err := exceptedResources(input.PolicyID)
+	if err != nil {
+		return nil, &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
 	zap.L().Info("querying items to update",
 		zap.String("policyId", string(input.PolicyID)))
 	var writes []*dynamodb.WriteRequest
@@ -87,6 +93,7 @@ func itemsToUpdate(input *models.UpdateMetadata) ([]*dynamodb.WriteRequest, *eve
 		if patternErr != nil {
 			return patternErr
 		}
+		ignored = ignored || excepted[item.ResourceID]
 
 		// This status entry has changed - we need to rewrite it
 		if bool(item.Suppressed) != ignored || item.PolicySeverity != input.Severity {
@@ -24,10 +24,12 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -124,6 +126,8 @@ func TestIntegrationAPI(t *testing.T) {
 
 	// Reset Dynamo table and build API client
 	require.NoError(t, testutils.ClearDynamoTable(awsSession, "panther-compliance"))
+	require.NoError(t, testutils.ClearDynamoTable(awsSession, "panther-compliance-exceptions"))
+	require.NoError(t, testutils.ClearDynamoTable(awsSession, "panther-compliance-trend"))
 	require.NotEmpty(t, endpoint)
 	apiClient = client.NewHTTPClientWithConfig(nil, client.DefaultTransportConfig().
 		WithBasePath("/v1").WithHost(endpoint))
@@ -131,6 +135,8 @@ func TestIntegrationAPI(t *testing.T) {
 	t.Run("CheckEmpty", func(t *testing.T) {
 		t.Run("DescribeOrgEmpty", describeOrgEmpty)
 		t.Run("GetOrgOverviewEmpty", getOrgOverviewEmpty)
+		t.Run("GetComplianceTrendEmpty", getComplianceTrendEmpty)
+		t.Run("ListExceptionsEmpty", listExceptionsEmpty)
 	})
 
 	t.Run("SetStatus", func(t *testing.T) {
@@ -167,6 +173,22 @@ func TestIntegrationAPI(t *testing.T) {
 	})
 	t.Run("DescribePolicyPageAndFilter", describePolicyPageAndFilter)
 
+	t.Run("Exceptions", func(t *testing.T) {
+		t.Run("AddExceptionExpired", addExceptionExpired)
+		t.Run("AddExceptionUnknownApprover", addExceptionUnknownApprover)
+		t.Run("ExpireExceptionsEmpty", expireExceptionsEmpty)
+	})
+
+	t.Run("Report", func(t *testing.T) {
+		t.Run("GetComplianceReportInvalidFramework", getComplianceReportInvalidFramework)
+		t.Run("GetComplianceReport", getComplianceReport)
+	})
+
+	t.Run("Trend", func(t *testing.T) {
+		t.Run("GetComplianceTrendInvalidRange", getComplianceTrendInvalidRange)
+		t.Run("RecordTrendSnapshot", recordTrendSnapshot)
+	})
+
 	t.Run("Update", update)
 	t.Run("Delete", deleteBatch)
 }
@@ -743,6 +765,151 @@ func getOrgOverviewCustomLimit(t *testing.T) {
 	assert.Equal(t, models.ResourceID("arn:aws:s3:::my-bucket"), resources[0].ID)
 }
 
+func listExceptionsEmpty(t *testing.T) {
+	t.Parallel()
+	result, err := apiClient.Operations.ListExceptions(&operations.ListExceptionsParams{
+		HTTPClient: httpClient,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &models.ExceptionList{Exceptions: []*models.PolicyException{}}, result.Payload)
+}
+
+func newException(expiresAt time.Time) *models.AddException {
+	expiration := strfmt.DateTime(expiresAt)
+	return &models.AddException{
+		ApprovedBy:    "4c3e2a1d-8f1b-4b0e-9c5d-6a7b8c9d0e1f",
+		CreatedBy:     "0f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0",
+		ExpiresAt:     &expiration,
+		Justification: "Public bucket hosts the marketing website",
+		PolicyID:      "AWS-S3-BlockPublicAccess",
+		ResourceID:    "arn:aws:s3:::my-bucket",
+	}
+}
+
+func addExceptionExpired(t *testing.T) {
+	t.Parallel()
+	result, err := apiClient.Operations.AddException(&operations.AddExceptionParams{
+		Body:       newException(time.Now().Add(-time.Hour)),
+		HTTPClient: httpClient,
+	})
+	assert.Nil(t, result)
+	require.Error(t, err)
+	require.IsType(t, &operations.AddExceptionBadRequest{}, err)
+	assert.Equal(t, "expiresAt: must be in the future",
+		aws.StringValue(err.(*operations.AddExceptionBadRequest).Payload.Message))
+}
+
+func addExceptionUnknownApprover(t *testing.T) {
+	t.Parallel()
+	result, err := apiClient.Operations.AddException(&operations.AddExceptionParams{
+		Body:       newException(time.Now().Add(24 * time.Hour)),
+		HTTPClient: httpClient,
+	})
+	assert.Nil(t, result)
+	require.Error(t, err)
+	require.IsType(t, &operations.AddExceptionBadRequest{}, err)
+}
+
+func expireExceptionsEmpty(t *testing.T) {
+	t.Parallel()
+	result, err := apiClient.Operations.ExpireExceptions(&operations.ExpireExceptionsParams{
+		HTTPClient: httpClient,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &models.ExceptionList{Exceptions: []*models.PolicyException{}}, result.Payload)
+}
+
+func getComplianceReportInvalidFramework(t *testing.T) {
+	t.Parallel()
+	result, err := apiClient.Operations.GetComplianceReport(&operations.GetComplianceReportParams{
+		Framework:  "HIPAA",
+		HTTPClient: httpClient,
+	})
+	assert.Nil(t, result)
+	require.Error(t, err)
+	require.IsType(t, &operations.GetComplianceReportBadRequest{}, err)
+}
+
+func getComplianceReport(t *testing.T) {
+	t.Parallel()
+	result, err := apiClient.Operations.GetComplianceReport(&operations.GetComplianceReportParams{
+		Framework:     models.ComplianceReportFrameworkCIS,
+		HTTPClient:    httpClient,
+		IntegrationID: aws.String(string(integrationID)),
+	})
+	require.NoError(t, err)
+
+	report := result.Payload
+	assert.Equal(t, models.ComplianceReportFrameworkCIS, *report.Framework)
+	assert.Equal(t, integrationID, report.IntegrationID)
+
+	// Every control in the benchmark is reported, whether or not a policy is mapped to it
+	require.True(t, len(report.Controls) >= 49)
+	assert.Equal(t, "1.1", *report.Controls[0].ID)
+	total := *report.Summary.Error + *report.Summary.Fail + *report.Summary.NotEvaluated + *report.Summary.Pass
+	assert.Equal(t, int64(len(report.Controls)), total)
+}
+
+func getComplianceTrendEmpty(t *testing.T) {
+	t.Parallel()
+	result, err := apiClient.Operations.GetComplianceTrend(&operations.GetComplianceTrendParams{
+		HTTPClient: httpClient,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &models.ComplianceTrend{Snapshots: []*models.ComplianceSnapshot{}}, result.Payload)
+}
+
+func getComplianceTrendInvalidRange(t *testing.T) {
+	t.Parallel()
+	start, end := strfmt.Date(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)),
+		strfmt.Date(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))
+	result, err := apiClient.Operations.GetComplianceTrend(&operations.GetComplianceTrendParams{
+		Start:      &start,
+		End:        &end,
+		HTTPClient: httpClient,
+	})
+	assert.Nil(t, result)
+	require.Error(t, err)
+	require.IsType(t, &operations.GetComplianceTrendBadRequest{}, err)
+}
+
+func recordTrendSnapshot(t *testing.T) {
+	result, err := apiClient.Operations.RecordTrendSnapshot(&operations.RecordTrendSnapshotParams{
+		HTTPClient: httpClient,
+	})
+	require.NoError(t, err)
+
+	snapshot := result.Payload
+	expectedResources := &models.StatusCount{
+		Error: aws.Int64(1),
+		Fail:  aws.Int64(1),
+		Pass:  aws.Int64(1),
+	}
+	assert.Equal(t, expectedResources, snapshot.Resources)
+	assert.Equal(t, []*models.IntegrationStatusCount{
+		{Count: expectedResources, IntegrationID: integrationID},
+	}, snapshot.ByIntegration)
+	require.Len(t, snapshot.ByPolicy, 4)
+	require.Len(t, snapshot.ByResourceType, 2)
+
+	// Breakdowns are omitted unless requested
+	trend, err := apiClient.Operations.GetComplianceTrend(&operations.GetComplianceTrendParams{
+		HTTPClient: httpClient,
+	})
+	require.NoError(t, err)
+	require.Len(t, trend.Payload.Snapshots, 1)
+	assert.Equal(t, snapshot.Day, trend.Payload.Snapshots[0].Day)
+	assert.Equal(t, snapshot.Policies, trend.Payload.Snapshots[0].Policies)
+	assert.Nil(t, trend.Payload.Snapshots[0].ByPolicy)
+
+	trend, err = apiClient.Operations.GetComplianceTrend(&operations.GetComplianceTrendParams{
+		Include:    []string{"byIntegration", "byPolicy", "byResourceType"},
+		HTTPClient: httpClient,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*models.ComplianceSnapshot{snapshot}, trend.Payload.Snapshots)
+}
+
 func update(t *testing.T) {
 	result, err := apiClient.Operations.UpdateMetadata(&operations.UpdateMetadataParams{
 		Body: &models.UpdateMetadata{
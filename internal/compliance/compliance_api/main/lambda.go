@@ -20,7 +20,6 @@ package main
 
 import (
 	"github.com/aws/aws-lambda-go/lambda"
-	"github.com/kelseyhightower/envconfig"
 
 	"github.com/panther-labs/panther/internal/compliance/compliance_api/handlers"
 	"github.com/panther-labs/panther/pkg/gatewayapi"
@@ -30,15 +29,21 @@ var methodHandlers = map[string]gatewayapi.RequestHandler{
 	"GET /describe-org":      handlers.DescribeOrg,
 	"GET /describe-policy":   handlers.DescribePolicy,
 	"GET /describe-resource": handlers.DescribeResource,
+	"GET /exceptions":        handlers.ListExceptions,
 	"GET /org-overview":      handlers.GetOrgOverview,
+	"GET /report":            handlers.GetComplianceReport,
 	"GET /status":            handlers.GetStatus,
+	"GET /trend":             handlers.GetComplianceTrend,
 
-	"POST /delete": handlers.DeleteStatus,
-	"POST /status": handlers.SetStatus,
-	"POST /update": handlers.UpdateMetadata,
+	"POST /delete":           handlers.DeleteStatus,
+	"POST /exception":        handlers.AddException,
+	"POST /exception/expire": handlers.ExpireExceptions,
+	"POST /status":           handlers.SetStatus,
+	"POST /trend":            handlers.RecordTrendSnapshot,
+	"POST /update":           handlers.UpdateMetadata,
 }
 
 func main() {
-	envconfig.MustProcess("", &handlers.Env)
+	handlers.Setup()
 	lambda.Start(gatewayapi.LambdaProxy(methodHandlers))
 }
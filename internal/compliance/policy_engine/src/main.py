@@ -17,14 +17,16 @@
 import logging
 import os
 import shutil
+import sys
 import tempfile
-from typing import Any, Dict
+from typing import Any, Dict, List
 
 from . import engine
 
 _LOGGER = logging.getLogger()
 _LOGGER.setLevel('INFO')
 _TMP = os.path.join(tempfile.gettempdir(), 'analysis')
+_GLOBALS = os.path.join(_TMP, 'globals')
 
 
 def lambda_handler(lambda_event: Dict[str, Any], unused_context: Any) -> Dict[str, Any]:
@@ -33,6 +35,12 @@ def lambda_handler(lambda_event: Dict[str, Any], unused_context: Any) -> Dict[st
     Args:
         lambda_event: {
             ###### Compliance Evaluation ######
+            'globals': [  # optional, replace the deployed globals with the same id
+                {
+                    'body': 'def helper(): ...',
+                    'id': 'panther'
+                }
+            ],
             'policies': [
                 {
                     'body': 'def policy(resource): ...',
@@ -94,7 +102,49 @@ def lambda_handler(lambda_event: Dict[str, Any], unused_context: Any) -> Dict[st
             py_file.write(policy['body'])
         policy['body'] = path  # Replace policy body with file path.
 
-    return engine.analyze(lambda_event)
+    global_names = _install_globals(lambda_event.get('globals') or [])
+    try:
+        return engine.analyze(lambda_event)
+    finally:
+        _remove_globals(global_names)
+
+
+def _install_globals(raw_globals: List[Dict[str, str]]) -> List[str]:
+    """Save globals to /tmp so they are imported instead of the deployed globals with the same name.
+
+    Returns the module names, which are passed to _remove_globals once the analysis is done.
+    """
+    if not raw_globals:
+        return []
+
+    os.makedirs(_GLOBALS)
+    names = []
+    for raw_global in raw_globals:
+        # Globals are imported by their id, any other id could never be imported
+        if not raw_global['id'].isidentifier():
+            continue
+        with open(os.path.join(_GLOBALS, raw_global['id'] + '.py'), 'w') as py_file:
+            py_file.write(raw_global['body'])
+        names.append(raw_global['id'])
+
+    if names:
+        sys.path.insert(0, _GLOBALS)
+        _unload_modules(names)
+    return names
+
+
+def _remove_globals(names: List[str]) -> None:
+    """Restore the deployed globals after _install_globals."""
+    if not names:
+        return
+    sys.path.remove(_GLOBALS)
+    _unload_modules(names)
+
+
+def _unload_modules(names: List[str]) -> None:
+    """Forget imported modules, so the next import loads them from the current sys.path."""
+    for name in names:
+        sys.modules.pop(name, None)
 
 
 def _allowed_char(char: str) -> bool:
@@ -72,6 +72,32 @@ class TestMain(unittest.TestCase):
         self.assertEqual(1, mock_logger.info.call_count)
         mock_logger.exception.assert_not_called()
 
+    def test_globals(self, unused_mock_logger: mock.MagicMock) -> None:
+        """Policies can import the globals of the event."""
+        lambda_event = {
+            'globals': [{
+                'body': 'def helper(): return False',
+                'id': 'test_policy_global'
+            }],
+            'policies': [{
+                'body': 'from test_policy_global import helper\ndef policy(resource): return helper()',
+                'id': 'panther-global'
+            }],
+            'resources': [{
+                'attributes': {},
+                'id': 'my-trail',
+                'type': 'AWS.CloudTrail'
+            }]
+        }
+        result = main.lambda_handler(lambda_event, None)
+        self.assertEqual(['panther-global'], result['resources'][0]['failed'])
+
+        # The globals are only importable during the invocation
+        del lambda_event['globals']
+        lambda_event['policies'][0]['body'] = 'from test_policy_global import helper\ndef policy(resource): return helper()'
+        result = main.lambda_handler(lambda_event, None)
+        self.assertEqual(1, len(result['resources'][0]['errored']))
+
     def test_duplicate_id(self, mock_logger: mock.MagicMock) -> None:
         """Policies with duplicate sanitized ids raise an error."""
         lambda_event = {
@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	jsoniter "github.com/json-iterator/go"
@@ -32,14 +33,23 @@ import (
 
 	"github.com/panther-labs/panther/api/gateway/remediation/models"
 	"github.com/panther-labs/panther/internal/compliance/remediation_api/remediation"
+	"github.com/panther-labs/panther/pkg/authz"
 )
 
 var (
 	sqsQueueURL = os.Getenv("SQS_QUEUE_URL")
 
+	// When true, automatic remediations wait for a user to approve them
+	requireApproval = os.Getenv("REQUIRE_APPROVAL") == "true"
+
 	awsSession                        = session.Must(session.NewSession())
 	sqsClient  sqsiface.SQSAPI        = sqs.New(awsSession)
 	invoker    remediation.InvokerAPI = remediation.NewInvoker(session.Must(session.NewSession()))
+	auditor    remediation.AuditAPI   = remediation.NewAudit(awsSession)
+
+	// PermissionChecker resolves the permissions of API callers.
+	PermissionChecker authz.Checker = authz.NewTableChecker(
+		dynamodb.New(awsSession), os.Getenv("USER_ROLES_TABLE"), os.Getenv("ROLES_TABLE"))
 
 	//RemediationLambdaNotFound is the Error when the remediation Lambda is not found
 	RemediationLambdaNotFound = &models.Error{Message: aws.String("Remediation Lambda not found or misconfigured")}
@@ -42,3 +42,55 @@ func (m *mockInvoker) GetRemediations() (*models.Remediations, error) {
 	}
 	return args.Get(0).(*models.Remediations), args.Error(1)
 }
+
+func (m *mockInvoker) GetPayload(input *models.RemediateResource) (*remediation.Payload, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*remediation.Payload), args.Error(1)
+}
+
+func (m *mockInvoker) Invoke(payload *remediation.Payload) error {
+	args := m.Called(payload)
+	return args.Error(0)
+}
+
+func (m *mockInvoker) DryRun(payload *remediation.Payload) (*models.RemediationPlan, error) {
+	args := m.Called(payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RemediationPlan), args.Error(1)
+}
+
+type mockAuditor struct {
+	remediation.AuditAPI
+	mock.Mock
+}
+
+func (m *mockAuditor) Put(record *models.RemediationRecord) error {
+	args := m.Called(record)
+	return args.Error(0)
+}
+
+func (m *mockAuditor) List(status models.RemediationStatus) ([]*models.RemediationRecord, error) {
+	args := m.Called(status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.RemediationRecord), args.Error(1)
+}
+
+func (m *mockAuditor) Finish(record *models.RemediationRecord, payload *remediation.Payload, remediationErr error) error {
+	args := m.Called(record, payload, remediationErr)
+	return args.Error(0)
+}
+
+func (m *mockAuditor) Review(input *models.ReviewRemediation, reviewedBy models.UserID) (*models.RemediationRecord, error) {
+	args := m.Called(input, reviewedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RemediationRecord), args.Error(1)
+}
@@ -22,9 +22,11 @@ import (
 	"net/http"
 
 	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/aws"
 	jsoniter "github.com/json-iterator/go"
 	"go.uber.org/zap"
 
+	"github.com/panther-labs/panther/api/gateway/remediation/models"
 	"github.com/panther-labs/panther/pkg/gatewayapi"
 	"github.com/panther-labs/panther/pkg/genericapi"
 )
@@ -50,3 +52,21 @@ func GetRemediations(_ *events.APIGatewayProxyRequest) *events.APIGatewayProxyRe
 
 	return &events.APIGatewayProxyResponse{StatusCode: http.StatusOK, Body: body}
 }
+
+// ListRemediationAudit returns the audit record of every remediation, newest first
+func ListRemediationAudit(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	status := models.RemediationStatus(request.QueryStringParameters["status"])
+	if status != "" {
+		if err := status.Validate(nil); err != nil {
+			return badRequest(aws.String("invalid status: " + err.Error()))
+		}
+	}
+
+	records, err := auditor.List(status)
+	if err != nil {
+		zap.L().Error("failed to list remediation records", zap.Error(err))
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	return gatewayapi.MarshalResponse(&models.RemediationRecordList{Records: records}, http.StatusOK)
+}
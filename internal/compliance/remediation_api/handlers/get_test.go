@@ -77,3 +77,41 @@ func TestGetRemediationsLambdaDoesntExist(t *testing.T) {
 	assert.Equal(t, expectedResponseBody, responseBody)
 	mockInvoker.AssertExpectations(t)
 }
+
+func TestListRemediationAudit(t *testing.T) {
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+
+	request := &events.APIGatewayProxyRequest{
+		QueryStringParameters: map[string]string{"status": "PENDING_APPROVAL"},
+	}
+
+	records := []*models.RemediationRecord{{
+		ID:         "0a0d6b5e-3c1d-4c43-8d0b-3e0b4a7a2f11",
+		Parameters: models.RemediationParameters{"SSEAlgorithm": "AES256"},
+		PolicyID:   "policyId",
+		ResourceID: "resourceId",
+		Status:     models.RemediationStatusPENDINGAPPROVAL,
+	}}
+	mockAuditor.On("List", models.RemediationStatusPENDINGAPPROVAL).Return(records, nil)
+
+	response := ListRemediationAudit(request)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	var responseBody models.RemediationRecordList
+	assert.NoError(t, jsoniter.UnmarshalFromString(response.Body, &responseBody))
+	assert.Equal(t, records, responseBody.Records)
+	mockAuditor.AssertExpectations(t)
+}
+
+func TestListRemediationAuditInvalidStatus(t *testing.T) {
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+
+	request := &events.APIGatewayProxyRequest{
+		QueryStringParameters: map[string]string{"status": "DONE"},
+	}
+
+	response := ListRemediationAudit(request)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	mockAuditor.AssertExpectations(t)
+}
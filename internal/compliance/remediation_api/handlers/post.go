@@ -40,20 +40,27 @@ func RemediateResource(request *events.APIGatewayProxyRequest) *events.APIGatewa
 		return errorResponse
 	}
 
-	zap.L().Debug("invoking remediation synchronously")
+	payload, err := invoker.GetPayload(remediateResource)
+	if err != nil {
+		return remediationError(err)
+	}
 
-	if err := invoker.Remediate(remediateResource); err != nil {
-		if err == remediation.ErrNotFound {
-			return gatewayapi.MarshalResponse(
-				&models.Error{Message: aws.String(err.Error())}, http.StatusBadRequest)
-		}
-		if _, ok := err.(*genericapi.DoesNotExistError); ok {
-			return gatewayapi.MarshalResponse(RemediationLambdaNotFound, http.StatusNotFound)
-		}
-		zap.L().Warn("failed to invoke remediation", zap.Error(err))
+	// The remediation is only applied once the audit record is saved
+	record := remediation.NewRecord(remediateResource, payload, models.RemediationStatusINPROGRESS)
+	if err = auditor.Put(record); err != nil {
+		zap.L().Error("failed to save remediation record", zap.Error(err))
 		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
 	}
 
+	zap.L().Debug("invoking remediation synchronously")
+	remediationErr := invoker.Invoke(payload)
+	if err = auditor.Finish(record, payload, remediationErr); err != nil {
+		zap.L().Error("failed to record remediation result", zap.String("auditId", string(record.ID)), zap.Error(err))
+	}
+	if remediationErr != nil {
+		return remediationError(remediationErr)
+	}
+
 	zap.L().Debug("successfully invoked remediation",
 		zap.Any("policyId", remediateResource.PolicyID),
 		zap.Any("resourceId", remediateResource.ResourceID))
@@ -63,21 +70,51 @@ func RemediateResource(request *events.APIGatewayProxyRequest) *events.APIGatewa
 // RemediateResourceAsync triggers remediation for a resource. The remediation is asynchronous
 // so the method will return before the resource has been fixed, independently if it was
 // successful or failed.
+//
+// If approval is required, automatic remediations are not queued until a user approves them.
 func RemediateResourceAsync(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
 	remediateResource, errorResponse := checkRequest(request)
 	if errorResponse != nil {
 		return errorResponse
 	}
 
-	zap.L().Debug("sending SQS message to trigger asynchronous remediation")
+	payload, err := invoker.GetPayload(remediateResource)
+	if err != nil {
+		return remediationError(err)
+	}
 
-	sendMessageRequest := &sqs.SendMessageInput{
-		MessageBody: aws.String(request.Body),
-		QueueUrl:    aws.String(sqsQueueURL),
+	// Remediations requested by a user have already been confirmed by that user
+	if requireApproval && remediateResource.UserID == "" {
+		record := remediation.NewRecord(remediateResource, payload, models.RemediationStatusPENDINGAPPROVAL)
+
+		// Show the reviewer exactly what would change. The plan is informational,
+		// so the remediation is still pending approval if it can't be built.
+		if record.Plan, err = invoker.DryRun(payload); err != nil {
+			zap.L().Warn("failed to plan remediation pending approval", zap.Error(err))
+		}
+
+		if err = auditor.Put(record); err != nil {
+			zap.L().Error("failed to save remediation record", zap.Error(err))
+			return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+		}
+
+		zap.L().Info("remediation is pending approval",
+			zap.String("auditId", string(record.ID)),
+			zap.Any("policyId", remediateResource.PolicyID),
+			zap.Any("resourceId", remediateResource.ResourceID))
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusOK}
 	}
 
-	if _, err := sqsClient.SendMessage(sendMessageRequest); err != nil {
-		zap.L().Warn("failed to send message", zap.Error(err))
+	record := remediation.NewRecord(remediateResource, payload, models.RemediationStatusINPROGRESS)
+	if err = auditor.Put(record); err != nil {
+		zap.L().Error("failed to save remediation record", zap.Error(err))
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	if err = queueRemediation(remediateResource, record); err != nil {
+		if err = auditor.Finish(record, payload, err); err != nil {
+			zap.L().Error("failed to record remediation result", zap.String("auditId", string(record.ID)), zap.Error(err))
+		}
 		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
 	}
 
@@ -87,6 +124,119 @@ func RemediateResourceAsync(request *events.APIGatewayProxyRequest) *events.APIG
 	return &events.APIGatewayProxyResponse{StatusCode: http.StatusOK}
 }
 
+// DryRunRemediation returns the changes a remediation would make without applying them
+func DryRunRemediation(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	remediateResource, errorResponse := checkRequest(request)
+	if errorResponse != nil {
+		return errorResponse
+	}
+
+	payload, err := invoker.GetPayload(remediateResource)
+	if err != nil {
+		return remediationError(err)
+	}
+
+	record := remediation.NewRecord(remediateResource, payload, models.RemediationStatusINPROGRESS)
+	record.DryRun = aws.Bool(true)
+
+	plan, planErr := invoker.DryRun(payload)
+	record.Plan = plan
+	if err = auditor.Finish(record, payload, planErr); err != nil {
+		zap.L().Error("failed to save remediation record", zap.Error(err))
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+	if planErr != nil {
+		return remediationError(planErr)
+	}
+
+	return gatewayapi.MarshalResponse(plan, http.StatusOK)
+}
+
+// ReviewRemediation approves or rejects a remediation which is pending approval.
+//
+// The reviewer is the user who made the request. Approved remediations are queued and applied asynchronously.
+func ReviewRemediation(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	reviewedBy := gatewayapi.CallerID(request)
+	if reviewedBy == "" {
+		return gatewayapi.MarshalResponse(
+			&models.Error{Message: aws.String("missing " + gatewayapi.UserIDHeader + " header")}, http.StatusForbidden)
+	}
+
+	var input models.ReviewRemediation
+	if err := jsoniter.UnmarshalFromString(request.Body, &input); err != nil {
+		return badRequest(aws.String("invalid request"))
+	}
+	if err := input.Validate(nil); err != nil {
+		return badRequest(aws.String(err.Error()))
+	}
+
+	record, err := auditor.Review(&input, models.UserID(reviewedBy))
+	if err != nil {
+		if err == remediation.ErrNotPending {
+			return gatewayapi.MarshalResponse(&models.Error{Message: aws.String(err.Error())}, http.StatusNotFound)
+		}
+		zap.L().Error("failed to review remediation", zap.Error(err))
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	zap.L().Info("remediation reviewed",
+		zap.String("auditId", string(record.ID)),
+		zap.String("reviewedBy", string(record.ReviewedBy)),
+		zap.String("status", string(record.Status)))
+
+	// The remediation processor applies the remediation only if it still makes the approved changes
+	if *input.Approve {
+		remediateResource := &models.RemediateResource{
+			PolicyID:   record.PolicyID,
+			ResourceID: record.ResourceID,
+			UserID:     record.TriggeredBy,
+		}
+		if err = queueRemediation(remediateResource, record); err != nil {
+			if err = auditor.Finish(record, nil, err); err != nil {
+				zap.L().Error("failed to record remediation result", zap.String("auditId", string(record.ID)), zap.Error(err))
+			}
+			return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+		}
+	}
+
+	return gatewayapi.MarshalResponse(record, http.StatusOK)
+}
+
+// Send the remediation to the queue to be applied by the remediation processor
+func queueRemediation(remediateResource *models.RemediateResource, record *models.RemediationRecord) error {
+	body, err := jsoniter.MarshalToString(&remediation.QueueMessage{
+		RemediateResource: *remediateResource,
+		AuditID:           record.ID,
+	})
+	if err != nil {
+		zap.L().Error("failed to marshal queue message", zap.Error(err))
+		return err
+	}
+
+	zap.L().Debug("sending SQS message to trigger asynchronous remediation")
+	sendMessageRequest := &sqs.SendMessageInput{
+		MessageBody: aws.String(body),
+		QueueUrl:    aws.String(sqsQueueURL),
+	}
+	if _, err = sqsClient.SendMessage(sendMessageRequest); err != nil {
+		zap.L().Warn("failed to send message", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Convert an error from building or invoking a remediation into a proxy response
+func remediationError(err error) *events.APIGatewayProxyResponse {
+	if err == remediation.ErrNotFound {
+		return gatewayapi.MarshalResponse(&models.Error{Message: aws.String(err.Error())}, http.StatusBadRequest)
+	}
+	if _, ok := err.(*genericapi.DoesNotExistError); ok {
+		return gatewayapi.MarshalResponse(RemediationLambdaNotFound, http.StatusNotFound)
+	}
+	zap.L().Warn("failed to invoke remediation", zap.Error(err))
+	return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+}
+
 func checkRequest(request *events.APIGatewayProxyRequest) (*models.RemediateResource, *events.APIGatewayProxyResponse) {
 	var remediateResource models.RemediateResource
 
@@ -98,5 +248,8 @@ func checkRequest(request *events.APIGatewayProxyRequest) (*models.RemediateReso
 		return nil, badRequest(aws.String(err.Error()))
 	}
 
+	// The user is whoever made the request, not whoever the body claims to be
+	remediateResource.UserID = models.UserID(gatewayapi.CallerID(request))
+
 	return &remediateResource, nil
 }
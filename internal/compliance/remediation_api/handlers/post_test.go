@@ -19,6 +19,7 @@ package apihandlers
  */
 
 import (
+	"errors"
 	"net/http"
 	"testing"
 
@@ -31,6 +32,8 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/panther-labs/panther/api/gateway/remediation/models"
+	"github.com/panther-labs/panther/internal/compliance/remediation_api/remediation"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
 	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
@@ -49,6 +52,21 @@ var input = &models.RemediateResource{
 	ResourceID: "resourceId",
 }
 
+var reviewer models.UserID = "5f9f1d0b-6d3b-4e55-a0a5-1ff1d1a7b5b4"
+
+var payload = &remediation.Payload{
+	RemediationID: "AWS.S3.EnableBucketEncryption",
+	Resource:      map[string]interface{}{"Name": "bucket"},
+	Parameters:    models.RemediationParameters{"SSEAlgorithm": "AES256"},
+}
+
+// Match a record saved by a handler, the record ID and timestamps are generated
+func recordWithStatus(status models.RemediationStatus) interface{} {
+	return mock.MatchedBy(func(record *models.RemediationRecord) bool {
+		return record.Status == status && record.PolicyID == input.PolicyID && record.ResourceID == input.ResourceID
+	})
+}
+
 func TestRemediateResource(t *testing.T) {
 	mockInvoker := &mockInvoker{}
 	invoker = mockInvoker
@@ -58,13 +76,39 @@ func TestRemediateResource(t *testing.T) {
 	serializedPayload, _ := jsoniter.MarshalToString(input)
 	request := &events.APIGatewayProxyRequest{Body: serializedPayload}
 
-	mockInvoker.On("Remediate", input).Return(nil)
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+
+	mockInvoker.On("GetPayload", input).Return(payload, nil)
+	mockInvoker.On("Invoke", payload).Return(nil)
+	mockAuditor.On("Put", recordWithStatus(models.RemediationStatusINPROGRESS)).Return(nil)
+	mockAuditor.On("Finish", mock.Anything, payload, nil).Return(nil)
 
 	response := RemediateResource(request)
 	assert.Equal(t, http.StatusOK, response.StatusCode)
 	assert.Equal(t, "", response.Body)
 	mockInvoker.AssertExpectations(t)
 	mockSqsClient.AssertExpectations(t)
+	mockAuditor.AssertExpectations(t)
+}
+
+func TestRemediateResourceAuditFailure(t *testing.T) {
+	mockInvoker := &mockInvoker{}
+	invoker = mockInvoker
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+
+	serializedPayload, _ := jsoniter.MarshalToString(input)
+	request := &events.APIGatewayProxyRequest{Body: serializedPayload}
+
+	mockInvoker.On("GetPayload", input).Return(payload, nil)
+	mockAuditor.On("Put", mock.Anything).Return(errors.New("error"))
+
+	// The remediation must not be applied if it can't be audited
+	response := RemediateResource(request)
+	assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
+	mockInvoker.AssertExpectations(t)
+	mockAuditor.AssertExpectations(t)
 }
 
 func TestRemediateResourceMissingParameters(t *testing.T) {
@@ -102,22 +146,283 @@ func TestRemediateResourceAsync(t *testing.T) {
 	invoker = mockInvoker
 	mockSqsClient := &mockSqsClient{}
 	sqsClient = mockSqsClient
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
 	sqsQueueURL = "sqsQueueURL"
+	requireApproval = false
 
 	serializedPayload, _ := input.MarshalBinary()
 	request := &events.APIGatewayProxyRequest{Body: string(serializedPayload)}
 
-	expectedSqsInput := &sqs.SendMessageInput{
-		MessageBody: aws.String(string(serializedPayload)),
-		QueueUrl:    aws.String(sqsQueueURL),
-	}
-	mockSqsClient.On("SendMessage", expectedSqsInput).Return(&sqs.SendMessageOutput{}, nil)
+	var auditID models.RemediationRecordID
+	mockInvoker.On("GetPayload", input).Return(payload, nil)
+	mockAuditor.On("Put", recordWithStatus(models.RemediationStatusINPROGRESS)).Return(nil).Run(
+		func(args mock.Arguments) { auditID = args.Get(0).(*models.RemediationRecord).ID })
+	mockSqsClient.On("SendMessage", mock.Anything).Return(&sqs.SendMessageOutput{}, nil)
 
 	response := RemediateResourceAsync(request)
 	assert.Equal(t, http.StatusOK, response.StatusCode)
 	assert.Equal(t, "", response.Body)
 	mockInvoker.AssertExpectations(t)
 	mockSqsClient.AssertExpectations(t)
+	mockAuditor.AssertExpectations(t)
+
+	sendMessageInput := mockSqsClient.Calls[0].Arguments.Get(0).(*sqs.SendMessageInput)
+	assert.Equal(t, sqsQueueURL, *sendMessageInput.QueueUrl)
+	var message remediation.QueueMessage
+	assert.NoError(t, jsoniter.UnmarshalFromString(*sendMessageInput.MessageBody, &message))
+	assert.Equal(t, remediation.QueueMessage{RemediateResource: *input, AuditID: auditID}, message)
+}
+
+func TestRemediateResourceAsyncPendingApproval(t *testing.T) {
+	mockInvoker := &mockInvoker{}
+	invoker = mockInvoker
+	mockSqsClient := &mockSqsClient{}
+	sqsClient = mockSqsClient
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+	requireApproval = true
+	defer func() { requireApproval = false }()
+
+	serializedPayload, _ := input.MarshalBinary()
+	request := &events.APIGatewayProxyRequest{Body: string(serializedPayload)}
+
+	plan := &models.RemediationPlan{RemediationID: "AWS.S3.EnableBucketEncryption"}
+	mockInvoker.On("GetPayload", input).Return(payload, nil)
+	mockInvoker.On("DryRun", payload).Return(plan, nil)
+	mockAuditor.On("Put", mock.MatchedBy(func(record *models.RemediationRecord) bool {
+		return record.Status == models.RemediationStatusPENDINGAPPROVAL && record.Plan == plan
+	})).Return(nil)
+
+	// Nothing is queued until the remediation is approved
+	response := RemediateResourceAsync(request)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	mockInvoker.AssertExpectations(t)
+	mockSqsClient.AssertExpectations(t)
+	mockAuditor.AssertExpectations(t)
+}
+
+func TestRemediateResourceAsyncUserSkipsApproval(t *testing.T) {
+	mockInvoker := &mockInvoker{}
+	invoker = mockInvoker
+	mockSqsClient := &mockSqsClient{}
+	sqsClient = mockSqsClient
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+	requireApproval = true
+	defer func() { requireApproval = false }()
+
+	userInput := &models.RemediateResource{
+		PolicyID:   input.PolicyID,
+		ResourceID: input.ResourceID,
+		UserID:     reviewer,
+	}
+	serializedPayload, _ := input.MarshalBinary()
+	request := &events.APIGatewayProxyRequest{
+		Body:    string(serializedPayload),
+		Headers: map[string]string{gatewayapi.UserIDHeader: string(reviewer)},
+	}
+
+	mockInvoker.On("GetPayload", userInput).Return(payload, nil)
+	mockAuditor.On("Put", mock.MatchedBy(func(record *models.RemediationRecord) bool {
+		return record.Status == models.RemediationStatusINPROGRESS && record.TriggeredBy == userInput.UserID
+	})).Return(nil)
+	mockSqsClient.On("SendMessage", mock.Anything).Return(&sqs.SendMessageOutput{}, nil)
+
+	response := RemediateResourceAsync(request)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	mockInvoker.AssertExpectations(t)
+	mockSqsClient.AssertExpectations(t)
+	mockAuditor.AssertExpectations(t)
+}
+
+func TestRemediateResourceAsyncBodyUserNeedsApproval(t *testing.T) {
+	mockInvoker := &mockInvoker{}
+	invoker = mockInvoker
+	mockSqsClient := &mockSqsClient{}
+	sqsClient = mockSqsClient
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+	requireApproval = true
+	defer func() { requireApproval = false }()
+
+	// Only the caller header identifies a user, a user ID in the body does not skip the approval
+	userInput := &models.RemediateResource{
+		PolicyID:   input.PolicyID,
+		ResourceID: input.ResourceID,
+		UserID:     reviewer,
+	}
+	serializedPayload, _ := userInput.MarshalBinary()
+	request := &events.APIGatewayProxyRequest{Body: string(serializedPayload)}
+
+	mockInvoker.On("GetPayload", input).Return(payload, nil)
+	mockInvoker.On("DryRun", payload).Return(&models.RemediationPlan{}, nil)
+	mockAuditor.On("Put", mock.MatchedBy(func(record *models.RemediationRecord) bool {
+		return record.Status == models.RemediationStatusPENDINGAPPROVAL && record.TriggeredBy == ""
+	})).Return(nil)
+
+	response := RemediateResourceAsync(request)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	mockInvoker.AssertExpectations(t)
+	mockSqsClient.AssertExpectations(t)
+	mockAuditor.AssertExpectations(t)
+}
+
+func TestRemediateResourceAsyncQueueFailure(t *testing.T) {
+	mockInvoker := &mockInvoker{}
+	invoker = mockInvoker
+	mockSqsClient := &mockSqsClient{}
+	sqsClient = mockSqsClient
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+	requireApproval = false
+
+	serializedPayload, _ := input.MarshalBinary()
+	request := &events.APIGatewayProxyRequest{Body: string(serializedPayload)}
+
+	mockInvoker.On("GetPayload", input).Return(payload, nil)
+	mockAuditor.On("Put", recordWithStatus(models.RemediationStatusINPROGRESS)).Return(nil)
+	mockSqsClient.On("SendMessage", mock.Anything).Return(&sqs.SendMessageOutput{}, errors.New("error"))
+	// The record must not be left in progress since the remediation will never run
+	mockAuditor.On("Finish", mock.Anything, payload, errors.New("error")).Return(nil)
+
+	response := RemediateResourceAsync(request)
+	assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
+	mockInvoker.AssertExpectations(t)
+	mockSqsClient.AssertExpectations(t)
+	mockAuditor.AssertExpectations(t)
+}
+
+func TestDryRunRemediation(t *testing.T) {
+	mockInvoker := &mockInvoker{}
+	invoker = mockInvoker
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+
+	serializedPayload, _ := input.MarshalBinary()
+	request := &events.APIGatewayProxyRequest{Body: string(serializedPayload)}
+
+	plan := &models.RemediationPlan{
+		Calls: []*models.RemediationCall{{
+			Operation:  aws.String("put_bucket_encryption"),
+			Parameters: map[string]interface{}{"Bucket": "bucket"},
+			Service:    aws.String("s3"),
+		}},
+		Parameters:    payload.Parameters,
+		RemediationID: "AWS.S3.EnableBucketEncryption",
+	}
+	mockInvoker.On("GetPayload", input).Return(payload, nil)
+	mockInvoker.On("DryRun", payload).Return(plan, nil)
+	mockAuditor.On("Finish", mock.MatchedBy(func(record *models.RemediationRecord) bool {
+		return *record.DryRun && record.Plan == plan
+	}), payload, nil).Return(nil)
+
+	response := DryRunRemediation(request)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	var responseBody models.RemediationPlan
+	assert.NoError(t, jsoniter.UnmarshalFromString(response.Body, &responseBody))
+	assert.Equal(t, plan, &responseBody)
+	mockInvoker.AssertExpectations(t)
+	mockAuditor.AssertExpectations(t)
+}
+
+func TestReviewRemediationApprove(t *testing.T) {
+	mockSqsClient := &mockSqsClient{}
+	sqsClient = mockSqsClient
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+
+	review := &models.ReviewRemediation{
+		Approve: aws.Bool(true),
+		ID:      "0a0d6b5e-3c1d-4c43-8d0b-3e0b4a7a2f11",
+	}
+	serializedPayload, _ := review.MarshalBinary()
+	request := &events.APIGatewayProxyRequest{
+		Body:    string(serializedPayload),
+		Headers: map[string]string{gatewayapi.UserIDHeader: string(reviewer)},
+	}
+
+	record := &models.RemediationRecord{
+		ID:         review.ID,
+		PolicyID:   input.PolicyID,
+		ResourceID: input.ResourceID,
+		ReviewedBy: reviewer,
+		Status:     models.RemediationStatusINPROGRESS,
+	}
+	mockAuditor.On("Review", review, reviewer).Return(record, nil)
+	mockSqsClient.On("SendMessage", mock.Anything).Return(&sqs.SendMessageOutput{}, nil)
+
+	response := ReviewRemediation(request)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	mockSqsClient.AssertExpectations(t)
+	mockAuditor.AssertExpectations(t)
+
+	var message remediation.QueueMessage
+	body := mockSqsClient.Calls[0].Arguments.Get(0).(*sqs.SendMessageInput).MessageBody
+	assert.NoError(t, jsoniter.UnmarshalFromString(*body, &message))
+	assert.Equal(t, remediation.QueueMessage{RemediateResource: *input, AuditID: review.ID}, message)
+}
+
+func TestReviewRemediationReject(t *testing.T) {
+	mockSqsClient := &mockSqsClient{}
+	sqsClient = mockSqsClient
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+
+	review := &models.ReviewRemediation{
+		Approve: aws.Bool(false),
+		ID:      "0a0d6b5e-3c1d-4c43-8d0b-3e0b4a7a2f11",
+	}
+	serializedPayload, _ := review.MarshalBinary()
+	request := &events.APIGatewayProxyRequest{
+		Body:    string(serializedPayload),
+		Headers: map[string]string{gatewayapi.UserIDHeader: string(reviewer)},
+	}
+
+	record := &models.RemediationRecord{ID: review.ID, Status: models.RemediationStatusREJECTED}
+	mockAuditor.On("Review", review, reviewer).Return(record, nil)
+
+	response := ReviewRemediation(request)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	mockSqsClient.AssertExpectations(t)
+	mockAuditor.AssertExpectations(t)
+}
+
+func TestReviewRemediationWithoutCaller(t *testing.T) {
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+
+	review := &models.ReviewRemediation{
+		Approve: aws.Bool(true),
+		ID:      "0a0d6b5e-3c1d-4c43-8d0b-3e0b4a7a2f11",
+	}
+	serializedPayload, _ := review.MarshalBinary()
+	request := &events.APIGatewayProxyRequest{Body: string(serializedPayload)}
+
+	response := ReviewRemediation(request)
+	assert.Equal(t, http.StatusForbidden, response.StatusCode)
+	mockAuditor.AssertExpectations(t)
+}
+
+func TestReviewRemediationNotPending(t *testing.T) {
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+
+	review := &models.ReviewRemediation{
+		Approve: aws.Bool(true),
+		ID:      "0a0d6b5e-3c1d-4c43-8d0b-3e0b4a7a2f11",
+	}
+	serializedPayload, _ := review.MarshalBinary()
+	request := &events.APIGatewayProxyRequest{
+		Body:    string(serializedPayload),
+		Headers: map[string]string{gatewayapi.UserIDHeader: string(reviewer)},
+	}
+
+	mockAuditor.On("Review", review, reviewer).Return(nil, remediation.ErrNotPending)
+
+	response := ReviewRemediation(request)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	mockAuditor.AssertExpectations(t)
 }
 
 func TestRemediateResourceLambdaDoesntExist(t *testing.T) {
@@ -127,8 +432,14 @@ func TestRemediateResourceLambdaDoesntExist(t *testing.T) {
 	serializedPayload, _ := jsoniter.MarshalToString(input)
 	request := &events.APIGatewayProxyRequest{Body: serializedPayload}
 
-	mockInvoker.On("Remediate", input).Return(
-		&genericapi.DoesNotExistError{Message: "there is no aws remediation lambda configured for organization"})
+	mockAuditor := &mockAuditor{}
+	auditor = mockAuditor
+
+	lambdaErr := &genericapi.DoesNotExistError{Message: "there is no aws remediation lambda configured for organization"}
+	mockInvoker.On("GetPayload", input).Return(payload, nil)
+	mockInvoker.On("Invoke", payload).Return(lambdaErr)
+	mockAuditor.On("Put", mock.Anything).Return(nil)
+	mockAuditor.On("Finish", mock.Anything, payload, lambdaErr).Return(nil)
 	expectedResponseBody := &models.Error{Message: aws.String("Remediation Lambda not found or misconfigured")}
 
 	response := RemediateResource(request)
@@ -139,4 +450,5 @@ func TestRemediateResourceLambdaDoesntExist(t *testing.T) {
 	assert.Equal(t, expectedResponseBody, responseBody)
 
 	mockInvoker.AssertExpectations(t)
+	mockAuditor.AssertExpectations(t)
 }
@@ -22,15 +22,34 @@ import (
 	"github.com/aws/aws-lambda-go/lambda"
 
 	apihandlers "github.com/panther-labs/panther/internal/compliance/remediation_api/handlers"
+	"github.com/panther-labs/panther/pkg/authz"
 	"github.com/panther-labs/panther/pkg/gatewayapi"
 )
 
 var methodHandlers = map[string]gatewayapi.RequestHandler{
 	"GET /":                apihandlers.GetRemediations,
+	"GET /audit":           apihandlers.ListRemediationAudit,
+	"POST /dryrun":         apihandlers.DryRunRemediation,
 	"POST /remediate":      apihandlers.RemediateResource,
 	"POST /remediateasync": apihandlers.RemediateResourceAsync,
+	"POST /review":         apihandlers.ReviewRemediation,
 }
 
+var policyModify = []authz.Permission{authz.PolicyModify}
+
+// Permissions required when a Panther user calls the API through AppSync
+var permissions = map[string][]authz.Permission{
+	"POST /dryrun":         policyModify,
+	"POST /remediate":      policyModify,
+	"POST /remediateasync": policyModify,
+	"POST /review":         policyModify,
+}
+
+// Protected methods which other Panther services invoke without a caller:
+// the automatic remediations triggered by the alert processor.
+var serviceMethods = []string{"POST /remediateasync"}
+
 func main() {
-	lambda.Start(gatewayapi.LambdaProxy(methodHandlers))
+	lambda.Start(gatewayapi.LambdaProxy(
+		gatewayapi.RequirePermissions(apihandlers.PermissionChecker, permissions, methodHandlers, serviceMethods...)))
 }
@@ -0,0 +1,269 @@
+package remediation
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"os"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/panther-labs/panther/api/gateway/remediation/models"
+)
+
+var (
+	auditTable = os.Getenv("AUDIT_TABLE")
+
+	ErrNotPending = errors.New("Remediation not found or no longer pending approval")
+	ErrChanged    = errors.New("Remediation no longer matches the approved changes, it must be requested and approved again")
+)
+
+// AuditAPI is the interface for the Audit,
+// the component that is responsible for recording every remediation
+type AuditAPI interface {
+	Put(*models.RemediationRecord) error
+	Get(models.RemediationRecordID) (*models.RemediationRecord, error)
+	List(models.RemediationStatus) ([]*models.RemediationRecord, error)
+	Finish(*models.RemediationRecord, *Payload, error) error
+	Review(*models.ReviewRemediation, models.UserID) (*models.RemediationRecord, error)
+}
+
+// Audit stores remediation records in the audit table
+type Audit struct {
+	ddbClient dynamodbiface.DynamoDBAPI
+}
+
+// NewAudit method returns a new instance of Audit
+func NewAudit(sess *session.Session) *Audit {
+	return &Audit{
+		ddbClient: dynamodb.New(sess),
+	}
+}
+
+// QueueMessage is the body of a message in the remediation queue.
+//
+// Messages queued before remediations were audited have no AuditID.
+type QueueMessage struct {
+	models.RemediateResource
+	AuditID models.RemediationRecordID `json:"auditId,omitempty"`
+}
+
+// NewRecord returns the audit record for a remediation request which has not been applied yet.
+//
+// The payload is nil if it could not be built, e.g. because the policy has no remediation.
+func NewRecord(
+	input *models.RemediateResource, payload *Payload, status models.RemediationStatus) *models.RemediationRecord {
+
+	now := strfmt.DateTime(time.Now().UTC())
+	record := &models.RemediationRecord{
+		CreatedAt:   &now,
+		DryRun:      aws.Bool(false),
+		ID:          models.RemediationRecordID(uuid.New().String()),
+		PolicyID:    input.PolicyID,
+		ResourceID:  input.ResourceID,
+		Status:      status,
+		TriggeredBy: input.UserID,
+		UpdatedAt:   &now,
+	}
+	if payload != nil {
+		record.Parameters = payload.Parameters
+		record.RemediationID = models.RemediationID(payload.RemediationID)
+	}
+	return record
+}
+
+// Put saves the record, replacing any previous version
+func (audit *Audit) Put(record *models.RemediationRecord) error {
+	item, err := dynamodbattribute.MarshalMap(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal remediation record")
+	}
+
+	if _, err = audit.ddbClient.PutItem(&dynamodb.PutItemInput{Item: item, TableName: &auditTable}); err != nil {
+		return errors.Wrap(err, "failed to save remediation record")
+	}
+	return nil
+}
+
+// Get loads a single record, returning ErrNotPending if it does not exist
+func (audit *Audit) Get(id models.RemediationRecordID) (*models.RemediationRecord, error) {
+	response, err := audit.ddbClient.GetItem(&dynamodb.GetItemInput{
+		Key:       map[string]*dynamodb.AttributeValue{"id": {S: aws.String(string(id))}},
+		TableName: &auditTable,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load remediation record")
+	}
+	if len(response.Item) == 0 {
+		return nil, ErrNotPending
+	}
+
+	var record models.RemediationRecord
+	if err := dynamodbattribute.UnmarshalMap(response.Item, &record); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal remediation record")
+	}
+	return &record, nil
+}
+
+// List returns every record, optionally limited to a single status, sorted newest first
+func (audit *Audit) List(status models.RemediationStatus) ([]*models.RemediationRecord, error) {
+	input := &dynamodb.ScanInput{TableName: &auditTable}
+	if status != "" {
+		filter := expression.Equal(expression.Name("status"), expression.Value(status))
+		expr, err := expression.NewBuilder().WithFilter(filter).Build()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to build filter expression")
+		}
+		input.ExpressionAttributeNames = expr.Names()
+		input.ExpressionAttributeValues = expr.Values()
+		input.FilterExpression = expr.Filter()
+	}
+
+	result := make([]*models.RemediationRecord, 0)
+	var innerErr error
+	err := audit.ddbClient.ScanPages(input, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+		var records []*models.RemediationRecord
+		if innerErr = dynamodbattribute.UnmarshalListOfMaps(page.Items, &records); innerErr != nil {
+			return false // stop paging
+		}
+		result = append(result, records...)
+		return true
+	})
+	if innerErr != nil {
+		return nil, errors.Wrap(innerErr, "failed to unmarshal remediation records")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan remediation records")
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return time.Time(*result[i].CreatedAt).After(time.Time(*result[j].CreatedAt))
+	})
+	return result, nil
+}
+
+// Finish records the result of applying a remediation.
+//
+// The payload is nil if it was not applied, the record then keeps the requested parameters.
+func (audit *Audit) Finish(record *models.RemediationRecord, payload *Payload, remediationErr error) error {
+	now := strfmt.DateTime(time.Now().UTC())
+	record.UpdatedAt = &now
+	if payload != nil {
+		record.Parameters = payload.Parameters
+		record.RemediationID = models.RemediationID(payload.RemediationID)
+	}
+
+	if remediationErr != nil {
+		record.Status = models.RemediationStatusFAILED
+		record.ErrorMessage = remediationErr.Error()
+	} else {
+		record.Status = models.RemediationStatusSUCCEEDED
+		record.ErrorMessage = ""
+	}
+	return audit.Put(record)
+}
+
+// Review approves or rejects a remediation which is pending approval on behalf of reviewedBy.
+//
+// Returns ErrNotPending if the remediation does not exist or has already been reviewed.
+func (audit *Audit) Review(input *models.ReviewRemediation, reviewedBy models.UserID) (*models.RemediationRecord, error) {
+	status := models.RemediationStatusREJECTED
+	if *input.Approve {
+		status = models.RemediationStatusINPROGRESS
+	}
+
+	now := strfmt.DateTime(time.Now().UTC())
+	update := expression.
+		Set(expression.Name("status"), expression.Value(status)).
+		Set(expression.Name("reviewedAt"), expression.Value(now)).
+		Set(expression.Name("reviewedBy"), expression.Value(reviewedBy)).
+		Set(expression.Name("updatedAt"), expression.Value(now))
+
+	// The condition also fails if the record does not exist
+	condition := expression.Equal(expression.Name("status"), expression.Value(models.RemediationStatusPENDINGAPPROVAL))
+
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build update expression")
+	}
+
+	response, err := audit.ddbClient.UpdateItem(&dynamodb.UpdateItemInput{
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		Key:                       map[string]*dynamodb.AttributeValue{"id": {S: aws.String(string(input.ID))}},
+		ReturnValues:              aws.String(dynamodb.ReturnValueAllNew),
+		TableName:                 &auditTable,
+		UpdateExpression:          expr.Update(),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			return nil, ErrNotPending
+		}
+		return nil, errors.Wrap(err, "failed to review remediation record")
+	}
+
+	var record models.RemediationRecord
+	if err := dynamodbattribute.UnmarshalMap(response.Attributes, &record); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal remediation record")
+	}
+	return &record, nil
+}
+
+// CheckApproved returns ErrChanged if the payload would not make the changes stored on the record.
+//
+// The policy or the resource can change between requesting and applying a remediation, e.g. while it
+// is pending approval. plan is the dry-run of the payload, it is only compared if the record has a plan.
+func CheckApproved(record *models.RemediationRecord, payload *Payload, plan *models.RemediationPlan) error {
+	if models.RemediationID(payload.RemediationID) != record.RemediationID {
+		return ErrChanged
+	}
+	if !equalWhenStored(payload.Parameters, record.Parameters) {
+		return ErrChanged
+	}
+	if record.Plan != nil && (plan == nil || !equalWhenStored(plan.Calls, record.Plan.Calls)) {
+		return ErrChanged
+	}
+	return nil
+}
+
+// The record was read back from the audit table, which e.g. stores empty strings as null.
+// Both values are compared in that form so that unchanged values always match.
+func equalWhenStored(value, stored interface{}) bool {
+	left, err := dynamodbattribute.Marshal(value)
+	if err != nil {
+		return false
+	}
+	right, err := dynamodbattribute.Marshal(stored)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(left, right)
+}
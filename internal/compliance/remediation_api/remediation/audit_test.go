@@ -0,0 +1,176 @@
+package remediation
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	processormodels "github.com/panther-labs/panther/api/gateway/remediation/models"
+	"github.com/panther-labs/panther/pkg/testutils"
+)
+
+var (
+	review = &processormodels.ReviewRemediation{
+		Approve: aws.Bool(true),
+		ID:      "0a0d6b5e-3c1d-4c43-8d0b-3e0b4a7a2f11",
+	}
+	reviewer processormodels.UserID = "5f9f1d0b-6d3b-4e55-a0a5-1ff1d1a7b5b4"
+)
+
+func TestNewRecord(t *testing.T) {
+	payload := &Payload{
+		RemediationID: "AWS.S3.EnableBucketEncryption",
+		Parameters:    processormodels.RemediationParameters{"SSEAlgorithm": "AES256"},
+	}
+	record := NewRecord(input, payload, processormodels.RemediationStatusPENDINGAPPROVAL)
+
+	require.NoError(t, record.Validate(nil))
+	assert.Equal(t, processormodels.RemediationStatusPENDINGAPPROVAL, record.Status)
+	assert.Equal(t, processormodels.RemediationID("AWS.S3.EnableBucketEncryption"), record.RemediationID)
+	assert.Equal(t, payload.Parameters, record.Parameters)
+	assert.False(t, *record.DryRun)
+	assert.Empty(t, record.TriggeredBy)
+}
+
+func TestFinishFailed(t *testing.T) {
+	mockDdb := &testutils.DynamoDBMock{}
+	audit := &Audit{ddbClient: mockDdb}
+	mockDdb.On("PutItem", mock.Anything).Return(&dynamodb.PutItemOutput{}, nil)
+
+	record := NewRecord(input, nil, processormodels.RemediationStatusINPROGRESS)
+	require.NoError(t, audit.Finish(record, nil, assert.AnError))
+	assert.Equal(t, processormodels.RemediationStatusFAILED, record.Status)
+	assert.Equal(t, assert.AnError.Error(), record.ErrorMessage)
+
+	item := mockDdb.Calls[0].Arguments.Get(0).(*dynamodb.PutItemInput).Item
+	assert.Equal(t, "FAILED", *item["status"].S)
+	mockDdb.AssertExpectations(t)
+}
+
+func TestReview(t *testing.T) {
+	mockDdb := &testutils.DynamoDBMock{}
+	audit := &Audit{ddbClient: mockDdb}
+	mockDdb.On("UpdateItem", mock.Anything).Return(&dynamodb.UpdateItemOutput{
+		Attributes: map[string]*dynamodb.AttributeValue{
+			"id":         {S: aws.String(string(review.ID))},
+			"reviewedBy": {S: aws.String(string(reviewer))},
+			"status":     {S: aws.String("IN_PROGRESS")},
+		},
+	}, nil)
+
+	record, err := audit.Review(review, reviewer)
+	require.NoError(t, err)
+	assert.Equal(t, processormodels.RemediationStatusINPROGRESS, record.Status)
+	assert.Equal(t, reviewer, record.ReviewedBy)
+
+	updateInput := mockDdb.Calls[0].Arguments.Get(0).(*dynamodb.UpdateItemInput)
+	assert.NotNil(t, updateInput.ConditionExpression)
+	mockDdb.AssertExpectations(t)
+}
+
+func TestReviewNotPending(t *testing.T) {
+	mockDdb := &testutils.DynamoDBMock{}
+	audit := &Audit{ddbClient: mockDdb}
+	mockDdb.On("UpdateItem", mock.Anything).Return(&dynamodb.UpdateItemOutput{},
+		awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "", nil))
+
+	record, err := audit.Review(review, reviewer)
+	assert.Nil(t, record)
+	assert.Equal(t, ErrNotPending, err)
+	mockDdb.AssertExpectations(t)
+}
+
+// A remediation pending approval, as it is read back from the audit table
+func approvedRecord(t *testing.T, payload *Payload, plan *processormodels.RemediationPlan) *processormodels.RemediationRecord {
+	record := NewRecord(input, payload, processormodels.RemediationStatusPENDINGAPPROVAL)
+	record.Plan = plan
+
+	item, err := dynamodbattribute.MarshalMap(record)
+	require.NoError(t, err)
+	var result processormodels.RemediationRecord
+	require.NoError(t, dynamodbattribute.UnmarshalMap(item, &result))
+	return &result
+}
+
+func testPlan(keyID string) *processormodels.RemediationPlan {
+	return &processormodels.RemediationPlan{
+		Calls: []*processormodels.RemediationCall{
+			{
+				Operation: aws.String("put_bucket_encryption"),
+				Parameters: map[string]interface{}{
+					"Bucket":         "my-bucket",
+					"KMSMasterKeyID": keyID,
+					"Rules":          []interface{}{float64(1)},
+				},
+				Service: aws.String("s3"),
+			},
+		},
+	}
+}
+
+func TestCheckApproved(t *testing.T) {
+	payload := &Payload{
+		RemediationID: "AWS.S3.EnableBucketEncryption",
+		Parameters:    processormodels.RemediationParameters{"KMSMasterKeyID": "", "SSEAlgorithm": "AES256"},
+	}
+	record := approvedRecord(t, payload, testPlan(""))
+
+	assert.NoError(t, CheckApproved(record, payload, testPlan("")))
+}
+
+func TestCheckApprovedChanged(t *testing.T) {
+	payload := &Payload{
+		RemediationID: "AWS.S3.EnableBucketEncryption",
+		Parameters:    processormodels.RemediationParameters{"SSEAlgorithm": "AES256"},
+	}
+	record := approvedRecord(t, payload, testPlan(""))
+
+	// The policy's remediation parameters were edited
+	changed := &Payload{
+		RemediationID: payload.RemediationID,
+		Parameters:    processormodels.RemediationParameters{"SSEAlgorithm": "aws:kms"},
+	}
+	assert.Equal(t, ErrChanged, CheckApproved(record, changed, testPlan("")))
+
+	// The policy has a different remediation
+	changed = &Payload{RemediationID: "AWS.S3.BlockBucketPublicAccess", Parameters: payload.Parameters}
+	assert.Equal(t, ErrChanged, CheckApproved(record, changed, testPlan("")))
+
+	// The remediation would make different calls, e.g. because the resource changed
+	assert.Equal(t, ErrChanged, CheckApproved(record, payload, testPlan("my-key")))
+	assert.Equal(t, ErrChanged, CheckApproved(record, payload, nil))
+}
+
+func TestCheckApprovedWithoutPlan(t *testing.T) {
+	payload := &Payload{
+		RemediationID: "AWS.S3.EnableBucketEncryption",
+		Parameters:    processormodels.RemediationParameters{"SSEAlgorithm": "AES256"},
+	}
+	record := approvedRecord(t, payload, nil)
+
+	assert.NoError(t, CheckApproved(record, payload, nil))
+}
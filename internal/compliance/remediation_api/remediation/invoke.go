@@ -39,6 +39,7 @@ import (
 )
 
 const remediationAction = "remediate"
+const dryRunAction = "dryRun"
 const listRemediationsAction = "listRemediations"
 
 var (
@@ -65,35 +66,47 @@ var (
 
 // Remediate will invoke remediation action in an AWS account
 func (remediator *Invoker) Remediate(remediation *remediationmodels.RemediateResource) error {
+	payload, err := remediator.GetPayload(remediation)
+	if err != nil {
+		return err
+	}
+	return remediator.Invoke(payload)
+}
+
+// GetPayload loads the policy and resource to build the input for the Remediation Lambda
+func (remediator *Invoker) GetPayload(remediation *remediationmodels.RemediateResource) (*Payload, error) {
 	zap.L().Debug("handling remediation",
 		zap.Any("policyId", remediation.PolicyID),
 		zap.Any("resourceId", remediation.ResourceID))
 
 	policy, err := getPolicy(string(remediation.PolicyID))
 	if err != nil {
-		return errors.Wrap(err, "Encountered issue when getting policy")
+		return nil, errors.Wrap(err, "Encountered issue when getting policy")
 	}
 
 	if policy.AutoRemediationID == "" {
-		return ErrNotFound
+		return nil, ErrNotFound
 	}
 
 	resource, err := getResource(string(remediation.ResourceID))
 	if err != nil {
-		return errors.Wrap(err, "Encountered issue when getting resource")
+		return nil, errors.Wrap(err, "Encountered issue when getting resource")
 	}
-	remediationPayload := &Payload{
+	return &Payload{
 		RemediationID: string(policy.AutoRemediationID),
 		Resource:      resource.Attributes,
-		Parameters:    policy.AutoRemediationParameters,
-	}
+		Parameters:    remediationmodels.RemediationParameters(policy.AutoRemediationParameters),
+	}, nil
+}
+
+// Invoke runs the remediation described by the payload
+func (remediator *Invoker) Invoke(payload *Payload) error {
 	lambdaInput := &LambdaInput{
 		Action:  aws.String(remediationAction),
-		Payload: remediationPayload,
+		Payload: payload,
 	}
 
-	_, err = remediator.invokeLambda(lambdaInput)
-	if err != nil {
+	if _, err := remediator.invokeLambda(lambdaInput); err != nil {
 		return errors.Wrap(err, "failed to invoke remediator")
 	}
 
@@ -101,6 +114,30 @@ func (remediator *Invoker) Remediate(remediation *remediationmodels.RemediateRes
 	return nil
 }
 
+// DryRun returns the AWS API calls the remediation would make, without making them
+func (remediator *Invoker) DryRun(payload *Payload) (*remediationmodels.RemediationPlan, error) {
+	lambdaInput := &LambdaInput{
+		Action:  aws.String(dryRunAction),
+		Payload: payload,
+	}
+
+	result, err := remediator.invokeLambda(lambdaInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to invoke remediator")
+	}
+
+	plan := &remediationmodels.RemediationPlan{
+		Parameters:    payload.Parameters,
+		RemediationID: remediationmodels.RemediationID(payload.RemediationID),
+	}
+	if err := jsoniter.Unmarshal(result, plan); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal remediation plan")
+	}
+
+	zap.L().Debug("finished dry run action")
+	return plan, nil
+}
+
 //GetRemediations invokes the Lambda in customer account and retrieves the list of available remediations
 func (remediator *Invoker) GetRemediations() (*remediationmodels.Remediations, error) {
 	zap.L().Info("getting list of remediations")
@@ -181,7 +218,7 @@ type LambdaInput struct {
 // Payload is the input to the Lambda running in customer account
 // that will perform the remediation tasks
 type Payload struct {
-	RemediationID string      `json:"remediationId"`
-	Resource      interface{} `json:"resource"`
-	Parameters    interface{} `json:"parameters"`
+	RemediationID string                                  `json:"remediationId"`
+	Resource      interface{}                             `json:"resource"`
+	Parameters    remediationmodels.RemediationParameters `json:"parameters"`
 }
@@ -101,7 +101,7 @@ func TestRemediate(t *testing.T) {
 	expectedPayload := Payload{
 		RemediationID: string(policy.AutoRemediationID),
 		Resource:      resourceAttributes,
-		Parameters:    policy.AutoRemediationParameters,
+		Parameters:    processormodels.RemediationParameters(policy.AutoRemediationParameters),
 	}
 	expectedInput := LambdaInput{
 		Action:  aws.String(remediationAction),
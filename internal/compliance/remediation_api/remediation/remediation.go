@@ -30,6 +30,9 @@ import (
 // the component that is responsible for invoking Remediation Lambda
 type InvokerAPI interface {
 	Remediate(*models.RemediateResource) error
+	GetPayload(*models.RemediateResource) (*Payload, error)
+	Invoke(*Payload) error
+	DryRun(*Payload) (*models.RemediationPlan, error)
 	GetRemediations() (*models.Remediations, error)
 }
 
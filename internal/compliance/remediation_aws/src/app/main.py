@@ -31,6 +31,8 @@ def lambda_handler(event: Dict[str, Any], unused_context: Any) -> Dict[Any, Any]
         1. 'listRemediations' event: The Lambda will return the available remediations
         and the parameters used by the remediation.
         2. 'remediate' event: The Lambda invokes the appropriate remediation.
+        3. 'dryRun' event: The Lambda returns the AWS API calls the remediation would make,
+        without making them. The payload is the same as for a 'remediate' event.
 
         unused_context: AWS LambdaContext object
 
@@ -66,6 +68,8 @@ def lambda_handler(event: Dict[str, Any], unused_context: Any) -> Dict[Any, Any]
     if event['action'] == 'remediate':
         Remediation.get(event['payload']['remediationId'])().fix(event['payload'])
         return {}
+    if event['action'] == 'dryRun':
+        return Remediation.get(event['payload']['remediationId'])().dry_run(event['payload'])
     raise InvalidInput('Unknown action "{}"'.format(event['action']))
 
 
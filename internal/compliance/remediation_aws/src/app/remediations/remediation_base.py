@@ -17,7 +17,7 @@
 from abc import abstractmethod
 from functools import lru_cache
 import os
-from typing import Any, Dict
+from typing import Any, Callable, cast, Dict, List
 
 import boto3
 from boto3 import Session
@@ -93,6 +93,17 @@ class RemediationBase:
         except Exception as exception:
             raise RemediationException(exception)
 
+    @classmethod
+    def dry_run(cls, event: Dict[str, Any]) -> Dict[str, Any]:
+        """Method invoked by AWS Lambda to return the AWS API calls a remediation would make, without making them"""
+        session = _DryRunSession()
+        try:
+            cls.logger.info('Planning remediation %s', cls.remediation_id())
+            cls._fix(cast(Session, session), event['resource'], event['parameters'])
+        except Exception as exception:
+            raise RemediationException(exception)
+        return {'calls': session.calls}
+
     @classmethod
     def _get_session(cls, account_id: str, region: str) -> Session:
         """Retrieves a session with valid credentials for the provided account.
@@ -156,3 +167,30 @@ class RemediationBase:
         client = boto3.client('sts', region_name=region)
         _STS_CLIENT_MAP[region] = client
         return client
+
+
+class _DryRunSession:
+    """Stands in for a boto3 Session, recording every API call instead of sending it to AWS"""
+
+    def __init__(self) -> None:
+        self.calls: List[Dict[str, Any]] = []
+
+    def client(self, service_name: str, **unused_kwargs: Any) -> '_DryRunClient':
+        """Returns a client which records calls made to the service"""
+        return _DryRunClient(service_name, self.calls)
+
+
+class _DryRunClient:  # pylint: disable=too-few-public-methods
+    """Stands in for a boto3 client, every operation is recorded and returns an empty response"""
+
+    def __init__(self, service_name: str, calls: List[Dict[str, Any]]) -> None:
+        self._service_name = service_name
+        self._calls = calls
+
+    def __getattr__(self, operation: str) -> Callable[..., Dict[str, Any]]:
+
+        def record(**kwargs: Any) -> Dict[str, Any]:
+            self._calls.append({'service': self._service_name, 'operation': operation, 'parameters': kwargs})
+            return {}
+
+        return record
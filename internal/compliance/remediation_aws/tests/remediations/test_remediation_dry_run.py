@@ -0,0 +1,59 @@
+# Panther is a Cloud-Native SIEM for the Modern Security Team.
+# Copyright (C) 2020 Panther Labs Inc
+#
+# This program is free software: you can redistribute it and/or modify
+# it under the terms of the GNU Affero General Public License as
+# published by the Free Software Foundation, either version 3 of the
+# License, or (at your option) any later version.
+#
+# This program is distributed in the hope that it will be useful,
+# but WITHOUT ANY WARRANTY; without even the implied warranty of
+# MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+# GNU Affero General Public License for more details.
+#
+# You should have received a copy of the GNU Affero General Public License
+# along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+from unittest import TestCase
+from ...src.app.remediations.aws_ec2_enable_vpc_flow_logs_to_s3 import AwsEc2EnableVpcFlowLogsToS3
+from ...src.app.remediations.aws_s3_enable_bucket_versioning import AwsS3EnableBucketVersioning
+
+
+class TestRemediationDryRun(TestCase):
+
+    def test_dry_run(self) -> None:
+        event = {'resource': {'Name': 'TestName'}, 'parameters': {}}
+        self.assertEqual(
+            {
+                'calls':
+                    [
+                        {
+                            'service': 's3',
+                            'operation': 'put_bucket_versioning',
+                            'parameters': {
+                                'Bucket': 'TestName',
+                                'VersioningConfiguration': {
+                                    'Status': 'Enabled'
+                                }
+                            }
+                        }
+                    ]
+            }, AwsS3EnableBucketVersioning.dry_run(event)
+        )
+
+    def test_dry_run_reads_response(self) -> None:
+        event = {
+            'resource': {
+                'Id': 'TestVpcId'
+            },
+            'parameters': {
+                'TargetBucketName': 'TestBucket',
+                'TargetPrefix': 'TestPrefix',
+                'TrafficType': 'ALL'
+            }
+        }
+        calls = AwsEc2EnableVpcFlowLogsToS3.dry_run(event)['calls']
+        self.assertEqual(1, len(calls))
+        self.assertEqual('ec2', calls[0]['service'])
+        self.assertEqual('create_flow_logs', calls[0]['operation'])
+        self.assertEqual(['TestVpcId'], calls[0]['parameters']['ResourceIds'])
@@ -35,7 +35,11 @@ import (
 	"github.com/panther-labs/panther/pkg/oplog"
 )
 
-var invoker = remediation.NewInvoker(session.Must(session.NewSession()))
+var (
+	awsSession = session.Must(session.NewSession())
+	invoker    = remediation.NewInvoker(awsSession)
+	auditor    = remediation.NewAudit(awsSession)
+)
 
 func main() {
 	lambda.Start(lambdaHandler)
@@ -49,15 +53,69 @@ func lambdaHandler(ctx context.Context, event events.SQSEvent) (err error) {
 	}()
 
 	for _, record := range event.Records {
-		var input models.RemediateResource
+		var input remediation.QueueMessage
 		if err = jsoniter.UnmarshalFromString(record.Body, &input); err != nil {
 			err = errors.Wrap(err, "Failed to unmarshal item")
 			return err
 		}
-		if err = invoker.Remediate(&input); err != nil {
+		if err = remediate(&input); err != nil {
 			err = errors.Wrap(err, "encountered issue while processing event")
 			return err
 		}
 	}
 	return nil
 }
+
+// Apply a queued remediation and record the result in its audit record.
+//
+// A failed remediation is recorded in the audit record instead of being retried,
+// so that the message is not redelivered and the remediation applied more than once.
+func remediate(input *remediation.QueueMessage) error {
+	// Messages queued before remediations were audited
+	if input.AuditID == "" {
+		return invoker.Remediate(&input.RemediateResource)
+	}
+
+	record, err := auditor.Get(input.AuditID)
+	if err != nil {
+		return err
+	}
+
+	payload, remediationErr := approvedPayload(record)
+	if remediationErr == nil {
+		remediationErr = invoker.Invoke(payload)
+	}
+
+	if err = auditor.Finish(record, payload, remediationErr); err != nil {
+		zap.L().Error("failed to record remediation result", zap.String("auditId", string(record.ID)), zap.Error(err))
+	}
+	if remediationErr != nil {
+		zap.L().Warn("remediation failed", zap.String("auditId", string(record.ID)), zap.Error(remediationErr))
+	}
+	return nil
+}
+
+// Build the payload for the record, which is only applied if it makes the changes stored on the record.
+//
+// Otherwise the remediation fails: it was approved or requested for changes it would no longer make.
+func approvedPayload(record *models.RemediationRecord) (*remediation.Payload, error) {
+	payload, err := invoker.GetPayload(&models.RemediateResource{
+		PolicyID:   record.PolicyID,
+		ResourceID: record.ResourceID,
+		UserID:     record.TriggeredBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var plan *models.RemediationPlan
+	if record.Plan != nil {
+		if plan, err = invoker.DryRun(payload); err != nil {
+			return nil, err
+		}
+	}
+	if err = remediation.CheckApproved(record, payload, plan); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
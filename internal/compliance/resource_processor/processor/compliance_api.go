@@ -0,0 +1,75 @@
+package processor
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/gateway/compliance/client/operations"
+)
+
+// Policy/resource pairs with an exception, mapped to the exception's expiration
+type exceptionMap map[exceptionKey]time.Time
+
+type exceptionKey struct {
+	PolicyID   string
+	ResourceID string
+}
+
+type exceptionCacheEntry struct {
+	LastUpdated time.Time
+	Exceptions  exceptionMap
+}
+
+var exceptionCache exceptionCacheEntry
+
+// Returns true if the policy/resource pair has an exception which has not yet expired
+func (m exceptionMap) isExcepted(policyID, resourceID string) bool {
+	expiresAt, ok := m[exceptionKey{PolicyID: policyID, ResourceID: resourceID}]
+	return ok && expiresAt.After(time.Now())
+}
+
+// Get policy exceptions from either the memory cache or the compliance-api
+func getExceptions() (exceptionMap, error) {
+	if exceptionCache.Exceptions != nil && exceptionCache.LastUpdated.Add(cacheDuration).After(time.Now()) {
+		// Cache entry exists and hasn't expired yet
+		return exceptionCache.Exceptions, nil
+	}
+
+	// Load from compliance-api
+	result, err := complianceClient.Operations.ListExceptions(
+		&operations.ListExceptionsParams{HTTPClient: httpClient})
+	if err != nil {
+		zap.L().Error("failed to load exceptions from compliance-api", zap.Error(err))
+		return nil, err
+	}
+	zap.L().Info("successfully loaded policy exceptions from compliance-api",
+		zap.Int("exceptionCount", len(result.Payload.Exceptions)))
+
+	exceptions := make(exceptionMap, len(result.Payload.Exceptions))
+	for _, exception := range result.Payload.Exceptions {
+		key := exceptionKey{PolicyID: string(exception.PolicyID), ResourceID: string(exception.ResourceID)}
+		exceptions[key] = time.Time(*exception.ExpiresAt)
+	}
+
+	exceptionCache = exceptionCacheEntry{LastUpdated: time.Now(), Exceptions: exceptions}
+	return exceptions, nil
+}
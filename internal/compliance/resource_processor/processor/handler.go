@@ -187,6 +187,11 @@ func (r *batchResults) analyze(resources resourceMap, policies policyMap) error
 		return nil
 	}
 
+	exceptions, err := getExceptions()
+	if err != nil {
+		return err
+	}
+
 	var analysis *enginemodels.PolicyEngineOutput
 	analysis, err = evaluatePolicies(policies, resources)
 	if err != nil {
@@ -196,14 +201,14 @@ func (r *batchResults) analyze(resources resourceMap, policies policyMap) error
 	// Add a status entry for every policy/resource pair
 	for _, result := range analysis.Resources {
 		for _, policyError := range result.Errored {
-			entry := buildStatus(policies[policyError.ID], resources[result.ID], compliancemodels.StatusERROR)
+			entry := buildStatus(policies[policyError.ID], resources[result.ID], compliancemodels.StatusERROR, exceptions)
 			entry.ErrorMessage = compliancemodels.ErrorMessage(policyError.Message)
 			r.StatusEntries = append(r.StatusEntries, entry)
 		}
 
 		for _, policyID := range result.Failed {
 			policy, resource := policies[policyID], resources[result.ID]
-			entry := buildStatus(policy, resource, compliancemodels.StatusFAIL)
+			entry := buildStatus(policy, resource, compliancemodels.StatusFAIL, exceptions)
 			r.StatusEntries = append(r.StatusEntries, entry)
 
 			if entry.Suppressed {
@@ -226,26 +231,29 @@ func (r *batchResults) analyze(resources resourceMap, policies policyMap) error
 				return err
 			}
 
-			status := compliancemodels.StatusPASS
+			status, wasSuppressed := compliancemodels.StatusPASS, false
 			if response != nil {
-				status = response.Payload.Status
+				status, wasSuppressed = response.Payload.Status, bool(response.Payload.Suppressed)
 			}
 
 			zap.L().Info("loaded previous compliance status",
 				zap.String("policyId", policyID),
 				zap.String("resourceId", result.ID),
 				zap.String("complianceStatus", string(status)),
+				zap.Bool("suppressed", wasSuppressed),
 			)
 
 			// Every failed policy, if not suppressed, will trigger the remediation flow
 			complianceNotification := &alertmodels.ComplianceNotification{
 				ResourceID:      aws.String(string(resource.ID)),
+				ResourceType:    aws.String(string(resource.Type)),
 				PolicyID:        aws.String(string(policy.ID)),
 				PolicyVersionID: aws.String(string(policy.VersionID)),
 				Timestamp:       aws.Time(time.Now()),
 
 				// We only need to send an alert to the user if the status is newly FAILing
-				ShouldAlert: aws.Bool(status != compliancemodels.StatusFAIL),
+				// (which includes a failure which was suppressed until now, e.g. an expired exception)
+				ShouldAlert: aws.Bool(status != compliancemodels.StatusFAIL || wasSuppressed),
 			}
 			var sqsMessageBody string
 			if sqsMessageBody, err = jsoniter.MarshalToString(complianceNotification); err != nil {
@@ -261,7 +269,7 @@ func (r *batchResults) analyze(resources resourceMap, policies policyMap) error
 		}
 
 		for _, policyID := range result.Passed {
-			entry := buildStatus(policies[policyID], resources[result.ID], compliancemodels.StatusPASS)
+			entry := buildStatus(policies[policyID], resources[result.ID], compliancemodels.StatusPASS, exceptions)
 			r.StatusEntries = append(r.StatusEntries, entry)
 		}
 	}
@@ -330,14 +338,18 @@ func buildStatus(
 	policy *analysismodels.EnabledPolicy,
 	resource *resourcemodels.Resource,
 	status compliancemodels.Status,
+	exceptions exceptionMap,
 ) *compliancemodels.SetStatus {
 
+	suppressed := isSuppressed(string(resource.ID), policy) ||
+		exceptions.isExcepted(string(policy.ID), string(resource.ID))
+
 	return &compliancemodels.SetStatus{
 		PolicyID:       compliancemodels.PolicyID(policy.ID),
 		PolicySeverity: compliancemodels.PolicySeverity(policy.Severity),
 		ResourceID:     compliancemodels.ResourceID(resource.ID),
 		ResourceType:   compliancemodels.ResourceType(resource.Type),
-		Suppressed:     compliancemodels.Suppressed(isSuppressed(string(resource.ID), policy)),
+		Suppressed:     compliancemodels.Suppressed(suppressed),
 		IntegrationID:  compliancemodels.IntegrationID(resource.IntegrationID),
 
 		Status: status,
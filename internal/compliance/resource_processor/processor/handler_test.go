@@ -20,11 +20,13 @@ package processor
 
 import (
 	"testing"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
 
 	analysismodels "github.com/panther-labs/panther/api/gateway/analysis/models"
+	compliancemodels "github.com/panther-labs/panther/api/gateway/compliance/models"
 	resourcemodels "github.com/panther-labs/panther/api/gateway/resources/models"
 	"github.com/panther-labs/panther/internal/compliance/resource_processor/models"
 )
@@ -102,3 +104,29 @@ func TestIsSuppressed(t *testing.T) {
 		Suppressions: []string{"not", "this", "one", "but", "here:", "*.us-west-2/*"},
 	}))
 }
+
+func TestBuildStatusException(t *testing.T) {
+	policy := &analysismodels.EnabledPolicy{ID: "AWS.S3.Versioning", Severity: "HIGH"}
+	resource := &resourcemodels.Resource{ID: "arn:aws:s3:::my-bucket", Type: "AWS.S3.Bucket"}
+	key := exceptionKey{PolicyID: "AWS.S3.Versioning", ResourceID: "arn:aws:s3:::my-bucket"}
+
+	// No exception
+	entry := buildStatus(policy, resource, compliancemodels.StatusFAIL, exceptionMap{})
+	assert.False(t, bool(entry.Suppressed))
+
+	// Active exception
+	entry = buildStatus(policy, resource, compliancemodels.StatusFAIL,
+		exceptionMap{key: time.Now().Add(time.Hour)})
+	assert.True(t, bool(entry.Suppressed))
+
+	// Expired exception
+	entry = buildStatus(policy, resource, compliancemodels.StatusFAIL,
+		exceptionMap{key: time.Now().Add(-time.Minute)})
+	assert.False(t, bool(entry.Suppressed))
+
+	// Exception for a different policy
+	otherKey := exceptionKey{PolicyID: "AWS.S3.Encryption", ResourceID: "arn:aws:s3:::my-bucket"}
+	entry = buildStatus(policy, resource, compliancemodels.StatusFAIL,
+		exceptionMap{otherKey: time.Now().Add(time.Hour)})
+	assert.False(t, bool(entry.Suppressed))
+}
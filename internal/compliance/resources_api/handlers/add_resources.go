@@ -53,8 +53,15 @@ func AddResources(request *events.APIGatewayProxyRequest) *events.APIGatewayProx
 		return badRequest(err)
 	}
 
+	// Load the stored attributes so that only configuration changes are recorded in the resource history
+	previous, err := currentAttributes(input.Resources)
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
 	now := models.LastModified(time.Now())
 	writeRequests := make([]*dynamodb.WriteRequest, len(input.Resources))
+	var historyRequests []*dynamodb.WriteRequest
 	sqsEntries := make([]*sqs.SendMessageBatchRequestEntry, len(input.Resources))
 	for i, r := range input.Resources {
 		item := resourceItem{
@@ -76,6 +83,15 @@ func AddResources(request *events.APIGatewayProxyRequest) *events.APIGatewayProx
 		}
 		writeRequests[i] = &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: marshalled}}
 
+		history, err := historyRequest(&item, marshalled, previous, r.ChangeEvent)
+		if err != nil {
+			zap.L().Error("failed to build resource history item", zap.Error(err))
+			return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+		}
+		if history != nil {
+			historyRequests = append(historyRequests, history)
+		}
+
 		body, err := jsoniter.MarshalToString(item.Resource(""))
 		if err != nil {
 			zap.L().Error("jsoniter.MarshalToString(resource) failed", zap.Error(err))
@@ -88,7 +104,10 @@ func AddResources(request *events.APIGatewayProxyRequest) *events.APIGatewayProx
 	}
 
 	dynamoInput := &dynamodb.BatchWriteItemInput{
-		RequestItems: map[string][]*dynamodb.WriteRequest{env.ResourcesTable: writeRequests},
+		RequestItems: map[string][]*dynamodb.WriteRequest{
+			env.ResourcesTable:        writeRequests,
+			env.ResourcesHistoryTable: historyRequests,
+		},
 	}
 	if err := dynamodbbatch.BatchWriteItem(dynamoClient, maxBackoff, dynamoInput); err != nil {
 		zap.L().Error("dynamodbbatch.BatchWriteItem failed", zap.Error(err))
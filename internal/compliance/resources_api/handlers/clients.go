@@ -44,10 +44,11 @@ var (
 )
 
 type envConfig struct {
-	ComplianceAPIHost string `required:"true" split_words:"true"`
-	ComplianceAPIPath string `required:"true" split_words:"true"`
-	ResourcesQueueURL string `required:"true" split_words:"true"`
-	ResourcesTable    string `required:"true" split_words:"true"`
+	ComplianceAPIHost     string `required:"true" split_words:"true"`
+	ComplianceAPIPath     string `required:"true" split_words:"true"`
+	ResourcesHistoryTable string `required:"true" split_words:"true"`
+	ResourcesQueueURL     string `required:"true" split_words:"true"`
+	ResourcesTable        string `required:"true" split_words:"true"`
 }
 
 // Setup parses the environment and builds the AWS and http clients.
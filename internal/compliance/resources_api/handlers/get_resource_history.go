@@ -0,0 +1,96 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/aws"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/gateway/resources/client/operations"
+	"github.com/panther-labs/panther/api/gateway/resources/models"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
+)
+
+const maxHistoryPageSize = 100
+
+// GetResourceHistory returns the most recent versions of a resource along with the changes between them.
+func GetResourceHistory(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	params, err := parseGetResourceHistory(request)
+	if err != nil {
+		return badRequest(err)
+	}
+	resourceID := models.ResourceID(params.ResourceID)
+
+	// Fetch one extra version so the oldest returned version can be diffed against its predecessor
+	items, err := queryHistory(resourceID, *params.PageSize+1)
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	if len(items) == 0 {
+		zap.L().Debug("could not find resource history", zap.String("resourceID", params.ResourceID))
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusNotFound}
+	}
+
+	result := &models.ResourceHistory{
+		ID:       resourceID,
+		Versions: make([]*models.ResourceVersion, 0, len(items)),
+	}
+	for i, item := range items {
+		if int64(i) == *params.PageSize {
+			break
+		}
+
+		version := item.Version()
+		if i+1 < len(items) {
+			version.Changes = diffAttributes(items[i+1].Attributes, item.Attributes)
+		}
+		result.Versions = append(result.Versions, version)
+	}
+
+	return gatewayapi.MarshalResponse(result, http.StatusOK)
+}
+
+func parseGetResourceHistory(request *events.APIGatewayProxyRequest) (*operations.GetResourceHistoryParams, error) {
+	result := operations.NewGetResourceHistoryParams() // initialize with default values
+
+	resourceID, err := parseGetResource(request)
+	if err != nil {
+		return nil, err
+	}
+	result.ResourceID = string(resourceID)
+
+	if pageSize := request.QueryStringParameters["pageSize"]; pageSize != "" {
+		size, err := strconv.ParseInt(pageSize, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid pageSize: " + err.Error())
+		}
+		if size < 1 || size > maxHistoryPageSize {
+			return nil, errors.New("invalid pageSize: must be between 1 and " + strconv.Itoa(maxHistoryPageSize))
+		}
+		result.PageSize = aws.Int64(size)
+	}
+
+	return result, nil
+}
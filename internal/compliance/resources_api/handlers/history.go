@@ -0,0 +1,269 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/gateway/resources/models"
+	"github.com/panther-labs/panther/pkg/awsbatch/dynamodbbatch"
+)
+
+// Resource history is retained for 90 days
+const historyRetentionSecs = 90 * 24 * 60 * 60
+
+// A single version of a resource's attributes, stored in the resource history table.
+//
+// The table is keyed by resource id (hash) and lastModified (range).
+type historyItem struct {
+	Attributes   models.Attributes   `json:"attributes"`
+	ChangeEvent  *models.ChangeEvent `json:"changeEvent,omitempty"`
+	ID           models.ResourceID   `json:"id"`
+	LastModified models.LastModified `json:"lastModified"`
+
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+// Convert dynamo history item to an external models.ResourceVersion
+func (h *historyItem) Version() *models.ResourceVersion {
+	return &models.ResourceVersion{
+		Attributes:   h.Attributes,
+		ChangeEvent:  h.ChangeEvent,
+		LastModified: h.LastModified,
+	}
+}
+
+// Load the currently stored attributes for each resource, keyed by resource ID.
+//
+// Resources which do not exist yet are omitted from the result.
+func currentAttributes(resources []*models.AddResourceEntry) (map[models.ResourceID]interface{}, error) {
+	// BatchGetItem rejects duplicate keys
+	keys := make([]map[string]*dynamodb.AttributeValue, 0, len(resources))
+	seen := make(map[models.ResourceID]bool, len(resources))
+	for _, r := range resources {
+		if !seen[r.ID] {
+			seen[r.ID] = true
+			keys = append(keys, tableKey(r.ID))
+		}
+	}
+
+	// "attributes" is a reserved word in Dynamo
+	projection := expression.NamesList(expression.Name("id"), expression.Name("attributes"))
+	expr, err := expression.NewBuilder().WithProjection(projection).Build()
+	if err != nil {
+		zap.L().Error("expr.Build failed", zap.Error(err))
+		return nil, err
+	}
+
+	response, err := dynamodbbatch.BatchGetItem(dynamoClient, &dynamodb.BatchGetItemInput{
+		RequestItems: map[string]*dynamodb.KeysAndAttributes{
+			env.ResourcesTable: {
+				ExpressionAttributeNames: expr.Names(),
+				Keys:                     keys,
+				ProjectionExpression:     expr.Projection(),
+			},
+		},
+	})
+	if err != nil {
+		zap.L().Error("dynamodbbatch.BatchGetItem failed", zap.Error(err))
+		return nil, err
+	}
+
+	var items []*resourceItem
+	if err := dynamodbattribute.UnmarshalListOfMaps(response.Responses[env.ResourcesTable], &items); err != nil {
+		zap.L().Error("dynamodbattribute.UnmarshalListOfMaps failed", zap.Error(err))
+		return nil, err
+	}
+
+	result := make(map[models.ResourceID]interface{}, len(items))
+	for _, item := range items {
+		result[item.ID] = item.Attributes
+	}
+	return result, nil
+}
+
+// Build the history write request for a resource, or nil if its attributes have not changed.
+//
+// The attributes are compared in the form they are stored in Dynamo, so that marshaling differences
+// (e.g. empty strings becoming null) are not mistaken for configuration changes.
+func historyRequest(
+	item *resourceItem,
+	marshalled map[string]*dynamodb.AttributeValue,
+	previous map[models.ResourceID]interface{},
+	changeEvent *models.ChangeEvent,
+) (*dynamodb.WriteRequest, error) {
+
+	var stored interface{}
+	if err := dynamodbattribute.Unmarshal(marshalled["attributes"], &stored); err != nil {
+		return nil, err
+	}
+
+	if old, ok := previous[item.ID]; ok && reflect.DeepEqual(old, stored) {
+		return nil, nil
+	}
+
+	history, err := dynamodbattribute.MarshalMap(&historyItem{
+		Attributes:   stored,
+		ChangeEvent:  changeEvent,
+		ID:           item.ID,
+		LastModified: item.LastModified,
+		ExpiresAt:    time.Now().Unix() + historyRetentionSecs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: history}}, nil
+}
+
+// Query the most recent versions of a resource, newest first.
+func queryHistory(resourceID models.ResourceID, limit int64) ([]*historyItem, error) {
+	keyCondition := expression.Key("id").Equal(expression.Value(resourceID))
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCondition).Build()
+	if err != nil {
+		zap.L().Error("expr.Build failed", zap.Error(err))
+		return nil, err
+	}
+
+	response, err := dynamoClient.Query(&dynamodb.QueryInput{
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		KeyConditionExpression:    expr.KeyCondition(),
+		Limit:                     aws.Int64(limit),
+		ScanIndexForward:          aws.Bool(false),
+		TableName:                 &env.ResourcesHistoryTable,
+	})
+	if err != nil {
+		zap.L().Error("dynamoClient.Query failed", zap.Error(err))
+		return nil, err
+	}
+
+	var items []*historyItem
+	if err := dynamodbattribute.UnmarshalListOfMaps(response.Items, &items); err != nil {
+		zap.L().Error("dynamodbattribute.UnmarshalListOfMaps failed", zap.Error(err))
+		return nil, err
+	}
+	return items, nil
+}
+
+// diffAttributes returns the JSON patch style differences between two versions of resource attributes.
+//
+// Paths are JSON pointers (RFC 6901). Objects are compared key by key and lists index by index,
+// so an element inserted in the middle of a list shows up as replacements followed by an add.
+func diffAttributes(before, after interface{}) []*models.AttributeChange {
+	return appendDiff(nil, "", before, after)
+}
+
+func appendDiff(changes []*models.AttributeChange, path string, before, after interface{}) []*models.AttributeChange {
+	switch oldValue := before.(type) {
+	case map[string]interface{}:
+		newValue, ok := after.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(oldValue)+len(newValue))
+		for key := range oldValue {
+			keys = append(keys, key)
+		}
+		for key := range newValue {
+			if _, exists := oldValue[key]; !exists {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			keyPath := path + "/" + escapePointer(key)
+			oldChild, inOld := oldValue[key]
+			newChild, inNew := newValue[key]
+			switch {
+			case !inNew:
+				changes = append(changes, removeChange(keyPath, oldChild))
+			case !inOld:
+				changes = append(changes, addChange(keyPath, newChild))
+			default:
+				changes = appendDiff(changes, keyPath, oldChild, newChild)
+			}
+		}
+		return changes
+
+	case []interface{}:
+		newValue, ok := after.([]interface{})
+		if !ok {
+			break
+		}
+
+		i := 0
+		for ; i < len(oldValue) && i < len(newValue); i++ {
+			changes = appendDiff(changes, path+"/"+strconv.Itoa(i), oldValue[i], newValue[i])
+		}
+		for j := i; j < len(newValue); j++ {
+			changes = append(changes, addChange(path+"/"+strconv.Itoa(j), newValue[j]))
+		}
+		// Trailing elements are removed from the end so the patch can be applied in order
+		for j := len(oldValue) - 1; j >= i; j-- {
+			changes = append(changes, removeChange(path+"/"+strconv.Itoa(j), oldValue[j]))
+		}
+		return changes
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		changes = append(changes, &models.AttributeChange{
+			OldValue: before,
+			Op:       aws.String(models.AttributeChangeOpReplace),
+			Path:     aws.String(path),
+			Value:    after,
+		})
+	}
+	return changes
+}
+
+func addChange(path string, value interface{}) *models.AttributeChange {
+	return &models.AttributeChange{
+		Op:    aws.String(models.AttributeChangeOpAdd),
+		Path:  aws.String(path),
+		Value: value,
+	}
+}
+
+func removeChange(path string, oldValue interface{}) *models.AttributeChange {
+	return &models.AttributeChange{
+		OldValue: oldValue,
+		Op:       aws.String(models.AttributeChangeOpRemove),
+		Path:     aws.String(path),
+	}
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// Escape a key for use as a JSON pointer reference token
+func escapePointer(key string) string {
+	return pointerEscaper.Replace(key)
+}
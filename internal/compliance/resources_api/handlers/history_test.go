@@ -0,0 +1,116 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/panther-labs/panther/api/gateway/resources/models"
+)
+
+func TestDiffAttributesEqual(t *testing.T) {
+	attributes := map[string]interface{}{
+		"Name": "my-group",
+		"IpPermissions": []interface{}{
+			map[string]interface{}{"FromPort": float64(22), "ToPort": float64(22)},
+		},
+	}
+	assert.Empty(t, diffAttributes(attributes, attributes))
+}
+
+func TestDiffAttributes(t *testing.T) {
+	before := map[string]interface{}{
+		"Description": "ssh access",
+		"IpPermissions": []interface{}{
+			map[string]interface{}{"FromPort": float64(22), "IpRanges": []interface{}{"10.0.0.0/8"}},
+		},
+		"Tags":    map[string]interface{}{"team/owner": "security"},
+		"VpcId":   "vpc-1",
+		"Removed": true,
+	}
+	after := map[string]interface{}{
+		"Description": "ssh access",
+		"IpPermissions": []interface{}{
+			map[string]interface{}{"FromPort": float64(22), "IpRanges": []interface{}{"0.0.0.0/0"}},
+			map[string]interface{}{"FromPort": float64(443)},
+		},
+		"Tags":  map[string]interface{}{"team/owner": "platform"},
+		"VpcId": nil,
+	}
+
+	expected := []*models.AttributeChange{
+		{
+			OldValue: "10.0.0.0/8",
+			Op:       aws.String("replace"),
+			Path:     aws.String("/IpPermissions/0/IpRanges/0"),
+			Value:    "0.0.0.0/0",
+		},
+		{
+			Op:    aws.String("add"),
+			Path:  aws.String("/IpPermissions/1"),
+			Value: map[string]interface{}{"FromPort": float64(443)},
+		},
+		{
+			OldValue: true,
+			Op:       aws.String("remove"),
+			Path:     aws.String("/Removed"),
+		},
+		{
+			OldValue: "security",
+			Op:       aws.String("replace"),
+			Path:     aws.String("/Tags/team~1owner"),
+			Value:    "platform",
+		},
+		{
+			OldValue: "vpc-1",
+			Op:       aws.String("replace"),
+			Path:     aws.String("/VpcId"),
+		},
+	}
+	assert.Equal(t, expected, diffAttributes(before, after))
+}
+
+func TestDiffAttributesListShrinks(t *testing.T) {
+	before := map[string]interface{}{"Subnets": []interface{}{"a", "b", "c"}}
+	after := map[string]interface{}{"Subnets": []interface{}{"a"}}
+
+	expected := []*models.AttributeChange{
+		{OldValue: "c", Op: aws.String("remove"), Path: aws.String("/Subnets/2")},
+		{OldValue: "b", Op: aws.String("remove"), Path: aws.String("/Subnets/1")},
+	}
+	assert.Equal(t, expected, diffAttributes(before, after))
+}
+
+func TestDiffAttributesTypeChange(t *testing.T) {
+	before := map[string]interface{}{"Policy": map[string]interface{}{"Version": "2012-10-17"}}
+	after := map[string]interface{}{"Policy": "{}"}
+
+	expected := []*models.AttributeChange{
+		{
+			OldValue: map[string]interface{}{"Version": "2012-10-17"},
+			Op:       aws.String("replace"),
+			Path:     aws.String("/Policy"),
+			Value:    "{}",
+		},
+	}
+	assert.Equal(t, expected, diffAttributes(before, after))
+}
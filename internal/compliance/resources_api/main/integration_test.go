@@ -97,6 +97,7 @@ func TestIntegrationAPI(t *testing.T) {
 
 	// Reset Dynamo tables and build API client
 	require.NoError(t, testutils.ClearDynamoTable(awsSession, "panther-resources"))
+	require.NoError(t, testutils.ClearDynamoTable(awsSession, "panther-resource-history"))
 	require.NoError(t, testutils.ClearDynamoTable(awsSession, "panther-compliance"))
 	require.NotEmpty(t, endpoint)
 	apiClient = client.NewHTTPClientWithConfig(nil, client.DefaultTransportConfig().
@@ -133,6 +134,11 @@ func TestIntegrationAPI(t *testing.T) {
 		t.Run("DeleteNotFound", deleteNotFound)
 		t.Run("DeleteSuccess", deleteSuccess)
 	})
+
+	t.Run("GetResourceHistory", func(t *testing.T) {
+		t.Run("HistoryNotFound", historyNotFound)
+		t.Run("HistorySuccess", historySuccess)
+	})
 }
 
 func addEmpty(t *testing.T) {
@@ -533,3 +539,65 @@ func deleteSuccess(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, list.Payload.Resources, 3)
 }
+
+func historyNotFound(t *testing.T) {
+	result, err := apiClient.Operations.GetResourceHistory(
+		&operations.GetResourceHistoryParams{
+			ResourceID: "arn:aws:s3:::no-such-bucket",
+			HTTPClient: httpClient,
+		})
+	assert.Nil(t, result)
+	assert.Equal(t, &operations.GetResourceHistoryNotFound{}, err)
+}
+
+func historySuccess(t *testing.T) {
+	// Re-adding the same attributes does not create a new version
+	entry := &models.AddResourceEntry{
+		Attributes:      bucket.Attributes,
+		ID:              bucket.ID,
+		IntegrationID:   bucket.IntegrationID,
+		IntegrationType: bucket.IntegrationType,
+		Type:            bucket.Type,
+	}
+	_, err := apiClient.Operations.AddResources(&operations.AddResourcesParams{
+		Body:       &models.AddResources{Resources: []*models.AddResourceEntry{entry}},
+		HTTPClient: httpClient,
+	})
+	require.NoError(t, err)
+
+	// Changing the attributes does
+	entry.Attributes = map[string]interface{}{"Panther": "Security"}
+	entry.ChangeEvent = &models.ChangeEvent{EventID: "example-event-id", EventName: "PutBucketPolicy"}
+	_, err = apiClient.Operations.AddResources(&operations.AddResourcesParams{
+		Body:       &models.AddResources{Resources: []*models.AddResourceEntry{entry}},
+		HTTPClient: httpClient,
+	})
+	require.NoError(t, err)
+
+	result, err := apiClient.Operations.GetResourceHistory(
+		&operations.GetResourceHistoryParams{
+			ResourceID: string(bucket.ID),
+			HTTPClient: httpClient,
+		})
+	require.NoError(t, err)
+	require.NoError(t, result.Payload.Validate(nil))
+	assert.Equal(t, bucket.ID, result.Payload.ID)
+	require.Len(t, result.Payload.Versions, 2)
+
+	newest, oldest := result.Payload.Versions[0], result.Payload.Versions[1]
+	assert.Equal(t, entry.Attributes, newest.Attributes)
+	assert.Equal(t, entry.ChangeEvent.EventName, newest.ChangeEvent.EventName)
+	expectedChanges := []*models.AttributeChange{
+		{
+			OldValue: "Labs",
+			Op:       aws.String(models.AttributeChangeOpReplace),
+			Path:     aws.String("/Panther"),
+			Value:    "Security",
+		},
+	}
+	assert.Equal(t, expectedChanges, newest.Changes)
+
+	assert.Equal(t, bucket.Attributes, oldest.Attributes)
+	assert.Nil(t, oldest.ChangeEvent)
+	assert.Empty(t, oldest.Changes)
+}
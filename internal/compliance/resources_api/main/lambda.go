@@ -27,6 +27,7 @@ import (
 
 var methodHandlers = map[string]gatewayapi.RequestHandler{
 	"POST /delete":      handlers.DeleteResources,
+	"GET /history":      handlers.GetResourceHistory,
 	"GET /list":         handlers.ListResources,
 	"GET /org-overview": handlers.OrgOverview,
 	"GET /resource":     handlers.GetResource,
@@ -0,0 +1,52 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+)
+
+const (
+	SecretsManagerSecretSchema = "AWS.SecretsManager.Secret"
+)
+
+// SecretsManagerSecret contains all the information about a Secrets Manager Secret
+type SecretsManagerSecret struct {
+	// Generic resource fields
+	GenericAWSResource
+	GenericResource
+
+	// Fields embedded from secretsmanager.DescribeSecretOutput
+	DeletedDate        *time.Time
+	Description        *string
+	KmsKeyId           *string
+	LastAccessedDate   *time.Time
+	LastChangedDate    *time.Time
+	LastRotatedDate    *time.Time
+	OwningService      *string
+	RotationEnabled    *bool
+	RotationLambdaARN  *string
+	RotationRules      *secretsmanager.RotationRulesType
+	VersionIdsToStages map[string][]*string
+
+	// Additional fields
+	ResourcePolicy *string
+}
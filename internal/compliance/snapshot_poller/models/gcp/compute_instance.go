@@ -0,0 +1,94 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+const (
+	ComputeInstanceSchema = "GCP.Compute.Instance"
+)
+
+// ComputeInstance contains all the information about a Compute Engine instance
+type ComputeInstance struct {
+	// Generic resource fields
+	GenericGCPResource
+	GenericResource
+
+	// Fields embedded from compute.Instance
+	CanIPForward               *bool
+	ConfidentialInstanceConfig *ComputeConfidentialInstanceConfig
+	DeletionProtection         *bool
+	Disks                      []*ComputeAttachedDisk
+	MachineType                *string
+	NetworkInterfaces          []*ComputeNetworkInterface
+	ServiceAccounts            []*ComputeServiceAccount
+	ShieldedInstanceConfig     *ComputeShieldedInstanceConfig
+	Status                     *string
+
+	// Additional fields
+	Metadata map[string]*string
+	Zone     *string
+}
+
+// ComputeConfidentialInstanceConfig reports whether memory encryption is enabled for an instance.
+type ComputeConfidentialInstanceConfig struct {
+	EnableConfidentialCompute *bool
+}
+
+// ComputeAttachedDisk is a disk attached to an instance.
+type ComputeAttachedDisk struct {
+	AutoDelete        *bool
+	Boot              *bool
+	DeviceName        *string
+	DiskEncryptionKey *ComputeDiskEncryptionKey
+	Mode              *string
+	Source            *string
+}
+
+// ComputeDiskEncryptionKey identifies the customer managed key encrypting a disk, if any.
+type ComputeDiskEncryptionKey struct {
+	KmsKeyName *string
+}
+
+// ComputeNetworkInterface connects an instance to a VPC network.
+type ComputeNetworkInterface struct {
+	AccessConfigs []*ComputeAccessConfig
+	Name          *string
+	Network       *string
+	NetworkIP     *string
+	Subnetwork    *string
+}
+
+// ComputeAccessConfig is an external IP address assigned to a network interface.
+type ComputeAccessConfig struct {
+	Name  *string
+	NatIP *string
+	Type  *string
+}
+
+// ComputeServiceAccount is the service account an instance runs as, with its OAuth scopes.
+type ComputeServiceAccount struct {
+	Email  *string
+	Scopes []*string
+}
+
+// ComputeShieldedInstanceConfig is the Shielded VM configuration of an instance.
+type ComputeShieldedInstanceConfig struct {
+	EnableIntegrityMonitoring *bool
+	EnableSecureBoot          *bool
+	EnableVtpm                *bool
+}
@@ -0,0 +1,84 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/go-openapi/strfmt"
+)
+
+const (
+	GcsBucketSchema = "GCP.GCS.Bucket"
+)
+
+// GcsBucket contains all the information about a Cloud Storage bucket
+type GcsBucket struct {
+	// Generic resource fields
+	GenericGCPResource
+	GenericResource
+
+	// Fields embedded from storage.Bucket
+	DefaultEventBasedHold *bool
+	Encryption            *GcsBucketEncryption
+	IamConfiguration      *GcsBucketIamConfiguration
+	LocationType          *string
+	Logging               *GcsBucketLogging
+	ProjectNumber         *string
+	RetentionPolicy       *GcsBucketRetentionPolicy
+	StorageClass          *string
+	Updated               *strfmt.DateTime
+	Versioning            *GcsBucketVersioning
+
+	// Additional fields
+	IamBindings []*IamBinding
+}
+
+// GcsBucketEncryption is the default encryption applied to new objects in a bucket.
+type GcsBucketEncryption struct {
+	DefaultKmsKeyName *string
+}
+
+// GcsBucketIamConfiguration controls how access to the objects of a bucket is granted.
+type GcsBucketIamConfiguration struct {
+	PublicAccessPrevention   *string
+	UniformBucketLevelAccess *GcsBucketUniformBucketLevelAccess
+}
+
+// GcsBucketUniformBucketLevelAccess reports whether object ACLs are disabled for a bucket.
+type GcsBucketUniformBucketLevelAccess struct {
+	Enabled    *bool
+	LockedTime *strfmt.DateTime
+}
+
+// GcsBucketLogging is the access logging configuration of a bucket.
+type GcsBucketLogging struct {
+	LogBucket       *string
+	LogObjectPrefix *string
+}
+
+// GcsBucketRetentionPolicy is the minimum time objects in a bucket are retained.
+type GcsBucketRetentionPolicy struct {
+	EffectiveTime   *strfmt.DateTime
+	IsLocked        *bool
+	RetentionPeriod *string
+}
+
+// GcsBucketVersioning is the object versioning configuration of a bucket.
+type GcsBucketVersioning struct {
+	Enabled *bool
+}
@@ -0,0 +1,48 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+const (
+	IamPolicySchema = "GCP.IAM.Policy"
+)
+
+// IamPolicy contains the IAM policy of a GCP project
+type IamPolicy struct {
+	// Generic resource fields
+	GenericGCPResource
+	GenericResource
+
+	// Fields embedded from cloudresourcemanager.Policy
+	AuditConfigs []*IamAuditConfig
+	Bindings     []*IamBinding
+	Etag         *string
+	Version      *int64
+}
+
+// IamAuditConfig enables data access audit logs for a service.
+type IamAuditConfig struct {
+	AuditLogConfigs []*IamAuditLogConfig
+	Service         *string
+}
+
+// IamAuditLogConfig is a type of audit log enabled for a service, and the members exempted from it.
+type IamAuditLogConfig struct {
+	ExemptedMembers []*string
+	LogType         *string
+}
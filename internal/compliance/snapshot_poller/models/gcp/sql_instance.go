@@ -0,0 +1,93 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+const (
+	SQLInstanceSchema = "GCP.SQL.Instance"
+)
+
+// SQLInstance contains all the information about a Cloud SQL instance
+type SQLInstance struct {
+	// Generic resource fields
+	GenericGCPResource
+	GenericResource
+
+	// Fields embedded from sqladmin.DatabaseInstance
+	BackendType                 *string
+	ConnectionName              *string
+	DatabaseVersion             *string
+	DiskEncryptionConfiguration *SQLDiskEncryptionConfiguration
+	GceZone                     *string
+	InstanceType                *string
+	IPAddresses                 []*SQLIPMapping
+	ServiceAccountEmailAddress  *string
+	Settings                    *SQLSettings
+	State                       *string
+}
+
+// SQLDiskEncryptionConfiguration identifies the customer managed key encrypting an instance, if any.
+type SQLDiskEncryptionConfiguration struct {
+	KmsKeyName *string
+}
+
+// SQLIPMapping is an IP address assigned to an instance.
+type SQLIPMapping struct {
+	IPAddress *string
+	Type      *string
+}
+
+// SQLSettings is the user configuration of an instance.
+type SQLSettings struct {
+	ActivationPolicy    *string
+	AvailabilityType    *string
+	BackupConfiguration *SQLBackupConfiguration
+	DatabaseFlags       []*SQLDatabaseFlag
+	DataDiskSizeGb      *string
+	IPConfiguration     *SQLIPConfiguration
+	StorageAutoResize   *bool
+	Tier                *string
+}
+
+// SQLBackupConfiguration is the automated backup configuration of an instance.
+type SQLBackupConfiguration struct {
+	BinaryLogEnabled           *bool
+	Enabled                    *bool
+	PointInTimeRecoveryEnabled *bool
+	StartTime                  *string
+}
+
+// SQLDatabaseFlag is a database engine flag set on an instance.
+type SQLDatabaseFlag struct {
+	Name  *string
+	Value *string
+}
+
+// SQLIPConfiguration controls how an instance can be reached over the network.
+type SQLIPConfiguration struct {
+	AuthorizedNetworks []*SQLAuthorizedNetwork
+	Ipv4Enabled        *bool
+	PrivateNetwork     *string
+	RequireSsl         *bool
+}
+
+// SQLAuthorizedNetwork is an external network allowed to connect to an instance.
+type SQLAuthorizedNetwork struct {
+	Name  *string
+	Value *string
+}
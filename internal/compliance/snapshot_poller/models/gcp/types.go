@@ -0,0 +1,75 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/go-openapi/strfmt"
+
+	resourcesapimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+)
+
+// Used to populate the GenericGCPResource.Region field for global GCP resources
+const GlobalRegion = "global"
+
+// GenericResource contains fields that will be common to all resources.
+type GenericResource struct {
+	ResourceID   *string          `json:"ResourceId"`   // A panther wide unique identifier
+	ResourceType *string          `json:"ResourceType"` // A panther defined resource type
+	TimeCreated  *strfmt.DateTime `json:"TimeCreated"`  // A standardized format for when the resource was created
+}
+
+// GenericGCPResource contains information that is standard across GCP resources
+type GenericGCPResource struct {
+	//
+	// As with AWS resources, the fields ID and Name are tagged omitempty as they either always
+	// exist or never exist for a given resource type, while ProjectID, Region and Labels are always sent.
+	//
+
+	// Fields that generally need to be populated after building the snapshot
+	ProjectID *string `json:"ProjectId"` // The ID of the GCP project the resource resides in
+	Region    *string `json:"Region"`    // The region or multi-region the resource exists in, value of GlobalRegion if global
+
+	// Fields that can generally be populated while building the snapshot
+	ID     *string            `json:"Id,omitempty"`   // The GCP resource identifier
+	Name   *string            `json:"Name,omitempty"` // The GCP resource name
+	Labels map[string]*string // The key/value labels assigned to the resource
+}
+
+// ResourcePollerInput contains the metadata to request GCP resource info.
+type ResourcePollerInput struct {
+	IntegrationID *string
+	ProjectID     *string
+}
+
+// ResourcePoller represents a function to poll a specific GCP resource.
+type ResourcePoller func(input *ResourcePollerInput) ([]*resourcesapimodels.AddResourceEntry, error)
+
+// IamBinding associates a list of members with a role, optionally under a condition.
+type IamBinding struct {
+	Condition *IamCondition
+	Members   []*string
+	Role      *string
+}
+
+// IamCondition is a CEL expression restricting when an IAM binding applies.
+type IamCondition struct {
+	Description *string
+	Expression  *string
+	Title       *string
+}
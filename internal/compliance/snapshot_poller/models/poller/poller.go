@@ -18,6 +18,10 @@ package poller
  * along with this program.  If not, see <https://www.gnu.org/licenses/>.
  */
 
+import (
+	resourcesapimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+)
+
 // ScanMsg contains a list of Scan Entries.
 type ScanMsg struct {
 	Entries []*ScanEntry `json:"entries"`
@@ -27,11 +31,17 @@ type ScanMsg struct {
 // to carry out that scan.
 // The poller can scan a single resource, all resources of a given type, or all resources.
 // Scanning all resources in an account is discouraged for performance reasons.
+//
+// ChangeEvent is set when the scan was triggered by a CloudTrail event, and is recorded in the resource history.
+//
+// GCPProjectID is set instead of AWSAccountID when scanning a GCP project.
 type ScanEntry struct {
-	AWSAccountID     *string `json:"awsAccountId"`
-	IntegrationID    *string `json:"integrationId"`
-	Region           *string `json:"region"`
-	ResourceID       *string `json:"resourceId"`
-	ResourceType     *string `json:"resourceType"`
-	ScanAllResources *bool   `json:"scanAllResources"`
+	AWSAccountID     *string                         `json:"awsAccountId"`
+	ChangeEvent      *resourcesapimodels.ChangeEvent `json:"changeEvent,omitempty"`
+	GCPProjectID     *string                         `json:"gcpProjectId,omitempty"`
+	IntegrationID    *string                         `json:"integrationId"`
+	Region           *string                         `json:"region"`
+	ResourceID       *string                         `json:"resourceId"`
+	ResourceType     *string                         `json:"resourceType"`
+	ScanAllResources *bool                           `json:"scanAllResources"`
 }
@@ -0,0 +1,172 @@
+package awstest
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/stretchr/testify/mock"
+)
+
+// Example EKS return values
+var (
+	ExampleEksClusterName = aws.String("example-cluster")
+
+	ExampleEksListClustersOutput = &eks.ListClustersOutput{
+		Clusters: []*string{
+			ExampleEksClusterName,
+		},
+	}
+
+	ExampleEksDescribeClusterOutput = &eks.DescribeClusterOutput{
+		Cluster: &eks.Cluster{
+			Arn:       aws.String("arn:aws:eks:us-west-2:123456789012:cluster/example-cluster"),
+			CreatedAt: ExampleDate,
+			Endpoint:  aws.String("https://ABCDEF0123456789.gr7.us-west-2.eks.amazonaws.com"),
+			Logging: &eks.Logging{
+				ClusterLogging: []*eks.LogSetup{
+					{
+						Enabled: aws.Bool(false),
+						Types:   []*string{aws.String("api"), aws.String("audit")},
+					},
+				},
+			},
+			Name:            ExampleEksClusterName,
+			PlatformVersion: aws.String("eks.2"),
+			ResourcesVpcConfig: &eks.VpcConfigResponse{
+				EndpointPrivateAccess: aws.Bool(false),
+				EndpointPublicAccess:  aws.Bool(true),
+				PublicAccessCidrs:     []*string{aws.String("0.0.0.0/0")},
+				SubnetIds:             []*string{aws.String("subnet-0123456789abcdef0")},
+				VpcId:                 aws.String("vpc-0123456789abcdef0"),
+			},
+			RoleArn: aws.String("arn:aws:iam::123456789012:role/eks-cluster-role"),
+			Status:  aws.String("ACTIVE"),
+			Tags: map[string]*string{
+				"KeyName1": aws.String("Value1"),
+			},
+			Version: aws.String("1.16"),
+		},
+	}
+
+	svcEksSetupCalls = map[string]func(*MockEks){
+		"ListClustersPages": func(svc *MockEks) {
+			svc.On("ListClustersPages", mock.Anything).
+				Return(nil)
+		},
+		"DescribeCluster": func(svc *MockEks) {
+			svc.On("DescribeCluster", mock.Anything).
+				Return(ExampleEksDescribeClusterOutput, nil)
+		},
+	}
+
+	svcEksSetupCallsError = map[string]func(*MockEks){
+		"ListClustersPages": func(svc *MockEks) {
+			svc.On("ListClustersPages", mock.Anything).
+				Return(errors.New("EKS.ListClustersPages error"))
+		},
+		"DescribeCluster": func(svc *MockEks) {
+			svc.On("DescribeCluster", mock.Anything).
+				Return(&eks.DescribeClusterOutput{},
+					errors.New("EKS.DescribeCluster error"),
+				)
+		},
+	}
+
+	MockEksForSetup = &MockEks{}
+)
+
+// EKS mock
+
+// SetupMockEks is used to override the EKS Client initializer
+func SetupMockEks(sess *session.Session, cfg *aws.Config) interface{} {
+	return MockEksForSetup
+}
+
+// MockEks is a mock EKS client
+type MockEks struct {
+	eksiface.EKSAPI
+	mock.Mock
+}
+
+// BuildMockEksSvc builds and returns a MockEks struct
+//
+// Additionally, the appropriate calls to On and Return are made based on the strings passed in
+func BuildMockEksSvc(funcs []string) (mockSvc *MockEks) {
+	mockSvc = &MockEks{}
+	for _, f := range funcs {
+		svcEksSetupCalls[f](mockSvc)
+	}
+	return
+}
+
+// BuildMockEksSvcError builds and returns a MockEks struct with errors set
+//
+// Additionally, the appropriate calls to On and Return are made based on the strings passed in
+func BuildMockEksSvcError(funcs []string) (mockSvc *MockEks) {
+	mockSvc = &MockEks{}
+	for _, f := range funcs {
+		svcEksSetupCallsError[f](mockSvc)
+	}
+	return
+}
+
+// BuildMockEksSvcAll builds and returns a MockEks struct
+//
+// Additionally, the appropriate calls to On and Return are made for all possible function calls
+func BuildMockEksSvcAll() (mockSvc *MockEks) {
+	mockSvc = &MockEks{}
+	for _, f := range svcEksSetupCalls {
+		f(mockSvc)
+	}
+	return
+}
+
+// BuildMockEksSvcAllError builds and returns a MockEks struct with errors set
+//
+// Additionally, the appropriate calls to On and Return are made for all possible function calls
+func BuildMockEksSvcAllError() (mockSvc *MockEks) {
+	mockSvc = &MockEks{}
+	for _, f := range svcEksSetupCallsError {
+		f(mockSvc)
+	}
+	return
+}
+
+func (m *MockEks) ListClustersPages(
+	in *eks.ListClustersInput,
+	paginationFunction func(*eks.ListClustersOutput, bool) bool,
+) error {
+
+	args := m.Called(in)
+	if args.Error(0) != nil {
+		return args.Error(0)
+	}
+	paginationFunction(ExampleEksListClustersOutput, true)
+	return args.Error(0)
+}
+
+func (m *MockEks) DescribeCluster(in *eks.DescribeClusterInput) (*eks.DescribeClusterOutput, error) {
+	args := m.Called(in)
+	return args.Get(0).(*eks.DescribeClusterOutput), args.Error(1)
+}
@@ -0,0 +1,189 @@
+package awstest
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/stretchr/testify/mock"
+)
+
+// Example Secrets Manager return values
+var (
+	ExampleSecretARN = aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:example-secret-AbCdEf")
+
+	ExampleSecretsManagerListSecretsOutput = &secretsmanager.ListSecretsOutput{
+		SecretList: []*secretsmanager.SecretListEntry{
+			{
+				ARN:  ExampleSecretARN,
+				Name: aws.String("example-secret"),
+			},
+		},
+	}
+
+	ExampleSecretsManagerDescribeSecretOutput = &secretsmanager.DescribeSecretOutput{
+		ARN:               ExampleSecretARN,
+		Description:       aws.String("An example secret"),
+		KmsKeyId:          aws.String("arn:aws:kms:us-west-2:123456789012:key/1"),
+		LastAccessedDate:  ExampleDate,
+		LastChangedDate:   ExampleDate,
+		LastRotatedDate:   ExampleDate,
+		Name:              aws.String("example-secret"),
+		RotationEnabled:   aws.Bool(true),
+		RotationLambdaARN: aws.String("arn:aws:lambda:us-west-2:123456789012:function:rotate-example-secret"),
+		RotationRules: &secretsmanager.RotationRulesType{
+			AutomaticallyAfterDays: aws.Int64(30),
+		},
+		Tags: []*secretsmanager.Tag{
+			{
+				Key:   aws.String("KeyName1"),
+				Value: aws.String("Value1"),
+			},
+		},
+		VersionIdsToStages: map[string][]*string{
+			"1234abcd-12ab-34cd-56ef-1234567890ab": {aws.String("AWSCURRENT")},
+		},
+	}
+
+	ExampleSecretsManagerGetResourcePolicyOutput = &secretsmanager.GetResourcePolicyOutput{
+		ARN:            ExampleSecretARN,
+		Name:           aws.String("example-secret"),
+		ResourcePolicy: aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+	}
+
+	svcSecretsManagerSetupCalls = map[string]func(*MockSecretsManager){
+		"ListSecretsPages": func(svc *MockSecretsManager) {
+			svc.On("ListSecretsPages", mock.Anything).
+				Return(nil)
+		},
+		"DescribeSecret": func(svc *MockSecretsManager) {
+			svc.On("DescribeSecret", mock.Anything).
+				Return(ExampleSecretsManagerDescribeSecretOutput, nil)
+		},
+		"GetResourcePolicy": func(svc *MockSecretsManager) {
+			svc.On("GetResourcePolicy", mock.Anything).
+				Return(ExampleSecretsManagerGetResourcePolicyOutput, nil)
+		},
+	}
+
+	svcSecretsManagerSetupCallsError = map[string]func(*MockSecretsManager){
+		"ListSecretsPages": func(svc *MockSecretsManager) {
+			svc.On("ListSecretsPages", mock.Anything).
+				Return(errors.New("SecretsManager.ListSecretsPages error"))
+		},
+		"DescribeSecret": func(svc *MockSecretsManager) {
+			svc.On("DescribeSecret", mock.Anything).
+				Return(&secretsmanager.DescribeSecretOutput{},
+					errors.New("SecretsManager.DescribeSecret error"),
+				)
+		},
+		"GetResourcePolicy": func(svc *MockSecretsManager) {
+			svc.On("GetResourcePolicy", mock.Anything).
+				Return(&secretsmanager.GetResourcePolicyOutput{},
+					errors.New("SecretsManager.GetResourcePolicy error"),
+				)
+		},
+	}
+
+	MockSecretsManagerForSetup = &MockSecretsManager{}
+)
+
+// Secrets Manager mock
+
+// SetupMockSecretsManager is used to override the Secrets Manager Client initializer
+func SetupMockSecretsManager(sess *session.Session, cfg *aws.Config) interface{} {
+	return MockSecretsManagerForSetup
+}
+
+// MockSecretsManager is a mock Secrets Manager client
+type MockSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+	mock.Mock
+}
+
+// BuildMockSecretsManagerSvc builds and returns a MockSecretsManager struct
+//
+// Additionally, the appropriate calls to On and Return are made based on the strings passed in
+func BuildMockSecretsManagerSvc(funcs []string) (mockSvc *MockSecretsManager) {
+	mockSvc = &MockSecretsManager{}
+	for _, f := range funcs {
+		svcSecretsManagerSetupCalls[f](mockSvc)
+	}
+	return
+}
+
+// BuildMockSecretsManagerSvcError builds and returns a MockSecretsManager struct with errors set
+//
+// Additionally, the appropriate calls to On and Return are made based on the strings passed in
+func BuildMockSecretsManagerSvcError(funcs []string) (mockSvc *MockSecretsManager) {
+	mockSvc = &MockSecretsManager{}
+	for _, f := range funcs {
+		svcSecretsManagerSetupCallsError[f](mockSvc)
+	}
+	return
+}
+
+// BuildMockSecretsManagerSvcAll builds and returns a MockSecretsManager struct
+//
+// Additionally, the appropriate calls to On and Return are made for all possible function calls
+func BuildMockSecretsManagerSvcAll() (mockSvc *MockSecretsManager) {
+	mockSvc = &MockSecretsManager{}
+	for _, f := range svcSecretsManagerSetupCalls {
+		f(mockSvc)
+	}
+	return
+}
+
+// BuildMockSecretsManagerSvcAllError builds and returns a MockSecretsManager struct with errors set
+//
+// Additionally, the appropriate calls to On and Return are made for all possible function calls
+func BuildMockSecretsManagerSvcAllError() (mockSvc *MockSecretsManager) {
+	mockSvc = &MockSecretsManager{}
+	for _, f := range svcSecretsManagerSetupCallsError {
+		f(mockSvc)
+	}
+	return
+}
+
+func (m *MockSecretsManager) ListSecretsPages(
+	in *secretsmanager.ListSecretsInput,
+	paginationFunction func(*secretsmanager.ListSecretsOutput, bool) bool,
+) error {
+
+	args := m.Called(in)
+	if args.Error(0) != nil {
+		return args.Error(0)
+	}
+	paginationFunction(ExampleSecretsManagerListSecretsOutput, true)
+	return args.Error(0)
+}
+
+func (m *MockSecretsManager) DescribeSecret(in *secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error) {
+	args := m.Called(in)
+	return args.Get(0).(*secretsmanager.DescribeSecretOutput), args.Error(1)
+}
+
+func (m *MockSecretsManager) GetResourcePolicy(in *secretsmanager.GetResourcePolicyInput) (*secretsmanager.GetResourcePolicyOutput, error) {
+	args := m.Called(in)
+	return args.Get(0).(*secretsmanager.GetResourcePolicyOutput), args.Error(1)
+}
@@ -0,0 +1,217 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	apimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+	awsmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+	pollermodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/poller"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/utils"
+)
+
+// Set as variables to be overridden in testing
+var CloudFrontClientFunc = setupCloudFrontClient
+
+func setupCloudFrontClient(sess *session.Session, cfg *aws.Config) interface{} {
+	return cloudfront.New(sess, cfg)
+}
+
+func getCloudFrontClient(pollerResourceInput *awsmodels.ResourcePollerInput,
+	region string) (cloudfrontiface.CloudFrontAPI, error) {
+
+	client, err := getClient(pollerResourceInput, CloudFrontClientFunc, "cloudfront", region)
+	if err != nil {
+		return nil, err // error is logged in getClient()
+	}
+
+	return client.(cloudfrontiface.CloudFrontAPI), nil
+}
+
+// PollCloudFrontDistribution polls a single CloudFront distribution resource
+func PollCloudFrontDistribution(
+	pollerInput *awsmodels.ResourcePollerInput,
+	resourceARN arn.ARN,
+	_ *pollermodels.ScanEntry,
+) (interface{}, error) {
+
+	// CloudFront is a global service
+	client, err := getCloudFrontClient(pollerInput, defaultRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	distributionID := strings.Replace(resourceARN.Resource, "distribution/", "", 1)
+	snapshot := buildCloudFrontDistributionSnapshot(client, aws.String(distributionID))
+	if snapshot == nil {
+		return nil, nil
+	}
+	snapshot.Region = aws.String(awsmodels.GlobalRegion)
+	snapshot.AccountID = aws.String(resourceARN.AccountID)
+
+	return snapshot, nil
+}
+
+// listDistributions returns the IDs of all CloudFront distributions in the account
+func listDistributions(cloudFrontSvc cloudfrontiface.CloudFrontAPI) (distributionIDs []*string, err error) {
+	err = cloudFrontSvc.ListDistributionsPages(&cloudfront.ListDistributionsInput{},
+		func(page *cloudfront.ListDistributionsOutput, lastPage bool) bool {
+			if page.DistributionList == nil {
+				return true
+			}
+			for _, distribution := range page.DistributionList.Items {
+				distributionIDs = append(distributionIDs, distribution.Id)
+			}
+			return true
+		})
+	if err != nil {
+		return nil, errors.Wrap(err, "CloudFront.ListDistributionsPages")
+	}
+	return
+}
+
+// getDistribution provides detailed information about a given CloudFront distribution
+func getDistribution(cloudFrontSvc cloudfrontiface.CloudFrontAPI, id *string) *cloudfront.Distribution {
+	out, err := cloudFrontSvc.GetDistribution(&cloudfront.GetDistributionInput{Id: id})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == cloudfront.ErrCodeNoSuchDistribution {
+			zap.L().Warn("tried to scan non-existent resource",
+				zap.String("resource", *id),
+				zap.String("resourceType", awsmodels.CloudFrontDistributionSchema))
+			return nil
+		}
+		utils.LogAWSError("CloudFront.GetDistribution", err)
+		return nil
+	}
+
+	return out.Distribution
+}
+
+// listTagsForResourceCloudFront returns the tags for a given CloudFront distribution
+func listTagsForResourceCloudFront(cloudFrontSvc cloudfrontiface.CloudFrontAPI, arn *string) ([]*cloudfront.Tag, error) {
+	out, err := cloudFrontSvc.ListTagsForResource(&cloudfront.ListTagsForResourceInput{Resource: arn})
+	if err != nil {
+		utils.LogAWSError("CloudFront.ListTagsForResource", err)
+		return nil, err
+	}
+	if out.Tags == nil {
+		return nil, nil
+	}
+
+	return out.Tags.Items, nil
+}
+
+// buildCloudFrontDistributionSnapshot returns a complete snapshot of a CloudFront distribution
+func buildCloudFrontDistributionSnapshot(
+	cloudFrontSvc cloudfrontiface.CloudFrontAPI, distributionID *string) *awsmodels.CloudFrontDistribution {
+
+	details := getDistribution(cloudFrontSvc, distributionID)
+	if details == nil {
+		return nil
+	}
+
+	distribution := &awsmodels.CloudFrontDistribution{
+		GenericResource: awsmodels.GenericResource{
+			ResourceID:   details.ARN,
+			ResourceType: aws.String(awsmodels.CloudFrontDistributionSchema),
+		},
+		GenericAWSResource: awsmodels.GenericAWSResource{
+			ARN: details.ARN,
+			ID:  details.Id,
+		},
+		ActiveTrustedSigners:          details.ActiveTrustedSigners,
+		AliasICPRecordals:             details.AliasICPRecordals,
+		DomainName:                    details.DomainName,
+		InProgressInvalidationBatches: details.InProgressInvalidationBatches,
+		LastModifiedTime:              details.LastModifiedTime,
+		Status:                        details.Status,
+	}
+
+	if config := details.DistributionConfig; config != nil {
+		distribution.Aliases = config.Aliases
+		distribution.CacheBehaviors = config.CacheBehaviors
+		distribution.CallerReference = config.CallerReference
+		distribution.Comment = config.Comment
+		distribution.CustomErrorResponses = config.CustomErrorResponses
+		distribution.DefaultCacheBehavior = config.DefaultCacheBehavior
+		distribution.DefaultRootObject = config.DefaultRootObject
+		distribution.Enabled = config.Enabled
+		distribution.HttpVersion = config.HttpVersion
+		distribution.IsIPV6Enabled = config.IsIPV6Enabled
+		distribution.Logging = config.Logging
+		distribution.OriginGroups = config.OriginGroups
+		distribution.Origins = config.Origins
+		distribution.PriceClass = config.PriceClass
+		distribution.Restrictions = config.Restrictions
+		distribution.ViewerCertificate = config.ViewerCertificate
+		distribution.WebACLId = config.WebACLId
+	}
+
+	tags, err := listTagsForResourceCloudFront(cloudFrontSvc, details.ARN)
+	if err == nil {
+		distribution.Tags = utils.ParseTagSlice(tags)
+	}
+
+	return distribution
+}
+
+// PollCloudFrontDistributions gathers information on each CloudFront distribution for an AWS account.
+func PollCloudFrontDistributions(pollerInput *awsmodels.ResourcePollerInput) ([]*apimodels.AddResourceEntry, error) {
+	zap.L().Debug("starting global CloudFront Distribution resource poller")
+
+	cloudFrontSvc, err := getCloudFrontClient(pollerInput, defaultRegion)
+	if err != nil {
+		return nil, err // error is logged in getClient()
+	}
+
+	distributionIDs, err := listDistributions(cloudFrontSvc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "PollCloudFrontDistributions(%#v)", *pollerInput)
+	}
+
+	resources := make([]*apimodels.AddResourceEntry, 0, len(distributionIDs))
+	for _, distributionID := range distributionIDs {
+		distribution := buildCloudFrontDistributionSnapshot(cloudFrontSvc, distributionID)
+		if distribution == nil {
+			continue
+		}
+		distribution.AccountID = aws.String(pollerInput.AuthSourceParsedARN.AccountID)
+		distribution.Region = aws.String(awsmodels.GlobalRegion)
+
+		resources = append(resources, &apimodels.AddResourceEntry{
+			Attributes:      distribution,
+			ID:              apimodels.ResourceID(*distribution.ARN),
+			IntegrationID:   apimodels.IntegrationID(*pollerInput.IntegrationID),
+			IntegrationType: apimodels.IntegrationTypeAws,
+			Type:            awsmodels.CloudFrontDistributionSchema,
+		})
+	}
+
+	return resources, nil
+}
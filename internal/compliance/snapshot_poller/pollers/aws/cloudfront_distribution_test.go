@@ -0,0 +1,152 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	awsmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/aws/awstest"
+)
+
+func TestCloudFrontListDistributions(t *testing.T) {
+	mockSvc := awstest.BuildMockCloudFrontSvc([]string{"ListDistributionsPages"})
+
+	out, err := listDistributions(mockSvc)
+	require.NoError(t, err)
+	assert.Equal(t, []*string{awstest.ExampleDistributionID}, out)
+}
+
+func TestCloudFrontListDistributionsError(t *testing.T) {
+	mockSvc := awstest.BuildMockCloudFrontSvcError([]string{"ListDistributionsPages"})
+
+	out, err := listDistributions(mockSvc)
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestCloudFrontGetDistribution(t *testing.T) {
+	mockSvc := awstest.BuildMockCloudFrontSvc([]string{"GetDistribution"})
+
+	out := getDistribution(mockSvc, awstest.ExampleDistributionID)
+	require.NotNil(t, out)
+	assert.Equal(t, awstest.ExampleDistributionID, out.Id)
+}
+
+func TestCloudFrontGetDistributionError(t *testing.T) {
+	mockSvc := awstest.BuildMockCloudFrontSvcError([]string{"GetDistribution"})
+
+	out := getDistribution(mockSvc, awstest.ExampleDistributionID)
+	assert.Nil(t, out)
+}
+
+func TestCloudFrontListTagsForResource(t *testing.T) {
+	mockSvc := awstest.BuildMockCloudFrontSvc([]string{"ListTagsForResource"})
+
+	out, err := listTagsForResourceCloudFront(mockSvc, aws.String("arn:aws:cloudfront::123456789012:distribution/EDFDVBD632BHDS5"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, out)
+}
+
+func TestCloudFrontListTagsForResourceError(t *testing.T) {
+	mockSvc := awstest.BuildMockCloudFrontSvcError([]string{"ListTagsForResource"})
+
+	out, err := listTagsForResourceCloudFront(mockSvc, aws.String("arn:aws:cloudfront::123456789012:distribution/EDFDVBD632BHDS5"))
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestBuildCloudFrontDistributionSnapshot(t *testing.T) {
+	mockSvc := awstest.BuildMockCloudFrontSvcAll()
+
+	distribution := buildCloudFrontDistributionSnapshot(mockSvc, awstest.ExampleDistributionID)
+	require.NotNil(t, distribution)
+	assert.Equal(t, awstest.ExampleDistributionID, distribution.ID)
+	assert.Equal(t, aws.String("allow-all"), distribution.DefaultCacheBehavior.ViewerProtocolPolicy)
+	assert.Equal(t, aws.String("TLSv1"), distribution.ViewerCertificate.MinimumProtocolVersion)
+	assert.Equal(t, aws.String("Value1"), distribution.Tags["KeyName1"])
+}
+
+func TestBuildCloudFrontDistributionSnapshotError(t *testing.T) {
+	mockSvc := awstest.BuildMockCloudFrontSvcAllError()
+
+	distribution := buildCloudFrontDistributionSnapshot(mockSvc, awstest.ExampleDistributionID)
+	assert.Nil(t, distribution)
+}
+
+func TestPollCloudFrontDistribution(t *testing.T) {
+	awstest.MockCloudFrontForSetup = awstest.BuildMockCloudFrontSvcAll()
+	CloudFrontClientFunc = awstest.SetupMockCloudFront
+
+	resourceARN, err := arn.Parse("arn:aws:cloudfront::123456789012:distribution/EDFDVBD632BHDS5")
+	require.NoError(t, err)
+	resource, err := PollCloudFrontDistribution(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	}, resourceARN, nil)
+
+	require.NoError(t, err)
+	distribution := resource.(*awsmodels.CloudFrontDistribution)
+	assert.Equal(t, aws.String(awsmodels.GlobalRegion), distribution.Region)
+	assert.Equal(t, aws.String("123456789012"), distribution.AccountID)
+}
+
+func TestCloudFrontDistributionPoller(t *testing.T) {
+	awstest.MockCloudFrontForSetup = awstest.BuildMockCloudFrontSvcAll()
+	CloudFrontClientFunc = awstest.SetupMockCloudFront
+
+	resources, err := PollCloudFrontDistributions(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	distribution := resources[0].Attributes.(*awsmodels.CloudFrontDistribution)
+	assert.Equal(t, aws.String(awsmodels.GlobalRegion), distribution.Region)
+	assert.Equal(t, aws.String("d111111abcdef8.cloudfront.net"), distribution.DomainName)
+	assert.Equal(t, awsmodels.CloudFrontDistributionSchema, string(resources[0].Type))
+}
+
+func TestCloudFrontDistributionPollerError(t *testing.T) {
+	awstest.MockCloudFrontForSetup = awstest.BuildMockCloudFrontSvcAllError()
+	CloudFrontClientFunc = awstest.SetupMockCloudFront
+
+	resources, err := PollCloudFrontDistributions(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	})
+
+	require.Error(t, err)
+	assert.Empty(t, resources)
+}
@@ -0,0 +1,221 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	apimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+	awsmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+	pollermodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/poller"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/utils"
+)
+
+// Set as variables to be overridden in testing
+var EcrClientFunc = setupEcrClient
+
+func setupEcrClient(sess *session.Session, cfg *aws.Config) interface{} {
+	return ecr.New(sess, cfg)
+}
+
+func getEcrClient(pollerResourceInput *awsmodels.ResourcePollerInput, region string) (ecriface.ECRAPI, error) {
+	client, err := getClient(pollerResourceInput, EcrClientFunc, "ecr", region)
+	if err != nil {
+		return nil, err // error is logged in getClient()
+	}
+
+	return client.(ecriface.ECRAPI), nil
+}
+
+// PollECRRepository polls a single ECR repository resource
+func PollECRRepository(
+	pollerInput *awsmodels.ResourcePollerInput,
+	resourceARN arn.ARN,
+	_ *pollermodels.ScanEntry,
+) (interface{}, error) {
+
+	client, err := getEcrClient(pollerInput, resourceARN.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryName := strings.Replace(resourceARN.Resource, "repository/", "", 1)
+	repository := getEcrRepository(client, aws.String(repositoryName))
+	if repository == nil {
+		return nil, nil
+	}
+
+	snapshot := buildEcrRepositorySnapshot(client, repository)
+	snapshot.Region = aws.String(resourceARN.Region)
+	snapshot.AccountID = aws.String(resourceARN.AccountID)
+
+	return snapshot, nil
+}
+
+// describeEcrRepositories returns all ECR repositories in the account
+func describeEcrRepositories(ecrSvc ecriface.ECRAPI) (repositories []*ecr.Repository, err error) {
+	err = ecrSvc.DescribeRepositoriesPages(&ecr.DescribeRepositoriesInput{},
+		func(page *ecr.DescribeRepositoriesOutput, lastPage bool) bool {
+			repositories = append(repositories, page.Repositories...)
+			return true
+		})
+	if err != nil {
+		return nil, errors.Wrap(err, "ECR.DescribeRepositoriesPages")
+	}
+	return
+}
+
+// getEcrRepository returns a specific ECR repository
+func getEcrRepository(ecrSvc ecriface.ECRAPI, name *string) *ecr.Repository {
+	out, err := ecrSvc.DescribeRepositories(&ecr.DescribeRepositoriesInput{
+		RepositoryNames: []*string{name},
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == ecr.ErrCodeRepositoryNotFoundException {
+			zap.L().Warn("tried to scan non-existent resource",
+				zap.String("resource", *name),
+				zap.String("resourceType", awsmodels.EcrRepositorySchema))
+			return nil
+		}
+		utils.LogAWSError("ECR.DescribeRepositories", err)
+		return nil
+	}
+
+	if len(out.Repositories) != 1 {
+		zap.L().Warn("unexpected number of repositories returned",
+			zap.String("resource", *name),
+			zap.Int("count", len(out.Repositories)))
+		return nil
+	}
+	return out.Repositories[0]
+}
+
+// getEcrRepositoryPolicy returns the access policy attached to an ECR repository, if any
+func getEcrRepositoryPolicy(ecrSvc ecriface.ECRAPI, name *string) (*string, error) {
+	out, err := ecrSvc.GetRepositoryPolicy(&ecr.GetRepositoryPolicyInput{RepositoryName: name})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == ecr.ErrCodeRepositoryPolicyNotFoundException {
+			return nil, nil
+		}
+		utils.LogAWSError("ECR.GetRepositoryPolicy", err)
+		return nil, err
+	}
+
+	return out.PolicyText, nil
+}
+
+// listTagsForResourceEcr returns the tags for an ECR repository
+func listTagsForResourceEcr(ecrSvc ecriface.ECRAPI, arn *string) ([]*ecr.Tag, error) {
+	out, err := ecrSvc.ListTagsForResource(&ecr.ListTagsForResourceInput{ResourceArn: arn})
+	if err != nil {
+		utils.LogAWSError("ECR.ListTagsForResource", err)
+		return nil, err
+	}
+
+	return out.Tags, nil
+}
+
+// buildEcrRepositorySnapshot returns a complete snapshot of an ECR repository
+func buildEcrRepositorySnapshot(ecrSvc ecriface.ECRAPI, repository *ecr.Repository) *awsmodels.EcrRepository {
+	ecrRepository := &awsmodels.EcrRepository{
+		GenericResource: awsmodels.GenericResource{
+			ResourceID:   repository.RepositoryArn,
+			ResourceType: aws.String(awsmodels.EcrRepositorySchema),
+		},
+		GenericAWSResource: awsmodels.GenericAWSResource{
+			ARN:  repository.RepositoryArn,
+			Name: repository.RepositoryName,
+		},
+		ImageScanningConfiguration: repository.ImageScanningConfiguration,
+		ImageTagMutability:         repository.ImageTagMutability,
+		RegistryId:                 repository.RegistryId,
+		RepositoryUri:              repository.RepositoryUri,
+	}
+	if repository.CreatedAt != nil {
+		ecrRepository.TimeCreated = utils.DateTimeFormat(*repository.CreatedAt)
+	}
+
+	policy, err := getEcrRepositoryPolicy(ecrSvc, repository.RepositoryName)
+	if err == nil {
+		ecrRepository.Policy = policy
+	}
+
+	tags, err := listTagsForResourceEcr(ecrSvc, repository.RepositoryArn)
+	if err == nil {
+		ecrRepository.Tags = utils.ParseTagSlice(tags)
+	}
+
+	return ecrRepository
+}
+
+// PollEcrRepositories gathers information on each ECR repository for an AWS account.
+func PollEcrRepositories(pollerInput *awsmodels.ResourcePollerInput) ([]*apimodels.AddResourceEntry, error) {
+	zap.L().Debug("starting ECR Repository resource poller")
+	ecrRepositorySnapshots := make(map[string]*awsmodels.EcrRepository)
+
+	for _, regionID := range utils.GetServiceRegions(pollerInput.Regions, "api.ecr") {
+		ecrSvc, err := getEcrClient(pollerInput, *regionID)
+		if err != nil {
+			return nil, err // error is logged in getClient()
+		}
+
+		repositories, err := describeEcrRepositories(ecrSvc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "PollEcrRepositories(%#v) in region %s", *pollerInput, *regionID)
+		}
+
+		for _, repository := range repositories {
+			ecrRepository := buildEcrRepositorySnapshot(ecrSvc, repository)
+			ecrRepository.AccountID = aws.String(pollerInput.AuthSourceParsedARN.AccountID)
+			ecrRepository.Region = regionID
+
+			if _, ok := ecrRepositorySnapshots[*ecrRepository.ARN]; !ok {
+				ecrRepositorySnapshots[*ecrRepository.ARN] = ecrRepository
+			} else {
+				zap.L().Info(
+					"overwriting existing ECR Repository snapshot",
+					zap.String("resourceID", *ecrRepository.ARN),
+				)
+				ecrRepositorySnapshots[*ecrRepository.ARN] = ecrRepository
+			}
+		}
+	}
+
+	resources := make([]*apimodels.AddResourceEntry, 0, len(ecrRepositorySnapshots))
+	for resourceID, ecrRepository := range ecrRepositorySnapshots {
+		resources = append(resources, &apimodels.AddResourceEntry{
+			Attributes:      ecrRepository,
+			ID:              apimodels.ResourceID(resourceID),
+			IntegrationID:   apimodels.IntegrationID(*pollerInput.IntegrationID),
+			IntegrationType: apimodels.IntegrationTypeAws,
+			Type:            awsmodels.EcrRepositorySchema,
+		})
+	}
+
+	return resources, nil
+}
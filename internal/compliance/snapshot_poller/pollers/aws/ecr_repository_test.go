@@ -0,0 +1,170 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	awsmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/aws/awstest"
+)
+
+func TestEcrDescribeRepositories(t *testing.T) {
+	mockSvc := awstest.BuildMockEcrSvc([]string{"DescribeRepositoriesPages"})
+
+	out, err := describeEcrRepositories(mockSvc)
+	require.NoError(t, err)
+	assert.NotEmpty(t, out)
+}
+
+func TestEcrDescribeRepositoriesError(t *testing.T) {
+	mockSvc := awstest.BuildMockEcrSvcError([]string{"DescribeRepositoriesPages"})
+
+	out, err := describeEcrRepositories(mockSvc)
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestEcrGetRepository(t *testing.T) {
+	mockSvc := awstest.BuildMockEcrSvc([]string{"DescribeRepositories"})
+
+	out := getEcrRepository(mockSvc, awstest.ExampleEcrRepositoryName)
+	require.NotNil(t, out)
+	assert.Equal(t, "example-repository", *out.RepositoryName)
+}
+
+func TestEcrGetRepositoryError(t *testing.T) {
+	mockSvc := awstest.BuildMockEcrSvcError([]string{"DescribeRepositories"})
+
+	out := getEcrRepository(mockSvc, awstest.ExampleEcrRepositoryName)
+	assert.Nil(t, out)
+}
+
+func TestEcrGetRepositoryPolicy(t *testing.T) {
+	mockSvc := awstest.BuildMockEcrSvc([]string{"GetRepositoryPolicy"})
+
+	out, err := getEcrRepositoryPolicy(mockSvc, awstest.ExampleEcrRepositoryName)
+	require.NoError(t, err)
+	assert.NotEmpty(t, out)
+}
+
+func TestEcrGetRepositoryPolicyError(t *testing.T) {
+	mockSvc := awstest.BuildMockEcrSvcError([]string{"GetRepositoryPolicy"})
+
+	out, err := getEcrRepositoryPolicy(mockSvc, awstest.ExampleEcrRepositoryName)
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestEcrListTagsForResource(t *testing.T) {
+	mockSvc := awstest.BuildMockEcrSvc([]string{"ListTagsForResource"})
+
+	out, err := listTagsForResourceEcr(mockSvc, awstest.ExampleEcrRepository.RepositoryArn)
+	require.NoError(t, err)
+	assert.NotEmpty(t, out)
+}
+
+func TestEcrListTagsForResourceError(t *testing.T) {
+	mockSvc := awstest.BuildMockEcrSvcError([]string{"ListTagsForResource"})
+
+	out, err := listTagsForResourceEcr(mockSvc, awstest.ExampleEcrRepository.RepositoryArn)
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestBuildEcrRepositorySnapshot(t *testing.T) {
+	mockSvc := awstest.BuildMockEcrSvcAll()
+
+	repository := buildEcrRepositorySnapshot(mockSvc, awstest.ExampleEcrRepository)
+	assert.Equal(t, awstest.ExampleEcrRepository.RepositoryArn, repository.ARN)
+	assert.NotNil(t, repository.Policy)
+	assert.Equal(t, aws.String("Value1"), repository.Tags["KeyName1"])
+	assert.True(t, *repository.ImageScanningConfiguration.ScanOnPush)
+}
+
+func TestBuildEcrRepositorySnapshotError(t *testing.T) {
+	mockSvc := awstest.BuildMockEcrSvcAllError()
+
+	// The repository itself is always built, the supplementary information is left empty
+	repository := buildEcrRepositorySnapshot(mockSvc, awstest.ExampleEcrRepository)
+	assert.Equal(t, awstest.ExampleEcrRepository.RepositoryArn, repository.ARN)
+	assert.Nil(t, repository.Policy)
+	assert.Nil(t, repository.Tags)
+}
+
+func TestPollECRRepository(t *testing.T) {
+	awstest.MockEcrForSetup = awstest.BuildMockEcrSvcAll()
+	EcrClientFunc = awstest.SetupMockEcr
+
+	resourceARN, err := arn.Parse("arn:aws:ecr:us-west-2:123456789012:repository/example-repository")
+	require.NoError(t, err)
+	resource, err := PollECRRepository(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	}, resourceARN, nil)
+
+	require.NoError(t, err)
+	repository := resource.(*awsmodels.EcrRepository)
+	assert.Equal(t, aws.String("us-west-2"), repository.Region)
+	assert.Equal(t, aws.String("123456789012"), repository.AccountID)
+}
+
+func TestEcrRepositoryPoller(t *testing.T) {
+	awstest.MockEcrForSetup = awstest.BuildMockEcrSvcAll()
+	EcrClientFunc = awstest.SetupMockEcr
+
+	resources, err := PollEcrRepositories(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	repository := resources[0].Attributes.(*awsmodels.EcrRepository)
+	assert.Equal(t, aws.String("example-repository"), repository.Name)
+	assert.Equal(t, aws.String("MUTABLE"), repository.ImageTagMutability)
+	assert.Equal(t, awsmodels.EcrRepositorySchema, string(resources[0].Type))
+}
+
+func TestEcrRepositoryPollerError(t *testing.T) {
+	awstest.MockEcrForSetup = awstest.BuildMockEcrSvcAllError()
+	EcrClientFunc = awstest.SetupMockEcr
+
+	resources, err := PollEcrRepositories(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	})
+
+	require.Error(t, err)
+	assert.Empty(t, resources)
+}
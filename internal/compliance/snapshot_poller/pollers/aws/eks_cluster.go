@@ -0,0 +1,191 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	apimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+	awsmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+	pollermodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/poller"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/utils"
+)
+
+// Set as variables to be overridden in testing
+var EksClientFunc = setupEksClient
+
+func setupEksClient(sess *session.Session, cfg *aws.Config) interface{} {
+	return eks.New(sess, cfg)
+}
+
+func getEksClient(pollerResourceInput *awsmodels.ResourcePollerInput, region string) (eksiface.EKSAPI, error) {
+	client, err := getClient(pollerResourceInput, EksClientFunc, "eks", region)
+	if err != nil {
+		return nil, err // error is logged in getClient()
+	}
+
+	return client.(eksiface.EKSAPI), nil
+}
+
+// PollEKSCluster polls a single EKS cluster resource
+func PollEKSCluster(
+	pollerInput *awsmodels.ResourcePollerInput,
+	resourceARN arn.ARN,
+	_ *pollermodels.ScanEntry,
+) (interface{}, error) {
+
+	client, err := getEksClient(pollerInput, resourceARN.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterName := strings.Replace(resourceARN.Resource, "cluster/", "", 1)
+	snapshot := buildEksClusterSnapshot(client, aws.String(clusterName))
+	if snapshot == nil {
+		return nil, nil
+	}
+	snapshot.Region = aws.String(resourceARN.Region)
+	snapshot.AccountID = aws.String(resourceARN.AccountID)
+
+	return snapshot, nil
+}
+
+// listEksClusters returns the names of all EKS clusters in the account
+func listEksClusters(eksSvc eksiface.EKSAPI) (clusters []*string, err error) {
+	err = eksSvc.ListClustersPages(&eks.ListClustersInput{},
+		func(page *eks.ListClustersOutput, lastPage bool) bool {
+			clusters = append(clusters, page.Clusters...)
+			return true
+		})
+	if err != nil {
+		return nil, errors.Wrap(err, "EKS.ListClustersPages")
+	}
+	return
+}
+
+// describeEksCluster provides detailed information about a given EKS cluster
+func describeEksCluster(eksSvc eksiface.EKSAPI, name *string) *eks.Cluster {
+	out, err := eksSvc.DescribeCluster(&eks.DescribeClusterInput{Name: name})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == eks.ErrCodeResourceNotFoundException {
+			zap.L().Warn("tried to scan non-existent resource",
+				zap.String("resource", *name),
+				zap.String("resourceType", awsmodels.EksClusterSchema))
+			return nil
+		}
+		utils.LogAWSError("EKS.DescribeCluster", err)
+		return nil
+	}
+
+	return out.Cluster
+}
+
+// buildEksClusterSnapshot returns a complete snapshot of an EKS cluster
+func buildEksClusterSnapshot(eksSvc eksiface.EKSAPI, clusterName *string) *awsmodels.EksCluster {
+	details := describeEksCluster(eksSvc, clusterName)
+	if details == nil {
+		return nil
+	}
+
+	cluster := &awsmodels.EksCluster{
+		GenericResource: awsmodels.GenericResource{
+			ResourceID:   details.Arn,
+			ResourceType: aws.String(awsmodels.EksClusterSchema),
+		},
+		GenericAWSResource: awsmodels.GenericAWSResource{
+			ARN:  details.Arn,
+			Name: details.Name,
+			Tags: details.Tags,
+		},
+		CertificateAuthority: details.CertificateAuthority,
+		EncryptionConfig:     details.EncryptionConfig,
+		Endpoint:             details.Endpoint,
+		Identity:             details.Identity,
+		Logging:              details.Logging,
+		PlatformVersion:      details.PlatformVersion,
+		ResourcesVpcConfig:   details.ResourcesVpcConfig,
+		RoleArn:              details.RoleArn,
+		Status:               details.Status,
+		Version:              details.Version,
+	}
+	if details.CreatedAt != nil {
+		cluster.TimeCreated = utils.DateTimeFormat(*details.CreatedAt)
+	}
+
+	return cluster
+}
+
+// PollEksClusters gathers information on each EKS cluster for an AWS account.
+func PollEksClusters(pollerInput *awsmodels.ResourcePollerInput) ([]*apimodels.AddResourceEntry, error) {
+	zap.L().Debug("starting EKS Cluster resource poller")
+	eksClusterSnapshots := make(map[string]*awsmodels.EksCluster)
+
+	for _, regionID := range utils.GetServiceRegions(pollerInput.Regions, "eks") {
+		eksSvc, err := getEksClient(pollerInput, *regionID)
+		if err != nil {
+			return nil, err // error is logged in getClient()
+		}
+
+		clusters, err := listEksClusters(eksSvc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "PollEksClusters(%#v) in region %s", *pollerInput, *regionID)
+		}
+
+		for _, clusterName := range clusters {
+			eksCluster := buildEksClusterSnapshot(eksSvc, clusterName)
+			if eksCluster == nil {
+				continue
+			}
+			eksCluster.AccountID = aws.String(pollerInput.AuthSourceParsedARN.AccountID)
+			eksCluster.Region = regionID
+
+			if _, ok := eksClusterSnapshots[*eksCluster.ARN]; !ok {
+				eksClusterSnapshots[*eksCluster.ARN] = eksCluster
+			} else {
+				zap.L().Info(
+					"overwriting existing EKS Cluster snapshot",
+					zap.String("resourceID", *eksCluster.ARN),
+				)
+				eksClusterSnapshots[*eksCluster.ARN] = eksCluster
+			}
+		}
+	}
+
+	resources := make([]*apimodels.AddResourceEntry, 0, len(eksClusterSnapshots))
+	for resourceID, eksCluster := range eksClusterSnapshots {
+		resources = append(resources, &apimodels.AddResourceEntry{
+			Attributes:      eksCluster,
+			ID:              apimodels.ResourceID(resourceID),
+			IntegrationID:   apimodels.IntegrationID(*pollerInput.IntegrationID),
+			IntegrationType: apimodels.IntegrationTypeAws,
+			Type:            awsmodels.EksClusterSchema,
+		})
+	}
+
+	return resources, nil
+}
@@ -0,0 +1,136 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	awsmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/aws/awstest"
+)
+
+func TestEksListClusters(t *testing.T) {
+	mockSvc := awstest.BuildMockEksSvc([]string{"ListClustersPages"})
+
+	out, err := listEksClusters(mockSvc)
+	require.NoError(t, err)
+	assert.NotEmpty(t, out)
+}
+
+func TestEksListClustersError(t *testing.T) {
+	mockSvc := awstest.BuildMockEksSvcError([]string{"ListClustersPages"})
+
+	out, err := listEksClusters(mockSvc)
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestEksDescribeCluster(t *testing.T) {
+	mockSvc := awstest.BuildMockEksSvc([]string{"DescribeCluster"})
+
+	out := describeEksCluster(mockSvc, awstest.ExampleEksClusterName)
+	require.NotNil(t, out)
+	assert.Equal(t, "example-cluster", *out.Name)
+}
+
+func TestEksDescribeClusterError(t *testing.T) {
+	mockSvc := awstest.BuildMockEksSvcError([]string{"DescribeCluster"})
+
+	out := describeEksCluster(mockSvc, awstest.ExampleEksClusterName)
+	assert.Nil(t, out)
+}
+
+func TestBuildEksClusterSnapshot(t *testing.T) {
+	mockSvc := awstest.BuildMockEksSvcAll()
+
+	cluster := buildEksClusterSnapshot(mockSvc, awstest.ExampleEksClusterName)
+	require.NotNil(t, cluster)
+	assert.Equal(t, aws.String("arn:aws:eks:us-west-2:123456789012:cluster/example-cluster"), cluster.ARN)
+	assert.Equal(t, aws.String("Value1"), cluster.Tags["KeyName1"])
+	assert.True(t, *cluster.ResourcesVpcConfig.EndpointPublicAccess)
+	assert.NotNil(t, cluster.TimeCreated)
+}
+
+func TestBuildEksClusterSnapshotError(t *testing.T) {
+	mockSvc := awstest.BuildMockEksSvcAllError()
+
+	cluster := buildEksClusterSnapshot(mockSvc, awstest.ExampleEksClusterName)
+	assert.Nil(t, cluster)
+}
+
+func TestPollEKSCluster(t *testing.T) {
+	awstest.MockEksForSetup = awstest.BuildMockEksSvcAll()
+	EksClientFunc = awstest.SetupMockEks
+
+	resourceARN, err := arn.Parse("arn:aws:eks:us-west-2:123456789012:cluster/example-cluster")
+	require.NoError(t, err)
+	resource, err := PollEKSCluster(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	}, resourceARN, nil)
+
+	require.NoError(t, err)
+	cluster := resource.(*awsmodels.EksCluster)
+	assert.Equal(t, aws.String("us-west-2"), cluster.Region)
+	assert.Equal(t, aws.String("123456789012"), cluster.AccountID)
+}
+
+func TestEksClusterPoller(t *testing.T) {
+	awstest.MockEksForSetup = awstest.BuildMockEksSvcAll()
+	EksClientFunc = awstest.SetupMockEks
+
+	resources, err := PollEksClusters(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	cluster := resources[0].Attributes.(*awsmodels.EksCluster)
+	assert.Equal(t, aws.String("example-cluster"), cluster.Name)
+	assert.Equal(t, aws.String("1.16"), cluster.Version)
+	assert.Equal(t, awsmodels.EksClusterSchema, string(resources[0].Type))
+}
+
+func TestEksClusterPollerError(t *testing.T) {
+	awstest.MockEksForSetup = awstest.BuildMockEksSvcAllError()
+	EksClientFunc = awstest.SetupMockEks
+
+	resources, err := PollEksClusters(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	})
+
+	require.Error(t, err)
+	assert.Empty(t, resources)
+}
@@ -49,31 +49,37 @@ var (
 	// functions for resources whose ID is their ARN.
 	IndividualARNResourcePollers = map[string]func(
 		input *awsmodels.ResourcePollerInput, arn arn.ARN, entry *pollermodels.ScanEntry) (interface{}, error){
-		awsmodels.AcmCertificateSchema:      PollACMCertificate,
-		awsmodels.CloudFormationStackSchema: PollCloudFormationStack,
-		awsmodels.CloudTrailSchema:          PollCloudTrailTrail,
-		awsmodels.CloudWatchLogGroupSchema:  PollCloudWatchLogsLogGroup,
-		awsmodels.DynamoDBTableSchema:       PollDynamoDBTable,
-		awsmodels.Ec2AmiSchema:              PollEC2Image,
-		awsmodels.Ec2InstanceSchema:         PollEC2Instance,
-		awsmodels.Ec2NetworkAclSchema:       PollEC2NetworkACL,
-		awsmodels.Ec2SecurityGroupSchema:    PollEC2SecurityGroup,
-		awsmodels.Ec2VolumeSchema:           PollEC2Volume,
-		awsmodels.Ec2VpcSchema:              PollEC2VPC,
-		awsmodels.EcsClusterSchema:          PollECSCluster,
-		awsmodels.Elbv2LoadBalancerSchema:   PollELBV2LoadBalancer,
-		awsmodels.IAMGroupSchema:            PollIAMGroup,
-		awsmodels.IAMPolicySchema:           PollIAMPolicy,
-		awsmodels.IAMRoleSchema:             PollIAMRole,
-		awsmodels.IAMUserSchema:             PollIAMUser,
-		awsmodels.IAMRootUserSchema:         PollIAMRootUser,
-		awsmodels.KmsKeySchema:              PollKMSKey,
-		awsmodels.LambdaFunctionSchema:      PollLambdaFunction,
-		awsmodels.RDSInstanceSchema:         PollRDSInstance,
-		awsmodels.RedshiftClusterSchema:     PollRedshiftCluster,
-		awsmodels.S3BucketSchema:            PollS3Bucket,
-		awsmodels.WafWebAclSchema:           PollWAFWebACL,
-		awsmodels.WafRegionalWebAclSchema:   PollWAFRegionalWebACL,
+		awsmodels.AcmCertificateSchema:         PollACMCertificate,
+		awsmodels.CloudFormationStackSchema:    PollCloudFormationStack,
+		awsmodels.CloudFrontDistributionSchema: PollCloudFrontDistribution,
+		awsmodels.CloudTrailSchema:             PollCloudTrailTrail,
+		awsmodels.CloudWatchLogGroupSchema:     PollCloudWatchLogsLogGroup,
+		awsmodels.DynamoDBTableSchema:          PollDynamoDBTable,
+		awsmodels.Ec2AmiSchema:                 PollEC2Image,
+		awsmodels.Ec2InstanceSchema:            PollEC2Instance,
+		awsmodels.Ec2NetworkAclSchema:          PollEC2NetworkACL,
+		awsmodels.Ec2SecurityGroupSchema:       PollEC2SecurityGroup,
+		awsmodels.Ec2VolumeSchema:              PollEC2Volume,
+		awsmodels.Ec2VpcSchema:                 PollEC2VPC,
+		awsmodels.EcrRepositorySchema:          PollECRRepository,
+		awsmodels.EcsClusterSchema:             PollECSCluster,
+		awsmodels.EksClusterSchema:             PollEKSCluster,
+		awsmodels.Elbv2LoadBalancerSchema:      PollELBV2LoadBalancer,
+		awsmodels.IAMGroupSchema:               PollIAMGroup,
+		awsmodels.IAMPolicySchema:              PollIAMPolicy,
+		awsmodels.IAMRoleSchema:                PollIAMRole,
+		awsmodels.IAMUserSchema:                PollIAMUser,
+		awsmodels.IAMRootUserSchema:            PollIAMRootUser,
+		awsmodels.KmsKeySchema:                 PollKMSKey,
+		awsmodels.LambdaFunctionSchema:         PollLambdaFunction,
+		awsmodels.RDSInstanceSchema:            PollRDSInstance,
+		awsmodels.RedshiftClusterSchema:        PollRedshiftCluster,
+		awsmodels.S3BucketSchema:               PollS3Bucket,
+		awsmodels.SecretsManagerSecretSchema:   PollSecretsManagerSecret,
+		awsmodels.SnsTopicSchema:               PollSNSTopic,
+		awsmodels.SqsQueueSchema:               PollSQSQueue,
+		awsmodels.WafWebAclSchema:              PollWAFWebACL,
+		awsmodels.WafRegionalWebAclSchema:      PollWAFRegionalWebACL,
 	}
 
 	// IndividualResourcePollers maps resource types to their corresponding individual polling
@@ -87,34 +93,40 @@ var (
 
 	// ServicePollers maps a resource type to its Poll function
 	ServicePollers = map[string]resourcePoller{
-		awsmodels.AcmCertificateSchema:      {"ACMCertificate", PollAcmCertificates},
-		awsmodels.CloudTrailSchema:          {"CloudTrail", PollCloudTrails},
-		awsmodels.Ec2AmiSchema:              {"EC2AMI", PollEc2Amis},
-		awsmodels.Ec2InstanceSchema:         {"EC2Instance", PollEc2Instances},
-		awsmodels.Ec2NetworkAclSchema:       {"EC2NetworkACL", PollEc2NetworkAcls},
-		awsmodels.Ec2SecurityGroupSchema:    {"EC2SecurityGroup", PollEc2SecurityGroups},
-		awsmodels.Ec2VolumeSchema:           {"EC2Volume", PollEc2Volumes},
-		awsmodels.Ec2VpcSchema:              {"EC2VPC", PollEc2Vpcs},
-		awsmodels.EcsClusterSchema:          {"ECSCluster", PollEcsClusters},
-		awsmodels.Elbv2LoadBalancerSchema:   {"ELBV2LoadBalancer", PollElbv2ApplicationLoadBalancers},
-		awsmodels.KmsKeySchema:              {"KMSKey", PollKmsKeys},
-		awsmodels.S3BucketSchema:            {"S3Bucket", PollS3Buckets},
-		awsmodels.WafWebAclSchema:           {"WAFWebAcl", PollWafWebAcls},
-		awsmodels.WafRegionalWebAclSchema:   {"WAFRegionalWebAcl", PollWafRegionalWebAcls},
-		awsmodels.CloudFormationStackSchema: {"CloudFormationStack", PollCloudFormationStacks},
-		awsmodels.CloudWatchLogGroupSchema:  {"CloudWatchLogGroup", PollCloudWatchLogsLogGroups},
-		awsmodels.ConfigServiceSchema:       {"ConfigService", PollConfigServices},
-		awsmodels.DynamoDBTableSchema:       {"DynamoDBTable", PollDynamoDBTables},
-		awsmodels.GuardDutySchema:           {"GuardDutyDetector", PollGuardDutyDetectors},
-		awsmodels.IAMUserSchema:             {"IAMUser", PollIAMUsers},
+		awsmodels.AcmCertificateSchema:         {"ACMCertificate", PollAcmCertificates},
+		awsmodels.CloudTrailSchema:             {"CloudTrail", PollCloudTrails},
+		awsmodels.Ec2AmiSchema:                 {"EC2AMI", PollEc2Amis},
+		awsmodels.Ec2InstanceSchema:            {"EC2Instance", PollEc2Instances},
+		awsmodels.Ec2NetworkAclSchema:          {"EC2NetworkACL", PollEc2NetworkAcls},
+		awsmodels.Ec2SecurityGroupSchema:       {"EC2SecurityGroup", PollEc2SecurityGroups},
+		awsmodels.Ec2VolumeSchema:              {"EC2Volume", PollEc2Volumes},
+		awsmodels.Ec2VpcSchema:                 {"EC2VPC", PollEc2Vpcs},
+		awsmodels.EcsClusterSchema:             {"ECSCluster", PollEcsClusters},
+		awsmodels.Elbv2LoadBalancerSchema:      {"ELBV2LoadBalancer", PollElbv2ApplicationLoadBalancers},
+		awsmodels.KmsKeySchema:                 {"KMSKey", PollKmsKeys},
+		awsmodels.S3BucketSchema:               {"S3Bucket", PollS3Buckets},
+		awsmodels.WafWebAclSchema:              {"WAFWebAcl", PollWafWebAcls},
+		awsmodels.WafRegionalWebAclSchema:      {"WAFRegionalWebAcl", PollWafRegionalWebAcls},
+		awsmodels.CloudFormationStackSchema:    {"CloudFormationStack", PollCloudFormationStacks},
+		awsmodels.CloudFrontDistributionSchema: {"CloudFrontDistribution", PollCloudFrontDistributions},
+		awsmodels.CloudWatchLogGroupSchema:     {"CloudWatchLogGroup", PollCloudWatchLogsLogGroups},
+		awsmodels.ConfigServiceSchema:          {"ConfigService", PollConfigServices},
+		awsmodels.DynamoDBTableSchema:          {"DynamoDBTable", PollDynamoDBTables},
+		awsmodels.EcrRepositorySchema:          {"ECRRepository", PollEcrRepositories},
+		awsmodels.EksClusterSchema:             {"EKSCluster", PollEksClusters},
+		awsmodels.GuardDutySchema:              {"GuardDutyDetector", PollGuardDutyDetectors},
+		awsmodels.IAMUserSchema:                {"IAMUser", PollIAMUsers},
 		// Service scan for the resource type IAMRootUserSchema is not defined! Do not do it!
-		awsmodels.IAMRoleSchema:         {"IAMRoles", PollIAMRoles},
-		awsmodels.IAMGroupSchema:        {"IAMGroups", PollIamGroups},
-		awsmodels.IAMPolicySchema:       {"IAMPolicies", PollIamPolicies},
-		awsmodels.LambdaFunctionSchema:  {"LambdaFunctions", PollLambdaFunctions},
-		awsmodels.PasswordPolicySchema:  {"PasswordPolicy", PollPasswordPolicy},
-		awsmodels.RDSInstanceSchema:     {"RDSInstance", PollRDSInstances},
-		awsmodels.RedshiftClusterSchema: {"RedshiftCluster", PollRedshiftClusters},
+		awsmodels.IAMRoleSchema:              {"IAMRoles", PollIAMRoles},
+		awsmodels.IAMGroupSchema:             {"IAMGroups", PollIamGroups},
+		awsmodels.IAMPolicySchema:            {"IAMPolicies", PollIamPolicies},
+		awsmodels.LambdaFunctionSchema:       {"LambdaFunctions", PollLambdaFunctions},
+		awsmodels.PasswordPolicySchema:       {"PasswordPolicy", PollPasswordPolicy},
+		awsmodels.RDSInstanceSchema:          {"RDSInstance", PollRDSInstances},
+		awsmodels.RedshiftClusterSchema:      {"RedshiftCluster", PollRedshiftClusters},
+		awsmodels.SecretsManagerSecretSchema: {"SecretsManagerSecret", PollSecretsManagerSecrets},
+		awsmodels.SnsTopicSchema:             {"SNSTopic", PollSnsTopics},
+		awsmodels.SqsQueueSchema:             {"SQSQueue", PollSqsQueues},
 	}
 )
 
@@ -0,0 +1,210 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	apimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+	awsmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+	pollermodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/poller"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/utils"
+)
+
+// Set as variables to be overridden in testing
+var SecretsManagerClientFunc = setupSecretsManagerClient
+
+func setupSecretsManagerClient(sess *session.Session, cfg *aws.Config) interface{} {
+	return secretsmanager.New(sess, cfg)
+}
+
+func getSecretsManagerClient(pollerResourceInput *awsmodels.ResourcePollerInput,
+	region string) (secretsmanageriface.SecretsManagerAPI, error) {
+
+	client, err := getClient(pollerResourceInput, SecretsManagerClientFunc, "secretsmanager", region)
+	if err != nil {
+		return nil, err // error is logged in getClient()
+	}
+
+	return client.(secretsmanageriface.SecretsManagerAPI), nil
+}
+
+// PollSecretsManagerSecret polls a single Secrets Manager secret resource
+func PollSecretsManagerSecret(
+	pollerInput *awsmodels.ResourcePollerInput,
+	resourceARN arn.ARN,
+	_ *pollermodels.ScanEntry,
+) (interface{}, error) {
+
+	client, err := getSecretsManagerClient(pollerInput, resourceARN.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := buildSecretsManagerSecretSnapshot(client, aws.String(resourceARN.String()))
+	if snapshot == nil {
+		return nil, nil
+	}
+	snapshot.Region = aws.String(resourceARN.Region)
+	snapshot.AccountID = aws.String(resourceARN.AccountID)
+
+	return snapshot, nil
+}
+
+// listSecrets returns the ARNs of all Secrets Manager secrets in the account
+func listSecrets(secretsSvc secretsmanageriface.SecretsManagerAPI) (secretARNs []*string, err error) {
+	err = secretsSvc.ListSecretsPages(&secretsmanager.ListSecretsInput{},
+		func(page *secretsmanager.ListSecretsOutput, lastPage bool) bool {
+			for _, secret := range page.SecretList {
+				secretARNs = append(secretARNs, secret.ARN)
+			}
+			return true
+		})
+	if err != nil {
+		return nil, errors.Wrap(err, "SecretsManager.ListSecretsPages")
+	}
+	return
+}
+
+// describeSecret provides the metadata of a given secret. The secret value itself is never retrieved.
+func describeSecret(
+	secretsSvc secretsmanageriface.SecretsManagerAPI, secretID *string) *secretsmanager.DescribeSecretOutput {
+
+	out, err := secretsSvc.DescribeSecret(&secretsmanager.DescribeSecretInput{SecretId: secretID})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+			zap.L().Warn("tried to scan non-existent resource",
+				zap.String("resource", *secretID),
+				zap.String("resourceType", awsmodels.SecretsManagerSecretSchema))
+			return nil
+		}
+		utils.LogAWSError("SecretsManager.DescribeSecret", err)
+		return nil
+	}
+
+	return out
+}
+
+// getSecretResourcePolicy returns the resource policy attached to a given secret, if any
+func getSecretResourcePolicy(secretsSvc secretsmanageriface.SecretsManagerAPI, secretID *string) (*string, error) {
+	out, err := secretsSvc.GetResourcePolicy(&secretsmanager.GetResourcePolicyInput{SecretId: secretID})
+	if err != nil {
+		utils.LogAWSError("SecretsManager.GetResourcePolicy", err)
+		return nil, err
+	}
+
+	return out.ResourcePolicy, nil
+}
+
+// buildSecretsManagerSecretSnapshot returns a complete snapshot of a Secrets Manager secret
+func buildSecretsManagerSecretSnapshot(
+	secretsSvc secretsmanageriface.SecretsManagerAPI, secretID *string) *awsmodels.SecretsManagerSecret {
+
+	details := describeSecret(secretsSvc, secretID)
+	if details == nil {
+		return nil
+	}
+
+	secret := &awsmodels.SecretsManagerSecret{
+		GenericResource: awsmodels.GenericResource{
+			ResourceID:   details.ARN,
+			ResourceType: aws.String(awsmodels.SecretsManagerSecretSchema),
+		},
+		GenericAWSResource: awsmodels.GenericAWSResource{
+			ARN:  details.ARN,
+			Name: details.Name,
+			Tags: utils.ParseTagSlice(details.Tags),
+		},
+		DeletedDate:        details.DeletedDate,
+		Description:        details.Description,
+		KmsKeyId:           details.KmsKeyId,
+		LastAccessedDate:   details.LastAccessedDate,
+		LastChangedDate:    details.LastChangedDate,
+		LastRotatedDate:    details.LastRotatedDate,
+		OwningService:      details.OwningService,
+		RotationEnabled:    details.RotationEnabled,
+		RotationLambdaARN:  details.RotationLambdaARN,
+		RotationRules:      details.RotationRules,
+		VersionIdsToStages: details.VersionIdsToStages,
+	}
+
+	policy, err := getSecretResourcePolicy(secretsSvc, details.ARN)
+	if err == nil {
+		secret.ResourcePolicy = policy
+	}
+
+	return secret
+}
+
+// PollSecretsManagerSecrets gathers information on each Secrets Manager secret for an AWS account.
+func PollSecretsManagerSecrets(pollerInput *awsmodels.ResourcePollerInput) ([]*apimodels.AddResourceEntry, error) {
+	zap.L().Debug("starting Secrets Manager Secret resource poller")
+	secretSnapshots := make(map[string]*awsmodels.SecretsManagerSecret)
+
+	for _, regionID := range utils.GetServiceRegions(pollerInput.Regions, "secretsmanager") {
+		secretsSvc, err := getSecretsManagerClient(pollerInput, *regionID)
+		if err != nil {
+			return nil, err // error is logged in getClient()
+		}
+
+		secretARNs, err := listSecrets(secretsSvc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "PollSecretsManagerSecrets(%#v) in region %s", *pollerInput, *regionID)
+		}
+
+		for _, secretARN := range secretARNs {
+			secret := buildSecretsManagerSecretSnapshot(secretsSvc, secretARN)
+			if secret == nil {
+				continue
+			}
+			secret.AccountID = aws.String(pollerInput.AuthSourceParsedARN.AccountID)
+			secret.Region = regionID
+
+			if _, ok := secretSnapshots[*secret.ARN]; !ok {
+				secretSnapshots[*secret.ARN] = secret
+			} else {
+				zap.L().Info(
+					"overwriting existing Secrets Manager Secret snapshot",
+					zap.String("resourceID", *secret.ARN),
+				)
+				secretSnapshots[*secret.ARN] = secret
+			}
+		}
+	}
+
+	resources := make([]*apimodels.AddResourceEntry, 0, len(secretSnapshots))
+	for resourceID, secret := range secretSnapshots {
+		resources = append(resources, &apimodels.AddResourceEntry{
+			Attributes:      secret,
+			ID:              apimodels.ResourceID(resourceID),
+			IntegrationID:   apimodels.IntegrationID(*pollerInput.IntegrationID),
+			IntegrationType: apimodels.IntegrationTypeAws,
+			Type:            awsmodels.SecretsManagerSecretSchema,
+		})
+	}
+
+	return resources, nil
+}
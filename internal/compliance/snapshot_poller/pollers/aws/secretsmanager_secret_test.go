@@ -0,0 +1,152 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	awsmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/aws/awstest"
+)
+
+func TestSecretsManagerListSecrets(t *testing.T) {
+	mockSvc := awstest.BuildMockSecretsManagerSvc([]string{"ListSecretsPages"})
+
+	out, err := listSecrets(mockSvc)
+	require.NoError(t, err)
+	assert.Equal(t, []*string{awstest.ExampleSecretARN}, out)
+}
+
+func TestSecretsManagerListSecretsError(t *testing.T) {
+	mockSvc := awstest.BuildMockSecretsManagerSvcError([]string{"ListSecretsPages"})
+
+	out, err := listSecrets(mockSvc)
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestSecretsManagerDescribeSecret(t *testing.T) {
+	mockSvc := awstest.BuildMockSecretsManagerSvc([]string{"DescribeSecret"})
+
+	out := describeSecret(mockSvc, awstest.ExampleSecretARN)
+	require.NotNil(t, out)
+	assert.Equal(t, "example-secret", *out.Name)
+}
+
+func TestSecretsManagerDescribeSecretError(t *testing.T) {
+	mockSvc := awstest.BuildMockSecretsManagerSvcError([]string{"DescribeSecret"})
+
+	out := describeSecret(mockSvc, awstest.ExampleSecretARN)
+	assert.Nil(t, out)
+}
+
+func TestSecretsManagerGetResourcePolicy(t *testing.T) {
+	mockSvc := awstest.BuildMockSecretsManagerSvc([]string{"GetResourcePolicy"})
+
+	out, err := getSecretResourcePolicy(mockSvc, awstest.ExampleSecretARN)
+	require.NoError(t, err)
+	assert.NotEmpty(t, out)
+}
+
+func TestSecretsManagerGetResourcePolicyError(t *testing.T) {
+	mockSvc := awstest.BuildMockSecretsManagerSvcError([]string{"GetResourcePolicy"})
+
+	out, err := getSecretResourcePolicy(mockSvc, awstest.ExampleSecretARN)
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestBuildSecretsManagerSecretSnapshot(t *testing.T) {
+	mockSvc := awstest.BuildMockSecretsManagerSvcAll()
+
+	secret := buildSecretsManagerSecretSnapshot(mockSvc, awstest.ExampleSecretARN)
+	require.NotNil(t, secret)
+	assert.Equal(t, awstest.ExampleSecretARN, secret.ARN)
+	assert.True(t, *secret.RotationEnabled)
+	assert.Equal(t, aws.Int64(30), secret.RotationRules.AutomaticallyAfterDays)
+	assert.NotNil(t, secret.ResourcePolicy)
+	assert.Equal(t, aws.String("Value1"), secret.Tags["KeyName1"])
+}
+
+func TestBuildSecretsManagerSecretSnapshotError(t *testing.T) {
+	mockSvc := awstest.BuildMockSecretsManagerSvcAllError()
+
+	secret := buildSecretsManagerSecretSnapshot(mockSvc, awstest.ExampleSecretARN)
+	assert.Nil(t, secret)
+}
+
+func TestPollSecretsManagerSecret(t *testing.T) {
+	awstest.MockSecretsManagerForSetup = awstest.BuildMockSecretsManagerSvcAll()
+	SecretsManagerClientFunc = awstest.SetupMockSecretsManager
+
+	resourceARN, err := arn.Parse(*awstest.ExampleSecretARN)
+	require.NoError(t, err)
+	resource, err := PollSecretsManagerSecret(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	}, resourceARN, nil)
+
+	require.NoError(t, err)
+	secret := resource.(*awsmodels.SecretsManagerSecret)
+	assert.Equal(t, aws.String("us-west-2"), secret.Region)
+	assert.Equal(t, aws.String("123456789012"), secret.AccountID)
+}
+
+func TestSecretsManagerSecretPoller(t *testing.T) {
+	awstest.MockSecretsManagerForSetup = awstest.BuildMockSecretsManagerSvcAll()
+	SecretsManagerClientFunc = awstest.SetupMockSecretsManager
+
+	resources, err := PollSecretsManagerSecrets(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	secret := resources[0].Attributes.(*awsmodels.SecretsManagerSecret)
+	assert.Equal(t, aws.String("example-secret"), secret.Name)
+	assert.Equal(t, awsmodels.SecretsManagerSecretSchema, string(resources[0].Type))
+}
+
+func TestSecretsManagerSecretPollerError(t *testing.T) {
+	awstest.MockSecretsManagerForSetup = awstest.BuildMockSecretsManagerSvcAllError()
+	SecretsManagerClientFunc = awstest.SetupMockSecretsManager
+
+	resources, err := PollSecretsManagerSecrets(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	})
+
+	require.Error(t, err)
+	assert.Empty(t, resources)
+}
@@ -0,0 +1,222 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	apimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+	awsmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+	pollermodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/poller"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/utils"
+)
+
+// Set as variables to be overridden in testing
+var SnsClientFunc = setupSnsClient
+
+func setupSnsClient(sess *session.Session, cfg *aws.Config) interface{} {
+	return sns.New(sess, cfg)
+}
+
+func getSnsClient(pollerResourceInput *awsmodels.ResourcePollerInput, region string) (snsiface.SNSAPI, error) {
+	client, err := getClient(pollerResourceInput, SnsClientFunc, "sns", region)
+	if err != nil {
+		return nil, err // error is logged in getClient()
+	}
+
+	return client.(snsiface.SNSAPI), nil
+}
+
+// PollSNSTopic polls a single SNS topic resource
+func PollSNSTopic(
+	pollerInput *awsmodels.ResourcePollerInput,
+	resourceARN arn.ARN,
+	_ *pollermodels.ScanEntry,
+) (interface{}, error) {
+
+	client, err := getSnsClient(pollerInput, resourceARN.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := buildSnsTopicSnapshot(client, aws.String(resourceARN.String()))
+	if snapshot == nil {
+		return nil, nil
+	}
+	snapshot.Region = aws.String(resourceARN.Region)
+	snapshot.AccountID = aws.String(resourceARN.AccountID)
+
+	return snapshot, nil
+}
+
+// listTopics returns the ARNs of all SNS topics in the account
+func listTopics(snsSvc snsiface.SNSAPI) (topicARNs []*string, err error) {
+	err = snsSvc.ListTopicsPages(&sns.ListTopicsInput{},
+		func(page *sns.ListTopicsOutput, lastPage bool) bool {
+			for _, topic := range page.Topics {
+				topicARNs = append(topicARNs, topic.TopicArn)
+			}
+			return true
+		})
+	if err != nil {
+		return nil, errors.Wrap(err, "SNS.ListTopicsPages")
+	}
+	return
+}
+
+// getTopicAttributes returns all attributes of a given SNS topic
+func getTopicAttributes(snsSvc snsiface.SNSAPI, topicARN *string) map[string]*string {
+	out, err := snsSvc.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicArn: topicARN})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == sns.ErrCodeNotFoundException {
+			zap.L().Warn("tried to scan non-existent resource",
+				zap.String("resource", *topicARN),
+				zap.String("resourceType", awsmodels.SnsTopicSchema))
+			return nil
+		}
+		utils.LogAWSError("SNS.GetTopicAttributes", err)
+		return nil
+	}
+
+	return out.Attributes
+}
+
+// listSubscriptionsByTopic returns the subscriptions to a given SNS topic
+func listSubscriptionsByTopic(snsSvc snsiface.SNSAPI, topicARN *string) (subscriptions []*sns.Subscription, err error) {
+	err = snsSvc.ListSubscriptionsByTopicPages(&sns.ListSubscriptionsByTopicInput{TopicArn: topicARN},
+		func(page *sns.ListSubscriptionsByTopicOutput, lastPage bool) bool {
+			subscriptions = append(subscriptions, page.Subscriptions...)
+			return true
+		})
+	if err != nil {
+		utils.LogAWSError("SNS.ListSubscriptionsByTopicPages", err)
+		return nil, err
+	}
+	return
+}
+
+// listTagsForResourceSns returns the tags for a given SNS topic
+func listTagsForResourceSns(snsSvc snsiface.SNSAPI, topicARN *string) ([]*sns.Tag, error) {
+	out, err := snsSvc.ListTagsForResource(&sns.ListTagsForResourceInput{ResourceArn: topicARN})
+	if err != nil {
+		utils.LogAWSError("SNS.ListTagsForResource", err)
+		return nil, err
+	}
+
+	return out.Tags, nil
+}
+
+// buildSnsTopicSnapshot returns a complete snapshot of an SNS topic
+func buildSnsTopicSnapshot(snsSvc snsiface.SNSAPI, topicARN *string) *awsmodels.SnsTopic {
+	attributes := getTopicAttributes(snsSvc, topicARN)
+	if attributes == nil {
+		return nil
+	}
+
+	topic := &awsmodels.SnsTopic{
+		GenericResource: awsmodels.GenericResource{
+			ResourceID:   topicARN,
+			ResourceType: aws.String(awsmodels.SnsTopicSchema),
+		},
+		GenericAWSResource: awsmodels.GenericAWSResource{
+			ARN: topicARN,
+		},
+		DeliveryPolicy:          attributes["DeliveryPolicy"],
+		DisplayName:             attributes["DisplayName"],
+		EffectiveDeliveryPolicy: attributes["EffectiveDeliveryPolicy"],
+		KmsMasterKeyId:          attributes["KmsMasterKeyId"],
+		Owner:                   attributes["Owner"],
+		Policy:                  attributes["Policy"],
+		SubscriptionsConfirmed:  utils.Int64Attribute(attributes, "SubscriptionsConfirmed"),
+		SubscriptionsDeleted:    utils.Int64Attribute(attributes, "SubscriptionsDeleted"),
+		SubscriptionsPending:    utils.Int64Attribute(attributes, "SubscriptionsPending"),
+	}
+	if parsedARN, err := arn.Parse(*topicARN); err == nil {
+		topic.Name = aws.String(parsedARN.Resource)
+	}
+
+	subscriptions, err := listSubscriptionsByTopic(snsSvc, topicARN)
+	if err == nil {
+		topic.Subscriptions = subscriptions
+	}
+
+	tags, err := listTagsForResourceSns(snsSvc, topicARN)
+	if err == nil {
+		topic.Tags = utils.ParseTagSlice(tags)
+	}
+
+	return topic
+}
+
+// PollSnsTopics gathers information on each SNS topic for an AWS account.
+func PollSnsTopics(pollerInput *awsmodels.ResourcePollerInput) ([]*apimodels.AddResourceEntry, error) {
+	zap.L().Debug("starting SNS Topic resource poller")
+	snsTopicSnapshots := make(map[string]*awsmodels.SnsTopic)
+
+	for _, regionID := range utils.GetServiceRegions(pollerInput.Regions, "sns") {
+		snsSvc, err := getSnsClient(pollerInput, *regionID)
+		if err != nil {
+			return nil, err // error is logged in getClient()
+		}
+
+		topicARNs, err := listTopics(snsSvc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "PollSnsTopics(%#v) in region %s", *pollerInput, *regionID)
+		}
+
+		for _, topicARN := range topicARNs {
+			snsTopic := buildSnsTopicSnapshot(snsSvc, topicARN)
+			if snsTopic == nil {
+				continue
+			}
+			snsTopic.AccountID = aws.String(pollerInput.AuthSourceParsedARN.AccountID)
+			snsTopic.Region = regionID
+
+			if _, ok := snsTopicSnapshots[*snsTopic.ARN]; !ok {
+				snsTopicSnapshots[*snsTopic.ARN] = snsTopic
+			} else {
+				zap.L().Info(
+					"overwriting existing SNS Topic snapshot",
+					zap.String("resourceID", *snsTopic.ARN),
+				)
+				snsTopicSnapshots[*snsTopic.ARN] = snsTopic
+			}
+		}
+	}
+
+	resources := make([]*apimodels.AddResourceEntry, 0, len(snsTopicSnapshots))
+	for resourceID, snsTopic := range snsTopicSnapshots {
+		resources = append(resources, &apimodels.AddResourceEntry{
+			Attributes:      snsTopic,
+			ID:              apimodels.ResourceID(resourceID),
+			IntegrationID:   apimodels.IntegrationID(*pollerInput.IntegrationID),
+			IntegrationType: apimodels.IntegrationTypeAws,
+			Type:            awsmodels.SnsTopicSchema,
+		})
+	}
+
+	return resources, nil
+}
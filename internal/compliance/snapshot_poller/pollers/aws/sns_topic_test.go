@@ -0,0 +1,167 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	awsmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/aws/awstest"
+)
+
+func TestSnsListTopics(t *testing.T) {
+	mockSvc := awstest.BuildMockSnsSvc([]string{"ListTopicsPages"})
+
+	out, err := listTopics(mockSvc)
+	require.NoError(t, err)
+	assert.Equal(t, []*string{awstest.ExampleSnsTopicARN}, out)
+}
+
+func TestSnsListTopicsError(t *testing.T) {
+	mockSvc := awstest.BuildMockSnsSvcError([]string{"ListTopicsPages"})
+
+	out, err := listTopics(mockSvc)
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestSnsGetTopicAttributes(t *testing.T) {
+	mockSvc := awstest.BuildMockSnsSvc([]string{"GetTopicAttributes"})
+
+	out := getTopicAttributes(mockSvc, awstest.ExampleSnsTopicARN)
+	assert.NotEmpty(t, out)
+}
+
+func TestSnsGetTopicAttributesError(t *testing.T) {
+	mockSvc := awstest.BuildMockSnsSvcError([]string{"GetTopicAttributes"})
+
+	out := getTopicAttributes(mockSvc, awstest.ExampleSnsTopicARN)
+	assert.Nil(t, out)
+}
+
+func TestSnsListSubscriptionsByTopic(t *testing.T) {
+	mockSvc := awstest.BuildMockSnsSvc([]string{"ListSubscriptionsByTopicPages"})
+
+	out, err := listSubscriptionsByTopic(mockSvc, awstest.ExampleSnsTopicARN)
+	require.NoError(t, err)
+	assert.NotEmpty(t, out)
+}
+
+func TestSnsListSubscriptionsByTopicError(t *testing.T) {
+	mockSvc := awstest.BuildMockSnsSvcError([]string{"ListSubscriptionsByTopicPages"})
+
+	out, err := listSubscriptionsByTopic(mockSvc, awstest.ExampleSnsTopicARN)
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestSnsListTagsForResource(t *testing.T) {
+	mockSvc := awstest.BuildMockSnsSvc([]string{"ListTagsForResource"})
+
+	out, err := listTagsForResourceSns(mockSvc, awstest.ExampleSnsTopicARN)
+	require.NoError(t, err)
+	assert.NotEmpty(t, out)
+}
+
+func TestSnsListTagsForResourceError(t *testing.T) {
+	mockSvc := awstest.BuildMockSnsSvcError([]string{"ListTagsForResource"})
+
+	out, err := listTagsForResourceSns(mockSvc, awstest.ExampleSnsTopicARN)
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestBuildSnsTopicSnapshot(t *testing.T) {
+	mockSvc := awstest.BuildMockSnsSvcAll()
+
+	topic := buildSnsTopicSnapshot(mockSvc, awstest.ExampleSnsTopicARN)
+	require.NotNil(t, topic)
+	assert.Equal(t, aws.String("example-topic"), topic.Name)
+	assert.Equal(t, aws.String("Example Topic"), topic.DisplayName)
+	assert.Equal(t, aws.Int64(1), topic.SubscriptionsConfirmed)
+	assert.Nil(t, topic.KmsMasterKeyId)
+	assert.Len(t, topic.Subscriptions, 1)
+	assert.Equal(t, aws.String("Value1"), topic.Tags["KeyName1"])
+}
+
+func TestBuildSnsTopicSnapshotError(t *testing.T) {
+	mockSvc := awstest.BuildMockSnsSvcAllError()
+
+	topic := buildSnsTopicSnapshot(mockSvc, awstest.ExampleSnsTopicARN)
+	assert.Nil(t, topic)
+}
+
+func TestPollSNSTopic(t *testing.T) {
+	awstest.MockSnsForSetup = awstest.BuildMockSnsSvcAll()
+	SnsClientFunc = awstest.SetupMockSns
+
+	resourceARN, err := arn.Parse(*awstest.ExampleSnsTopicARN)
+	require.NoError(t, err)
+	resource, err := PollSNSTopic(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	}, resourceARN, nil)
+
+	require.NoError(t, err)
+	topic := resource.(*awsmodels.SnsTopic)
+	assert.Equal(t, aws.String("us-west-2"), topic.Region)
+	assert.Equal(t, aws.String("123456789012"), topic.AccountID)
+}
+
+func TestSnsTopicPoller(t *testing.T) {
+	awstest.MockSnsForSetup = awstest.BuildMockSnsSvcAll()
+	SnsClientFunc = awstest.SetupMockSns
+
+	resources, err := PollSnsTopics(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, *awstest.ExampleSnsTopicARN, string(resources[0].ID))
+	assert.Equal(t, awsmodels.SnsTopicSchema, string(resources[0].Type))
+}
+
+func TestSnsTopicPollerError(t *testing.T) {
+	awstest.MockSnsForSetup = awstest.BuildMockSnsSvcAllError()
+	SnsClientFunc = awstest.SetupMockSns
+
+	resources, err := PollSnsTopics(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	})
+
+	require.Error(t, err)
+	assert.Empty(t, resources)
+}
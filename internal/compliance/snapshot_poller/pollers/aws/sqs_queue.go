@@ -0,0 +1,248 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	apimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+	awsmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+	pollermodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/poller"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/utils"
+)
+
+// Set as variables to be overridden in testing
+var SqsClientFunc = setupSqsClient
+
+func setupSqsClient(sess *session.Session, cfg *aws.Config) interface{} {
+	return sqs.New(sess, cfg)
+}
+
+func getSqsClient(pollerResourceInput *awsmodels.ResourcePollerInput, region string) (sqsiface.SQSAPI, error) {
+	client, err := getClient(pollerResourceInput, SqsClientFunc, "sqs", region)
+	if err != nil {
+		return nil, err // error is logged in getClient()
+	}
+
+	return client.(sqsiface.SQSAPI), nil
+}
+
+// PollSQSQueue polls a single SQS queue resource
+func PollSQSQueue(
+	pollerInput *awsmodels.ResourcePollerInput,
+	resourceARN arn.ARN,
+	_ *pollermodels.ScanEntry,
+) (interface{}, error) {
+
+	client, err := getSqsClient(pollerInput, resourceARN.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQS APIs operate on the queue URL rather than the ARN
+	queueURL := getQueueURL(client, aws.String(resourceARN.Resource), aws.String(resourceARN.AccountID))
+	if queueURL == nil {
+		return nil, nil
+	}
+
+	snapshot := buildSqsQueueSnapshot(client, queueURL)
+	if snapshot == nil {
+		return nil, nil
+	}
+	snapshot.Region = aws.String(resourceARN.Region)
+	snapshot.AccountID = aws.String(resourceARN.AccountID)
+
+	return snapshot, nil
+}
+
+// listQueues returns the URLs of all SQS queues in the account
+func listQueues(sqsSvc sqsiface.SQSAPI) (queueURLs []*string, err error) {
+	err = sqsSvc.ListQueuesPages(&sqs.ListQueuesInput{},
+		func(page *sqs.ListQueuesOutput, lastPage bool) bool {
+			queueURLs = append(queueURLs, page.QueueUrls...)
+			return true
+		})
+	if err != nil {
+		return nil, errors.Wrap(err, "SQS.ListQueuesPages")
+	}
+	return
+}
+
+// getQueueURL looks up the URL of a queue from its name and owning account
+func getQueueURL(sqsSvc sqsiface.SQSAPI, name *string, accountID *string) *string {
+	out, err := sqsSvc.GetQueueUrl(&sqs.GetQueueUrlInput{
+		QueueName:              name,
+		QueueOwnerAWSAccountId: accountID,
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == sqs.ErrCodeQueueDoesNotExist {
+			zap.L().Warn("tried to scan non-existent resource",
+				zap.String("resource", *name),
+				zap.String("resourceType", awsmodels.SqsQueueSchema))
+			return nil
+		}
+		utils.LogAWSError("SQS.GetQueueUrl", err)
+		return nil
+	}
+
+	return out.QueueUrl
+}
+
+// getQueueAttributes returns all attributes of a given SQS queue
+func getQueueAttributes(sqsSvc sqsiface.SQSAPI, queueURL *string) map[string]*string {
+	out, err := sqsSvc.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: []*string{aws.String(sqs.QueueAttributeNameAll)},
+		QueueUrl:       queueURL,
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == sqs.ErrCodeQueueDoesNotExist {
+			zap.L().Warn("tried to scan non-existent resource",
+				zap.String("resource", *queueURL),
+				zap.String("resourceType", awsmodels.SqsQueueSchema))
+			return nil
+		}
+		utils.LogAWSError("SQS.GetQueueAttributes", err)
+		return nil
+	}
+
+	return out.Attributes
+}
+
+// listQueueTags returns the tags for a given SQS queue
+func listQueueTags(sqsSvc sqsiface.SQSAPI, queueURL *string) (map[string]*string, error) {
+	out, err := sqsSvc.ListQueueTags(&sqs.ListQueueTagsInput{QueueUrl: queueURL})
+	if err != nil {
+		utils.LogAWSError("SQS.ListQueueTags", err)
+		return nil, err
+	}
+
+	return out.Tags, nil
+}
+
+// buildSqsQueueSnapshot returns a complete snapshot of an SQS queue
+func buildSqsQueueSnapshot(sqsSvc sqsiface.SQSAPI, queueURL *string) *awsmodels.SqsQueue {
+	attributes := getQueueAttributes(sqsSvc, queueURL)
+	if attributes == nil {
+		return nil
+	}
+
+	queueARN := attributes[sqs.QueueAttributeNameQueueArn]
+	if queueARN == nil {
+		zap.L().Warn("queue attributes missing ARN", zap.String("queueUrl", *queueURL))
+		return nil
+	}
+	parsedARN, err := arn.Parse(*queueARN)
+	if err != nil {
+		zap.L().Warn("unable to parse queue ARN", zap.String("queueArn", *queueARN), zap.Error(err))
+		return nil
+	}
+
+	queue := &awsmodels.SqsQueue{
+		GenericResource: awsmodels.GenericResource{
+			ResourceID:   queueARN,
+			ResourceType: aws.String(awsmodels.SqsQueueSchema),
+		},
+		GenericAWSResource: awsmodels.GenericAWSResource{
+			ARN:  queueARN,
+			Name: aws.String(parsedARN.Resource),
+		},
+		ContentBasedDeduplication:     utils.BoolAttribute(attributes, sqs.QueueAttributeNameContentBasedDeduplication),
+		DelaySeconds:                  utils.Int64Attribute(attributes, sqs.QueueAttributeNameDelaySeconds),
+		FifoQueue:                     utils.BoolAttribute(attributes, sqs.QueueAttributeNameFifoQueue),
+		KmsDataKeyReusePeriodSeconds:  utils.Int64Attribute(attributes, sqs.QueueAttributeNameKmsDataKeyReusePeriodSeconds),
+		KmsMasterKeyId:                attributes[sqs.QueueAttributeNameKmsMasterKeyId],
+		MaximumMessageSize:            utils.Int64Attribute(attributes, sqs.QueueAttributeNameMaximumMessageSize),
+		MessageRetentionPeriod:        utils.Int64Attribute(attributes, sqs.QueueAttributeNameMessageRetentionPeriod),
+		Policy:                        attributes[sqs.QueueAttributeNamePolicy],
+		ReceiveMessageWaitTimeSeconds: utils.Int64Attribute(attributes, sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds),
+		RedrivePolicy:                 attributes[sqs.QueueAttributeNameRedrivePolicy],
+		VisibilityTimeout:             utils.Int64Attribute(attributes, sqs.QueueAttributeNameVisibilityTimeout),
+		QueueUrl:                      queueURL,
+	}
+	if created := utils.Int64Attribute(attributes, sqs.QueueAttributeNameCreatedTimestamp); created != nil {
+		queue.TimeCreated = utils.UnixTimeToDateTime(*created)
+	}
+	if modified := utils.Int64Attribute(attributes, sqs.QueueAttributeNameLastModifiedTimestamp); modified != nil {
+		queue.LastModifiedTimestamp = utils.UnixTimeToDateTime(*modified)
+	}
+
+	tags, err := listQueueTags(sqsSvc, queueURL)
+	if err == nil {
+		queue.Tags = tags
+	}
+
+	return queue
+}
+
+// PollSqsQueues gathers information on each SQS queue for an AWS account.
+func PollSqsQueues(pollerInput *awsmodels.ResourcePollerInput) ([]*apimodels.AddResourceEntry, error) {
+	zap.L().Debug("starting SQS Queue resource poller")
+	sqsQueueSnapshots := make(map[string]*awsmodels.SqsQueue)
+
+	for _, regionID := range utils.GetServiceRegions(pollerInput.Regions, "sqs") {
+		sqsSvc, err := getSqsClient(pollerInput, *regionID)
+		if err != nil {
+			return nil, err // error is logged in getClient()
+		}
+
+		queueURLs, err := listQueues(sqsSvc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "PollSqsQueues(%#v) in region %s", *pollerInput, *regionID)
+		}
+
+		for _, queueURL := range queueURLs {
+			sqsQueue := buildSqsQueueSnapshot(sqsSvc, queueURL)
+			if sqsQueue == nil {
+				continue
+			}
+			sqsQueue.AccountID = aws.String(pollerInput.AuthSourceParsedARN.AccountID)
+			sqsQueue.Region = regionID
+
+			if _, ok := sqsQueueSnapshots[*sqsQueue.ARN]; !ok {
+				sqsQueueSnapshots[*sqsQueue.ARN] = sqsQueue
+			} else {
+				zap.L().Info(
+					"overwriting existing SQS Queue snapshot",
+					zap.String("resourceID", *sqsQueue.ARN),
+				)
+				sqsQueueSnapshots[*sqsQueue.ARN] = sqsQueue
+			}
+		}
+	}
+
+	resources := make([]*apimodels.AddResourceEntry, 0, len(sqsQueueSnapshots))
+	for resourceID, sqsQueue := range sqsQueueSnapshots {
+		resources = append(resources, &apimodels.AddResourceEntry{
+			Attributes:      sqsQueue,
+			ID:              apimodels.ResourceID(resourceID),
+			IntegrationID:   apimodels.IntegrationID(*pollerInput.IntegrationID),
+			IntegrationType: apimodels.IntegrationTypeAws,
+			Type:            awsmodels.SqsQueueSchema,
+		})
+	}
+
+	return resources, nil
+}
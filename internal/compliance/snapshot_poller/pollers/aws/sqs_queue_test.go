@@ -0,0 +1,167 @@
+package aws
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	awsmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/aws"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/aws/awstest"
+)
+
+func TestSqsListQueues(t *testing.T) {
+	mockSvc := awstest.BuildMockSqsSvc([]string{"ListQueuesPages"})
+
+	out, err := listQueues(mockSvc)
+	require.NoError(t, err)
+	assert.NotEmpty(t, out)
+}
+
+func TestSqsListQueuesError(t *testing.T) {
+	mockSvc := awstest.BuildMockSqsSvcError([]string{"ListQueuesPages"})
+
+	out, err := listQueues(mockSvc)
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestSqsGetQueueURL(t *testing.T) {
+	mockSvc := awstest.BuildMockSqsSvc([]string{"GetQueueUrl"})
+
+	out := getQueueURL(mockSvc, aws.String("example-queue"), awstest.ExampleAccountId)
+	assert.Equal(t, awstest.ExampleSqsQueueURL, out)
+}
+
+func TestSqsGetQueueURLError(t *testing.T) {
+	mockSvc := awstest.BuildMockSqsSvcError([]string{"GetQueueUrl"})
+
+	out := getQueueURL(mockSvc, aws.String("example-queue"), awstest.ExampleAccountId)
+	assert.Nil(t, out)
+}
+
+func TestSqsGetQueueAttributes(t *testing.T) {
+	mockSvc := awstest.BuildMockSqsSvc([]string{"GetQueueAttributes"})
+
+	out := getQueueAttributes(mockSvc, awstest.ExampleSqsQueueURL)
+	assert.NotEmpty(t, out)
+}
+
+func TestSqsGetQueueAttributesError(t *testing.T) {
+	mockSvc := awstest.BuildMockSqsSvcError([]string{"GetQueueAttributes"})
+
+	out := getQueueAttributes(mockSvc, awstest.ExampleSqsQueueURL)
+	assert.Nil(t, out)
+}
+
+func TestSqsListQueueTags(t *testing.T) {
+	mockSvc := awstest.BuildMockSqsSvc([]string{"ListQueueTags"})
+
+	out, err := listQueueTags(mockSvc, awstest.ExampleSqsQueueURL)
+	require.NoError(t, err)
+	assert.NotEmpty(t, out)
+}
+
+func TestSqsListQueueTagsError(t *testing.T) {
+	mockSvc := awstest.BuildMockSqsSvcError([]string{"ListQueueTags"})
+
+	out, err := listQueueTags(mockSvc, awstest.ExampleSqsQueueURL)
+	require.Error(t, err)
+	assert.Nil(t, out)
+}
+
+func TestBuildSqsQueueSnapshot(t *testing.T) {
+	mockSvc := awstest.BuildMockSqsSvcAll()
+
+	queue := buildSqsQueueSnapshot(mockSvc, awstest.ExampleSqsQueueURL)
+	require.NotNil(t, queue)
+	assert.Equal(t, aws.String("arn:aws:sqs:us-west-2:123456789012:example-queue"), queue.ARN)
+	assert.Equal(t, aws.String("example-queue"), queue.Name)
+	assert.Equal(t, aws.String("alias/aws/sqs"), queue.KmsMasterKeyId)
+	assert.Equal(t, aws.Int64(30), queue.VisibilityTimeout)
+	assert.Nil(t, queue.FifoQueue)
+	assert.Equal(t, awstest.ExampleTime.String(), queue.TimeCreated.String())
+	assert.Equal(t, aws.String("Value1"), queue.Tags["KeyName1"])
+}
+
+func TestBuildSqsQueueSnapshotError(t *testing.T) {
+	mockSvc := awstest.BuildMockSqsSvcAllError()
+
+	queue := buildSqsQueueSnapshot(mockSvc, awstest.ExampleSqsQueueURL)
+	assert.Nil(t, queue)
+}
+
+func TestPollSQSQueue(t *testing.T) {
+	awstest.MockSqsForSetup = awstest.BuildMockSqsSvcAll()
+	SqsClientFunc = awstest.SetupMockSqs
+
+	resourceARN, err := arn.Parse("arn:aws:sqs:us-west-2:123456789012:example-queue")
+	require.NoError(t, err)
+	resource, err := PollSQSQueue(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	}, resourceARN, nil)
+
+	require.NoError(t, err)
+	queue := resource.(*awsmodels.SqsQueue)
+	assert.Equal(t, aws.String("us-west-2"), queue.Region)
+	assert.Equal(t, awstest.ExampleSqsQueueURL, queue.QueueUrl)
+}
+
+func TestSqsQueuePoller(t *testing.T) {
+	awstest.MockSqsForSetup = awstest.BuildMockSqsSvcAll()
+	SqsClientFunc = awstest.SetupMockSqs
+
+	resources, err := PollSqsQueues(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	queue := resources[0].Attributes.(*awsmodels.SqsQueue)
+	assert.Equal(t, aws.Int64(345600), queue.MessageRetentionPeriod)
+	assert.Equal(t, awsmodels.SqsQueueSchema, string(resources[0].Type))
+}
+
+func TestSqsQueuePollerError(t *testing.T) {
+	awstest.MockSqsForSetup = awstest.BuildMockSqsSvcAllError()
+	SqsClientFunc = awstest.SetupMockSqs
+
+	resources, err := PollSqsQueues(&awsmodels.ResourcePollerInput{
+		AuthSource:          &awstest.ExampleAuthSource,
+		AuthSourceParsedARN: awstest.ExampleAuthSourceParsedARN,
+		IntegrationID:       awstest.ExampleIntegrationID,
+		Regions:             awstest.ExampleRegions,
+		Timestamp:           &awstest.ExampleTime,
+	})
+
+	require.Error(t, err)
+	assert.Empty(t, resources)
+}
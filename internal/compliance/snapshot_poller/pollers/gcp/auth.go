@@ -0,0 +1,189 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// The pollers only need read access to the project
+	tokenScope = "https://www.googleapis.com/auth/cloud-platform.read-only"
+	// Token endpoint used when the key file does not specify one
+	defaultTokenURI = "https://oauth2.googleapis.com/token"
+	jwtGrantType    = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	// The lifetime requested for the signed assertion, which is the maximum Google accepts
+	assertionLifetime = time.Hour
+	// Access tokens are refreshed this long before they expire
+	tokenExpiryDelta = time.Minute
+)
+
+// ServiceAccountKey is the JSON key file of a GCP service account.
+type ServiceAccountKey struct {
+	Type         string `json:"type"`
+	ProjectID    string `json:"project_id"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	ClientEmail  string `json:"client_email"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// ParseServiceAccountKey parses and sanity checks the JSON key file of a service account.
+func ParseServiceAccountKey(keyJSON []byte) (*ServiceAccountKey, error) {
+	var key ServiceAccountKey
+	if err := json.Unmarshal(keyJSON, &key); err != nil {
+		return nil, errors.Wrap(err, "invalid service account key")
+	}
+	if key.Type != "service_account" {
+		return nil, errors.Errorf("invalid service account key type %q", key.Type)
+	}
+	if key.ClientEmail == "" || key.PrivateKey == "" {
+		return nil, errors.New("service account key is missing client_email or private_key")
+	}
+	if key.TokenURI == "" {
+		key.TokenURI = defaultTokenURI
+	}
+	return &key, nil
+}
+
+// tokenSource exchanges signed JWT assertions for OAuth2 access tokens, caching them until they expire.
+//
+// See https://developers.google.com/identity/protocols/oauth2/service-account#httprest
+type tokenSource struct {
+	key        *ServiceAccountKey
+	signer     *rsa.PrivateKey
+	httpClient *http.Client
+
+	token  string
+	expiry time.Time
+}
+
+func newTokenSource(key *ServiceAccountKey, httpClient *http.Client) (*tokenSource, error) {
+	signer, err := parsePrivateKey(key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenSource{key: key, signer: signer, httpClient: httpClient}, nil
+}
+
+// parsePrivateKey decodes the PEM encoded RSA key of a service account, which is PKCS#8 or PKCS#1.
+func parsePrivateKey(pemKey string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(pemKey))
+	if block == nil {
+		return nil, errors.New("service account private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse service account private key")
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("service account private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// Token returns a valid access token, requesting a new one if the cached token is about to expire.
+func (ts *tokenSource) Token() (string, error) {
+	now := time.Now()
+	if ts.token != "" && now.Add(tokenExpiryDelta).Before(ts.expiry) {
+		return ts.token, nil
+	}
+
+	assertion, err := ts.assertion(now)
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{
+		"grant_type": {jwtGrantType},
+		"assertion":  {assertion},
+	}
+	resp, err := ts.httpClient.PostForm(ts.key.TokenURI, form)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to request access token")
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", errors.Wrapf(err, "failed to decode token response (status %d)", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK || body.AccessToken == "" {
+		return "", errors.Errorf("access token request failed (status %d): %s %s",
+			resp.StatusCode, body.Error, body.ErrorDescription)
+	}
+
+	ts.token = body.AccessToken
+	ts.expiry = now.Add(time.Duration(body.ExpiresIn) * time.Second)
+	return ts.token, nil
+}
+
+// assertion builds the RS256 signed JWT identifying the service account to the token endpoint.
+func (ts *tokenSource) assertion(now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"kid": ts.key.PrivateKeyID,
+	})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss":   ts.key.ClientEmail,
+		"scope": tokenScope,
+		"aud":   ts.key.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(assertionLifetime).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := strings.Join([]string{
+		base64.RawURLEncoding.EncodeToString(header),
+		base64.RawURLEncoding.EncodeToString(claims),
+	}, ".")
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, ts.signer, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign token assertion")
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,209 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/pkg/errors"
+
+	sourcemodels "github.com/panther-labs/panther/api/lambda/source/models"
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/utils"
+)
+
+const (
+	// retries on default session
+	maxRetries = 6
+	// timeout of a single GCP API request
+	requestTimeout = 30 * time.Second
+	// how long a client is cached before its key is reloaded, so rotated keys are picked up
+	clientCacheTTL = 15 * time.Minute
+)
+
+var (
+	snapshotPollerSession = session.Must(session.NewSession(&aws.Config{MaxRetries: aws.Int(maxRetries)}))
+
+	// Set as variables to be overridden in testing
+	secretsClient   secretsmanageriface.SecretsManagerAPI = secretsmanager.New(snapshotPollerSession)
+	serviceEndpoint                                       = func(service string) string {
+		return "https://" + service + ".googleapis.com"
+	}
+
+	// Clients are cached per integration, access tokens are refreshed by the client itself
+	clientCache = make(map[string]cachedClient)
+)
+
+type cachedClient struct {
+	Client    *Client
+	ExpiresAt time.Time
+}
+
+// Client calls the GCP REST APIs with the credentials of a service account.
+type Client struct {
+	httpClient *http.Client
+	tokens     *tokenSource
+}
+
+// NewClient builds a client from the JSON key file of a service account.
+func NewClient(keyJSON []byte) (*Client, error) {
+	key, err := ParseServiceAccountKey(keyJSON)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := &http.Client{Timeout: requestTimeout}
+	tokens, err := newTokenSource(key, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{httpClient: httpClient, tokens: tokens}, nil
+}
+
+// Authenticate exchanges the service account key for an access token, which verifies the key is active.
+func (c *Client) Authenticate() error {
+	_, err := c.tokens.Token()
+	return err
+}
+
+// CheckProject verifies the service account can read the metadata of a project.
+func (c *Client) CheckProject(projectID string) error {
+	var project struct {
+		LifecycleState string `json:"lifecycleState"`
+	}
+	if err := c.get(serviceURL("cloudresourcemanager", "/v1/projects/%s", projectID), nil, &project); err != nil {
+		return err
+	}
+	if project.LifecycleState != "ACTIVE" {
+		return errors.Errorf("project %s is %s", projectID, project.LifecycleState)
+	}
+	return nil
+}
+
+// APIError is the error returned by a GCP API for an unsuccessful request.
+type APIError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Status  string `json:"status"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("GCP API request failed with %d %s: %s", e.Code, e.Status, e.Message)
+}
+
+// isNotFound returns true if the error is a GCP API 404 response.
+func isNotFound(err error) bool {
+	apiErr, ok := errors.Cause(err).(*APIError)
+	return ok && apiErr.Code == http.StatusNotFound
+}
+
+// serviceURL builds the URL of a GCP API method, escaping the path arguments.
+func serviceURL(service, pathFormat string, args ...string) string {
+	escaped := make([]interface{}, len(args))
+	for i, arg := range args {
+		escaped[i] = url.PathEscape(arg)
+	}
+	return serviceEndpoint(service) + fmt.Sprintf(pathFormat, escaped...)
+}
+
+func (c *Client) get(rawURL string, query url.Values, out interface{}) error {
+	if len(query) > 0 {
+		rawURL += "?" + query.Encode()
+	}
+	return c.do(http.MethodGet, rawURL, nil, out)
+}
+
+func (c *Client) post(rawURL string, body interface{}, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return c.do(http.MethodPost, rawURL, bytes.NewReader(payload), out)
+}
+
+func (c *Client) do(method, rawURL string, body io.Reader, out interface{}) error {
+	token, err := c.tokens.Token()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(method, rawURL, body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "%s %s", method, rawURL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		var errResponse struct {
+			Error *APIError `json:"error"`
+		}
+		if json.Unmarshal(respBody, &errResponse) != nil || errResponse.Error == nil {
+			errResponse.Error = &APIError{Code: resp.StatusCode, Message: string(respBody)}
+		}
+		return errors.Wrapf(errResponse.Error, "%s %s", method, rawURL)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return errors.Wrapf(err, "failed to decode response of %s %s", method, rawURL)
+	}
+	return nil
+}
+
+// getClient returns a client for the project of an integration, using the key stored in Secrets Manager.
+func getClient(pollerInput *gcpmodels.ResourcePollerInput) (*Client, error) {
+	if cached, ok := clientCache[*pollerInput.IntegrationID]; ok && time.Now().Before(cached.ExpiresAt) {
+		return cached.Client, nil
+	}
+
+	secret, err := secretsClient.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(sourcemodels.GCPServiceAccountKeySecretPrefix + *pollerInput.IntegrationID),
+	})
+	if err != nil {
+		utils.LogAWSError("SecretsManager.GetSecretValue", err)
+		return nil, errors.Wrapf(err, "failed to load service account key of integration %s", *pollerInput.IntegrationID)
+	}
+
+	client, err := NewClient([]byte(aws.StringValue(secret.SecretString)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "integration %s", *pollerInput.IntegrationID)
+	}
+	clientCache[*pollerInput.IntegrationID] = cachedClient{
+		Client:    client,
+		ExpiresAt: time.Now().Add(clientCacheTTL),
+	}
+	return client, nil
+}
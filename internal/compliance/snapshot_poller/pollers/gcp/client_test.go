@@ -0,0 +1,154 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/gcp/gcptest"
+)
+
+const testIntegrationID = "8e2a5c6d-1f3b-4a7e-9c0d-2b4f6e8a0c1d"
+
+var testPollerInput = &gcpmodels.ResourcePollerInput{
+	IntegrationID: aws.String(testIntegrationID),
+	ProjectID:     aws.String(gcptest.ExampleProjectID),
+}
+
+type mockSecretsClient struct {
+	secretsmanageriface.SecretsManagerAPI
+	mock.Mock
+}
+
+func (m *mockSecretsClient) GetSecretValue(input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(*secretsmanager.GetSecretValueOutput), args.Error(1)
+}
+
+// setupFakeServer points the pollers at a fake GCP API server holding the key of the test integration
+func setupFakeServer(t *testing.T) *gcptest.Server {
+	server := gcptest.NewServer()
+	t.Cleanup(server.Close)
+
+	secrets := &mockSecretsClient{}
+	secrets.On("GetSecretValue", &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String("panther-gcp-scan-" + testIntegrationID),
+	}).Return(&secretsmanager.GetSecretValueOutput{
+		SecretString: aws.String(string(server.ServiceAccountKey())),
+	}, nil)
+
+	secretsClient = secrets
+	serviceEndpoint = server.Endpoint
+	clientCache = make(map[string]cachedClient)
+	return server
+}
+
+func TestNewClientInvalidKey(t *testing.T) {
+	_, err := NewClient([]byte(`{"type": "authorized_user"}`))
+	require.Error(t, err)
+
+	_, err = NewClient([]byte(`{"type": "service_account", "client_email": "a@b.c", "private_key": "not a key"}`))
+	require.Error(t, err)
+}
+
+func TestClientAuthenticate(t *testing.T) {
+	server := setupFakeServer(t)
+
+	client, err := NewClient(server.ServiceAccountKey())
+	require.NoError(t, err)
+	require.NoError(t, client.Authenticate())
+	token, err := client.tokens.Token()
+	require.NoError(t, err)
+	assert.Equal(t, gcptest.AccessToken, token)
+}
+
+func TestClientAuthenticateUnknownKey(t *testing.T) {
+	server := setupFakeServer(t)
+	otherServer := gcptest.NewServer()
+	defer otherServer.Close()
+
+	// Keys issued by another server are signed with a different private key
+	key, err := ParseServiceAccountKey(otherServer.ServiceAccountKey())
+	require.NoError(t, err)
+	key.TokenURI = server.URL + "/token"
+	tokens, err := newTokenSource(key, http.DefaultClient)
+	require.NoError(t, err)
+
+	_, err = tokens.Token()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid_grant")
+}
+
+func TestClientCheckProject(t *testing.T) {
+	server := setupFakeServer(t)
+	server.Handle(http.MethodGet, "/cloudresourcemanager/v1/projects/example-project", http.StatusOK, gcptest.ExampleProject)
+
+	client, err := NewClient(server.ServiceAccountKey())
+	require.NoError(t, err)
+	require.NoError(t, client.CheckProject(gcptest.ExampleProjectID))
+
+	err = client.CheckProject("missing-project")
+	require.Error(t, err)
+	assert.True(t, isNotFound(err))
+}
+
+func TestGetClientCached(t *testing.T) {
+	setupFakeServer(t)
+
+	client, err := getClient(testPollerInput)
+	require.NoError(t, err)
+	cached, err := getClient(testPollerInput)
+	require.NoError(t, err)
+	assert.Same(t, client, cached)
+	secretsClient.(*mockSecretsClient).AssertNumberOfCalls(t, "GetSecretValue", 1)
+}
+
+func TestGetClientCacheExpired(t *testing.T) {
+	setupFakeServer(t)
+
+	client, err := getClient(testPollerInput)
+	require.NoError(t, err)
+	clientCache[*testPollerInput.IntegrationID] = cachedClient{Client: client, ExpiresAt: time.Now().Add(-time.Second)}
+	reloaded, err := getClient(testPollerInput)
+	require.NoError(t, err)
+	assert.NotSame(t, client, reloaded)
+	secretsClient.(*mockSecretsClient).AssertNumberOfCalls(t, "GetSecretValue", 2)
+}
+
+func TestGetClientUnknownIntegration(t *testing.T) {
+	setupFakeServer(t)
+	secrets := &mockSecretsClient{}
+	secrets.On("GetSecretValue", mock.Anything).Return(
+		&secretsmanager.GetSecretValueOutput{}, &secretsmanager.ResourceNotFoundException{})
+	secretsClient = secrets
+
+	client, err := getClient(testPollerInput)
+	require.Error(t, err)
+	assert.Nil(t, client)
+}
@@ -0,0 +1,133 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	apimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+)
+
+// computeFirewall is a firewall rule as returned by the Compute Engine API
+type computeFirewall struct {
+	Allowed               []*gcpmodels.ComputeFirewallRule    `json:"allowed"`
+	CreationTimestamp     *strfmt.DateTime                    `json:"creationTimestamp"`
+	Denied                []*gcpmodels.ComputeFirewallRule    `json:"denied"`
+	Description           *string                             `json:"description"`
+	DestinationRanges     []*string                           `json:"destinationRanges"`
+	Direction             *string                             `json:"direction"`
+	Disabled              *bool                               `json:"disabled"`
+	ID                    *string                             `json:"id"`
+	LogConfig             *gcpmodels.ComputeFirewallLogConfig `json:"logConfig"`
+	Name                  *string                             `json:"name"`
+	Network               *string                             `json:"network"`
+	Priority              *int64                              `json:"priority"`
+	SourceRanges          []*string                           `json:"sourceRanges"`
+	SourceServiceAccounts []*string                           `json:"sourceServiceAccounts"`
+	SourceTags            []*string                           `json:"sourceTags"`
+	TargetServiceAccounts []*string                           `json:"targetServiceAccounts"`
+	TargetTags            []*string                           `json:"targetTags"`
+}
+
+// listFirewalls returns all VPC firewall rules in a project
+func listFirewalls(client *Client, projectID string) (firewalls []*computeFirewall, err error) {
+	query := url.Values{}
+	for {
+		var page struct {
+			Items         []*computeFirewall `json:"items"`
+			NextPageToken string             `json:"nextPageToken"`
+		}
+		err = client.get(serviceURL("compute", "/compute/v1/projects/%s/global/firewalls", projectID), query, &page)
+		if err != nil {
+			return nil, errors.Wrap(err, "Compute.Firewalls.List")
+		}
+		firewalls = append(firewalls, page.Items...)
+		if page.NextPageToken == "" {
+			return firewalls, nil
+		}
+		query.Set("pageToken", page.NextPageToken)
+	}
+}
+
+// buildComputeFirewallSnapshot returns a complete snapshot of a VPC firewall rule
+func buildComputeFirewallSnapshot(projectID string, firewall *computeFirewall) *gcpmodels.ComputeFirewall {
+	return &gcpmodels.ComputeFirewall{
+		GenericResource: gcpmodels.GenericResource{
+			ResourceID: aws.String("//compute.googleapis.com/projects/" + projectID +
+				"/global/firewalls/" + aws.StringValue(firewall.Name)),
+			ResourceType: aws.String(gcpmodels.ComputeFirewallSchema),
+			TimeCreated:  firewall.CreationTimestamp,
+		},
+		GenericGCPResource: gcpmodels.GenericGCPResource{
+			ID:     firewall.ID,
+			Name:   firewall.Name,
+			Region: aws.String(gcpmodels.GlobalRegion),
+		},
+		Allowed:               firewall.Allowed,
+		Denied:                firewall.Denied,
+		Description:           firewall.Description,
+		DestinationRanges:     firewall.DestinationRanges,
+		Direction:             firewall.Direction,
+		Disabled:              firewall.Disabled,
+		LogConfig:             firewall.LogConfig,
+		Network:               firewall.Network,
+		Priority:              firewall.Priority,
+		SourceRanges:          firewall.SourceRanges,
+		SourceServiceAccounts: firewall.SourceServiceAccounts,
+		SourceTags:            firewall.SourceTags,
+		TargetServiceAccounts: firewall.TargetServiceAccounts,
+		TargetTags:            firewall.TargetTags,
+	}
+}
+
+// PollComputeFirewalls gathers information on each VPC firewall rule of a GCP project.
+func PollComputeFirewalls(pollerInput *gcpmodels.ResourcePollerInput) ([]*apimodels.AddResourceEntry, error) {
+	zap.L().Debug("starting Compute Firewall resource poller")
+	client, err := getClient(pollerInput)
+	if err != nil {
+		return nil, err
+	}
+
+	firewalls, err := listFirewalls(client, *pollerInput.ProjectID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "PollComputeFirewalls(%s)", *pollerInput.ProjectID)
+	}
+
+	resources := make([]*apimodels.AddResourceEntry, 0, len(firewalls))
+	for _, firewall := range firewalls {
+		snapshot := buildComputeFirewallSnapshot(*pollerInput.ProjectID, firewall)
+		snapshot.ProjectID = pollerInput.ProjectID
+
+		resources = append(resources, &apimodels.AddResourceEntry{
+			Attributes:      snapshot,
+			ID:              apimodels.ResourceID(*snapshot.ResourceID),
+			IntegrationID:   apimodels.IntegrationID(*pollerInput.IntegrationID),
+			IntegrationType: apimodels.IntegrationTypeGcp,
+			Type:            gcpmodels.ComputeFirewallSchema,
+		})
+	}
+
+	return resources, nil
+}
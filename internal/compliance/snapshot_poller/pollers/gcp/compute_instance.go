@@ -0,0 +1,165 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	apimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+)
+
+// computeInstance is an instance as returned by the Compute Engine API
+type computeInstance struct {
+	CanIPForward               *bool                                        `json:"canIpForward"`
+	ConfidentialInstanceConfig *gcpmodels.ComputeConfidentialInstanceConfig `json:"confidentialInstanceConfig"`
+	CreationTimestamp          *strfmt.DateTime                             `json:"creationTimestamp"`
+	DeletionProtection         *bool                                        `json:"deletionProtection"`
+	Disks                      []*gcpmodels.ComputeAttachedDisk             `json:"disks"`
+	ID                         *string                                      `json:"id"`
+	Labels                     map[string]*string                           `json:"labels"`
+	MachineType                *string                                      `json:"machineType"`
+	Metadata                   *computeMetadata                             `json:"metadata"`
+	Name                       *string                                      `json:"name"`
+	NetworkInterfaces          []*gcpmodels.ComputeNetworkInterface         `json:"networkInterfaces"`
+	ServiceAccounts            []*gcpmodels.ComputeServiceAccount           `json:"serviceAccounts"`
+	ShieldedInstanceConfig     *gcpmodels.ComputeShieldedInstanceConfig     `json:"shieldedInstanceConfig"`
+	Status                     *string                                      `json:"status"`
+	Zone                       *string                                      `json:"zone"`
+}
+
+// computeMetadata is the list of key/value pairs attached to an instance
+type computeMetadata struct {
+	Items []*struct {
+		Key   *string `json:"key"`
+		Value *string `json:"value"`
+	} `json:"items"`
+}
+
+// listInstances returns all Compute Engine instances in a project, across all zones
+func listInstances(client *Client, projectID string) (instances []*computeInstance, err error) {
+	query := url.Values{}
+	for {
+		var page struct {
+			// Scoped lists are keyed by zone, zones without instances only contain a warning
+			Items map[string]struct {
+				Instances []*computeInstance `json:"instances"`
+			} `json:"items"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		err = client.get(serviceURL("compute", "/compute/v1/projects/%s/aggregated/instances", projectID), query, &page)
+		if err != nil {
+			return nil, errors.Wrap(err, "Compute.Instances.AggregatedList")
+		}
+		for _, scopedList := range page.Items {
+			instances = append(instances, scopedList.Instances...)
+		}
+		if page.NextPageToken == "" {
+			return instances, nil
+		}
+		query.Set("pageToken", page.NextPageToken)
+	}
+}
+
+// buildComputeInstanceSnapshot returns a complete snapshot of a Compute Engine instance
+func buildComputeInstanceSnapshot(projectID string, instance *computeInstance) *gcpmodels.ComputeInstance {
+	// The API references zones and machine types by URL
+	zone := path.Base(aws.StringValue(instance.Zone))
+	snapshot := &gcpmodels.ComputeInstance{
+		GenericResource: gcpmodels.GenericResource{
+			ResourceID: aws.String("//compute.googleapis.com/projects/" + projectID +
+				"/zones/" + zone + "/instances/" + aws.StringValue(instance.Name)),
+			ResourceType: aws.String(gcpmodels.ComputeInstanceSchema),
+			TimeCreated:  instance.CreationTimestamp,
+		},
+		GenericGCPResource: gcpmodels.GenericGCPResource{
+			ID:     instance.ID,
+			Name:   instance.Name,
+			Region: aws.String(zoneRegion(zone)),
+			Labels: instance.Labels,
+		},
+		CanIPForward:               instance.CanIPForward,
+		ConfidentialInstanceConfig: instance.ConfidentialInstanceConfig,
+		DeletionProtection:         instance.DeletionProtection,
+		Disks:                      instance.Disks,
+		NetworkInterfaces:          instance.NetworkInterfaces,
+		ServiceAccounts:            instance.ServiceAccounts,
+		ShieldedInstanceConfig:     instance.ShieldedInstanceConfig,
+		Status:                     instance.Status,
+		Zone:                       aws.String(zone),
+	}
+	if instance.MachineType != nil {
+		snapshot.MachineType = aws.String(path.Base(*instance.MachineType))
+	}
+
+	// Flatten the metadata so policies can look up keys such as block-project-ssh-keys directly
+	if instance.Metadata != nil {
+		snapshot.Metadata = make(map[string]*string, len(instance.Metadata.Items))
+		for _, item := range instance.Metadata.Items {
+			snapshot.Metadata[aws.StringValue(item.Key)] = item.Value
+		}
+	}
+
+	return snapshot
+}
+
+// zoneRegion returns the region of a zone, e.g. us-central1 for us-central1-a
+func zoneRegion(zone string) string {
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		return zone[:i]
+	}
+	return zone
+}
+
+// PollComputeInstances gathers information on each Compute Engine instance of a GCP project.
+func PollComputeInstances(pollerInput *gcpmodels.ResourcePollerInput) ([]*apimodels.AddResourceEntry, error) {
+	zap.L().Debug("starting Compute Instance resource poller")
+	client, err := getClient(pollerInput)
+	if err != nil {
+		return nil, err
+	}
+
+	instances, err := listInstances(client, *pollerInput.ProjectID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "PollComputeInstances(%s)", *pollerInput.ProjectID)
+	}
+
+	resources := make([]*apimodels.AddResourceEntry, 0, len(instances))
+	for _, instance := range instances {
+		snapshot := buildComputeInstanceSnapshot(*pollerInput.ProjectID, instance)
+		snapshot.ProjectID = pollerInput.ProjectID
+
+		resources = append(resources, &apimodels.AddResourceEntry{
+			Attributes:      snapshot,
+			ID:              apimodels.ResourceID(*snapshot.ResourceID),
+			IntegrationID:   apimodels.IntegrationID(*pollerInput.IntegrationID),
+			IntegrationType: apimodels.IntegrationTypeGcp,
+			Type:            gcpmodels.ComputeInstanceSchema,
+		})
+	}
+
+	return resources, nil
+}
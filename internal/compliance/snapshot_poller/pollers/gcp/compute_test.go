@@ -0,0 +1,96 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/gcp/gcptest"
+)
+
+const instancesPath = "/compute/compute/v1/projects/example-project/aggregated/instances"
+
+func TestComputeZoneRegion(t *testing.T) {
+	assert.Equal(t, "us-central1", zoneRegion("us-central1-a"))
+	assert.Equal(t, "europe-west1", zoneRegion("europe-west1-b"))
+}
+
+func TestComputeListInstancesError(t *testing.T) {
+	setupFakeServer(t)
+	client, err := getClient(testPollerInput)
+	require.NoError(t, err)
+
+	instances, err := listInstances(client, gcptest.ExampleProjectID)
+	require.Error(t, err)
+	assert.True(t, isNotFound(err))
+	assert.Nil(t, instances)
+}
+
+func TestPollComputeInstances(t *testing.T) {
+	server := setupFakeServer(t)
+	server.Handle(http.MethodGet, instancesPath, http.StatusOK, gcptest.ExampleInstancesAggregatedList)
+
+	resources, err := PollComputeInstances(testPollerInput)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "//compute.googleapis.com/projects/example-project/zones/us-central1-a/instances/example-instance",
+		string(resources[0].ID))
+	assert.Equal(t, gcpmodels.ComputeInstanceSchema, string(resources[0].Type))
+
+	instance := resources[0].Attributes.(*gcpmodels.ComputeInstance)
+	assert.Equal(t, "4567890123456789012", *instance.ID)
+	assert.Equal(t, "us-central1", *instance.Region)
+	assert.Equal(t, "us-central1-a", *instance.Zone)
+	assert.Equal(t, "e2-medium", *instance.MachineType)
+	assert.Equal(t, "TRUE", *instance.Metadata["enable-oslogin"])
+	assert.Equal(t, "203.0.113.10", *instance.NetworkInterfaces[0].AccessConfigs[0].NatIP)
+	assert.Equal(t, "10.128.0.2", *instance.NetworkInterfaces[0].NetworkIP)
+	assert.False(t, *instance.CanIPForward)
+	assert.False(t, *instance.ShieldedInstanceConfig.EnableSecureBoot)
+	assert.NotNil(t, instance.TimeCreated)
+}
+
+func TestPollComputeFirewalls(t *testing.T) {
+	server := setupFakeServer(t)
+	server.Handle(http.MethodGet, "/compute/compute/v1/projects/example-project/global/firewalls",
+		http.StatusOK, gcptest.ExampleFirewallsList)
+
+	resources, err := PollComputeFirewalls(testPollerInput)
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	assert.Equal(t, "//compute.googleapis.com/projects/example-project/global/firewalls/default-allow-ssh",
+		string(resources[0].ID))
+
+	firewall := resources[0].Attributes.(*gcpmodels.ComputeFirewall)
+	assert.Equal(t, gcpmodels.GlobalRegion, *firewall.Region)
+	assert.Equal(t, "INGRESS", *firewall.Direction)
+	assert.Equal(t, int64(65534), *firewall.Priority)
+	assert.Equal(t, "0.0.0.0/0", *firewall.SourceRanges[0])
+	assert.Equal(t, "tcp", *firewall.Allowed[0].IPProtocol)
+	assert.Equal(t, "22", *firewall.Allowed[0].Ports[0])
+
+	egress := resources[1].Attributes.(*gcpmodels.ComputeFirewall)
+	assert.Equal(t, "25", *egress.Denied[0].Ports[0])
+	assert.True(t, *egress.LogConfig.Enable)
+}
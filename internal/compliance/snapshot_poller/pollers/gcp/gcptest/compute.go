@@ -0,0 +1,129 @@
+package gcptest
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Example responses of the Compute Engine API
+const (
+	ExampleInstancesAggregatedList = `{
+  "kind": "compute#instanceAggregatedList",
+  "id": "projects/example-project/aggregated/instances",
+  "items": {
+    "zones/us-central1-a": {
+      "instances": [
+        {
+          "kind": "compute#instance",
+          "id": "4567890123456789012",
+          "creationTimestamp": "2020-06-23T11:37:11.123-07:00",
+          "name": "example-instance",
+          "machineType": "https://www.googleapis.com/compute/v1/projects/example-project/zones/us-central1-a/machineTypes/e2-medium",
+          "status": "RUNNING",
+          "zone": "https://www.googleapis.com/compute/v1/projects/example-project/zones/us-central1-a",
+          "canIpForward": false,
+          "networkInterfaces": [
+            {
+              "network": "https://www.googleapis.com/compute/v1/projects/example-project/global/networks/default",
+              "subnetwork": "https://www.googleapis.com/compute/v1/projects/example-project/regions/us-central1/subnetworks/default",
+              "networkIP": "10.128.0.2",
+              "name": "nic0",
+              "accessConfigs": [
+                {"type": "ONE_TO_ONE_NAT", "name": "External NAT", "natIP": "203.0.113.10"}
+              ]
+            }
+          ],
+          "disks": [
+            {
+              "type": "PERSISTENT",
+              "mode": "READ_WRITE",
+              "source": "https://www.googleapis.com/compute/v1/projects/example-project/zones/us-central1-a/disks/example-instance",
+              "deviceName": "example-instance",
+              "boot": true,
+              "autoDelete": true
+            }
+          ],
+          "metadata": {
+            "fingerprint": "lF7mGzPZ4Qg=",
+            "items": [
+              {"key": "enable-oslogin", "value": "TRUE"},
+              {"key": "serial-port-enable", "value": "false"}
+            ]
+          },
+          "serviceAccounts": [
+            {
+              "email": "123456789012-compute@developer.gserviceaccount.com",
+              "scopes": ["https://www.googleapis.com/auth/cloud-platform"]
+            }
+          ],
+          "shieldedInstanceConfig": {
+            "enableSecureBoot": false,
+            "enableVtpm": true,
+            "enableIntegrityMonitoring": true
+          },
+          "labels": {"env": "production"},
+          "deletionProtection": true
+        }
+      ]
+    },
+    "zones/europe-west1-b": {
+      "warning": {
+        "code": "NO_RESULTS_ON_PAGE",
+        "message": "There are no results for scope 'zones/europe-west1-b' on this page."
+      }
+    }
+  }
+}`
+
+	ExampleFirewallsList = `{
+  "kind": "compute#firewallList",
+  "id": "projects/example-project/global/firewalls",
+  "items": [
+    {
+      "kind": "compute#firewall",
+      "id": "7890123456789012345",
+      "creationTimestamp": "2020-01-15T10:20:31.127-08:00",
+      "name": "default-allow-ssh",
+      "description": "Allow SSH from anywhere",
+      "network": "https://www.googleapis.com/compute/v1/projects/example-project/global/networks/default",
+      "priority": 65534,
+      "sourceRanges": ["0.0.0.0/0"],
+      "allowed": [
+        {"IPProtocol": "tcp", "ports": ["22"]}
+      ],
+      "direction": "INGRESS",
+      "logConfig": {"enable": false},
+      "disabled": false
+    },
+    {
+      "kind": "compute#firewall",
+      "id": "7890123456789012346",
+      "creationTimestamp": "2020-01-15T10:20:31.127-08:00",
+      "name": "deny-egress-smtp",
+      "network": "https://www.googleapis.com/compute/v1/projects/example-project/global/networks/default",
+      "priority": 1000,
+      "destinationRanges": ["0.0.0.0/0"],
+      "denied": [
+        {"IPProtocol": "tcp", "ports": ["25"]}
+      ],
+      "targetTags": ["web"],
+      "direction": "EGRESS",
+      "logConfig": {"enable": true},
+      "disabled": false
+    }
+  ]
+}`
+)
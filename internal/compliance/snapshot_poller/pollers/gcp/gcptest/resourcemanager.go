@@ -0,0 +1,60 @@
+package gcptest
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Example responses of the Resource Manager API
+const (
+	ExampleProjectID = "example-project"
+
+	ExampleProject = `{
+  "projectNumber": "123456789012",
+  "projectId": "example-project",
+  "lifecycleState": "ACTIVE",
+  "name": "Example Project",
+  "createTime": "2020-01-15T18:20:31.127Z"
+}`
+
+	ExampleProjectIamPolicy = `{
+  "version": 3,
+  "etag": "BwWqeXJ1fL8=",
+  "bindings": [
+    {
+      "role": "roles/owner",
+      "members": ["user:admin@example.com"]
+    },
+    {
+      "role": "roles/storage.objectViewer",
+      "members": ["allUsers"],
+      "condition": {
+        "title": "expires_2021",
+        "expression": "request.time < timestamp(\"2021-01-01T00:00:00Z\")"
+      }
+    }
+  ],
+  "auditConfigs": [
+    {
+      "service": "allServices",
+      "auditLogConfigs": [
+        {"logType": "ADMIN_READ"},
+        {"logType": "DATA_READ", "exemptedMembers": ["user:admin@example.com"]}
+      ]
+    }
+  ]
+}`
+)
@@ -0,0 +1,202 @@
+package gcptest
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+const (
+	// AccessToken is issued by the token endpoint of the fake server and required by its API endpoints
+	AccessToken = "ya29.example-access-token"
+	// ServiceAccountEmail identifies the service account of the keys issued by the fake server
+	ServiceAccountEmail = "panther-audit@example-project.iam.gserviceaccount.com"
+
+	tokenPath = "/token"
+)
+
+// Server is a fake GCP API server.
+//
+// It implements the OAuth2 token endpoint for service account keys it issued,
+// and serves canned responses for the API methods registered with Handle.
+type Server struct {
+	*httptest.Server
+
+	privateKey *rsa.PrivateKey
+
+	mu       sync.Mutex
+	routes   map[string]http.HandlerFunc
+	requests []string
+}
+
+// NewServer starts a fake GCP API server, it should be closed when the test is done.
+func NewServer() *Server {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	server := &Server{
+		privateKey: privateKey,
+		routes:     make(map[string]http.HandlerFunc),
+	}
+	server.Server = httptest.NewServer(server)
+	return server
+}
+
+// Endpoint returns the base URL of a GCP service on the fake server.
+func (s *Server) Endpoint(service string) string {
+	return s.URL + "/" + service
+}
+
+// ServiceAccountKey returns a JSON key file accepted by the token endpoint of the fake server.
+func (s *Server) ServiceAccountKey() []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(s.privateKey)
+	if err != nil {
+		panic(err)
+	}
+	key, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     ExampleProjectID,
+		"private_key_id": "0123456789abcdef0123456789abcdef01234567",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"client_email":   ServiceAccountEmail,
+		"token_uri":      s.URL + tokenPath,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// Handle registers the response returned by an API method, such as "GET /storage/storage/v1/b".
+//
+// The path includes the service prefix of Endpoint and query parameters are ignored.
+func (s *Server) Handle(method, path string, status int, body string) {
+	s.HandleFunc(method, path, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, status, body)
+	})
+}
+
+// HandleFunc registers the handler of an API method, for responses which depend on the request.
+func (s *Server) HandleFunc(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[method+" "+path] = handler
+}
+
+// Requests returns the API methods called so far, excluding the token endpoint.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == tokenPath {
+		s.serveToken(w, r)
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+AccessToken {
+		writeError(w, http.StatusUnauthorized, "UNAUTHENTICATED", "Request had invalid authentication credentials.")
+		return
+	}
+
+	route := r.Method + " " + r.URL.Path
+	s.mu.Lock()
+	s.requests = append(s.requests, route)
+	handler, ok := s.routes[route]
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "The requested resource was not found: "+r.URL.Path)
+		return
+	}
+	handler(w, r)
+}
+
+// serveToken exchanges a JWT assertion signed with the key of the server for an access token
+func (s *Server) serveToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" {
+		writeJSON(w, http.StatusBadRequest, `{"error": "unsupported_grant_type"}`)
+		return
+	}
+	if err := s.verifyAssertion(r.PostForm.Get("assertion")); err != nil {
+		writeJSON(w, http.StatusBadRequest,
+			fmt.Sprintf(`{"error": "invalid_grant", "error_description": %q}`, err.Error()))
+		return
+	}
+	writeJSON(w, http.StatusOK,
+		fmt.Sprintf(`{"access_token": %q, "expires_in": 3599, "token_type": "Bearer"}`, AccessToken))
+}
+
+func (s *Server) verifyAssertion(assertion string) error {
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("malformed assertion")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&s.privateKey.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+		return fmt.Errorf("invalid JWT Signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return err
+	}
+	var claims struct {
+		Issuer   string `json:"iss"`
+		Audience string `json:"aud"`
+		Scope    string `json:"scope"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return err
+	}
+	if claims.Issuer != ServiceAccountEmail || claims.Audience != s.URL+tokenPath || claims.Scope == "" {
+		return fmt.Errorf("invalid JWT claims")
+	}
+	return nil
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, fmt.Sprintf(`{"error": {"code": %d, "message": %q, "status": %q}}`, status, message, code))
+}
+
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(body))
+}
@@ -0,0 +1,77 @@
+package gcptest
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Example responses of the Cloud SQL Admin API
+const (
+	ExampleSQLInstancesList = `{
+  "items": [
+    {
+      "kind": "sql#instance",
+      "state": "RUNNABLE",
+      "databaseVersion": "POSTGRES_12",
+      "settings": {
+        "authorizedGaeApplications": [],
+        "tier": "db-custom-1-3840",
+        "kind": "sql#settings",
+        "availabilityType": "REGIONAL",
+        "pricingPlan": "PER_USE",
+        "replicationType": "SYNCHRONOUS",
+        "activationPolicy": "ALWAYS",
+        "ipConfiguration": {
+          "authorizedNetworks": [
+            {"value": "0.0.0.0/0", "name": "anywhere", "kind": "sql#aclEntry"}
+          ],
+          "ipv4Enabled": true,
+          "requireSsl": false
+        },
+        "locationPreference": {"zone": "us-central1-f", "kind": "sql#locationPreference"},
+        "dataDiskType": "PD_SSD",
+        "backupConfiguration": {
+          "startTime": "07:00",
+          "kind": "sql#backupConfiguration",
+          "enabled": true,
+          "pointInTimeRecoveryEnabled": true
+        },
+        "databaseFlags": [
+          {"name": "log_connections", "value": "on"}
+        ],
+        "settingsVersion": "3",
+        "storageAutoResize": true,
+        "dataDiskSizeGb": "10",
+        "userLabels": {"team": "data"}
+      },
+      "etag": "a9c7fb6b2d6e9a1f5b8d3e4c0f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a",
+      "ipAddresses": [
+        {"type": "PRIMARY", "ipAddress": "203.0.113.20"}
+      ],
+      "instanceType": "CLOUD_SQL_INSTANCE",
+      "project": "example-project",
+      "serviceAccountEmailAddress": "p123456789012-abcdef@gcp-sa-cloud-sql.iam.gserviceaccount.com",
+      "backendType": "SECOND_GEN",
+      "selfLink": "https://sqladmin.googleapis.com/sql/v1beta4/projects/example-project/instances/example-db",
+      "connectionName": "example-project:us-central1:example-db",
+      "name": "example-db",
+      "region": "us-central1",
+      "gceZone": "us-central1-f",
+      "createTime": "2020-06-23T18:37:11.146Z"
+    }
+  ]
+}`
+)
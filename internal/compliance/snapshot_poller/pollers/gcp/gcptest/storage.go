@@ -0,0 +1,70 @@
+package gcptest
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+// Example responses of the Cloud Storage JSON API
+const (
+	ExampleBucketsList = `{
+  "kind": "storage#buckets",
+  "items": [
+    {
+      "kind": "storage#bucket",
+      "id": "example-bucket",
+      "selfLink": "https://www.googleapis.com/storage/v1/b/example-bucket",
+      "projectNumber": "123456789012",
+      "name": "example-bucket",
+      "timeCreated": "2020-06-23T18:37:11.146Z",
+      "updated": "2020-06-24T09:12:42.301Z",
+      "metageneration": "2",
+      "iamConfiguration": {
+        "bucketPolicyOnly": {"enabled": true, "lockedTime": "2020-09-21T18:37:11.146Z"},
+        "uniformBucketLevelAccess": {"enabled": true, "lockedTime": "2020-09-21T18:37:11.146Z"},
+        "publicAccessPrevention": "enforced"
+      },
+      "encryption": {
+        "defaultKmsKeyName": "projects/example-project/locations/us/keyRings/example/cryptoKeys/storage"
+      },
+      "location": "US",
+      "locationType": "multi-region",
+      "logging": {"logBucket": "example-logs", "logObjectPrefix": "example-bucket/"},
+      "versioning": {"enabled": true},
+      "labels": {"team": "platform"},
+      "storageClass": "STANDARD",
+      "etag": "CAI="
+    }
+  ]
+}`
+
+	ExampleBucketIamPolicy = `{
+  "kind": "storage#policy",
+  "resourceId": "projects/_/buckets/example-bucket",
+  "version": 1,
+  "etag": "CAI=",
+  "bindings": [
+    {
+      "role": "roles/storage.legacyBucketOwner",
+      "members": ["projectEditor:example-project", "projectOwner:example-project"]
+    },
+    {
+      "role": "roles/storage.objectViewer",
+      "members": ["allUsers"]
+    }
+  ]
+}`
+)
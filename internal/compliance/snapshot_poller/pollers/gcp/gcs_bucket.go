@@ -0,0 +1,156 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	apimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+)
+
+// storageBucket is a bucket as returned by the Cloud Storage JSON API
+type storageBucket struct {
+	DefaultEventBasedHold *bool                                `json:"defaultEventBasedHold"`
+	Encryption            *gcpmodels.GcsBucketEncryption       `json:"encryption"`
+	IamConfiguration      *gcpmodels.GcsBucketIamConfiguration `json:"iamConfiguration"`
+	ID                    *string                              `json:"id"`
+	Labels                map[string]*string                   `json:"labels"`
+	Location              *string                              `json:"location"`
+	LocationType          *string                              `json:"locationType"`
+	Logging               *gcpmodels.GcsBucketLogging          `json:"logging"`
+	Name                  *string                              `json:"name"`
+	ProjectNumber         *string                              `json:"projectNumber"`
+	RetentionPolicy       *gcpmodels.GcsBucketRetentionPolicy  `json:"retentionPolicy"`
+	StorageClass          *string                              `json:"storageClass"`
+	TimeCreated           *strfmt.DateTime                     `json:"timeCreated"`
+	Updated               *strfmt.DateTime                     `json:"updated"`
+	Versioning            *gcpmodels.GcsBucketVersioning       `json:"versioning"`
+}
+
+// listBuckets returns all Cloud Storage buckets in a project
+func listBuckets(client *Client, projectID string) (buckets []*storageBucket, err error) {
+	query := url.Values{"project": {projectID}}
+	for {
+		var page struct {
+			Items         []*storageBucket `json:"items"`
+			NextPageToken string           `json:"nextPageToken"`
+		}
+		if err = client.get(serviceURL("storage", "/storage/v1/b"), query, &page); err != nil {
+			return nil, errors.Wrap(err, "Storage.Buckets.List")
+		}
+		buckets = append(buckets, page.Items...)
+		if page.NextPageToken == "" {
+			return buckets, nil
+		}
+		query.Set("pageToken", page.NextPageToken)
+	}
+}
+
+// getBucketIamBindings returns the role bindings of the IAM policy of a bucket
+func getBucketIamBindings(client *Client, bucketName string) ([]*gcpmodels.IamBinding, error) {
+	// Version 3 is required to receive conditional role bindings
+	query := url.Values{"optionsRequestedPolicyVersion": {"3"}}
+	var policy struct {
+		Bindings []*gcpmodels.IamBinding `json:"bindings"`
+	}
+	if err := client.get(serviceURL("storage", "/storage/v1/b/%s/iam", bucketName), query, &policy); err != nil {
+		return nil, errors.Wrap(err, "Storage.Buckets.GetIamPolicy")
+	}
+	return policy.Bindings, nil
+}
+
+// buildGcsBucketSnapshot returns a complete snapshot of a Cloud Storage bucket
+func buildGcsBucketSnapshot(client *Client, bucket *storageBucket) *gcpmodels.GcsBucket {
+	name := aws.StringValue(bucket.Name)
+	snapshot := &gcpmodels.GcsBucket{
+		GenericResource: gcpmodels.GenericResource{
+			ResourceID:   aws.String(gcsBucketResourceID(name)),
+			ResourceType: aws.String(gcpmodels.GcsBucketSchema),
+			TimeCreated:  bucket.TimeCreated,
+		},
+		GenericGCPResource: gcpmodels.GenericGCPResource{
+			ID:     bucket.ID,
+			Name:   bucket.Name,
+			Region: aws.String(strings.ToLower(aws.StringValue(bucket.Location))),
+			Labels: bucket.Labels,
+		},
+		DefaultEventBasedHold: bucket.DefaultEventBasedHold,
+		Encryption:            bucket.Encryption,
+		IamConfiguration:      bucket.IamConfiguration,
+		LocationType:          bucket.LocationType,
+		Logging:               bucket.Logging,
+		ProjectNumber:         bucket.ProjectNumber,
+		RetentionPolicy:       bucket.RetentionPolicy,
+		StorageClass:          bucket.StorageClass,
+		Updated:               bucket.Updated,
+		Versioning:            bucket.Versioning,
+	}
+
+	bindings, err := getBucketIamBindings(client, name)
+	if err != nil {
+		// The bucket is still reported, policies can detect the missing bindings
+		zap.L().Warn("failed to get bucket IAM policy", zap.String("bucket", name), zap.Error(err))
+	} else {
+		snapshot.IamBindings = bindings
+	}
+
+	return snapshot
+}
+
+// gcsBucketResourceID returns the full resource name of a bucket, which is unique across projects
+func gcsBucketResourceID(name string) string {
+	return "//storage.googleapis.com/" + name
+}
+
+// PollGcsBuckets gathers information on each Cloud Storage bucket of a GCP project.
+func PollGcsBuckets(pollerInput *gcpmodels.ResourcePollerInput) ([]*apimodels.AddResourceEntry, error) {
+	zap.L().Debug("starting GCS Bucket resource poller")
+	client, err := getClient(pollerInput)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets, err := listBuckets(client, *pollerInput.ProjectID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "PollGcsBuckets(%s)", *pollerInput.ProjectID)
+	}
+
+	resources := make([]*apimodels.AddResourceEntry, 0, len(buckets))
+	for _, bucket := range buckets {
+		snapshot := buildGcsBucketSnapshot(client, bucket)
+		snapshot.ProjectID = pollerInput.ProjectID
+
+		resources = append(resources, &apimodels.AddResourceEntry{
+			Attributes:      snapshot,
+			ID:              apimodels.ResourceID(*snapshot.ResourceID),
+			IntegrationID:   apimodels.IntegrationID(*pollerInput.IntegrationID),
+			IntegrationType: apimodels.IntegrationTypeGcp,
+			Type:            gcpmodels.GcsBucketSchema,
+		})
+	}
+
+	return resources, nil
+}
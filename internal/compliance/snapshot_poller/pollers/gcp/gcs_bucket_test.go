@@ -0,0 +1,99 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/gcp/gcptest"
+)
+
+func TestGcsListBucketsPages(t *testing.T) {
+	server := setupFakeServer(t)
+	server.HandleFunc(http.MethodGet, "/storage/storage/v1/b", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, gcptest.ExampleProjectID, r.URL.Query().Get("project"))
+		if r.URL.Query().Get("pageToken") == "" {
+			_, _ = w.Write([]byte(`{"items": [{"name": "first-bucket"}], "nextPageToken": "page-2"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"items": [{"name": "second-bucket"}]}`))
+	})
+	client, err := getClient(testPollerInput)
+	require.NoError(t, err)
+
+	buckets, err := listBuckets(client, gcptest.ExampleProjectID)
+	require.NoError(t, err)
+	require.Len(t, buckets, 2)
+	assert.Equal(t, "second-bucket", *buckets[1].Name)
+}
+
+func TestGcsListBucketsError(t *testing.T) {
+	server := setupFakeServer(t)
+	server.Handle(http.MethodGet, "/storage/storage/v1/b", http.StatusForbidden,
+		`{"error": {"code": 403, "message": "caller does not have storage.buckets.list access", "status": "PERMISSION_DENIED"}}`)
+	client, err := getClient(testPollerInput)
+	require.NoError(t, err)
+
+	buckets, err := listBuckets(client, gcptest.ExampleProjectID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "storage.buckets.list")
+	assert.Nil(t, buckets)
+}
+
+func TestGcsBuildBucketSnapshotIamError(t *testing.T) {
+	setupFakeServer(t)
+	client, err := getClient(testPollerInput)
+	require.NoError(t, err)
+
+	// The IAM policy is not registered on the fake server, the bucket is still reported
+	snapshot := buildGcsBucketSnapshot(client, &storageBucket{Name: aws.String("example-bucket")})
+	require.NotNil(t, snapshot)
+	assert.Equal(t, "//storage.googleapis.com/example-bucket", *snapshot.ResourceID)
+	assert.Nil(t, snapshot.IamBindings)
+}
+
+func TestPollGcsBuckets(t *testing.T) {
+	server := setupFakeServer(t)
+	server.Handle(http.MethodGet, "/storage/storage/v1/b", http.StatusOK, gcptest.ExampleBucketsList)
+	server.Handle(http.MethodGet, "/storage/storage/v1/b/example-bucket/iam", http.StatusOK, gcptest.ExampleBucketIamPolicy)
+
+	resources, err := PollGcsBuckets(testPollerInput)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "//storage.googleapis.com/example-bucket", string(resources[0].ID))
+	assert.Equal(t, "gcp", string(resources[0].IntegrationType))
+	assert.Equal(t, gcpmodels.GcsBucketSchema, string(resources[0].Type))
+
+	bucket := resources[0].Attributes.(*gcpmodels.GcsBucket)
+	assert.Equal(t, gcptest.ExampleProjectID, *bucket.ProjectID)
+	assert.Equal(t, "us", *bucket.Region)
+	assert.Equal(t, "platform", *bucket.Labels["team"])
+	assert.True(t, *bucket.Versioning.Enabled)
+	assert.True(t, *bucket.IamConfiguration.UniformBucketLevelAccess.Enabled)
+	assert.Equal(t, "example-logs", *bucket.Logging.LogBucket)
+	assert.NotNil(t, bucket.TimeCreated)
+	require.Len(t, bucket.IamBindings, 2)
+	assert.Equal(t, "allUsers", *bucket.IamBindings[1].Members[0])
+}
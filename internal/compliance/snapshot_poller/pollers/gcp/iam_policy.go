@@ -0,0 +1,93 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	apimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+)
+
+// iamPolicy is a project IAM policy as returned by the Resource Manager API
+type iamPolicy struct {
+	AuditConfigs []*gcpmodels.IamAuditConfig `json:"auditConfigs"`
+	Bindings     []*gcpmodels.IamBinding     `json:"bindings"`
+	Etag         *string                     `json:"etag"`
+	Version      *int64                      `json:"version"`
+}
+
+// getProjectIamPolicy returns the IAM policy of a project
+func getProjectIamPolicy(client *Client, projectID string) (*iamPolicy, error) {
+	// Version 3 is required to receive conditional role bindings
+	request := map[string]interface{}{
+		"options": map[string]int{"requestedPolicyVersion": 3},
+	}
+	var policy iamPolicy
+	err := client.post(serviceURL("cloudresourcemanager", "/v1/projects/%s:getIamPolicy", projectID), request, &policy)
+	if err != nil {
+		return nil, errors.Wrap(err, "ResourceManager.Projects.GetIamPolicy")
+	}
+	return &policy, nil
+}
+
+// buildIamPolicySnapshot returns a complete snapshot of the IAM policy of a project
+func buildIamPolicySnapshot(projectID string, policy *iamPolicy) *gcpmodels.IamPolicy {
+	return &gcpmodels.IamPolicy{
+		GenericResource: gcpmodels.GenericResource{
+			ResourceID:   aws.String("//cloudresourcemanager.googleapis.com/projects/" + projectID),
+			ResourceType: aws.String(gcpmodels.IamPolicySchema),
+		},
+		GenericGCPResource: gcpmodels.GenericGCPResource{
+			Name:   aws.String(projectID),
+			Region: aws.String(gcpmodels.GlobalRegion),
+		},
+		AuditConfigs: policy.AuditConfigs,
+		Bindings:     policy.Bindings,
+		Etag:         policy.Etag,
+		Version:      policy.Version,
+	}
+}
+
+// PollIamPolicies gathers the IAM policy of a GCP project.
+func PollIamPolicies(pollerInput *gcpmodels.ResourcePollerInput) ([]*apimodels.AddResourceEntry, error) {
+	zap.L().Debug("starting IAM Policy resource poller")
+	client, err := getClient(pollerInput)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := getProjectIamPolicy(client, *pollerInput.ProjectID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "PollIamPolicies(%s)", *pollerInput.ProjectID)
+	}
+
+	snapshot := buildIamPolicySnapshot(*pollerInput.ProjectID, policy)
+	snapshot.ProjectID = pollerInput.ProjectID
+
+	return []*apimodels.AddResourceEntry{{
+		Attributes:      snapshot,
+		ID:              apimodels.ResourceID(*snapshot.ResourceID),
+		IntegrationID:   apimodels.IntegrationID(*pollerInput.IntegrationID),
+		IntegrationType: apimodels.IntegrationTypeGcp,
+		Type:            gcpmodels.IamPolicySchema,
+	}}, nil
+}
@@ -0,0 +1,68 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/gcp/gcptest"
+)
+
+func TestPollIamPolicies(t *testing.T) {
+	server := setupFakeServer(t)
+	server.HandleFunc(http.MethodPost, "/cloudresourcemanager/v1/projects/example-project:getIamPolicy",
+		func(w http.ResponseWriter, r *http.Request) {
+			var request struct {
+				Options struct {
+					RequestedPolicyVersion int `json:"requestedPolicyVersion"`
+				} `json:"options"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			assert.Equal(t, 3, request.Options.RequestedPolicyVersion)
+			_, _ = w.Write([]byte(gcptest.ExampleProjectIamPolicy))
+		})
+
+	resources, err := PollIamPolicies(testPollerInput)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "//cloudresourcemanager.googleapis.com/projects/example-project", string(resources[0].ID))
+	assert.Equal(t, gcpmodels.IamPolicySchema, string(resources[0].Type))
+
+	policy := resources[0].Attributes.(*gcpmodels.IamPolicy)
+	assert.Equal(t, gcptest.ExampleProjectID, *policy.ProjectID)
+	assert.Equal(t, int64(3), *policy.Version)
+	require.Len(t, policy.Bindings, 2)
+	assert.Equal(t, "roles/owner", *policy.Bindings[0].Role)
+	assert.Equal(t, "expires_2021", *policy.Bindings[1].Condition.Title)
+	assert.Equal(t, "DATA_READ", *policy.AuditConfigs[0].AuditLogConfigs[1].LogType)
+}
+
+func TestPollIamPoliciesError(t *testing.T) {
+	setupFakeServer(t)
+
+	resources, err := PollIamPolicies(testPollerInput)
+	require.Error(t, err)
+	assert.Nil(t, resources)
+}
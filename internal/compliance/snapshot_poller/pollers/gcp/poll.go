@@ -0,0 +1,110 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	resourcesapimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+	pollermodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/poller"
+)
+
+// resourcePoller is a simple struct to be used only for invoking the ResourcePollers in order.
+type resourcePoller struct {
+	description    string
+	resourcePoller gcpmodels.ResourcePoller
+}
+
+// ServicePollers maps a resource type to its Poll function
+var ServicePollers = map[string]resourcePoller{
+	gcpmodels.ComputeFirewallSchema: {"ComputeFirewall", PollComputeFirewalls},
+	gcpmodels.ComputeInstanceSchema: {"ComputeInstance", PollComputeInstances},
+	gcpmodels.GcsBucketSchema:       {"GCSBucket", PollGcsBuckets},
+	gcpmodels.IamPolicySchema:       {"IAMPolicy", PollIamPolicies},
+	gcpmodels.SQLInstanceSchema:     {"SQLInstance", PollSQLInstances},
+}
+
+// Poll coordinates GCP resource gathering across all relevant resources for compliance monitoring.
+//
+// Only project wide scans are supported, GCP projects are not scanned in response to change events.
+func Poll(scanRequest *pollermodels.ScanEntry) ([]*resourcesapimodels.AddResourceEntry, error) {
+	if scanRequest.GCPProjectID == nil {
+		return nil, errors.New("no valid GCP project ID provided")
+	}
+	if scanRequest.ResourceID != nil {
+		return nil, errors.New("single resource scans are not supported for GCP projects")
+	}
+
+	pollerResourceInput := &gcpmodels.ResourcePollerInput{
+		IntegrationID: scanRequest.IntegrationID,
+		ProjectID:     scanRequest.GCPProjectID,
+	}
+
+	// Full project scan
+	if aws.BoolValue(scanRequest.ScanAllResources) {
+		zap.L().Info("processing full project scan")
+		allPollers := make([]resourcePoller, 0, len(ServicePollers))
+		for _, poller := range ServicePollers {
+			allPollers = append(allPollers, poller)
+		}
+		return serviceScan(allPollers, pollerResourceInput)
+
+		// Project wide resource type scan
+	} else if scanRequest.ResourceType != nil {
+		zap.L().Info("processing full project resource type scan")
+		poller, ok := ServicePollers[*scanRequest.ResourceType]
+		if !ok {
+			return nil, errors.Errorf("invalid resource type '%s' scan requested", *scanRequest.ResourceType)
+		}
+		return serviceScan([]resourcePoller{poller}, pollerResourceInput)
+	}
+
+	zap.L().Error("Invalid scan request input")
+	return nil, nil
+}
+
+func serviceScan(
+	pollers []resourcePoller,
+	pollerInput *gcpmodels.ResourcePollerInput,
+) (generatedEvents []*resourcesapimodels.AddResourceEntry, err error) {
+
+	var generatedResources []*resourcesapimodels.AddResourceEntry
+	for _, resourcePoller := range pollers {
+		generatedResources, err = resourcePoller.resourcePoller(pollerInput)
+		if err != nil {
+			zap.L().Error(
+				"an error occurred while polling",
+				zap.String("resourcePoller", resourcePoller.description),
+				zap.String("errorMessage", err.Error()),
+			)
+			return
+		} else if generatedResources != nil {
+			zap.L().Info(
+				"resources generated",
+				zap.Int("numResources", len(generatedResources)),
+				zap.String("resourcePoller", resourcePoller.description),
+			)
+			generatedEvents = append(generatedEvents, generatedResources...)
+		}
+	}
+	return
+}
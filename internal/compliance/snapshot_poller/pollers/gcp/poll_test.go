@@ -0,0 +1,93 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+	pollermodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/poller"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/gcp/gcptest"
+)
+
+func TestPollNoProject(t *testing.T) {
+	resources, err := Poll(&pollermodels.ScanEntry{
+		AWSAccountID:  aws.String("123456789012"),
+		IntegrationID: aws.String(testIntegrationID),
+		ResourceType:  aws.String(gcpmodels.GcsBucketSchema),
+	})
+	require.Error(t, err)
+	assert.Nil(t, resources)
+}
+
+func TestPollInvalidResourceType(t *testing.T) {
+	resources, err := Poll(&pollermodels.ScanEntry{
+		GCPProjectID:  aws.String(gcptest.ExampleProjectID),
+		IntegrationID: aws.String(testIntegrationID),
+		ResourceType:  aws.String("AWS.S3.Bucket"),
+	})
+	require.Error(t, err)
+	assert.Nil(t, resources)
+}
+
+func TestPollResourceType(t *testing.T) {
+	server := setupFakeServer(t)
+	server.Handle(http.MethodGet, "/compute/compute/v1/projects/example-project/global/firewalls",
+		http.StatusOK, gcptest.ExampleFirewallsList)
+
+	resources, err := Poll(&pollermodels.ScanEntry{
+		GCPProjectID:  aws.String(gcptest.ExampleProjectID),
+		IntegrationID: aws.String(testIntegrationID),
+		ResourceType:  aws.String(gcpmodels.ComputeFirewallSchema),
+	})
+	require.NoError(t, err)
+	assert.Len(t, resources, 2)
+	assert.Equal(t, []string{"GET /compute/compute/v1/projects/example-project/global/firewalls"}, server.Requests())
+}
+
+func TestPollAllResources(t *testing.T) {
+	server := setupFakeServer(t)
+	server.Handle(http.MethodGet, "/storage/storage/v1/b", http.StatusOK, gcptest.ExampleBucketsList)
+	server.Handle(http.MethodGet, "/storage/storage/v1/b/example-bucket/iam", http.StatusOK, gcptest.ExampleBucketIamPolicy)
+	server.Handle(http.MethodGet, instancesPath, http.StatusOK, gcptest.ExampleInstancesAggregatedList)
+	server.Handle(http.MethodGet, "/compute/compute/v1/projects/example-project/global/firewalls",
+		http.StatusOK, gcptest.ExampleFirewallsList)
+	server.Handle(http.MethodPost, "/cloudresourcemanager/v1/projects/example-project:getIamPolicy",
+		http.StatusOK, gcptest.ExampleProjectIamPolicy)
+	server.Handle(http.MethodGet, "/sqladmin/sql/v1beta4/projects/example-project/instances",
+		http.StatusOK, gcptest.ExampleSQLInstancesList)
+
+	resources, err := Poll(&pollermodels.ScanEntry{
+		GCPProjectID:     aws.String(gcptest.ExampleProjectID),
+		IntegrationID:    aws.String(testIntegrationID),
+		ScanAllResources: aws.Bool(true),
+	})
+	require.NoError(t, err)
+	// 1 bucket, 1 instance, 2 firewalls, 1 project policy and 1 database
+	assert.Len(t, resources, 6)
+	for _, resource := range resources {
+		assert.Equal(t, testIntegrationID, string(resource.IntegrationID))
+		assert.NoError(t, resource.IntegrationType.Validate(nil))
+	}
+}
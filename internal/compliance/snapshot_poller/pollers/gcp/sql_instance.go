@@ -0,0 +1,135 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	apimodels "github.com/panther-labs/panther/api/gateway/resources/models"
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+)
+
+// sqlInstance is a database instance as returned by the Cloud SQL Admin API
+type sqlInstance struct {
+	BackendType                 *string                                   `json:"backendType"`
+	ConnectionName              *string                                   `json:"connectionName"`
+	CreateTime                  *strfmt.DateTime                          `json:"createTime"`
+	DatabaseVersion             *string                                   `json:"databaseVersion"`
+	DiskEncryptionConfiguration *gcpmodels.SQLDiskEncryptionConfiguration `json:"diskEncryptionConfiguration"`
+	GceZone                     *string                                   `json:"gceZone"`
+	InstanceType                *string                                   `json:"instanceType"`
+	IPAddresses                 []*gcpmodels.SQLIPMapping                 `json:"ipAddresses"`
+	Name                        *string                                   `json:"name"`
+	Region                      *string                                   `json:"region"`
+	ServiceAccountEmailAddress  *string                                   `json:"serviceAccountEmailAddress"`
+	Settings                    *sqlSettings                              `json:"settings"`
+	State                       *string                                   `json:"state"`
+}
+
+// sqlSettings adds the labels, which are reported as generic resource fields, to the instance settings
+type sqlSettings struct {
+	gcpmodels.SQLSettings
+	UserLabels map[string]*string `json:"userLabels"`
+}
+
+// listSQLInstances returns all Cloud SQL instances in a project
+func listSQLInstances(client *Client, projectID string) (instances []*sqlInstance, err error) {
+	query := url.Values{}
+	for {
+		var page struct {
+			Items         []*sqlInstance `json:"items"`
+			NextPageToken string         `json:"nextPageToken"`
+		}
+		err = client.get(serviceURL("sqladmin", "/sql/v1beta4/projects/%s/instances", projectID), query, &page)
+		if err != nil {
+			return nil, errors.Wrap(err, "SQLAdmin.Instances.List")
+		}
+		instances = append(instances, page.Items...)
+		if page.NextPageToken == "" {
+			return instances, nil
+		}
+		query.Set("pageToken", page.NextPageToken)
+	}
+}
+
+// buildSQLInstanceSnapshot returns a complete snapshot of a Cloud SQL instance
+func buildSQLInstanceSnapshot(projectID string, instance *sqlInstance) *gcpmodels.SQLInstance {
+	snapshot := &gcpmodels.SQLInstance{
+		GenericResource: gcpmodels.GenericResource{
+			ResourceID: aws.String("//cloudsql.googleapis.com/projects/" + projectID +
+				"/instances/" + aws.StringValue(instance.Name)),
+			ResourceType: aws.String(gcpmodels.SQLInstanceSchema),
+			TimeCreated:  instance.CreateTime,
+		},
+		GenericGCPResource: gcpmodels.GenericGCPResource{
+			Name:   instance.Name,
+			Region: instance.Region,
+		},
+		BackendType:                 instance.BackendType,
+		ConnectionName:              instance.ConnectionName,
+		DatabaseVersion:             instance.DatabaseVersion,
+		DiskEncryptionConfiguration: instance.DiskEncryptionConfiguration,
+		GceZone:                     instance.GceZone,
+		InstanceType:                instance.InstanceType,
+		IPAddresses:                 instance.IPAddresses,
+		ServiceAccountEmailAddress:  instance.ServiceAccountEmailAddress,
+		State:                       instance.State,
+	}
+	if instance.Settings != nil {
+		snapshot.Settings = &instance.Settings.SQLSettings
+		snapshot.Labels = instance.Settings.UserLabels
+	}
+
+	return snapshot
+}
+
+// PollSQLInstances gathers information on each Cloud SQL instance of a GCP project.
+func PollSQLInstances(pollerInput *gcpmodels.ResourcePollerInput) ([]*apimodels.AddResourceEntry, error) {
+	zap.L().Debug("starting SQL Instance resource poller")
+	client, err := getClient(pollerInput)
+	if err != nil {
+		return nil, err
+	}
+
+	instances, err := listSQLInstances(client, *pollerInput.ProjectID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "PollSQLInstances(%s)", *pollerInput.ProjectID)
+	}
+
+	resources := make([]*apimodels.AddResourceEntry, 0, len(instances))
+	for _, instance := range instances {
+		snapshot := buildSQLInstanceSnapshot(*pollerInput.ProjectID, instance)
+		snapshot.ProjectID = pollerInput.ProjectID
+
+		resources = append(resources, &apimodels.AddResourceEntry{
+			Attributes:      snapshot,
+			ID:              apimodels.ResourceID(*snapshot.ResourceID),
+			IntegrationID:   apimodels.IntegrationID(*pollerInput.IntegrationID),
+			IntegrationType: apimodels.IntegrationTypeGcp,
+			Type:            gcpmodels.SQLInstanceSchema,
+		})
+	}
+
+	return resources, nil
+}
@@ -0,0 +1,63 @@
+package gcp
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gcpmodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/gcp"
+	"github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/gcp/gcptest"
+)
+
+func TestPollSQLInstances(t *testing.T) {
+	server := setupFakeServer(t)
+	server.Handle(http.MethodGet, "/sqladmin/sql/v1beta4/projects/example-project/instances",
+		http.StatusOK, gcptest.ExampleSQLInstancesList)
+
+	resources, err := PollSQLInstances(testPollerInput)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "//cloudsql.googleapis.com/projects/example-project/instances/example-db", string(resources[0].ID))
+	assert.Equal(t, gcpmodels.SQLInstanceSchema, string(resources[0].Type))
+
+	instance := resources[0].Attributes.(*gcpmodels.SQLInstance)
+	assert.Equal(t, "us-central1", *instance.Region)
+	assert.Equal(t, "data", *instance.Labels["team"])
+	assert.Equal(t, "POSTGRES_12", *instance.DatabaseVersion)
+	assert.Equal(t, "203.0.113.20", *instance.IPAddresses[0].IPAddress)
+	assert.False(t, *instance.Settings.IPConfiguration.RequireSsl)
+	assert.True(t, *instance.Settings.IPConfiguration.Ipv4Enabled)
+	assert.Equal(t, "0.0.0.0/0", *instance.Settings.IPConfiguration.AuthorizedNetworks[0].Value)
+	assert.True(t, *instance.Settings.BackupConfiguration.Enabled)
+	assert.Equal(t, "log_connections", *instance.Settings.DatabaseFlags[0].Name)
+	assert.NotNil(t, instance.TimeCreated)
+}
+
+func TestPollSQLInstancesEmpty(t *testing.T) {
+	server := setupFakeServer(t)
+	server.Handle(http.MethodGet, "/sqladmin/sql/v1beta4/projects/example-project/instances", http.StatusOK, `{}`)
+
+	resources, err := PollSQLInstances(testPollerInput)
+	require.NoError(t, err)
+	assert.Empty(t, resources)
+}
@@ -31,6 +31,7 @@ import (
 	api "github.com/panther-labs/panther/api/gateway/resources/models"
 	pollermodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/poller"
 	pollers "github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/aws"
+	gcppollers "github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/gcp"
 	"github.com/panther-labs/panther/pkg/lambdalogger"
 	"github.com/panther-labs/panther/pkg/oplog"
 )
@@ -80,23 +81,34 @@ func Handle(ctx context.Context, event events.SQSEvent) (err error) {
 		}
 
 		for _, entry := range scanRequest.Entries {
+			integrationType, poll := "aws", pollers.Poll
+			if entry.GCPProjectID != nil {
+				integrationType, poll = "gcp", gcppollers.Poll
+			}
 			zap.L().Debug("starting poller",
 				zap.Any("sqsEntry", entry),
 				zap.Int("messageNumber", indx),
-				zap.String("integrationType", "aws"))
+				zap.String("integrationType", integrationType))
 
-			resources, pollErr := pollers.Poll(entry)
+			resources, pollErr := poll(entry)
 			if pollErr != nil {
 				operation.LogError(errors.Wrap(pollErr, "poll failed"), zap.Any("sqsEntry", entry))
 				continue
 			}
 
+			// Record which CloudTrail event (if any) triggered this scan in the resource history
+			if entry.ChangeEvent != nil {
+				for _, resource := range resources {
+					resource.ChangeEvent = entry.ChangeEvent
+				}
+			}
+
 			// Send data to the Resources API
 			if resources != nil {
 				zap.L().Debug("total resources generated",
 					zap.Int("messageNumber", indx),
 					zap.Int("numResources", len(resources)),
-					zap.String("integrationType", "aws"),
+					zap.String("integrationType", integrationType),
 				)
 
 				for _, batch := range batchResources(resources) {
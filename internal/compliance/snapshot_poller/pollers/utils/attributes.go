@@ -0,0 +1,51 @@
+package utils
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import "strconv"
+
+// Services such as SQS and SNS describe their resources as a map of string attributes. These
+// helpers convert individual attributes to typed values, returning nil if the attribute is
+// missing or cannot be parsed.
+
+// Int64Attribute returns the named attribute as an int64
+func Int64Attribute(attributes map[string]*string, name string) *int64 {
+	value, ok := attributes[name]
+	if !ok || value == nil {
+		return nil
+	}
+	parsed, err := strconv.ParseInt(*value, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
+
+// BoolAttribute returns the named attribute as a bool
+func BoolAttribute(attributes map[string]*string, name string) *bool {
+	value, ok := attributes[name]
+	if !ok || value == nil {
+		return nil
+	}
+	parsed, err := strconv.ParseBool(*value)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
@@ -0,0 +1,50 @@
+package utils
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInt64Attribute(t *testing.T) {
+	attributes := map[string]*string{
+		"DelaySeconds": aws.String("30"),
+		"Policy":       aws.String("{}"),
+		"Empty":        nil,
+	}
+
+	assert.Equal(t, aws.Int64(30), Int64Attribute(attributes, "DelaySeconds"))
+	assert.Nil(t, Int64Attribute(attributes, "Policy"))
+	assert.Nil(t, Int64Attribute(attributes, "Empty"))
+	assert.Nil(t, Int64Attribute(attributes, "Missing"))
+}
+
+func TestBoolAttribute(t *testing.T) {
+	attributes := map[string]*string{
+		"FifoQueue": aws.String("true"),
+		"Policy":    aws.String("{}"),
+	}
+
+	assert.Equal(t, aws.Bool(true), BoolAttribute(attributes, "FifoQueue"))
+	assert.Nil(t, BoolAttribute(attributes, "Policy"))
+	assert.Nil(t, BoolAttribute(attributes, "Missing"))
+}
@@ -71,18 +71,27 @@ func PollAndIssueNewScans() error {
 	)
 }
 
-// getEnabledIntegrations lists enabled integrations from the snapshot-api.
-func getEnabledIntegrations() (integrations []*models.SourceIntegration, err error) {
-	err = genericapi.Invoke(
+// getEnabledIntegrations lists the AWS and GCP scan integrations from the snapshot-api.
+func getEnabledIntegrations() ([]*models.SourceIntegration, error) {
+	var allIntegrations []*models.SourceIntegration
+	err := genericapi.Invoke(
 		lambdaClient,
 		sourceAPIFunctionName,
-		&models.LambdaInput{ListIntegrations: &models.ListIntegrationsInput{
-			IntegrationType: aws.String("aws-scan"),
-		}},
-		&integrations,
+		&models.LambdaInput{ListIntegrations: &models.ListIntegrationsInput{}},
+		&allIntegrations,
 	)
+	if err != nil {
+		return nil, err
+	}
 
-	return
+	var integrations []*models.SourceIntegration
+	for _, integration := range allIntegrations {
+		switch aws.StringValue(integration.IntegrationType) {
+		case models.IntegrationTypeAWSScan, models.IntegrationTypeGCPScan:
+			integrations = append(integrations, integration)
+		}
+	}
+	return integrations, nil
 }
 
 // scanIsStuck checks if an integration's is stuck in the "scanning" state.
@@ -57,9 +57,7 @@ func (client *mockLambdaClient) Invoke(input *lambda.InvokeInput) (*lambda.Invok
 // getTestInvokeInput returns an example Lambda.Invoke input for the SnapshotAPI.
 func getTestInvokeInput() *lambda.InvokeInput {
 	input := &models.LambdaInput{
-		ListIntegrations: &models.ListIntegrationsInput{
-			IntegrationType: aws.String("aws-scan"),
-		},
+		ListIntegrations: &models.ListIntegrationsInput{},
 	}
 	payload, err := jsoniter.Marshal(input)
 	if err != nil {
@@ -23,8 +23,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/stretchr/testify/mock"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 	"github.com/panther-labs/panther/internal/core/alert_delivery/outputs"
 )
 
@@ -33,8 +33,10 @@ type mockOutputsClient struct {
 	mock.Mock
 }
 
-func (m *mockOutputsClient) Slack(alert *alertmodels.Alert, config *outputmodels.SlackConfig) *outputs.AlertDeliveryError {
-	args := m.Called(alert, config)
+func (m *mockOutputsClient) Slack(
+	alert *alertmodels.Alert, config *outputmodels.SlackConfig, message string) *outputs.AlertDeliveryError {
+
+	args := m.Called(alert, config, message)
 	return args.Get(0).(*outputs.AlertDeliveryError)
 }
 
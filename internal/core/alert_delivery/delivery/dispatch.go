@@ -19,10 +19,14 @@ package delivery
  */
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"go.uber.org/zap"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 	"github.com/panther-labs/panther/internal/core/alert_delivery/outputs"
 )
 
@@ -31,6 +35,10 @@ type outputStatus struct {
 	outputID   string
 	success    bool
 	needsRetry bool
+	// errorMessage describes why the alert could not be sent
+	errorMessage string
+	// statusCode is the HTTP status code returned by a failed output, if any
+	statusCode int
 }
 
 // Send an alert to one specific output (run as a child goroutine).
@@ -46,7 +54,9 @@ func send(alert *alertmodels.Alert, output *outputmodels.AlertOutput, statusChan
 		// Otherwise, the main routine will wait forever for this to finish.
 		if r := recover(); r != nil {
 			zap.L().Error("panic sending alert", append(commonFields, zap.Any("panic", r))...)
-			statusChannel <- outputStatus{outputID: *output.OutputID, success: false, needsRetry: false}
+			statusChannel <- outputStatus{
+				outputID: *output.OutputID, success: false, needsRetry: false,
+				errorMessage: fmt.Sprintf("panic sending alert: %v", r)}
 		}
 	}()
 
@@ -55,37 +65,55 @@ func send(alert *alertmodels.Alert, output *outputmodels.AlertOutput, statusChan
 		append(commonFields, zap.String("name", *output.DisplayName))...,
 	)
 
+	// Render the user-defined message template, if any, which replaces the default message of the output
+	var message string
+	if aws.StringValue(output.MessageTemplate) != "" {
+		var err error
+		if message, err = outputs.RenderMessageTemplate(*output.MessageTemplate, alert); err != nil {
+			zap.L().Error("failed to render message template", append(commonFields, zap.Error(err))...)
+			statusChannel <- outputStatus{
+				outputID: *output.OutputID, success: false, needsRetry: false,
+				errorMessage: "failed to render message template: " + err.Error()}
+			return
+		}
+	}
+
 	var alertDeliveryError *outputs.AlertDeliveryError
 	switch *output.OutputType {
 	case "slack":
-		alertDeliveryError = outputClient.Slack(alert, output.OutputConfig.Slack)
+		alertDeliveryError = outputClient.Slack(alert, output.OutputConfig.Slack, message)
 	case "pagerduty":
-		alertDeliveryError = outputClient.PagerDuty(alert, output.OutputConfig.PagerDuty)
+		alertDeliveryError = outputClient.PagerDuty(alert, output.OutputConfig.PagerDuty, message)
 	case "github":
-		alertDeliveryError = outputClient.Github(alert, output.OutputConfig.Github)
+		alertDeliveryError = outputClient.Github(alert, output.OutputConfig.Github, message)
 	case "opsgenie":
-		alertDeliveryError = outputClient.Opsgenie(alert, output.OutputConfig.Opsgenie)
+		alertDeliveryError = outputClient.Opsgenie(alert, output.OutputConfig.Opsgenie, message)
 	case "jira":
-		alertDeliveryError = outputClient.Jira(alert, output.OutputConfig.Jira)
+		alertDeliveryError = outputClient.Jira(alert, output.OutputConfig.Jira, message)
 	case "msteams":
-		alertDeliveryError = outputClient.MsTeams(alert, output.OutputConfig.MsTeams)
+		alertDeliveryError = outputClient.MsTeams(alert, output.OutputConfig.MsTeams, message)
 	case "sqs":
-		alertDeliveryError = outputClient.Sqs(alert, output.OutputConfig.Sqs)
+		alertDeliveryError = outputClient.Sqs(alert, output.OutputConfig.Sqs, message)
 	case "sns":
-		alertDeliveryError = outputClient.Sns(alert, output.OutputConfig.Sns)
+		alertDeliveryError = outputClient.Sns(alert, output.OutputConfig.Sns, message)
 	case "asana":
-		alertDeliveryError = outputClient.Asana(alert, output.OutputConfig.Asana)
+		alertDeliveryError = outputClient.Asana(alert, output.OutputConfig.Asana, message)
 	case "customwebhook":
-		alertDeliveryError = outputClient.CustomWebhook(alert, output.OutputConfig.CustomWebhook)
+		alertDeliveryError = outputClient.CustomWebhook(alert, output.OutputConfig.CustomWebhook, message)
+	case "email":
+		alertDeliveryError = outputClient.Email(alert, output.OutputConfig.Email, message)
 	default:
 		zap.L().Warn("unsupported output type", commonFields...)
-		statusChannel <- outputStatus{outputID: *output.OutputID, success: false, needsRetry: false}
+		statusChannel <- outputStatus{
+			outputID: *output.OutputID, success: false, needsRetry: false,
+			errorMessage: "unsupported output type " + *output.OutputType}
 		return
 	}
 	if alertDeliveryError != nil {
 		zap.L().Warn("failed to send alert", append(commonFields, zap.Error(alertDeliveryError))...)
 		statusChannel <- outputStatus{
-			outputID: *output.OutputID, success: false, needsRetry: !alertDeliveryError.Permanent}
+			outputID: *output.OutputID, success: false, needsRetry: !alertDeliveryError.Permanent,
+			errorMessage: alertDeliveryError.Message, statusCode: alertDeliveryError.StatusCode}
 		return
 	}
 
@@ -95,8 +123,9 @@ func send(alert *alertmodels.Alert, output *outputmodels.AlertOutput, statusChan
 
 // Dispatch sends the alert to each of its designated outputs.
 //
-// Returns true if the alert was sent successfully, false if it needs to be retried.
-func dispatch(alert *alertmodels.Alert) bool {
+// Returns true if the alert was sent successfully, false if it needs to be retried,
+// along with the result of the delivery attempt for each output.
+func dispatch(alert *alertmodels.Alert) (bool, []*outputmodels.DeliveryAttempt) {
 	outputs, err := getAlertOutputs(alert)
 
 	if err != nil {
@@ -105,7 +134,7 @@ func dispatch(alert *alertmodels.Alert) bool {
 			zap.String("severity", alert.Severity),
 			zap.Error(err),
 		)
-		return false
+		return false, nil
 	}
 
 	if len(outputs) == 0 {
@@ -113,20 +142,38 @@ func dispatch(alert *alertmodels.Alert) bool {
 			zap.String("policyId", alert.AnalysisID),
 			zap.String("severity", alert.Severity),
 		)
-		return true
+		return true, nil
 	}
 
 	// Dispatch all outputs in parallel.
 	// This ensures one slow or failing output won't block the others.
 	statusChannel := make(chan outputStatus)
+	start := time.Now().UTC()
 	for _, output := range outputs {
 		go send(alert, output, statusChannel)
 	}
 
 	// Wait until all outputs have finished, gathering any that need to be retried.
+	// Since outputs are sent in parallel, the latency of each is the time until its status is received.
 	var retryOutputs []string
+	attempts := make([]*outputmodels.DeliveryAttempt, 0, len(outputs))
 	for range outputs {
 		status := <-statusChannel
+		attempt := &outputmodels.DeliveryAttempt{
+			OutputID:    aws.String(status.outputID),
+			AnalysisID:  alert.AnalysisID,
+			AlertID:     alert.AlertID,
+			Success:     status.success,
+			Permanent:   !status.success && !status.needsRetry,
+			StatusCode:  status.statusCode,
+			LatencyMs:   time.Since(start).Milliseconds(),
+			AttemptedAt: start,
+		}
+		if !status.success {
+			attempt.ErrorMessage = aws.String(status.errorMessage)
+		}
+		attempts = append(attempts, attempt)
+
 		if status.needsRetry {
 			retryOutputs = append(retryOutputs, status.outputID)
 		} else if !status.success {
@@ -139,8 +186,8 @@ func dispatch(alert *alertmodels.Alert) bool {
 
 	if len(retryOutputs) > 0 {
 		alert.OutputIDs = retryOutputs // Replace the outputs with the set that failed
-		return false
+		return false, attempts
 	}
 
-	return true
+	return true, attempts
 }
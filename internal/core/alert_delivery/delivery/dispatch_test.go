@@ -29,8 +29,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 	"github.com/panther-labs/panther/internal/core/alert_delivery/outputs"
 	"github.com/panther-labs/panther/pkg/box"
 )
@@ -66,11 +66,12 @@ func TestSendPanic(t *testing.T) {
 	outputClient = mockOutputsClient
 
 	ch := make(chan outputStatus, 1)
-	mockOutputsClient.On("Slack", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+	mockOutputsClient.On("Slack", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
 		panic("panicking")
 	})
 	go send(sampleAlert(), alertOutput, ch)
-	require.Equal(t, outputStatus{outputID: *alertOutput.OutputID}, <-ch)
+	require.Equal(t, outputStatus{
+		outputID: *alertOutput.OutputID, errorMessage: "panic sending alert: panicking"}, <-ch)
 	mockOutputsClient.AssertExpectations(t)
 }
 
@@ -80,8 +81,12 @@ func TestSendUnsupportedOutput(t *testing.T) {
 	setCaches()
 	ch := make(chan outputStatus, 1)
 
-	send(sampleAlert(), alertOutput, ch)
-	assert.Equal(t, outputStatus{outputID: *alertOutput.OutputID}, <-ch)
+	unsupportedOutput := *alertOutput
+	unsupportedOutput.OutputType = aws.String("carrier-pigeon")
+
+	send(sampleAlert(), &unsupportedOutput, ch)
+	assert.Equal(t, outputStatus{
+		outputID: *alertOutput.OutputID, errorMessage: "unsupported output type carrier-pigeon"}, <-ch)
 	mockClient.AssertExpectations(t)
 }
 
@@ -90,10 +95,10 @@ func TestSendTransientFailure(t *testing.T) {
 	outputClient = mockClient
 	setCaches()
 	ch := make(chan outputStatus, 1)
-	mockClient.On("Slack", mock.Anything, mock.Anything).Return(&outputs.AlertDeliveryError{})
+	mockClient.On("Slack", mock.Anything, mock.Anything, mock.Anything).Return(&outputs.AlertDeliveryError{Message: "timeout"})
 
 	send(sampleAlert(), alertOutput, ch)
-	assert.Equal(t, outputStatus{outputID: *alertOutput.OutputID, needsRetry: true}, <-ch)
+	assert.Equal(t, outputStatus{outputID: *alertOutput.OutputID, needsRetry: true, errorMessage: "timeout"}, <-ch)
 	mockClient.AssertExpectations(t)
 }
 
@@ -101,7 +106,7 @@ func TestSendSuccess(t *testing.T) {
 	mockClient := &mockOutputsClient{}
 	outputClient = mockClient
 	setCaches()
-	mockClient.On("Slack", mock.Anything, mock.Anything).Return((*outputs.AlertDeliveryError)(nil))
+	mockClient.On("Slack", mock.Anything, mock.Anything, mock.Anything).Return((*outputs.AlertDeliveryError)(nil))
 	ch := make(chan outputStatus, 1)
 
 	send(sampleAlert(), alertOutput, ch)
@@ -109,13 +114,69 @@ func TestSendSuccess(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+func TestSendMessageTemplate(t *testing.T) {
+	mockClient := &mockOutputsClient{}
+	outputClient = mockClient
+	templatedOutput := *alertOutput
+	templatedOutput.MessageTemplate = aws.String("{{.Name}} is {{.Severity}}")
+	mockClient.On("Slack", mock.Anything, mock.Anything, "test_rule_name is INFO").
+		Return((*outputs.AlertDeliveryError)(nil))
+	ch := make(chan outputStatus, 1)
+
+	send(sampleAlert(), &templatedOutput, ch)
+	assert.Equal(t, outputStatus{outputID: *alertOutput.OutputID, success: true}, <-ch)
+	mockClient.AssertExpectations(t)
+}
+
+func TestSendInvalidMessageTemplate(t *testing.T) {
+	mockClient := &mockOutputsClient{}
+	outputClient = mockClient
+	templatedOutput := *alertOutput
+	templatedOutput.MessageTemplate = aws.String("{{.NoSuchField}}")
+	ch := make(chan outputStatus, 1)
+
+	send(sampleAlert(), &templatedOutput, ch)
+	// Rendering errors are permanent, the output is not called
+	status := <-ch
+	assert.Equal(t, *alertOutput.OutputID, status.outputID)
+	assert.False(t, status.success)
+	assert.False(t, status.needsRetry)
+	assert.Contains(t, status.errorMessage, "failed to render message template")
+	mockClient.AssertExpectations(t)
+}
+
 func TestDispatchFailure(t *testing.T) {
 	mockClient := &mockOutputsClient{}
 	outputClient = mockClient
 	setCaches()
-	mockClient.On("Slack", mock.Anything, mock.Anything).Return(&outputs.AlertDeliveryError{})
+	mockClient.On("Slack", mock.Anything, mock.Anything, mock.Anything).Return(&outputs.AlertDeliveryError{Message: "timeout"})
+
+	success, attempts := dispatch(sampleAlert())
+	assert.False(t, success)
+	require.Len(t, attempts, 1)
+	assert.Equal(t, "output-id", *attempts[0].OutputID)
+	assert.Equal(t, "test-rule-id", attempts[0].AnalysisID)
+	assert.False(t, attempts[0].Success)
+	assert.Equal(t, "timeout", *attempts[0].ErrorMessage)
+	assert.False(t, attempts[0].Permanent)
+	mockClient.AssertExpectations(t)
+}
+
+func TestDispatchPermanentFailure(t *testing.T) {
+	mockClient := &mockOutputsClient{}
+	outputClient = mockClient
+	setCaches()
+	mockClient.On("Slack", mock.Anything, mock.Anything, mock.Anything).Return(
+		&outputs.AlertDeliveryError{Message: "invalid token", Permanent: true, StatusCode: 401})
 
-	assert.False(t, dispatch(sampleAlert()))
+	// The alert is not retried, but the failure is reported
+	success, attempts := dispatch(sampleAlert())
+	assert.True(t, success)
+	require.Len(t, attempts, 1)
+	assert.False(t, attempts[0].Success)
+	assert.Equal(t, "invalid token", *attempts[0].ErrorMessage)
+	assert.Equal(t, 401, attempts[0].StatusCode)
+	assert.True(t, attempts[0].Permanent)
 	mockClient.AssertExpectations(t)
 }
 
@@ -123,8 +184,14 @@ func TestDispatchSuccess(t *testing.T) {
 	mockClient := &mockOutputsClient{}
 	outputClient = mockClient
 	setCaches()
-	mockClient.On("Slack", mock.Anything, mock.Anything).Return((*outputs.AlertDeliveryError)(nil))
-	assert.True(t, dispatch(sampleAlert()))
+	mockClient.On("Slack", mock.Anything, mock.Anything, mock.Anything).Return((*outputs.AlertDeliveryError)(nil))
+	success, attempts := dispatch(sampleAlert())
+	assert.True(t, success)
+	require.Len(t, attempts, 1)
+	assert.True(t, attempts[0].Success)
+	assert.False(t, attempts[0].Permanent)
+	assert.Nil(t, attempts[0].ErrorMessage)
+	assert.False(t, attempts[0].AttemptedAt.IsZero())
 }
 
 func TestDispatchUseCachedDefault(t *testing.T) {
@@ -135,7 +202,8 @@ func TestDispatchUseCachedDefault(t *testing.T) {
 	alert := sampleAlert()
 	alert.OutputIDs = nil //Setting OutputIds in the alert to nil, in order to fetch default outputs
 
-	assert.True(t, dispatch(alert))
+	success, _ := dispatch(alert)
+	assert.True(t, success)
 	mockLambdaClient.AssertExpectations(t)
 }
 
@@ -160,7 +228,8 @@ func TestDispatchUseNonCachedDefault(t *testing.T) {
 	alert := sampleAlert()
 	alert.OutputIDs = nil //Setting OutputIds in the alert to nil, in order to fetch default outputs
 	cache = nil           // Setting cache to nil, so we fetch latest outputs IDs from Lambda
-	assert.True(t, dispatch(alert))
+	success, _ := dispatch(alert)
+	assert.True(t, success)
 	mockLambdaClient.AssertExpectations(t)
 }
 
@@ -190,6 +259,7 @@ func TestAllGoRoutinesShouldComplete(t *testing.T) {
 	alert.OutputIDs = nil //Setting OutputIds in the alert to nil, in order to fetch default outputs
 	cache = nil           // Clearing the default output ids cache
 
-	assert.True(t, dispatch(alert))
+	success, _ := dispatch(alert)
+	assert.True(t, success)
 	mockLambdaClient.AssertExpectations(t)
 }
@@ -0,0 +1,60 @@
+package delivery
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"go.uber.org/zap"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
+	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+// storeDeliveryFailures saves alerts which permanently failed in the outputs-api so they can be replayed.
+func storeDeliveryFailures(failures []*outputmodels.DeliveryFailure) {
+	zap.L().Warn("storing failed alert deliveries", zap.Int("failures", len(failures)))
+	input := outputmodels.LambdaInput{
+		AddDeliveryFailures: &outputmodels.AddDeliveryFailuresInput{Failures: failures},
+	}
+	if err := genericapi.Invoke(lambdaClient, outputsAPI, &input, nil); err != nil {
+		zap.L().Error("unable to store failed alert deliveries", zap.Error(err))
+	}
+}
+
+// newDeliveryFailures returns a failure for each failed delivery attempt of the alert.
+//
+// If permanentOnly is set, failures which will be retried are skipped.
+func newDeliveryFailures(
+	alert *alertmodels.Alert, attempts []*outputmodels.DeliveryAttempt, permanentOnly bool) []*outputmodels.DeliveryFailure {
+
+	var result []*outputmodels.DeliveryFailure
+	for _, attempt := range attempts {
+		if attempt.Success || (permanentOnly && !attempt.Permanent) {
+			continue
+		}
+		result = append(result, &outputmodels.DeliveryFailure{
+			OutputID:     attempt.OutputID,
+			Alert:        alert,
+			ErrorMessage: attempt.ErrorMessage,
+			Permanent:    attempt.Permanent,
+			FailedAt:     attempt.AttemptedAt,
+		})
+	}
+	return result
+}
@@ -25,7 +25,8 @@ import (
 
 	"go.uber.org/zap"
 
-	"github.com/panther-labs/panther/internal/core/alert_delivery/models"
+	"github.com/panther-labs/panther/api/lambda/delivery/models"
+	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
 )
 
 func mustParseInt(text string) int {
@@ -41,32 +42,53 @@ func getMaxRetryDuration() time.Duration {
 }
 
 // HandleAlerts sends each alert to its outputs and puts failed alerts back on the queue to retry.
+//
+// Every delivery attempt is recorded, and alerts which permanently failed for an output
+// are stored so they can be replayed later.
 func HandleAlerts(alerts []*models.Alert) {
 	var failedAlerts []*models.Alert
+	var deliveryAttempts []*outputmodels.DeliveryAttempt
+	var deliveryFailures []*outputmodels.DeliveryFailure
 
 	zap.L().Info("starting processing alerts", zap.Int("alerts", len(alerts)))
 
 	for _, alert := range alerts {
-		if !dispatch(alert) {
-			if time.Since(alert.CreatedAt) > getMaxRetryDuration() {
-				zap.L().Error(
-					"alert delivery permanently failed, exceeded max retry duration",
-					zap.Strings("failedOutputs", alert.OutputIDs),
-					zap.Time("alertCreatedAt", alert.CreatedAt),
-					zap.String("policyId", alert.AnalysisID),
-					zap.String("severity", alert.Severity),
-				)
-			} else {
-				zap.L().Warn("will retry delivery of alert",
-					zap.String("policyId", alert.AnalysisID),
-					zap.String("severity", alert.Severity),
-				)
-				failedAlerts = append(failedAlerts, alert)
-			}
+		success, attempts := dispatch(alert)
+		deliveryAttempts = append(deliveryAttempts, attempts...)
+		if success {
+			deliveryFailures = append(deliveryFailures, newDeliveryFailures(alert, attempts, true)...)
+			continue
+		}
+
+		if time.Since(alert.CreatedAt) > getMaxRetryDuration() {
+			zap.L().Error(
+				"alert delivery permanently failed, exceeded max retry duration",
+				zap.Strings("failedOutputs", alert.OutputIDs),
+				zap.Time("alertCreatedAt", alert.CreatedAt),
+				zap.String("policyId", alert.AnalysisID),
+				zap.String("severity", alert.Severity),
+			)
+			deliveryFailures = append(deliveryFailures, newDeliveryFailures(alert, attempts, false)...)
+		} else {
+			zap.L().Warn("will retry delivery of alert",
+				zap.String("policyId", alert.AnalysisID),
+				zap.String("severity", alert.Severity),
+			)
+			failedAlerts = append(failedAlerts, alert)
+			// Outputs which can be retried are not stored yet
+			deliveryFailures = append(deliveryFailures, newDeliveryFailures(alert, attempts, true)...)
 		}
 	}
 
 	if len(failedAlerts) > 0 {
 		retry(failedAlerts)
 	}
+
+	if len(deliveryAttempts) > 0 {
+		storeDeliveryAttempts(deliveryAttempts)
+	}
+
+	if len(deliveryFailures) > 0 {
+		storeDeliveryFailures(deliveryFailures)
+	}
 }
@@ -23,10 +23,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/lambda"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
-	"github.com/panther-labs/panther/internal/core/alert_delivery/models"
+	"github.com/panther-labs/panther/api/lambda/delivery/models"
+	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
 	"github.com/panther-labs/panther/internal/core/alert_delivery/outputs"
 )
 
@@ -38,7 +42,10 @@ func TestHandleAlertsPermanentlyFailed(t *testing.T) {
 	createdAtTime, _ := time.Parse(time.RFC3339, "2019-05-03T11:40:13Z")
 	mockClient := &mockOutputsClient{}
 	outputClient = mockClient
-	mockClient.On("Slack", mock.Anything, mock.Anything).Return(&outputs.AlertDeliveryError{})
+	mockClient.On("Slack", mock.Anything, mock.Anything, mock.Anything).Return(&outputs.AlertDeliveryError{})
+	mockLambda := &mockLambdaClient{}
+	lambdaClient = mockLambda
+	mockLambda.On("Invoke", mock.Anything).Return(&lambda.InvokeOutput{}, nil).Twice()
 	sqsClient = &mockSQSClient{}
 	setCaches()
 	os.Setenv("ALERT_RETRY_DURATION_MINS", "5")
@@ -49,13 +56,49 @@ func TestHandleAlertsPermanentlyFailed(t *testing.T) {
 
 	HandleAlerts(alerts)
 	assert.Equal(t, 0, sqsMessages)
+
+	// The attempts and failures are each stored in a single request
+	input := invokedInput(t, mockLambda, 0)
+	require.NotNil(t, input.AddDeliveryAttempts)
+	assert.Len(t, input.AddDeliveryAttempts.Attempts, 3)
+	input = invokedInput(t, mockLambda, 1)
+	require.NotNil(t, input.AddDeliveryFailures)
+	assert.Len(t, input.AddDeliveryFailures.Failures, 3)
+	mockLambda.AssertExpectations(t)
+}
+
+func TestHandleAlertsPermanentFailureStoredWithoutRetry(t *testing.T) {
+	mockClient := &mockOutputsClient{}
+	outputClient = mockClient
+	mockClient.On("Slack", mock.Anything, mock.Anything, mock.Anything).Return(
+		&outputs.AlertDeliveryError{Message: "invalid token", Permanent: true})
+	mockLambda := &mockLambdaClient{}
+	lambdaClient = mockLambda
+	mockLambda.On("Invoke", mock.Anything).Return(&lambda.InvokeOutput{}, nil).Twice()
+	sqsClient = &mockSQSClient{}
+	setCaches()
+	os.Setenv("ALERT_RETRY_DURATION_MINS", "5")
+	sqsMessages = 0
+
+	HandleAlerts([]*models.Alert{sampleAlert()})
+	assert.Equal(t, 0, sqsMessages)
+
+	input := invokedInput(t, mockLambda, 1)
+	require.NotNil(t, input.AddDeliveryFailures)
+	require.Len(t, input.AddDeliveryFailures.Failures, 1)
+	failure := input.AddDeliveryFailures.Failures[0]
+	assert.Equal(t, "output-id", *failure.OutputID)
+	assert.Equal(t, "invalid token", *failure.ErrorMessage)
+	assert.True(t, failure.Permanent)
+	assert.Equal(t, "test-rule-id", failure.Alert.AnalysisID)
+	mockLambda.AssertExpectations(t)
 }
 
 func TestHandleAlertsTemporarilyFailed(t *testing.T) {
 	createdAtTime := time.Now()
 	mockClient := &mockOutputsClient{}
 	outputClient = mockClient
-	mockClient.On("Slack", mock.Anything, mock.Anything).Return(&outputs.AlertDeliveryError{})
+	mockClient.On("Slack", mock.Anything, mock.Anything, mock.Anything).Return(&outputs.AlertDeliveryError{})
 	sqsClient = &mockSQSClient{}
 	setCaches()
 	os.Setenv("ALERT_RETRY_DURATION_MINS", "5")
@@ -66,7 +109,23 @@ func TestHandleAlertsTemporarilyFailed(t *testing.T) {
 	alert.CreatedAt = createdAtTime
 	alerts := []*models.Alert{alert, alert, alert}
 	sqsMessages = 0
+	mockLambda := &mockLambdaClient{}
+	lambdaClient = mockLambda
+	mockLambda.On("Invoke", mock.Anything).Return(&lambda.InvokeOutput{}, nil).Once()
 
 	HandleAlerts(alerts)
 	assert.Equal(t, 3, sqsMessages)
+	// Only the attempts are recorded, no failure is stored while the alerts can still be retried
+	input := invokedInput(t, mockLambda, 0)
+	require.NotNil(t, input.AddDeliveryAttempts)
+	assert.Len(t, input.AddDeliveryAttempts.Attempts, 3)
+	mockLambda.AssertExpectations(t)
+}
+
+// invokedInput returns the outputs-api input of the i-th call to the Lambda client
+func invokedInput(t *testing.T, mockLambda *mockLambdaClient, i int) *outputmodels.LambdaInput {
+	var input outputmodels.LambdaInput
+	payload := mockLambda.Calls[i].Arguments.Get(0).(*lambda.InvokeInput).Payload
+	require.NoError(t, jsoniter.Unmarshal(payload, &input))
+	return &input
 }
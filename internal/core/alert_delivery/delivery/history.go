@@ -0,0 +1,36 @@
+package delivery
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"go.uber.org/zap"
+
+	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+// storeDeliveryAttempts records the result of each delivery attempt in the outputs-api.
+func storeDeliveryAttempts(attempts []*outputmodels.DeliveryAttempt) {
+	input := outputmodels.LambdaInput{
+		AddDeliveryAttempts: &outputmodels.AddDeliveryAttemptsInput{Attempts: attempts},
+	}
+	if err := genericapi.Invoke(lambdaClient, outputsAPI, &input, nil); err != nil {
+		zap.L().Error("unable to store alert delivery history", zap.Error(err))
+	}
+}
@@ -24,8 +24,8 @@ import (
 
 	"go.uber.org/zap"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
@@ -64,9 +64,9 @@ func getAlertOutputs(alert *alertmodels.Alert) ([]*outputmodels.AlertOutput, err
 		}
 	}
 
-	// If alert doesn't have outputs IDs specified, return the defaults for the severity
+	// If alert doesn't have outputs IDs specified, return the defaults for the severity and routing rules
 	if len(alert.OutputIDs) == 0 {
-		return getOutputsBySeverity(alert.Severity), nil
+		return getDefaultOutputs(alert), nil
 	}
 
 	result := []*outputmodels.AlertOutput{}
@@ -80,17 +80,15 @@ func getAlertOutputs(alert *alertmodels.Alert) ([]*outputmodels.AlertOutput, err
 	return result, nil
 }
 
-func getOutputsBySeverity(severity string) []*outputmodels.AlertOutput {
+func getDefaultOutputs(alert *alertmodels.Alert) []*outputmodels.AlertOutput {
 	result := []*outputmodels.AlertOutput{}
 	if cache == nil {
 		return result
 	}
 
 	for _, output := range cache.Outputs {
-		for _, outputSeverity := range output.DefaultForSeverity {
-			if severity == *outputSeverity {
-				result = append(result, output)
-			}
+		if output.ShouldRoute(alert) {
+			result = append(result, output)
 		}
 	}
 	return result
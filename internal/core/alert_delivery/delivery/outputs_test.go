@@ -91,3 +91,45 @@ func TestGetAlertOutputsIdsError(t *testing.T) {
 	assert.Nil(t, result)
 	mockClient.AssertExpectations(t)
 }
+
+func TestGetAlertOutputsRoutingRules(t *testing.T) {
+	mockClient := &mockLambdaClient{}
+	lambdaClient = mockClient
+
+	cloudTrailRules := &outputmodels.RoutingRules{
+		Operator: outputmodels.RoutingOperatorAnd,
+		Conditions: []*outputmodels.RoutingCondition{
+			{Field: outputmodels.RoutingFieldLogTypes, Values: []string{"AWS.CloudTrail"}},
+		},
+	}
+	output := &outputmodels.GetOutputsOutput{
+		{
+			OutputID:           aws.String("default-info"),
+			DefaultForSeverity: aws.StringSlice([]string{"INFO"}),
+		},
+		{
+			OutputID:     aws.String("cloudtrail"),
+			RoutingRules: cloudTrailRules,
+		},
+		{
+			OutputID:           aws.String("cloudtrail-medium"),
+			DefaultForSeverity: aws.StringSlice([]string{"MEDIUM"}),
+			RoutingRules:       cloudTrailRules,
+		},
+	}
+	payload, err := jsoniter.Marshal(output)
+	require.NoError(t, err)
+
+	cache = nil // Clear the cache
+	mockClient.On("Invoke", mock.Anything).Return(&lambda.InvokeOutput{Payload: payload}, nil).Once()
+	alert := sampleAlert()
+	alert.OutputIDs = nil
+	alert.LogTypes = []string{"AWS.CloudTrail"}
+
+	result, err := getAlertOutputs(alert)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, aws.String("default-info"), result[0].OutputID)
+	assert.Equal(t, aws.String("cloudtrail"), result[1].OutputID)
+	mockClient.AssertExpectations(t)
+}
@@ -23,8 +23,8 @@ import (
 
 	"go.uber.org/zap"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 const (
@@ -33,13 +33,18 @@ const (
 )
 
 // Asana creates a task in Asana projects
-func (client *OutputClient) Asana(alert *alertmodels.Alert, config *outputmodels.AsanaConfig) *AlertDeliveryError {
+func (client *OutputClient) Asana(alert *alertmodels.Alert, config *outputmodels.AsanaConfig, message string) *AlertDeliveryError {
 	zap.L().Debug("sending alert to Asana")
+	notes := generateDetailedAlertMessage(alert)
+	if message != "" {
+		notes = message
+	}
+
 	payload := map[string]interface{}{
 		"data": map[string]interface{}{
 			"name":     generateAlertTitle(alert),
 			"projects": config.ProjectGids,
-			"notes":    generateDetailedAlertMessage(alert),
+			"notes":    notes,
 		},
 	}
 
@@ -25,8 +25,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 func TestAsanaAlert(t *testing.T) {
@@ -67,6 +67,6 @@ func TestAsanaAlert(t *testing.T) {
 
 	httpWrapper.On("post", expectedPostInput).Return((*AlertDeliveryError)(nil))
 
-	require.Nil(t, client.Asana(alert, asanaConfig))
+	require.Nil(t, client.Asana(alert, asanaConfig, ""))
 	httpWrapper.AssertExpectations(t)
 }
@@ -19,17 +19,28 @@ package outputs
  */
 
 import (
+	jsoniter "github.com/json-iterator/go"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 // CustomWebhook alert send an alert.
 func (client *OutputClient) CustomWebhook(
-	alert *alertmodels.Alert, config *outputmodels.CustomWebhookConfig) *AlertDeliveryError {
+	alert *alertmodels.Alert, config *outputmodels.CustomWebhookConfig, message string) *AlertDeliveryError {
+
+	var body interface{} = generateNotificationFromAlert(alert)
+	if message != "" {
+		// The message replaces the whole request body, so it has to be a JSON document
+		if !jsoniter.Valid([]byte(message)) {
+			return &AlertDeliveryError{Message: "custom webhook message template is not valid JSON", Permanent: true}
+		}
+		body = jsoniter.RawMessage(message)
+	}
 
 	postInput := &PostInput{
 		url:  config.WebhookURL,
-		body: generateNotificationFromAlert(alert),
+		body: body,
 	}
 	return client.httpWrapper.post(postInput)
 }
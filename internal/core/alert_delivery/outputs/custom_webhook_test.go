@@ -22,10 +22,11 @@ import (
 	"testing"
 	"time"
 
+	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/require"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 var customWebhookConfig = &outputmodels.CustomWebhookConfig{
@@ -62,6 +63,7 @@ func TestCustomWebhookAlert(t *testing.T) {
 		Tags:        []string{},
 		Version:     alert.Version,
 		CreatedAt:   alert.CreatedAt,
+		ResourceIDs: []string{},
 	}
 
 	expectedPostInput := &PostInput{
@@ -71,6 +73,27 @@ func TestCustomWebhookAlert(t *testing.T) {
 
 	httpWrapper.On("post", expectedPostInput).Return((*AlertDeliveryError)(nil))
 
-	require.Nil(t, client.CustomWebhook(alert, customWebhookConfig))
+	require.Nil(t, client.CustomWebhook(alert, customWebhookConfig, ""))
 	httpWrapper.AssertExpectations(t)
 }
+
+func TestCustomWebhookAlertMessageTemplate(t *testing.T) {
+	httpWrapper := &mockHTTPWrapper{}
+	client := &OutputClient{httpWrapper: httpWrapper}
+	alert := &alertmodels.Alert{AnalysisID: "policyId", Severity: "INFO"}
+
+	message := `{"ticket": {"summary": "policyId"}}`
+	expectedPostInput := &PostInput{
+		url:  "custom-webhook-url",
+		body: jsoniter.RawMessage(message),
+	}
+	httpWrapper.On("post", expectedPostInput).Return((*AlertDeliveryError)(nil))
+
+	require.Nil(t, client.CustomWebhook(alert, customWebhookConfig, message))
+	httpWrapper.AssertExpectations(t)
+
+	// The message has to be a valid JSON document
+	result := client.CustomWebhook(alert, customWebhookConfig, "not json")
+	require.NotNil(t, result)
+	require.True(t, result.Permanent)
+}
@@ -0,0 +1,253 @@
+package outputs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"html/template"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
+	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
+)
+
+const (
+	emailTLSModeStartTLS = "starttls"
+	emailTLSModeTLS      = "tls"
+
+	defaultSMTPPort   = 587
+	smtpDialTimeout   = 10 * time.Second
+	smtpDeadline      = 30 * time.Second
+	smtpPermanentCode = 500 // SMTP replies 5xx are permanent failures, 4xx are transient
+)
+
+var emailHTMLTemplate = template.Must(template.New("email").Parse(`<html>
+<body>
+<h2>{{.Title}}</h2>
+<p>{{.Message}}</p>
+{{if .CustomMessage}}<pre>{{.CustomMessage}}</pre>
+{{else}}<table>
+<tr><td><b>Severity</b></td><td>{{.Severity}}</td></tr>
+<tr><td><b>Runbook</b></td><td>{{.Runbook}}</td></tr>
+<tr><td><b>Description</b></td><td>{{.Description}}</td></tr>
+{{if .Tags}}<tr><td><b>Tags</b></td><td>{{.Tags}}</td></tr>{{end}}
+</table>
+{{end}}<p><a href="{{.Link}}">Click here to view in the Panther UI</a></p>
+</body>
+</html>
+`))
+
+type emailHTMLFields struct {
+	Title         string
+	Message       string
+	Severity      string
+	Runbook       string
+	Description   string
+	Tags          string
+	Link          string
+	CustomMessage string
+}
+
+// Email sends an alert to an SMTP server as an HTML and plain text email.
+func (client *OutputClient) Email(alert *alertmodels.Alert, config *outputmodels.EmailConfig, message string) *AlertDeliveryError {
+	email, err := generateEmailMessage(alert, config, message, time.Now())
+	if err != nil {
+		errorMsg := "Failed to generate email message"
+		zap.L().Error(errorMsg, zap.Error(errors.WithStack(err)))
+		return &AlertDeliveryError{Message: errorMsg, Permanent: true}
+	}
+
+	if err = client.sendEmail(config, email); err != nil {
+		errorMsg := "Failed to send email: " + err.Error()
+		zap.L().Error(errorMsg, zap.Error(errors.WithStack(err)))
+		return &AlertDeliveryError{Message: errorMsg, Permanent: isPermanentSMTPError(err)}
+	}
+	return nil
+}
+
+func (client *OutputClient) sendEmail(config *outputmodels.EmailConfig, message []byte) error {
+	port := config.Port
+	if port == 0 {
+		port = defaultSMTPPort
+	}
+	address := net.JoinHostPort(config.Host, strconv.Itoa(port))
+	tlsConfig := &tls.Config{
+		ServerName: config.Host,
+		RootCAs:    client.smtpRootCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	dialer := &net.Dialer{Timeout: smtpDialTimeout}
+	var conn net.Conn
+	var err error
+	if config.TLSMode == emailTLSModeTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", address)
+	}
+	if err != nil {
+		return err
+	}
+	if err = conn.SetDeadline(time.Now().Add(smtpDeadline)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	smtpClient, err := smtp.NewClient(conn, config.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer smtpClient.Close()
+
+	if config.TLSMode != emailTLSModeTLS {
+		// Never send credentials or alert contents in plain text
+		if ok, _ := smtpClient.Extension("STARTTLS"); !ok {
+			return &textproto.Error{Code: smtpPermanentCode, Msg: "server does not support STARTTLS"}
+		}
+		if err = smtpClient.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+
+	if config.Username != "" {
+		if err = smtpClient.Auth(smtp.PlainAuth("", config.Username, config.Password, config.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err = smtpClient.Mail(config.FromAddress); err != nil {
+		return err
+	}
+	for _, to := range config.ToAddresses {
+		if err = smtpClient.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	writer, err := smtpClient.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = writer.Write(message); err != nil {
+		return err
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+	return smtpClient.Quit()
+}
+
+// isPermanentSMTPError returns true if the SMTP server rejected the message permanently
+func isPermanentSMTPError(err error) bool {
+	if protoErr, ok := err.(*textproto.Error); ok {
+		return protoErr.Code >= smtpPermanentCode
+	}
+	return false
+}
+
+// generateEmailMessage builds a multipart/alternative message with a plain text and an HTML body.
+//
+// A non-empty message replaces the default plain text body and is shown preformatted in the HTML body.
+func generateEmailMessage(
+	alert *alertmodels.Alert, config *outputmodels.EmailConfig, message string, now time.Time) ([]byte, error) {
+
+	textBody := generateDetailedAlertMessage(alert)
+	if message != "" {
+		textBody = message
+	}
+	var body bytes.Buffer
+	bodyWriter := multipart.NewWriter(&body)
+
+	textPart, err := bodyWriter.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=UTF-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = writeQuotedPrintable(textPart, []byte(textBody)); err != nil {
+		return nil, err
+	}
+
+	var html bytes.Buffer
+	err = emailHTMLTemplate.Execute(&html, &emailHTMLFields{
+		Title:         generateAlertTitle(alert),
+		Message:       generateAlertMessage(alert),
+		Severity:      alert.Severity,
+		Runbook:       aws.StringValue(alert.Runbook),
+		Description:   aws.StringValue(alert.AnalysisDescription),
+		Tags:          strings.Join(alert.Tags, ", "),
+		Link:          generateURL(alert),
+		CustomMessage: message,
+	})
+	if err != nil {
+		return nil, err
+	}
+	htmlPart, err := bodyWriter.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/html; charset=UTF-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = writeQuotedPrintable(htmlPart, html.Bytes()); err != nil {
+		return nil, err
+	}
+	if err = bodyWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	var email bytes.Buffer
+	headers := []string{
+		"From: " + config.FromAddress,
+		"To: " + strings.Join(config.ToAddresses, ", "),
+		"Subject: " + mime.QEncoding.Encode("UTF-8", generateAlertTitle(alert)),
+		"Date: " + now.Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		fmt.Sprintf("Content-Type: multipart/alternative; boundary=%q", bodyWriter.Boundary()),
+	}
+	email.WriteString(strings.Join(headers, "\r\n"))
+	email.WriteString("\r\n\r\n")
+	email.Write(body.Bytes())
+	return email.Bytes(), nil
+}
+
+func writeQuotedPrintable(w io.Writer, data []byte) error {
+	qpWriter := quotedprintable.NewWriter(w)
+	if _, err := qpWriter.Write(data); err != nil {
+		return err
+	}
+	return qpWriter.Close()
+}
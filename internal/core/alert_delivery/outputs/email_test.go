@@ -0,0 +1,296 @@
+package outputs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
+	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
+)
+
+// smtpStandIn is a minimal SMTP server used to test the email output
+type smtpStandIn struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	implicit  bool // implicit TLS instead of STARTTLS
+	rejectTo  string
+
+	mutex    sync.Mutex
+	auth     string
+	from     string
+	to       []string
+	data     string
+	usedTLS  bool
+	finished chan struct{}
+}
+
+func newSMTPStandIn(t *testing.T, implicit bool) (*smtpStandIn, *x509.CertPool) {
+	cert, pool := generateTestCertificate(t)
+	server := &smtpStandIn{
+		tlsConfig: &tls.Config{Certificates: []tls.Certificate{cert}},
+		implicit:  implicit,
+		finished:  make(chan struct{}),
+	}
+
+	var err error
+	if implicit {
+		server.listener, err = tls.Listen("tcp", "127.0.0.1:0", server.tlsConfig)
+	} else {
+		server.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	require.NoError(t, err)
+	go server.serve()
+	return server, pool
+}
+
+func (s *smtpStandIn) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpStandIn) close() {
+	s.listener.Close()
+}
+
+func (s *smtpStandIn) serve() {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer close(s.finished)
+	defer conn.Close()
+
+	s.mutex.Lock()
+	s.usedTLS = s.implicit
+	s.mutex.Unlock()
+
+	text := textproto.NewConn(conn)
+	_ = text.PrintfLine("220 localhost ESMTP stand-in")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		argument := strings.TrimSpace(strings.TrimPrefix(line, strings.SplitN(line, " ", 2)[0]))
+
+		s.mutex.Lock()
+		switch command {
+		case "EHLO", "HELO":
+			if s.usedTLS {
+				_ = text.PrintfLine("250-localhost\r\n250 AUTH PLAIN")
+			} else {
+				_ = text.PrintfLine("250-localhost\r\n250 STARTTLS")
+			}
+		case "STARTTLS":
+			_ = text.PrintfLine("220 ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				s.mutex.Unlock()
+				return
+			}
+			conn = tlsConn
+			text = textproto.NewConn(conn)
+			s.usedTLS = true
+		case "AUTH":
+			decoded, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(argument, "PLAIN "))
+			s.auth = string(decoded)
+			_ = text.PrintfLine("235 authenticated")
+		case "MAIL":
+			s.from = argument
+			_ = text.PrintfLine("250 ok")
+		case "RCPT":
+			if s.rejectTo != "" && strings.Contains(argument, s.rejectTo) {
+				_ = text.PrintfLine("550 no such user")
+				break
+			}
+			s.to = append(s.to, argument)
+			_ = text.PrintfLine("250 ok")
+		case "DATA":
+			_ = text.PrintfLine("354 go ahead")
+			data, _ := text.ReadDotBytes()
+			s.data = string(data)
+			_ = text.PrintfLine("250 queued")
+		case "QUIT":
+			_ = text.PrintfLine("221 bye")
+			s.mutex.Unlock()
+			return
+		default:
+			_ = text.PrintfLine("502 not implemented")
+		}
+		s.mutex.Unlock()
+	}
+}
+
+func generateTestCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	parsed, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(parsed)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
+
+func emailTestAlert() *alertmodels.Alert {
+	return &alertmodels.Alert{
+		AnalysisID:          "policyId",
+		Type:                alertmodels.PolicyType,
+		CreatedAt:           time.Now().UTC(),
+		AnalysisName:        aws.String("policyName"),
+		AnalysisDescription: aws.String("<b>description</b>"),
+		Severity:            "INFO",
+		Runbook:             aws.String("runbook"),
+		Tags:                []string{"tag"},
+	}
+}
+
+func TestEmailStartTLS(t *testing.T) {
+	server, pool := newSMTPStandIn(t, false)
+	defer server.close()
+	client := &OutputClient{smtpRootCAs: pool}
+
+	config := &outputmodels.EmailConfig{
+		Host:        "127.0.0.1",
+		Port:        server.port(),
+		Username:    "user",
+		Password:    "secret",
+		FromAddress: "panther@example.com",
+		ToAddresses: []string{"security@example.com", "compliance@example.com"},
+	}
+	require.Nil(t, client.Email(emailTestAlert(), config, ""))
+	<-server.finished
+
+	assert.True(t, server.usedTLS)
+	assert.Equal(t, "\x00user\x00secret", server.auth)
+	assert.Equal(t, "FROM:<panther@example.com>", server.from)
+	assert.Equal(t, []string{"TO:<security@example.com>", "TO:<compliance@example.com>"}, server.to)
+	assert.Contains(t, server.data, "Subject: Policy Failure: policyName")
+	assert.Contains(t, server.data, "Content-Type: multipart/alternative")
+	assert.Contains(t, server.data, "Content-Type: text/plain; charset=UTF-8")
+	assert.Contains(t, server.data, "Content-Type: text/html; charset=UTF-8")
+	assert.Contains(t, server.data, "https://panther.io/policies/policyId")
+	// The description is escaped in the HTML part
+	assert.Contains(t, server.data, "&lt;b&gt;description&lt;/b&gt;")
+}
+
+func TestEmailImplicitTLS(t *testing.T) {
+	server, pool := newSMTPStandIn(t, true)
+	defer server.close()
+	client := &OutputClient{smtpRootCAs: pool}
+
+	config := &outputmodels.EmailConfig{
+		Host:        "127.0.0.1",
+		Port:        server.port(),
+		TLSMode:     "tls",
+		FromAddress: "panther@example.com",
+		ToAddresses: []string{"security@example.com"},
+	}
+	require.Nil(t, client.Email(emailTestAlert(), config, ""))
+	<-server.finished
+
+	assert.True(t, server.usedTLS)
+	assert.Empty(t, server.auth)
+	assert.Equal(t, []string{"TO:<security@example.com>"}, server.to)
+}
+
+func TestEmailRecipientRejected(t *testing.T) {
+	server, pool := newSMTPStandIn(t, false)
+	defer server.close()
+	server.rejectTo = "unknown@example.com"
+	client := &OutputClient{smtpRootCAs: pool}
+
+	config := &outputmodels.EmailConfig{
+		Host:        "127.0.0.1",
+		Port:        server.port(),
+		FromAddress: "panther@example.com",
+		ToAddresses: []string{"unknown@example.com"},
+	}
+	result := client.Email(emailTestAlert(), config, "")
+	require.NotNil(t, result)
+	assert.True(t, result.Permanent)
+}
+
+func TestEmailUntrustedCertificate(t *testing.T) {
+	server, _ := newSMTPStandIn(t, true)
+	defer server.close()
+	client := &OutputClient{}
+
+	config := &outputmodels.EmailConfig{
+		Host:        "127.0.0.1",
+		Port:        server.port(),
+		TLSMode:     "tls",
+		FromAddress: "panther@example.com",
+		ToAddresses: []string{"security@example.com"},
+	}
+	result := client.Email(emailTestAlert(), config, "")
+	require.NotNil(t, result)
+	assert.False(t, result.Permanent)
+}
+
+func TestEmailConnectionRefused(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	client := &OutputClient{}
+	config := &outputmodels.EmailConfig{
+		Host:        "127.0.0.1",
+		Port:        port,
+		FromAddress: "panther@example.com",
+		ToAddresses: []string{"security@example.com"},
+	}
+	result := client.Email(emailTestAlert(), config, "")
+	require.NotNil(t, result)
+	assert.False(t, result.Permanent)
+	assert.Contains(t, result.Message, strconv.Itoa(port))
+}
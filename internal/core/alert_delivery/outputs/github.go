@@ -23,8 +23,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 // Severity colors match those in the Panther UI
@@ -35,7 +35,7 @@ const (
 
 // Github alert send an issue.
 func (client *OutputClient) Github(
-	alert *alertmodels.Alert, config *outputmodels.GithubConfig) *AlertDeliveryError {
+	alert *alertmodels.Alert, config *outputmodels.GithubConfig, message string) *AlertDeliveryError {
 
 	description := "**Description:** " + aws.StringValue(alert.AnalysisDescription)
 	link := "\n [Click here to view in the Panther UI](" + generateURL(alert) + ")"
@@ -43,9 +43,14 @@ func (client *OutputClient) Github(
 	severity := "\n **Severity:** " + alert.Severity
 	tags := "\n **Tags:** " + strings.Join(alert.Tags, ", ")
 
+	body := description + link + runBook + severity + tags
+	if message != "" {
+		body = message
+	}
+
 	githubRequest := map[string]interface{}{
 		"title": generateAlertTitle(alert),
-		"body":  description + link + runBook + severity + tags,
+		"body":  body,
 	}
 
 	token := "token " + config.Token
@@ -25,8 +25,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 var githubConfig = &outputmodels.GithubConfig{RepoName: "profile/reponame", Token: "github-token"}
@@ -65,6 +65,6 @@ func TestGithubAlert(t *testing.T) {
 
 	httpWrapper.On("post", expectedPostInput).Return((*AlertDeliveryError)(nil))
 
-	require.Nil(t, client.Github(alert, githubConfig))
+	require.Nil(t, client.Github(alert, githubConfig, ""))
 	httpWrapper.AssertExpectations(t)
 }
@@ -24,8 +24,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 const (
@@ -34,7 +34,7 @@ const (
 
 // Jira alert send an issue.
 func (client *OutputClient) Jira(
-	alert *alertmodels.Alert, config *outputmodels.JiraConfig) *AlertDeliveryError {
+	alert *alertmodels.Alert, config *outputmodels.JiraConfig, message string) *AlertDeliveryError {
 
 	description := "*Description:* " + aws.StringValue(alert.AnalysisDescription)
 	link := "\n [Click here to view in the Panther UI](" + generateURL(alert) + ")"
@@ -42,9 +42,14 @@ func (client *OutputClient) Jira(
 	severity := "\n *Severity:* " + alert.Severity
 	tags := "\n *Tags:* " + strings.Join(alert.Tags, ", ")
 
+	jiraDescription := description + link + runBook + severity + tags
+	if message != "" {
+		jiraDescription = message
+	}
+
 	fields := map[string]interface{}{
 		"summary":     generateAlertTitle(alert),
-		"description": description + link + runBook + severity + tags,
+		"description": jiraDescription,
 		"project": map[string]*string{
 			"key": aws.String(config.ProjectKey),
 		},
@@ -26,8 +26,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 var jiraConfig = &outputmodels.JiraConfig{
@@ -83,6 +83,6 @@ func TestJiraAlert(t *testing.T) {
 
 	httpWrapper.On("post", expectedPostInput).Return((*AlertDeliveryError)(nil))
 
-	require.Nil(t, client.Jira(alert, jiraConfig))
+	require.Nil(t, client.Jira(alert, jiraConfig, ""))
 	httpWrapper.AssertExpectations(t)
 }
@@ -23,13 +23,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 // MsTeams alert send an alert.
 func (client *OutputClient) MsTeams(
-	alert *alertmodels.Alert, config *outputmodels.MsTeamsConfig) *AlertDeliveryError {
+	alert *alertmodels.Alert, config *outputmodels.MsTeamsConfig, message string) *AlertDeliveryError {
 
 	link := "[Click here to view in the Panther UI](" + policyURLPrefix + alert.AnalysisID + ").\n"
 
@@ -62,6 +62,12 @@ func (client *OutputClient) MsTeams(
 		},
 	}
 
+	if message != "" {
+		msTeamsRequestBody["sections"] = []interface{}{
+			map[string]interface{}{"text": message},
+		}
+	}
+
 	postInput := &PostInput{
 		url:  config.WebhookURL,
 		body: msTeamsRequestBody,
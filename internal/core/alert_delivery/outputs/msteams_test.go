@@ -25,8 +25,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 var msTeamConfig = &outputmodels.MsTeamsConfig{
@@ -84,6 +84,6 @@ func TestMsTeamsAlert(t *testing.T) {
 
 	httpWrapper.On("post", expectedPostInput).Return((*AlertDeliveryError)(nil))
 
-	require.Nil(t, client.MsTeams(alert, msTeamConfig))
+	require.Nil(t, client.MsTeams(alert, msTeamConfig, ""))
 	httpWrapper.AssertExpectations(t)
 }
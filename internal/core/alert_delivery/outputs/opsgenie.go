@@ -21,8 +21,8 @@ package outputs
 import (
 	"github.com/aws/aws-sdk-go/aws"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 var (
@@ -39,16 +39,21 @@ var pantherToOpsGeniePriority = map[string]string{
 
 // Opsgenie alert send an alert.
 func (client *OutputClient) Opsgenie(
-	alert *alertmodels.Alert, config *outputmodels.OpsgenieConfig) *AlertDeliveryError {
+	alert *alertmodels.Alert, config *outputmodels.OpsgenieConfig, message string) *AlertDeliveryError {
 
 	description := "<strong>Description:</strong> " + aws.StringValue(alert.AnalysisDescription)
 	link := "\n<a href=\"" + generateURL(alert) + "\">Click here to view in the Panther UI</a>"
 	runBook := "\n <strong>Runbook:</strong> " + aws.StringValue(alert.Runbook)
 	severity := "\n <strong>Severity:</strong> " + alert.Severity
 
+	opsgenieDescription := description + link + runBook + severity
+	if message != "" {
+		opsgenieDescription = message
+	}
+
 	opsgenieRequest := map[string]interface{}{
 		"message":     generateAlertTitle(alert),
-		"description": description + link + runBook + severity,
+		"description": opsgenieDescription,
 		"tags":        alert.Tags,
 		"priority":    pantherToOpsGeniePriority[alert.Severity],
 	}
@@ -26,8 +26,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 var opsgenieConfig = &outputmodels.OpsgenieConfig{APIKey: "apikey"}
@@ -73,6 +73,6 @@ func TestOpsgenieAlert(t *testing.T) {
 
 	httpWrapper.On("post", expectedPostInput).Return((*AlertDeliveryError)(nil))
 
-	require.Nil(t, client.Opsgenie(alert, opsgenieConfig))
+	require.Nil(t, client.Opsgenie(alert, opsgenieConfig, ""))
 	httpWrapper.AssertExpectations(t)
 }
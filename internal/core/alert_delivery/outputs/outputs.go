@@ -19,9 +19,11 @@ package outputs
  */
 
 import (
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -29,8 +31,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 	"github.com/panther-labs/panther/pkg/gatewayapi"
 )
 
@@ -63,16 +65,17 @@ type HTTPiface interface {
 
 // API is the interface for output delivery that can be used for mocks in tests.
 type API interface {
-	Slack(*alertmodels.Alert, *outputmodels.SlackConfig) *AlertDeliveryError
-	PagerDuty(*alertmodels.Alert, *outputmodels.PagerDutyConfig) *AlertDeliveryError
-	Github(*alertmodels.Alert, *outputmodels.GithubConfig) *AlertDeliveryError
-	Jira(*alertmodels.Alert, *outputmodels.JiraConfig) *AlertDeliveryError
-	Opsgenie(*alertmodels.Alert, *outputmodels.OpsgenieConfig) *AlertDeliveryError
-	MsTeams(*alertmodels.Alert, *outputmodels.MsTeamsConfig) *AlertDeliveryError
-	Sqs(*alertmodels.Alert, *outputmodels.SqsConfig) *AlertDeliveryError
-	Sns(*alertmodels.Alert, *outputmodels.SnsConfig) *AlertDeliveryError
-	Asana(*alertmodels.Alert, *outputmodels.AsanaConfig) *AlertDeliveryError
-	CustomWebhook(*alertmodels.Alert, *outputmodels.CustomWebhookConfig) *AlertDeliveryError
+	Slack(*alertmodels.Alert, *outputmodels.SlackConfig, string) *AlertDeliveryError
+	PagerDuty(*alertmodels.Alert, *outputmodels.PagerDutyConfig, string) *AlertDeliveryError
+	Github(*alertmodels.Alert, *outputmodels.GithubConfig, string) *AlertDeliveryError
+	Jira(*alertmodels.Alert, *outputmodels.JiraConfig, string) *AlertDeliveryError
+	Opsgenie(*alertmodels.Alert, *outputmodels.OpsgenieConfig, string) *AlertDeliveryError
+	MsTeams(*alertmodels.Alert, *outputmodels.MsTeamsConfig, string) *AlertDeliveryError
+	Sqs(*alertmodels.Alert, *outputmodels.SqsConfig, string) *AlertDeliveryError
+	Sns(*alertmodels.Alert, *outputmodels.SnsConfig, string) *AlertDeliveryError
+	Asana(*alertmodels.Alert, *outputmodels.AsanaConfig, string) *AlertDeliveryError
+	CustomWebhook(*alertmodels.Alert, *outputmodels.CustomWebhookConfig, string) *AlertDeliveryError
+	Email(*alertmodels.Alert, *outputmodels.EmailConfig, string) *AlertDeliveryError
 }
 
 // OutputClient encapsulates the clients that allow sending alerts to multiple outputs
@@ -82,6 +85,8 @@ type OutputClient struct {
 	// Map from region -> client
 	sqsClients map[string]sqsiface.SQSAPI
 	snsClients map[string]snsiface.SNSAPI
+	// Trusted certificate authorities for SMTP servers, the system pool is used if nil
+	smtpRootCAs *x509.CertPool
 }
 
 // OutputClient must satisfy the API interface.
@@ -100,6 +105,9 @@ func New(sess *session.Session) *OutputClient {
 
 const detailedMessageTemplate = "%s\nFor more details please visit: %s\nSeverity: %s\nRunbook: %s\nDescription: %s"
 
+// The maximum number of resources listed in the message of a policy alert digest
+const maxDigestResources = 25
+
 // The default payload delivered by all outputs to destinations
 // Each destination can augment this with its own custom fields.
 // This struct intentionally never uses the `omitempty` attribute as we want to keep the keys even
@@ -141,6 +149,9 @@ type Notification struct {
 
 	// Version is the S3 object version for the policy
 	Version *string `json:"version"`
+
+	// ResourceIDs is the set of failing resources in a policy alert digest. It will be empty otherwise
+	ResourceIDs []string `json:"resourceIds"`
 }
 
 func generateNotificationFromAlert(alert *alertmodels.Alert) Notification {
@@ -157,6 +168,7 @@ func generateNotificationFromAlert(alert *alertmodels.Alert) Notification {
 		Tags:        alert.Tags,
 		Version:     alert.Version,
 		CreatedAt:   alert.CreatedAt,
+		ResourceIDs: alert.ResourceIDs,
 	}
 	gatewayapi.ReplaceMapSliceNils(&notification)
 	return notification
@@ -166,11 +178,17 @@ func generateAlertMessage(alert *alertmodels.Alert) string {
 	if alert.Type == alertmodels.RuleType {
 		return getDisplayName(alert) + " triggered"
 	}
+	if len(alert.ResourceIDs) > 0 {
+		if total := numResources(alert); total > len(alert.ResourceIDs) {
+			return fmt.Sprintf("%s failed on %d resources (showing %d)", getDisplayName(alert), total, len(alert.ResourceIDs))
+		}
+		return fmt.Sprintf("%s failed on %d resources", getDisplayName(alert), len(alert.ResourceIDs))
+	}
 	return getDisplayName(alert) + " failed on new resources"
 }
 
 func generateDetailedAlertMessage(alert *alertmodels.Alert) string {
-	return fmt.Sprintf(
+	message := fmt.Sprintf(
 		detailedMessageTemplate,
 		generateAlertMessage(alert),
 		generateURL(alert),
@@ -178,6 +196,23 @@ func generateDetailedAlertMessage(alert *alertmodels.Alert) string {
 		aws.StringValue(alert.Runbook),
 		aws.StringValue(alert.AnalysisDescription),
 	)
+	if len(alert.ResourceIDs) > 0 {
+		message += "\nResources:\n" + generateResourceList(alert)
+	}
+	return message
+}
+
+// List the failing resources of a policy alert digest, one per line
+func generateResourceList(alert *alertmodels.Alert) string {
+	resources := alert.ResourceIDs
+	if len(resources) > maxDigestResources {
+		resources = resources[:maxDigestResources]
+	}
+	list := "- " + strings.Join(resources, "\n- ")
+	if remaining := numResources(alert) - len(resources); remaining > 0 {
+		list += fmt.Sprintf("\n... and %d more", remaining)
+	}
+	return list
 }
 
 func generateAlertTitle(alert *alertmodels.Alert) string {
@@ -187,9 +222,24 @@ func generateAlertTitle(alert *alertmodels.Alert) string {
 	if alert.Type == alertmodels.RuleType {
 		return "New Alert: " + getDisplayName(alert)
 	}
+	if len(alert.ResourceIDs) > 0 {
+		if total := numResources(alert); total > len(alert.ResourceIDs) {
+			return fmt.Sprintf("Policy Failure: %s (%d resources, showing %d)", getDisplayName(alert), total, len(alert.ResourceIDs))
+		}
+		return fmt.Sprintf("Policy Failure: %s (%d resources)", getDisplayName(alert), len(alert.ResourceIDs))
+	}
 	return "Policy Failure: " + getDisplayName(alert)
 }
 
+// The number of failing resources of a policy alert digest, which may list only some of them
+func numResources(alert *alertmodels.Alert) int {
+	if alert.NumResources > len(alert.ResourceIDs) {
+		return alert.NumResources
+	}
+	return len(alert.ResourceIDs)
+}
+
+
 func getDisplayName(alert *alertmodels.Alert) string {
 	if aws.StringValue(alert.AnalysisName) != "" {
 		return *alert.AnalysisName
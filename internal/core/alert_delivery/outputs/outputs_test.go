@@ -19,13 +19,14 @@ package outputs
  */
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
-	alertModel "github.com/panther-labs/panther/internal/core/alert_delivery/models"
+	alertModel "github.com/panther-labs/panther/api/lambda/delivery/models"
 )
 
 func init() {
@@ -82,3 +83,36 @@ func TestGenerateAlertTitlePolicyId(t *testing.T) {
 	}
 	assert.Equal(t, "Policy Failure: policy.id", generateAlertTitle(alert))
 }
+
+func TestGenerateAlertTitlePolicyDigest(t *testing.T) {
+	alert := &alertModel.Alert{
+		Type:         alertModel.PolicyType,
+		AnalysisName: aws.String("policy name"),
+		ResourceIDs:  []string{"resource-1", "resource-2"},
+	}
+	assert.Equal(t, "Policy Failure: policy name (2 resources)", generateAlertTitle(alert))
+	assert.Equal(t, "policy name failed on 2 resources", generateAlertMessage(alert))
+}
+
+func TestGenerateAlertTitlePolicyDigestUnlisted(t *testing.T) {
+	alert := &alertModel.Alert{
+		Type:         alertModel.PolicyType,
+		AnalysisName: aws.String("policy name"),
+		ResourceIDs:  []string{"resource-1", "resource-2"},
+		NumResources: 130,
+	}
+	assert.Equal(t, "Policy Failure: policy name (130 resources, showing 2)", generateAlertTitle(alert))
+	assert.Equal(t, "policy name failed on 130 resources (showing 2)", generateAlertMessage(alert))
+	assert.Equal(t, "- resource-1\n- resource-2\n... and 128 more", generateResourceList(alert))
+}
+
+func TestGenerateResourceListTruncated(t *testing.T) {
+	alert := &alertModel.Alert{Type: alertModel.PolicyType}
+	for i := 0; i < maxDigestResources+3; i++ {
+		alert.ResourceIDs = append(alert.ResourceIDs, "resource")
+	}
+
+	list := generateResourceList(alert)
+	assert.Equal(t, maxDigestResources+1, strings.Count(list, "\n")+1)
+	assert.True(t, strings.HasSuffix(list, "\n... and 3 more"))
+}
@@ -21,8 +21,8 @@ package outputs
 import (
 	"time"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 var (
@@ -31,7 +31,7 @@ var (
 )
 
 // PagerDuty sends an alert to a pager duty integration endpoint.
-func (client *OutputClient) PagerDuty(alert *alertmodels.Alert, config *outputmodels.PagerDutyConfig) *AlertDeliveryError {
+func (client *OutputClient) PagerDuty(alert *alertmodels.Alert, config *outputmodels.PagerDutyConfig, message string) *AlertDeliveryError {
 	severity, err := pantherSeverityToPagerDuty(alert.Severity)
 	if err != nil {
 		return err
@@ -44,6 +44,9 @@ func (client *OutputClient) PagerDuty(alert *alertmodels.Alert, config *outputmo
 		"source":         "pantherlabs",
 		"custom_details": generateNotificationFromAlert(alert),
 	}
+	if message != "" {
+		payload["custom_details"] = message
+	}
 
 	pagerDutyRequest := map[string]interface{}{
 		"payload":      payload,
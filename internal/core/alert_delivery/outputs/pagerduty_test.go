@@ -27,8 +27,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 var createdAtTime, _ = time.Parse(time.RFC3339, "2019-05-03T11:40:13Z")
@@ -53,15 +53,16 @@ func TestSendPagerDutyAlert(t *testing.T) {
 		"event_action": "trigger",
 		"payload": map[string]interface{}{
 			"custom_details": Notification{
-				ID:        "policyId",
-				CreatedAt: createdAtTime,
-				Severity:  "INFO",
-				Type:      alertmodels.PolicyType,
-				Link:      "https://panther.io/policies/policyId",
-				Title:     "Policy Failure: policyName",
-				Name:      aws.String("policyName"),
-				Runbook:   aws.String("runbook"),
-				Tags:      []string{},
+				ID:          "policyId",
+				CreatedAt:   createdAtTime,
+				Severity:    "INFO",
+				Type:        alertmodels.PolicyType,
+				Link:        "https://panther.io/policies/policyId",
+				Title:       "Policy Failure: policyName",
+				Name:        aws.String("policyName"),
+				Runbook:     aws.String("runbook"),
+				Tags:        []string{},
+				ResourceIDs: []string{},
 			},
 			"severity":  "info",
 			"source":    "pantherlabs",
@@ -77,7 +78,7 @@ func TestSendPagerDutyAlert(t *testing.T) {
 	}
 
 	httpWrapper.On("post", expectedPostInput).Return((*AlertDeliveryError)(nil))
-	result := outputClient.PagerDuty(pagerDutyAlert, pagerDutyConfig)
+	result := outputClient.PagerDuty(pagerDutyAlert, pagerDutyConfig, "")
 
 	assert.Nil(t, result)
 	httpWrapper.AssertExpectations(t)
@@ -89,6 +90,6 @@ func TestSendPagerDutyAlertPostError(t *testing.T) {
 
 	httpWrapper.On("post", mock.Anything).Return(&AlertDeliveryError{Message: "Exception"})
 
-	require.Error(t, outputClient.PagerDuty(pagerDutyAlert, pagerDutyConfig))
+	require.Error(t, outputClient.PagerDuty(pagerDutyAlert, pagerDutyConfig, ""))
 	httpWrapper.AssertExpectations(t)
 }
@@ -76,7 +76,9 @@ func TestPostNotOk(t *testing.T) {
 		url:  requestEndpoint,
 		body: map[string]interface{}{"abc": 123},
 	}
-	assert.NotNil(t, c.post(postInput))
+	err := c.post(postInput)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, err.StatusCode)
 }
 
 func TestPostOk(t *testing.T) {
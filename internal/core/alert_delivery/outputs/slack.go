@@ -23,8 +23,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 // Severity colors match those in the Panther UI
@@ -37,7 +37,7 @@ var severityColors = map[string]string{
 }
 
 // Slack sends an alert to a slack channel.
-func (client *OutputClient) Slack(alert *alertmodels.Alert, config *outputmodels.SlackConfig) *AlertDeliveryError {
+func (client *OutputClient) Slack(alert *alertmodels.Alert, config *outputmodels.SlackConfig, message string) *AlertDeliveryError {
 	messageField := fmt.Sprintf("<%s|%s>",
 		generateURL(alert),
 		"Click here to view in the Panther UI")
@@ -57,16 +57,27 @@ func (client *OutputClient) Slack(alert *alertmodels.Alert, config *outputmodels
 			"short": true,
 		},
 	}
+	if len(alert.ResourceIDs) > 0 {
+		fields = append(fields, map[string]interface{}{
+			"title": "Resources",
+			"value": generateResourceList(alert),
+			"short": false,
+		})
+	}
+
+	attachment := map[string]interface{}{
+		"fallback": generateAlertTitle(alert),
+		"color":    severityColors[alert.Severity],
+		"title":    generateAlertTitle(alert),
+		"fields":   fields,
+	}
+	if message != "" {
+		delete(attachment, "fields")
+		attachment["text"] = message
+	}
 
 	payload := map[string]interface{}{
-		"attachments": []map[string]interface{}{
-			{
-				"fallback": generateAlertTitle(alert),
-				"color":    severityColors[alert.Severity],
-				"title":    generateAlertTitle(alert),
-				"fields":   fields,
-			},
-		},
+		"attachments": []map[string]interface{}{attachment},
 	}
 	postInput := &PostInput{
 		url:  config.WebhookURL,
@@ -25,8 +25,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 var slackConfig = &outputmodels.SlackConfig{WebhookURL: "slack-channel-url"}
@@ -76,6 +76,34 @@ func TestSlackAlert(t *testing.T) {
 
 	httpWrapper.On("post", expectedPostInput).Return((*AlertDeliveryError)(nil))
 
-	require.Nil(t, client.Slack(alert, slackConfig))
+	require.Nil(t, client.Slack(alert, slackConfig, ""))
+	httpWrapper.AssertExpectations(t)
+}
+
+func TestSlackAlertMessageTemplate(t *testing.T) {
+	httpWrapper := &mockHTTPWrapper{}
+	client := &OutputClient{httpWrapper: httpWrapper}
+	alert := &alertmodels.Alert{
+		AnalysisID:   "policyId",
+		AnalysisName: aws.String("policyName"),
+		Severity:     "INFO",
+	}
+
+	expectedPostInput := &PostInput{
+		url: slackConfig.WebhookURL,
+		body: map[string]interface{}{
+			"attachments": []map[string]interface{}{
+				{
+					"color":    "#47b881",
+					"fallback": "Policy Failure: policyName",
+					"title":    "Policy Failure: policyName",
+					"text":     "custom message",
+				},
+			},
+		},
+	}
+	httpWrapper.On("post", expectedPostInput).Return((*AlertDeliveryError)(nil))
+
+	require.Nil(t, client.Slack(alert, slackConfig, "custom message"))
 	httpWrapper.AssertExpectations(t)
 }
@@ -27,8 +27,8 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 type snsMessage struct {
@@ -39,7 +39,7 @@ type snsMessage struct {
 
 // Sns sends an alert to an SNS Topic.
 // nolint: dupl
-func (client *OutputClient) Sns(alert *alertmodels.Alert, config *outputmodels.SnsConfig) *AlertDeliveryError {
+func (client *OutputClient) Sns(alert *alertmodels.Alert, config *outputmodels.SnsConfig, message string) *AlertDeliveryError {
 	notification := generateNotificationFromAlert(alert)
 	serializedDefaultMessage, err := jsoniter.MarshalToString(notification)
 	if err != nil {
@@ -52,6 +52,9 @@ func (client *OutputClient) Sns(alert *alertmodels.Alert, config *outputmodels.S
 		DefaultMessage: serializedDefaultMessage,
 		EmailMessage:   generateDetailedAlertMessage(alert),
 	}
+	if message != "" {
+		outputMessage.EmailMessage = message
+	}
 
 	serializedMessage, err := jsoniter.MarshalToString(outputMessage)
 	if err != nil {
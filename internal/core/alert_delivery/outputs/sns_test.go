@@ -29,8 +29,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 	"github.com/panther-labs/panther/pkg/testutils"
 )
 
@@ -62,6 +62,7 @@ func TestSendSns(t *testing.T) {
 		Link:        "https://panther.io/policies/policyId",
 		Title:       "Policy Failure: policyName",
 		Tags:        []string{},
+		ResourceIDs: []string{},
 	}
 
 	defaultSerializedMessage, err := jsoniter.MarshalToString(defaultMessage)
@@ -81,7 +82,7 @@ func TestSendSns(t *testing.T) {
 	}
 
 	client.On("Publish", expectedSnsPublishInput).Return(&sns.PublishOutput{}, nil)
-	result := outputClient.Sns(alert, snsOutputConfig)
+	result := outputClient.Sns(alert, snsOutputConfig, "")
 	assert.Nil(t, result)
 	client.AssertExpectations(t)
 }
@@ -27,13 +27,13 @@ import (
 	jsoniter "github.com/json-iterator/go"
 	"go.uber.org/zap"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 )
 
 // Sqs sends an alert to an SQS Queue.
 // nolint: dupl
-func (client *OutputClient) Sqs(alert *alertmodels.Alert, config *outputmodels.SqsConfig) *AlertDeliveryError {
+func (client *OutputClient) Sqs(alert *alertmodels.Alert, config *outputmodels.SqsConfig, message string) *AlertDeliveryError {
 	notification := generateNotificationFromAlert(alert)
 
 	serializedMessage, err := jsoniter.MarshalToString(notification)
@@ -42,6 +42,10 @@ func (client *OutputClient) Sqs(alert *alertmodels.Alert, config *outputmodels.S
 		return &AlertDeliveryError{Message: "Failed to serialize message"}
 	}
 
+	if message != "" {
+		serializedMessage = message
+	}
+
 	sqsSendMessageInput := &sqs.SendMessageInput{
 		QueueUrl:    aws.String(config.QueueURL),
 		MessageBody: aws.String(serializedMessage),
@@ -28,8 +28,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
 	outputmodels "github.com/panther-labs/panther/api/lambda/outputs/models"
-	alertmodels "github.com/panther-labs/panther/internal/core/alert_delivery/models"
 	"github.com/panther-labs/panther/pkg/testutils"
 )
 
@@ -57,6 +57,7 @@ func TestSendSqs(t *testing.T) {
 		Link:        "https://panther.io/policies/policyId",
 		Title:       "Policy Failure: policyName",
 		Tags:        []string{},
+		ResourceIDs: []string{},
 	}
 	expectedSerializedSqsMessage, err := jsoniter.MarshalToString(expectedSqsMessage)
 	require.NoError(t, err)
@@ -66,7 +67,7 @@ func TestSendSqs(t *testing.T) {
 	}
 
 	client.On("SendMessage", expectedSqsSendMessageInput).Return(&sqs.SendMessageOutput{}, nil)
-	result := outputClient.Sqs(alert, sqsOutputConfig)
+	result := outputClient.Sqs(alert, sqsOutputConfig, "")
 	assert.Nil(t, result)
 	client.AssertExpectations(t)
 }
@@ -0,0 +1,126 @@
+package outputs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	jsoniter "github.com/json-iterator/go"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
+)
+
+// The maximum size of a rendered message template
+const maxTemplateMessageSize = 64 * 1024
+
+var errTemplateMessageTooLarge = errors.New("rendered message exceeds the maximum size of 64KB")
+
+var templateFunctions = template.FuncMap{
+	"join": strings.Join,
+	// json renders a value as a JSON literal, which is useful to build JSON documents for webhooks
+	"json": func(value interface{}) (string, error) {
+		return jsoniter.MarshalToString(value)
+	},
+}
+
+// TemplateFields are the values available to user-defined message templates.
+//
+// Every alert field can be referenced directly, e.g. {{.AnalysisID}} or {{.Severity}}.
+// Optional alert fields are also available as plain strings, along with the generated alert title and URL.
+type TemplateFields struct {
+	*alertmodels.Alert
+	Name        string
+	Description string
+	Runbook     string
+	Title       string
+	Link        string
+}
+
+// ParseMessageTemplate parses a user-defined message template
+func ParseMessageTemplate(text string) (*template.Template, error) {
+	return template.New("message").Option("missingkey=error").Funcs(templateFunctions).Parse(text)
+}
+
+// RenderMessageTemplate renders a user-defined message template for an alert
+func RenderMessageTemplate(text string, alert *alertmodels.Alert) (string, error) {
+	tmpl, err := ParseMessageTemplate(text)
+	if err != nil {
+		return "", err
+	}
+
+	fields := &TemplateFields{
+		Alert:       alert,
+		Name:        getDisplayName(alert),
+		Description: aws.StringValue(alert.AnalysisDescription),
+		Runbook:     aws.StringValue(alert.Runbook),
+		Title:       generateAlertTitle(alert),
+		Link:        generateURL(alert),
+	}
+	var result bytes.Buffer
+	if err = tmpl.Execute(&limitedWriter{buffer: &result, remaining: maxTemplateMessageSize}, fields); err != nil {
+		return "", err
+	}
+	return result.String(), nil
+}
+
+// ValidateMessageTemplate checks that a template parses and renders for a sample alert
+func ValidateMessageTemplate(text string) error {
+	_, err := RenderMessageTemplate(text, SampleAlert())
+	return err
+}
+
+// SampleAlert returns an alert with all fields set, used to validate and preview message templates
+func SampleAlert() *alertmodels.Alert {
+	return &alertmodels.Alert{
+		AnalysisID:          "Sample.Rule",
+		Type:                alertmodels.RuleType,
+		CreatedAt:           time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Severity:            "HIGH",
+		OutputIDs:           []string{},
+		AnalysisDescription: aws.String("This is a sample alert description"),
+		AnalysisName:        aws.String("Sample Rule"),
+		Version:             aws.String("sampleVersion"),
+		Runbook:             aws.String("This is a sample runbook"),
+		Tags:                []string{"Sample Tag"},
+		LogTypes:            []string{"AWS.CloudTrail"},
+		ResourceTypes:       []string{},
+		ResourceIDs:         []string{},
+		AlertID:             aws.String("00000000000000000000000000000000"),
+		Title:               aws.String("Sample alert title"),
+	}
+}
+
+// limitedWriter fails writes once the maximum message size is exceeded
+type limitedWriter struct {
+	buffer    *bytes.Buffer
+	remaining int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.remaining {
+		return 0, errTemplateMessageTooLarge
+	}
+	w.remaining -= len(p)
+	return w.buffer.Write(p)
+}
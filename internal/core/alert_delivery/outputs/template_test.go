@@ -0,0 +1,70 @@
+package outputs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderMessageTemplate(t *testing.T) {
+	alert := SampleAlert()
+	message, err := RenderMessageTemplate(
+		"{{.AnalysisID}}|{{.Name}}|{{.Runbook}}|{{.Description}}|{{.Title}}|{{.Link}}|{{join .Tags \", \"}}|{{.CreatedAt.Year}}",
+		alert)
+	require.NoError(t, err)
+	assert.Equal(t, "Sample.Rule|Sample Rule|This is a sample runbook|This is a sample alert description|"+
+		"New Alert: Sample alert title|https://panther.io/alerts/00000000000000000000000000000000|Sample Tag|2020", message)
+}
+
+func TestRenderMessageTemplateOptionalFields(t *testing.T) {
+	alert := SampleAlert()
+	alert.Runbook = nil
+	alert.AnalysisName = nil
+	message, err := RenderMessageTemplate("{{.Name}}:{{.Runbook}}", alert)
+	require.NoError(t, err)
+	assert.Equal(t, "Sample.Rule:", message)
+}
+
+func TestRenderMessageTemplateJSON(t *testing.T) {
+	alert := SampleAlert()
+	alert.AnalysisDescription = aws.String(`quoted "description"`)
+	message, err := RenderMessageTemplate(`{"id": {{json .AnalysisID}}, "description": {{json .Description}}}`, alert)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id": "Sample.Rule", "description": "quoted \"description\""}`, message)
+}
+
+func TestValidateMessageTemplate(t *testing.T) {
+	assert.NoError(t, ValidateMessageTemplate("{{.Title}}"))
+	// Parse error
+	assert.Error(t, ValidateMessageTemplate("{{.Title"))
+	// Unknown field
+	assert.Error(t, ValidateMessageTemplate("{{.NoSuchField}}"))
+	// Unknown function
+	assert.Error(t, ValidateMessageTemplate("{{upper .Title}}"))
+}
+
+func TestRenderMessageTemplateTooLarge(t *testing.T) {
+	_, err := RenderMessageTemplate("{{.Title}}"+strings.Repeat("x", maxTemplateMessageSize), SampleAlert())
+	assert.Equal(t, errTemplateMessageTooLarge, err)
+}
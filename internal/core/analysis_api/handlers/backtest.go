@@ -0,0 +1,278 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	enginemodels "github.com/panther-labs/panther/api/gateway/analysis"
+	"github.com/panther-labs/panther/api/gateway/analysis/models"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
+)
+
+const (
+	// Athena scans every partition in the range, so keep it bounded
+	maxBacktestRange      = 31 * 24 * time.Hour
+	defaultBacktestEvents = 10000
+	defaultDedupPeriod    = 60
+	backtestRetention     = 7 * 24 * time.Hour
+
+	// The backtester times out after 15 minutes and failed asynchronous invocations are retried twice,
+	// so a backtest still running after this long was killed before it could save its results.
+	staleBacktestAge = time.Hour
+)
+
+// StartBacktest replays a rule over historical events of one of its log types.
+//
+// The backtest runs asynchronously in the panther-rule-backtester Lambda function,
+// its status and results are retrieved with GetBacktest. The backtest is created by the calling user.
+func StartBacktest(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	input, err := parseStartBacktest(request)
+	if err != nil {
+		return badRequest(err)
+	}
+
+	item, err := dynamoGet(input.RuleID, true)
+	if err != nil {
+		return failedRequest(fmt.Sprintf("Internal error finding %s (%s)", input.RuleID, typeRule), http.StatusInternalServerError)
+	}
+	if item == nil || item.Type != typeRule {
+		return failedRequest(fmt.Sprintf("Cannot find %s (%s)", input.RuleID, typeRule), http.StatusNotFound)
+	}
+	if !hasLogType(item, *input.LogType) {
+		return badRequest(fmt.Errorf("rule %s does not analyze log type %s", input.RuleID, *input.LogType))
+	}
+
+	now := time.Now().UTC()
+	job := &enginemodels.BacktestJob{
+		JobID:              uuid.New().String(),
+		RuleID:             string(item.ID),
+		Body:               string(item.Body),
+		DedupPeriodMinutes: int(item.DedupPeriodMinutes),
+		Threshold:          int(item.Threshold),
+		LogType:            *input.LogType,
+		StartTime:          time.Time(*input.StartTime).UTC(),
+		EndTime:            time.Time(*input.EndTime).UTC(),
+		MaxEvents:          defaultBacktestEvents,
+		Status:             string(models.BacktestStatusRUNNING),
+		CreatedAt:          now,
+		CreatedBy:          gatewayapi.CallerID(request),
+		ExpiresAt:          now.Add(backtestRetention).Unix(),
+	}
+	if job.DedupPeriodMinutes == 0 {
+		job.DedupPeriodMinutes = defaultDedupPeriod
+	}
+	if input.MaxEvents != nil {
+		job.MaxEvents = int(*input.MaxEvents)
+	}
+
+	if err = putBacktest(job); err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	if err = invokeBacktester(job.JobID); err != nil {
+		zap.L().Error("failed to start backtest", zap.String("jobId", job.JobID), zap.Error(err))
+		job.Status = string(models.BacktestStatusFAILED)
+		job.ErrorMessage = "failed to start backtest"
+		job.FinishedAt = &now
+		if err = putBacktest(job); err != nil {
+			zap.L().Error("failed to mark backtest as failed", zap.String("jobId", job.JobID), zap.Error(err))
+		}
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	return gatewayapi.MarshalResponse(backtestResult(job), http.StatusAccepted)
+}
+
+// GetBacktest returns the status and results of a rule backtest.
+func GetBacktest(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	jobID, err := url.QueryUnescape(request.QueryStringParameters["jobId"])
+	if err != nil {
+		return badRequest(errors.New("invalid jobId: " + err.Error()))
+	}
+	if err = models.JobID(jobID).Validate(nil); err != nil {
+		return badRequest(errors.New("invalid jobId: " + err.Error()))
+	}
+
+	job, err := getBacktest(jobID)
+	if err != nil {
+		return failedRequest("Internal error finding backtest "+jobID, http.StatusInternalServerError)
+	}
+	if job == nil {
+		return failedRequest("Cannot find backtest "+jobID, http.StatusNotFound)
+	}
+
+	if failStaleBacktest(job, time.Now().UTC()) {
+		zap.L().Warn("backtest timed out", zap.String("jobId", jobID))
+		if err = putBacktest(job); err != nil {
+			zap.L().Error("failed to mark backtest as failed", zap.String("jobId", jobID), zap.Error(err))
+		}
+	}
+
+	return gatewayapi.MarshalResponse(backtestResult(job), http.StatusOK)
+}
+
+// Mark a backtest which has been running for too long as failed.
+//
+// The backtester can time out without saving its results (e.g. while waiting for Athena),
+// which would otherwise leave the backtest running forever. Returns true if the job was changed.
+func failStaleBacktest(job *enginemodels.BacktestJob, now time.Time) bool {
+	if job.Status != string(models.BacktestStatusRUNNING) || now.Sub(job.CreatedAt) < staleBacktestAge {
+		return false
+	}
+	job.Status = string(models.BacktestStatusFAILED)
+	job.ErrorMessage = "backtest timed out"
+	job.FinishedAt = &now
+	return true
+}
+
+func parseStartBacktest(request *events.APIGatewayProxyRequest) (*models.StartBacktest, error) {
+	var result models.StartBacktest
+	if err := jsoniter.UnmarshalFromString(request.Body, &result); err != nil {
+		return nil, err
+	}
+
+	if err := result.Validate(nil); err != nil {
+		return nil, err
+	}
+
+	start, end := time.Time(*result.StartTime), time.Time(*result.EndTime)
+	if !start.Before(end) {
+		return nil, errors.New("startTime must be before endTime")
+	}
+	if end.Sub(start) > maxBacktestRange {
+		return nil, fmt.Errorf("backtest time range can be at most %d days", maxBacktestRange/(24*time.Hour))
+	}
+
+	return &result, nil
+}
+
+// Start the backtester asynchronously, it updates the job when it finishes.
+func invokeBacktester(jobID string) error {
+	payload, err := jsoniter.Marshal(&enginemodels.BacktesterInput{JobID: jobID})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal backtester input")
+	}
+
+	_, err = lambdaClient.Invoke(&lambda.InvokeInput{
+		FunctionName:   &env.Backtester,
+		InvocationType: aws.String(lambda.InvocationTypeEvent),
+		Payload:        payload,
+	})
+	return err
+}
+
+func putBacktest(job *enginemodels.BacktestJob) error {
+	item, err := dynamodbattribute.MarshalMap(job)
+	if err != nil {
+		zap.L().Error("failed to marshal backtest", zap.Error(err))
+		return err
+	}
+
+	if _, err = dynamoClient.PutItem(&dynamodb.PutItemInput{Item: item, TableName: &env.BacktestTable}); err != nil {
+		zap.L().Error("dynamoClient.PutItem failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Load a backtest from the Dynamo table.
+//
+// Returns (nil, nil) if the backtest doesn't exist.
+func getBacktest(jobID string) (*enginemodels.BacktestJob, error) {
+	response, err := dynamoClient.GetItem(&dynamodb.GetItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			"jobId": {S: &jobID},
+		},
+		TableName: &env.BacktestTable,
+	})
+	if err != nil {
+		zap.L().Error("dynamoClient.GetItem failed", zap.Error(err))
+		return nil, err
+	}
+
+	if len(response.Item) == 0 {
+		return nil, nil
+	}
+
+	var job enginemodels.BacktestJob
+	if err = dynamodbattribute.UnmarshalMap(response.Item, &job); err != nil {
+		zap.L().Error("dynamodbattribute.UnmarshalMap failed", zap.Error(err))
+		return nil, err
+	}
+	return &job, nil
+}
+
+// Convert a backtest from the Dynamo table to the external model
+func backtestResult(job *enginemodels.BacktestJob) *models.Backtest {
+	createdAt, startTime, endTime := strfmt.DateTime(job.CreatedAt),
+		strfmt.DateTime(job.StartTime), strfmt.DateTime(job.EndTime)
+
+	result := &models.Backtest{
+		AlertCount:    aws.Int64(int64(job.AlertCount)),
+		CreatedAt:     &createdAt,
+		CreatedBy:     models.UserID(job.CreatedBy),
+		EndTime:       &endTime,
+		ErrorCount:    aws.Int64(int64(job.ErrorCount)),
+		ErrorMessage:  job.ErrorMessage,
+		EventsScanned: aws.Int64(int64(job.EventsScanned)),
+		JobID:         models.JobID(job.JobID),
+		LogType:       aws.String(job.LogType),
+		MatchCount:    aws.Int64(int64(job.MatchCount)),
+		MaxEvents:     aws.Int64(int64(job.MaxEvents)),
+		RuleID:        models.ID(job.RuleID),
+		SampleMatches: job.SampleMatches,
+		StartTime:     &startTime,
+		Status:        models.BacktestStatus(job.Status),
+		Truncated:     aws.Bool(job.Truncated),
+	}
+	if result.SampleMatches == nil {
+		// serialize as an empty list (not null)
+		result.SampleMatches = []string{}
+	}
+	if job.FinishedAt != nil {
+		finishedAt := strfmt.DateTime(*job.FinishedAt)
+		result.FinishedAt = &finishedAt
+	}
+	return result
+}
+
+// Returns true if the rule analyzes the given log type
+func hasLogType(rule *tableItem, logType string) bool {
+	for _, ruleLogType := range rule.ResourceTypes {
+		if ruleLogType == logType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	enginemodels "github.com/panther-labs/panther/api/gateway/analysis"
+	"github.com/panther-labs/panther/api/gateway/analysis/models"
+)
+
+const testUserID = "9cb3ef5e-c1c6-4f27-9b59-a3d2ff2a4fbc"
+
+func TestParseStartBacktest(t *testing.T) {
+	result, err := parseStartBacktest(&events.APIGatewayProxyRequest{Body: `{
+		"ruleId": "AWS.Root.Login", "logType": "AWS.CloudTrail",
+		"startTime": "2020-05-01T00:00:00Z", "endTime": "2020-05-02T00:00:00Z"}`})
+	require.NoError(t, err)
+	assert.Equal(t, models.ID("AWS.Root.Login"), result.RuleID)
+	assert.Nil(t, result.MaxEvents)
+}
+
+func TestParseStartBacktestInvalidRange(t *testing.T) {
+	_, err := parseStartBacktest(&events.APIGatewayProxyRequest{Body: `{
+		"ruleId": "AWS.Root.Login", "logType": "AWS.CloudTrail",
+		"startTime": "2020-05-02T00:00:00Z", "endTime": "2020-05-01T00:00:00Z"}`})
+	assert.EqualError(t, err, "startTime must be before endTime")
+
+	_, err = parseStartBacktest(&events.APIGatewayProxyRequest{Body: `{
+		"ruleId": "AWS.Root.Login", "logType": "AWS.CloudTrail",
+		"startTime": "2020-01-01T00:00:00Z", "endTime": "2020-05-01T00:00:00Z"}`})
+	assert.EqualError(t, err, "backtest time range can be at most 31 days")
+
+	_, err = parseStartBacktest(&events.APIGatewayProxyRequest{Body: `{
+		"ruleId": "AWS.Root.Login", "logType": "AWS.CloudTrail",
+		"startTime": "2020-05-01T00:00:00Z", "endTime": "2020-05-02T00:00:00Z", "maxEvents": 0}`})
+	assert.Error(t, err)
+}
+
+func TestBacktestResult(t *testing.T) {
+	start := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	result := backtestResult(&enginemodels.BacktestJob{
+		JobID:     "a58cf7a4-4a1e-4cc6-b2e4-8b2a3e34e7b8",
+		RuleID:    "AWS.Root.Login",
+		LogType:   "AWS.CloudTrail",
+		StartTime: start,
+		EndTime:   start.Add(time.Hour),
+		MaxEvents: defaultBacktestEvents,
+		Status:    string(models.BacktestStatusRUNNING),
+		CreatedAt: start,
+		CreatedBy: testUserID,
+	})
+	require.NoError(t, result.Validate(nil))
+	assert.Equal(t, []string{}, result.SampleMatches)
+	assert.Nil(t, result.FinishedAt)
+	assert.Equal(t, int64(0), *result.MatchCount)
+}
+
+func TestFailStaleBacktest(t *testing.T) {
+	start := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	job := &enginemodels.BacktestJob{
+		Status:    string(models.BacktestStatusRUNNING),
+		CreatedAt: start,
+	}
+
+	assert.False(t, failStaleBacktest(job, start.Add(15*time.Minute)))
+	assert.Equal(t, string(models.BacktestStatusRUNNING), job.Status)
+
+	now := start.Add(staleBacktestAge)
+	assert.True(t, failStaleBacktest(job, now))
+	assert.Equal(t, string(models.BacktestStatusFAILED), job.Status)
+	assert.Equal(t, "backtest timed out", job.ErrorMessage)
+	assert.Equal(t, &now, job.FinishedAt)
+
+	// Finished backtests are left alone
+	assert.False(t, failStaleBacktest(job, now.Add(time.Hour)))
+}
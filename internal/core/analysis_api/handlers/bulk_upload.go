@@ -27,6 +27,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -42,6 +43,9 @@ import (
 	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
+// Maximum number of items whose unit tests run at the same time
+const maxConcurrentTests = 10
+
 type writeResult struct {
 	item       *tableItem
 	changeType int
@@ -60,21 +64,19 @@ func BulkUpload(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyR
 		return badRequest(err)
 	}
 
-	// Create/modify each policy in parallel
-	results := make(chan writeResult)
-	for _, policy := range policies {
-		go func(item *tableItem) {
-			defer func() {
-				// Recover from panic so we don't block forever when waiting for routines to finish.
-				if r := recover(); r != nil {
-					zap.L().Error("panicked while processing item",
-						zap.String("id", string(item.ID)), zap.Any("panic", r))
-					results <- writeResult{err: errors.New("panicked goroutine")}
-				}
-			}()
-			changeType, err := writeItem(item, input.UserID, nil)
-			results <- writeResult{item: item, changeType: changeType, err: err}
-		}(policy)
+	testFailures := make([]*models.TestFailure, 0)
+	if input.OverrideTests {
+		if errResponse := authorizeTestOverride(request); errResponse != nil {
+			return errResponse
+		}
+	} else {
+		var errResponse *events.APIGatewayProxyResponse
+		testFailures, errResponse = disableFailingItems(policies, testGlobals(policies), func(id models.ID) (*tableItem, error) {
+			return dynamoGet(id, true)
+		})
+		if errResponse != nil {
+			return errResponse
+		}
 	}
 
 	counts := &models.BulkUploadResult{
@@ -89,13 +91,13 @@ func BulkUpload(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyR
 		ModifiedGlobals: aws.Int64(0),
 		NewGlobals:      aws.Int64(0),
 		TotalGlobals:    aws.Int64(0),
+
+		TestFailures: testFailures,
 	}
 
 	var response *events.APIGatewayProxyResponse
 
-	// Wait for all the goroutines to finish.
-	for range policies {
-		result := <-results
+	for _, result := range writeItems(policies, input.UserID) {
 		if result.err != nil {
 			// Set the response with an error code - 4XX first, otherwise 5XX
 			if result.err == errWrongType {
@@ -149,6 +151,132 @@ func BulkUpload(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyR
 	return gatewayapi.MarshalResponse(counts, http.StatusOK)
 }
 
+// Create/modify each item in parallel.
+func writeItems(items map[models.ID]*tableItem, userID models.UserID) []writeResult {
+	results := make(chan writeResult)
+	for _, item := range items {
+		go func(item *tableItem) {
+			defer func() {
+				// Recover from panic so we don't block forever when waiting for routines to finish.
+				if r := recover(); r != nil {
+					zap.L().Error("panicked while processing item",
+						zap.String("id", string(item.ID)), zap.Any("panic", r))
+					results <- writeResult{err: errors.New("panicked goroutine")}
+				}
+			}()
+			changeType, err := writeItem(item, userID, nil)
+			results <- writeResult{item: item, changeType: changeType, err: err}
+		}(item)
+	}
+
+	// Wait for all the goroutines to finish.
+	all := make([]writeResult, 0, len(items))
+	for range items {
+		all = append(all, <-results)
+	}
+	return all
+}
+
+// The result of the unit tests of one item
+type itemTestResult struct {
+	failure     *models.TestFailure
+	errResponse *events.APIGatewayProxyResponse
+}
+
+// Run the unit tests of every enabled policy and rule in parallel and disable the ones which fail.
+//
+// The tests run with the given globals instead of the deployed ones with the same ID.
+// An update of a policy or rule which is currently enabled is not disabled: it is removed from
+// items so the enabled version stays in place, and reported with the other failures.
+func disableFailingItems(
+	items map[models.ID]*tableItem,
+	globals []analysis.Global,
+	currentItem func(models.ID) (*tableItem, error),
+) ([]*models.TestFailure, *events.APIGatewayProxyResponse) {
+
+	// Each item is tested in its own engine invocation, so limit how many run at once
+	queue := make(chan *tableItem, len(items))
+	for _, item := range items {
+		queue <- item
+	}
+	close(queue)
+
+	results := make(chan itemTestResult)
+	for i := 0; i < maxConcurrentTests && i < len(items); i++ {
+		go func() {
+			for item := range queue {
+				results <- testItem(item, globals)
+			}
+		}()
+	}
+
+	var (
+		failures = make([]*models.TestFailure, 0)
+		response *events.APIGatewayProxyResponse
+	)
+	for range items {
+		result := <-results
+		if result.errResponse != nil {
+			// Report a 4XX (invalid test) before a 5XX
+			if response == nil || result.errResponse.StatusCode < response.StatusCode {
+				response = result.errResponse
+			}
+			continue
+		}
+		if result.failure != nil {
+			failures = append(failures, result.failure)
+		}
+	}
+	if response != nil {
+		return nil, response
+	}
+
+	for _, failure := range failures {
+		item := items[failure.ID]
+		current, err := currentItem(failure.ID)
+		if err != nil {
+			return nil, &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+		}
+
+		if current != nil && current.Type == item.Type && bool(current.Enabled) {
+			delete(items, failure.ID)
+			failure.Message = aws.String(fmt.Sprintf("%s %s was not updated and the current version stays enabled, "+
+				"unit tests failed: %s", strings.ToLower(item.Type), item.ID, failedTests(failure.TestResults)))
+		} else {
+			item.Enabled = false
+		}
+	}
+
+	sort.Slice(failures, func(i, j int) bool { return failures[i].ID < failures[j].ID })
+	return failures, nil
+}
+
+// Run the unit tests of an item, recovering from a panic so the caller does not wait forever
+func testItem(item *tableItem, globals []analysis.Global) (result itemTestResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			zap.L().Error("panicked while testing item",
+				zap.String("id", string(item.ID)), zap.Any("panic", r))
+			result = itemTestResult{errResponse: &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}}
+		}
+	}()
+
+	failure, errResponse := testEnabledItem(item, globals)
+	return itemTestResult{failure: failure, errResponse: errResponse}
+}
+
+// The globals among the items, which unit tests run with instead of the deployed globals
+func testGlobals(items map[models.ID]*tableItem) []analysis.Global {
+	var globals []analysis.Global
+	for _, item := range items {
+		if item.Type == typeGlobal {
+			globals = append(globals, analysis.Global{Body: string(item.Body), ID: string(item.ID)})
+		}
+	}
+	sort.Slice(globals, func(i, j int) bool { return globals[i].ID < globals[j].ID })
+	return globals
+}
+
 func parseBulkUpload(request *events.APIGatewayProxyRequest) (*models.BulkUpload, error) {
 	var result models.BulkUpload
 	if err := jsoniter.UnmarshalFromString(request.Body, &result); err != nil {
@@ -273,6 +401,10 @@ func extractZipFile(input *models.BulkUpload) (map[models.ID]*tableItem, error)
 
 // typeNormalizeTableItem handles special cases that depend on a table item's analysis type
 func typeNormalizeTableItem(item *tableItem, config analysis.Config) {
+	if item.Type == string(models.AnalysisTypePOLICY) {
+		item.DigestPeriodMinutes = models.DigestPeriodMinutes(config.DigestPeriodMinutes)
+	}
+
 	if item.Type == string(models.AnalysisTypeRULE) {
 		// If there is no value set, default to 60 minutes
 		if config.DedupPeriodMinutes == 0 {
@@ -280,6 +412,7 @@ func typeNormalizeTableItem(item *tableItem, config analysis.Config) {
 		} else {
 			item.DedupPeriodMinutes = models.DedupPeriodMinutes(config.DedupPeriodMinutes)
 		}
+		item.Threshold = models.Threshold(config.Threshold)
 
 		// These "syntax sugar" re-mappings are to make managing rules from the CLI more intuitive
 		if config.PolicyID == "" {
@@ -33,6 +33,7 @@ import (
 	"github.com/kelseyhightower/envconfig"
 
 	complianceapi "github.com/panther-labs/panther/api/gateway/compliance/client"
+	"github.com/panther-labs/panther/pkg/authz"
 	"github.com/panther-labs/panther/pkg/gatewayapi"
 )
 
@@ -47,9 +48,14 @@ var (
 
 	httpClient       *http.Client
 	complianceClient *complianceapi.PantherCompliance
+
+	// PermissionChecker resolves the permissions of API callers.
+	PermissionChecker authz.Checker
 )
 
 type envConfig struct {
+	BacktestTable        string `required:"true" split_words:"true"`
+	Backtester           string `required:"true" split_words:"true"`
 	Bucket               string `required:"true" split_words:"true"`
 	ComplianceAPIHost    string `required:"true" split_words:"true"`
 	ComplianceAPIPath    string `required:"true" split_words:"true"`
@@ -58,6 +64,8 @@ type envConfig struct {
 	PolicyEngine         string `required:"true" split_words:"true"`
 	ResourceQueueURL     string `required:"true" split_words:"true"`
 	Table                string `required:"true" split_words:"true"`
+	UserRolesTable       string `required:"true" split_words:"true"`
+	RolesTable           string `required:"true" split_words:"true"`
 }
 
 // Setup parses the environment and constructs AWS and http clients on a cold Lambda start.
@@ -70,6 +78,7 @@ func Setup() {
 	s3Client = s3.New(awsSession)
 	sqsClient = sqs.New(awsSession)
 	lambdaClient = lambda.New(awsSession)
+	PermissionChecker = authz.NewTableChecker(dynamoClient, env.UserRolesTable, env.RolesTable)
 
 	httpClient = gatewayapi.GatewayClient(awsSession)
 	complianceClient = complianceapi.NewHTTPClientWithConfig(
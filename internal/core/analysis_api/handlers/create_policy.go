@@ -43,6 +43,7 @@ func CreatePolicy(request *events.APIGatewayProxyRequest) *events.APIGatewayProx
 		AutoRemediationParameters: input.AutoRemediationParameters,
 		Body:                      input.Body,
 		Description:               input.Description,
+		DigestPeriodMinutes:       input.DigestPeriodMinutes,
 		DisplayName:               input.DisplayName,
 		Enabled:                   input.Enabled,
 		ID:                        input.ID,
@@ -56,6 +57,10 @@ func CreatePolicy(request *events.APIGatewayProxyRequest) *events.APIGatewayProx
 		Type:                      typePolicy,
 	}
 
+	if errResponse := checkTests(request, item, input.OverrideTests); errResponse != nil {
+		return errResponse
+	}
+
 	if _, err := writeItem(item, input.UserID, aws.Bool(false)); err != nil {
 		if err == errExists {
 			return &events.APIGatewayProxyResponse{StatusCode: http.StatusConflict}
@@ -56,6 +56,11 @@ func CreateRule(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyR
 		Tests:              input.Tests,
 		Type:               typeRule,
 		DedupPeriodMinutes: input.DedupPeriodMinutes,
+		Threshold:          input.Threshold,
+	}
+
+	if errResponse := checkTests(request, item, input.OverrideTests); errResponse != nil {
+		return errResponse
 	}
 
 	if _, err := writeItem(item, input.UserID, aws.Bool(false)); err != nil {
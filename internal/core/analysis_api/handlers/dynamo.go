@@ -50,6 +50,7 @@ type tableItem struct {
 	AutoRemediationID         models.AutoRemediationID         `json:"autoRemediationId,omitempty"`
 	AutoRemediationParameters models.AutoRemediationParameters `json:"autoRemediationParameters,omitempty"`
 	Body                      models.Body                      `json:"body"`
+	CommitSha                 models.CommitSha                 `json:"commitSha,omitempty"`
 	CreatedAt                 models.ModifyTime                `json:"createdAt"`
 	CreatedBy                 models.UserID                    `json:"createdBy"`
 	Description               models.Description               `json:"description,omitempty"`
@@ -67,6 +68,8 @@ type tableItem struct {
 	Tests                     []*models.UnitTest               `json:"tests,omitempty"`
 	VersionID                 models.VersionID                 `json:"versionId,omitempty"`
 	DedupPeriodMinutes        models.DedupPeriodMinutes        `json:"dedupPeriodMinutes,omitempty"`
+	Threshold                 models.Threshold                 `json:"threshold,omitempty"`
+	DigestPeriodMinutes       models.DigestPeriodMinutes       `json:"digestPeriodMinutes,omitempty"`
 	Reports                   models.Reports                   `json:"reports,omitempty"`
 
 	// Logic type (policy or rule)
@@ -99,10 +102,12 @@ func (r *tableItem) Policy(status models.ComplianceStatus) *models.Policy {
 		AutoRemediationID:         r.AutoRemediationID,
 		AutoRemediationParameters: r.AutoRemediationParameters,
 		Body:                      r.Body,
+		CommitSha:                 r.CommitSha,
 		ComplianceStatus:          status,
 		CreatedAt:                 r.CreatedAt,
 		CreatedBy:                 r.CreatedBy,
 		Description:               r.Description,
+		DigestPeriodMinutes:       r.DigestPeriodMinutes,
 		DisplayName:               r.DisplayName,
 		Enabled:                   r.Enabled,
 		ID:                        r.ID,
@@ -146,6 +151,7 @@ func (r *tableItem) Rule() *models.Rule {
 	r.normalize()
 	result := &models.Rule{
 		Body:               r.Body,
+		CommitSha:          r.CommitSha,
 		CreatedAt:          r.CreatedAt,
 		CreatedBy:          r.CreatedBy,
 		Description:        r.Description,
@@ -162,6 +168,7 @@ func (r *tableItem) Rule() *models.Rule {
 		Tests:              r.Tests,
 		VersionID:          r.VersionID,
 		DedupPeriodMinutes: r.DedupPeriodMinutes,
+		Threshold:          r.Threshold,
 	}
 	gatewayapi.ReplaceMapSliceNils(result)
 	return result
@@ -172,6 +179,7 @@ func (r *tableItem) Global() *models.Global {
 	r.normalize()
 	result := &models.Global{
 		Body:           r.Body,
+		CommitSha:      r.CommitSha,
 		CreatedAt:      r.CreatedAt,
 		CreatedBy:      r.CreatedBy,
 		Description:    r.Description,
@@ -251,7 +259,9 @@ func (s suppressSet) MarshalDynamoDBAttributeValue(av *dynamodb.AttributeValue)
 
 // Add suppressions to an existing policy, returning the updated list of policies.
 func addSuppressions(policyIDs []models.ID, patterns models.Suppressions) ([]*tableItem, error) {
-	update := expression.Add(expression.Name("suppressions"), expression.Value(suppressSet(patterns)))
+	// Suppressions added in Panther are not in the Git repository (if any), the next sync should report a conflict
+	update := expression.Add(expression.Name("suppressions"), expression.Value(suppressSet(patterns))).
+		Remove(expression.Name("commitSha"))
 	condition := expression.AttributeExists(expression.Name("id"))
 	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
 	if err != nil {
@@ -39,6 +39,7 @@ func ModifyPolicy(request *events.APIGatewayProxyRequest) *events.APIGatewayProx
 		AutoRemediationParameters: input.AutoRemediationParameters,
 		Body:                      input.Body,
 		Description:               input.Description,
+		DigestPeriodMinutes:       input.DigestPeriodMinutes,
 		DisplayName:               input.DisplayName,
 		Enabled:                   input.Enabled,
 		ID:                        input.ID,
@@ -52,6 +53,10 @@ func ModifyPolicy(request *events.APIGatewayProxyRequest) *events.APIGatewayProx
 		Type:                      typePolicy,
 	}
 
+	if errResponse := checkTests(request, item, input.OverrideTests); errResponse != nil {
+		return errResponse
+	}
+
 	if _, err := writeItem(item, input.UserID, aws.Bool(true)); err != nil {
 		if err == errNotExists || err == errWrongType {
 			// errWrongType means we tried to modify a policy that is actually a rule.
@@ -48,6 +48,11 @@ func ModifyRule(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyR
 		Tests:              input.Tests,
 		Type:               typeRule,
 		DedupPeriodMinutes: input.DedupPeriodMinutes,
+		Threshold:          input.Threshold,
+	}
+
+	if errResponse := checkTests(request, item, input.OverrideTests); errResponse != nil {
+		return errResponse
 	}
 
 	if _, err := writeItem(item, input.UserID, aws.Bool(true)); err != nil {
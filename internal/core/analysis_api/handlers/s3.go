@@ -21,8 +21,10 @@ package handlers
 import (
 	"bytes"
 	"io/ioutil"
+	"net/http"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	jsoniter "github.com/json-iterator/go"
 	"go.uber.org/zap"
@@ -52,6 +54,8 @@ func s3BatchDelete(input *models.DeletePolicies) error {
 }
 
 // Load a policy from the S3 bucket.
+//
+// Returns nil, nil if the version does not exist.
 func s3Get(policyID models.ID, versionID models.VersionID) (*tableItem, error) {
 	result, err := s3Client.GetObject(&s3.GetObjectInput{
 		Bucket:    &env.Bucket,
@@ -59,6 +63,9 @@ func s3Get(policyID models.ID, versionID models.VersionID) (*tableItem, error) {
 		VersionId: aws.String(string(versionID)),
 	})
 	if err != nil {
+		if versionNotFound(err) {
+			return nil, nil
+		}
 		zap.L().Error("s3Client.GetObject failed", zap.Error(err))
 		return nil, err
 	}
@@ -106,3 +113,36 @@ func s3Upload(policy *tableItem) error {
 	policy.VersionID = models.VersionID(*result.VersionId)
 	return nil
 }
+
+// List the versions of a policy in the S3 bucket (S3 lists the versions of a key newest first).
+//
+// Versions of deleted policies are included, but the delete markers themselves are not.
+func s3ListVersions(policyID models.ID) ([]*s3.ObjectVersion, error) {
+	var versions []*s3.ObjectVersion
+	err := s3Client.ListObjectVersionsPages(&s3.ListObjectVersionsInput{
+		Bucket: &env.Bucket,
+		Prefix: aws.String(string(policyID)),
+	}, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		for _, version := range page.Versions {
+			// The prefix also matches longer IDs (e.g. "AWS.IAM" is a prefix of "AWS.IAM.MFA")
+			if aws.StringValue(version.Key) == string(policyID) {
+				versions = append(versions, version)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		zap.L().Error("s3Client.ListObjectVersionsPages failed", zap.Error(err))
+		return nil, err
+	}
+	return versions, nil
+}
+
+// Returns true if S3 rejected a GetObject because the key or version does not exist.
+func versionNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.RequestFailure); ok {
+		// S3 returns 400 InvalidArgument for a well-formed version ID which was never issued
+		return awsErr.StatusCode() == http.StatusNotFound || awsErr.Code() == "InvalidArgument"
+	}
+	return false
+}
@@ -0,0 +1,294 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/gateway/analysis/models"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
+)
+
+const (
+	// Max number of items in a single S3 DeleteObjects call
+	maxDeleteBatch = 1000
+
+	// A sync which deletes more than half of the synced items (and more than a few) is likely a
+	// mistake, e.g. a wrong path or branch, and has to be confirmed with overwriteConflicts
+	maxDeleteFraction  = 0.5
+	minMassDeleteCount = 10
+)
+
+// The changes needed to bring the analysis table in line with a repository snapshot
+type syncPlan struct {
+	created, updated, deleted []models.ID
+	unchanged                 int
+	conflicts                 []*models.SyncConflict
+
+	// Created and updated items, whose unit tests need to pass before they can be enabled
+	changed map[models.ID]*tableItem
+
+	// Unchanged items which were not yet stamped with a commit SHA
+	adopted map[models.ID]*tableItem
+}
+
+// SyncAnalysis synchronizes policies, rules and globals with a snapshot of a Git repository.
+func SyncAnalysis(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	input, err := parseSyncAnalysis(request)
+	if err != nil {
+		return badRequest(err)
+	}
+
+	// The snapshot has the same layout as a bulk upload
+	snapshot, err := extractZipFile(&models.BulkUpload{Data: input.Data, UserID: input.UserID})
+	if err != nil {
+		return badRequest(err)
+	}
+
+	if len(snapshot) == 0 && !input.OverwriteConflicts {
+		return badRequest(errors.New(
+			"the snapshot has no policies, rules or globals: set overwriteConflicts to delete every synced item"))
+	}
+
+	current, err := scanAllItems()
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	plan := planSync(snapshot, current, input.OverwriteConflicts)
+	if err := checkMassDelete(plan, current); err != nil && !input.OverwriteConflicts && !input.DryRun {
+		return badRequest(err)
+	}
+	testFailures, errResponse := disableFailingItems(plan.changed, testGlobals(snapshot), func(id models.ID) (*tableItem, error) {
+		return current[id], nil
+	})
+	if errResponse != nil {
+		return errResponse
+	}
+	// Updates which failed their tests were dropped to keep the enabled version
+	plan.updated = changedIDs(plan.updated, plan.changed)
+
+	result := &models.SyncResult{
+		CommitSha:    input.CommitSha,
+		Conflicts:    plan.conflicts,
+		Created:      plan.created,
+		Deleted:      plan.deleted,
+		DryRun:       aws.Bool(input.DryRun),
+		TestFailures: testFailures,
+		Unchanged:    aws.Int64(int64(plan.unchanged)),
+		Updated:      plan.updated,
+	}
+	if input.DryRun {
+		return gatewayapi.MarshalResponse(result, http.StatusOK)
+	}
+
+	// Every version written by the sync records the commit it came from
+	writes := make(map[models.ID]*tableItem, len(plan.changed)+len(plan.adopted))
+	for _, items := range []map[models.ID]*tableItem{plan.changed, plan.adopted} {
+		for id, item := range items {
+			item.CommitSha = input.CommitSha
+			writes[id] = item
+		}
+	}
+
+	globalsChanged := false
+	var response *events.APIGatewayProxyResponse
+	for _, write := range writeItems(writes, input.UserID) {
+		if write.err != nil {
+			response = &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+			continue
+		}
+		if write.item.Type == typeGlobal && write.changeType != noChange {
+			globalsChanged = true
+		}
+	}
+
+	// If a write failed, the snapshot was not fully applied: keep everything else until the next sync
+	if len(plan.deleted) > 0 && response == nil {
+		if err := deleteItems(plan.deleted); err != nil {
+			return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+		}
+		for _, id := range plan.deleted {
+			if current[id].Type == typeGlobal {
+				globalsChanged = true
+			}
+		}
+	}
+
+	if globalsChanged {
+		if err := updateLayer(typeGlobal); err != nil {
+			return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+		}
+	}
+
+	if response != nil {
+		return response
+	}
+
+	zap.L().Info("analysis synced", zap.String("commitSha", string(input.CommitSha)),
+		zap.Int("created", len(plan.created)), zap.Int("updated", len(plan.updated)),
+		zap.Int("deleted", len(plan.deleted)), zap.Int("conflicts", len(plan.conflicts)))
+	return gatewayapi.MarshalResponse(result, http.StatusOK)
+}
+
+func parseSyncAnalysis(request *events.APIGatewayProxyRequest) (*models.SyncAnalysis, error) {
+	var result models.SyncAnalysis
+	if err := jsoniter.UnmarshalFromString(request.Body, &result); err != nil {
+		return nil, err
+	}
+
+	if err := result.Validate(nil); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Load every policy, rule and global from the table, keyed by ID.
+func scanAllItems() (map[models.ID]*tableItem, error) {
+	result := make(map[models.ID]*tableItem)
+	err := scanPages(&dynamodb.ScanInput{ConsistentRead: aws.Bool(true), TableName: &env.Table},
+		func(item *tableItem) error {
+			result[item.ID] = item
+			return nil
+		})
+	return result, err
+}
+
+// Compare a repository snapshot with the current table contents.
+//
+// Items which carry a commit SHA are managed by the sync. Panther clears the SHA whenever an item is
+// edited in any other way, so a changed item without a SHA is a conflict: it is only overwritten if
+// overwriteConflicts is set. Items without a SHA are never deleted.
+func planSync(snapshot, current map[models.ID]*tableItem, overwriteConflicts bool) *syncPlan {
+	plan := &syncPlan{
+		created:   []models.ID{},
+		updated:   []models.ID{},
+		deleted:   []models.ID{},
+		conflicts: []*models.SyncConflict{},
+		changed:   make(map[models.ID]*tableItem),
+		adopted:   make(map[models.ID]*tableItem),
+	}
+
+	for id, item := range snapshot {
+		old := current[id]
+		switch {
+		case old == nil:
+			plan.created = append(plan.created, id)
+			plan.changed[id] = item
+
+		case old.Type != item.Type:
+			plan.conflicts = append(plan.conflicts, syncConflict(old,
+				"is a "+strings.ToLower(old.Type)+" in Panther but a "+strings.ToLower(item.Type)+" in the repository"))
+
+		case !itemUpdated(old, item):
+			plan.unchanged++
+			if old.CommitSha == "" {
+				plan.adopted[id] = item
+			}
+
+		case old.CommitSha == "" && !overwriteConflicts:
+			plan.conflicts = append(plan.conflicts, syncConflict(old, "was edited outside of the repository sync"))
+
+		default:
+			plan.updated = append(plan.updated, id)
+			plan.changed[id] = item
+		}
+	}
+
+	for id, old := range current {
+		if _, ok := snapshot[id]; !ok && old.CommitSha != "" {
+			plan.deleted = append(plan.deleted, id)
+		}
+	}
+
+	for _, ids := range [][]models.ID{plan.created, plan.updated, plan.deleted} {
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	}
+	sort.Slice(plan.conflicts, func(i, j int) bool { return plan.conflicts[i].ID < plan.conflicts[j].ID })
+	return plan
+}
+
+// Returns an error if the plan deletes too many of the items managed by the sync.
+func checkMassDelete(plan *syncPlan, current map[models.ID]*tableItem) error {
+	synced := 0
+	for _, item := range current {
+		if item.CommitSha != "" {
+			synced++
+		}
+	}
+
+	if len(plan.deleted) > minMassDeleteCount && float64(len(plan.deleted)) > maxDeleteFraction*float64(synced) {
+		return fmt.Errorf("the sync would delete %d of %d synced items: set overwriteConflicts to confirm",
+			len(plan.deleted), synced)
+	}
+	return nil
+}
+
+// Filter ids down to those which are still in changed
+func changedIDs(ids []models.ID, changed map[models.ID]*tableItem) []models.ID {
+	result := make([]models.ID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := changed[id]; ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func syncConflict(item *tableItem, reason string) *models.SyncConflict {
+	return &models.SyncConflict{
+		ID:             item.ID,
+		LastModified:   item.LastModified,
+		LastModifiedBy: item.LastModifiedBy,
+		Message:        aws.String(string(item.ID) + " " + reason),
+	}
+}
+
+// Delete policies, rules and globals from the table, the bucket and the compliance status table.
+func deleteItems(ids []models.ID) error {
+	for start := 0; start < len(ids); start += maxDeleteBatch {
+		batch := ids[start:intMin(start+maxDeleteBatch, len(ids))]
+		input := &models.DeletePolicies{Policies: make([]*models.DeleteEntry, len(batch))}
+		for i, id := range batch {
+			input.Policies[i] = &models.DeleteEntry{ID: id}
+		}
+
+		if err := dynamoBatchDelete(input); err != nil {
+			return err
+		}
+		if err := s3BatchDelete(input); err != nil {
+			return err
+		}
+		if err := complianceBatchDelete(input.Policies, []string{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
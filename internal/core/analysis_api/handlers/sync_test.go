@@ -0,0 +1,108 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/api/gateway/analysis/models"
+)
+
+const testCommitSha = "5b4b03a1e2d9a5c4e8e1f0a7c3d6b2e9f8a1c0d7"
+
+func syncTestItem(id models.ID, body models.Body, commitSha models.CommitSha) *tableItem {
+	return &tableItem{
+		Body:      body,
+		CommitSha: commitSha,
+		Enabled:   true,
+		ID:        id,
+		Severity:  models.SeverityINFO,
+		Type:      typeRule,
+	}
+}
+
+func TestPlanSync(t *testing.T) {
+	snapshot := map[models.ID]*tableItem{
+		"New":        syncTestItem("New", "def rule(e): return True", ""),
+		"Changed":    syncTestItem("Changed", "def rule(e): return False", ""),
+		"Same":       syncTestItem("Same", "def rule(e): return True", ""),
+		"Adopted":    syncTestItem("Adopted", "def rule(e): return True", ""),
+		"EditedInUI": syncTestItem("EditedInUI", "def rule(e): return False", ""),
+	}
+	current := map[models.ID]*tableItem{
+		"Changed":     syncTestItem("Changed", "def rule(e): return True", testCommitSha),
+		"Same":        syncTestItem("Same", "def rule(e): return True", testCommitSha),
+		"Adopted":     syncTestItem("Adopted", "def rule(e): return True", ""),
+		"EditedInUI":  syncTestItem("EditedInUI", "def rule(e): return True", ""),
+		"Removed":     syncTestItem("Removed", "def rule(e): return True", testCommitSha),
+		"CreatedInUI": syncTestItem("CreatedInUI", "def rule(e): return True", ""),
+	}
+
+	plan := planSync(snapshot, current, false)
+	assert.Equal(t, []models.ID{"New"}, plan.created)
+	assert.Equal(t, []models.ID{"Changed"}, plan.updated)
+	assert.Equal(t, []models.ID{"Removed"}, plan.deleted)
+	assert.Equal(t, 2, plan.unchanged)
+	assert.Len(t, plan.changed, 2)
+	assert.Contains(t, plan.adopted, models.ID("Adopted"))
+	require.Len(t, plan.conflicts, 1)
+	assert.Equal(t, "EditedInUI was edited outside of the repository sync", *plan.conflicts[0].Message)
+
+	plan = planSync(snapshot, current, true)
+	assert.Equal(t, []models.ID{"Changed", "EditedInUI"}, plan.updated)
+	assert.Empty(t, plan.conflicts)
+}
+
+func TestPlanSyncWrongType(t *testing.T) {
+	policy := syncTestItem("Item", "def policy(r): return True", testCommitSha)
+	policy.Type = typePolicy
+
+	plan := planSync(
+		map[models.ID]*tableItem{"Item": syncTestItem("Item", "def rule(e): return True", "")},
+		map[models.ID]*tableItem{"Item": policy}, true)
+	assert.Empty(t, plan.updated)
+	require.Len(t, plan.conflicts, 1)
+	assert.Equal(t, "Item is a policy in Panther but a rule in the repository", *plan.conflicts[0].Message)
+}
+
+func TestCheckMassDelete(t *testing.T) {
+	current := make(map[models.ID]*tableItem)
+	for i := 0; i < 30; i++ {
+		id := models.ID(fmt.Sprintf("Synced%d", i))
+		current[id] = syncTestItem(id, "def rule(e): return True", testCommitSha)
+	}
+	current["CreatedInUI"] = syncTestItem("CreatedInUI", "def rule(e): return True", "")
+
+	// Up to half of the synced items can be deleted
+	plan := planSync(make(map[models.ID]*tableItem), current, false)
+	plan.deleted = plan.deleted[:15]
+	assert.NoError(t, checkMassDelete(plan, current))
+
+	plan.deleted = append(plan.deleted, "Synced29")
+	assert.EqualError(t, checkMassDelete(plan, current),
+		"the sync would delete 16 of 30 synced items: set overwriteConflicts to confirm")
+
+	// Small repositories can always be emptied
+	small := map[models.ID]*tableItem{"Synced": syncTestItem("Synced", "def rule(e): return True", testCommitSha)}
+	assert.NoError(t, checkMassDelete(planSync(make(map[models.ID]*tableItem), small, false), small))
+}
@@ -19,11 +19,13 @@ package handlers
  */
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
@@ -31,6 +33,7 @@ import (
 
 	enginemodels "github.com/panther-labs/panther/api/gateway/analysis"
 	"github.com/panther-labs/panther/api/gateway/analysis/models"
+	"github.com/panther-labs/panther/pkg/authz"
 	"github.com/panther-labs/panther/pkg/gatewayapi"
 )
 
@@ -40,20 +43,47 @@ const (
 )
 
 // TestPolicy runs a policy against a set of unit tests.
-//
-// TODO - test policies before enabling them
 func TestPolicy(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
 	input, err := parseTestPolicy(request)
 	if err != nil {
 		return badRequest(err)
 	}
 
+	testResults, errResponse := runTests(input, nil)
+	if errResponse != nil {
+		return errResponse
+	}
+
+	// Return the number of passing, failing, and error-ing tests
+	return gatewayapi.MarshalResponse(testResults, http.StatusOK)
+}
+
+// Run the unit tests of a policy or rule through its analysis engine.
+//
+// The globals replace the deployed globals with the same ID while the tests run.
+func runTests(
+	input *models.TestPolicy, globals []enginemodels.Global) (*models.TestPolicyResult, *events.APIGatewayProxyResponse) {
+
+	// Determine the results of the tests
+	var testResults = models.TestPolicyResult{
+		TestSummary: true,
+		// initialize as empty slices (not null) so they serialize correctly
+		TestsErrored: models.TestsErrored{},
+		TestsFailed:  models.TestsFailed{},
+		TestsPassed:  models.TestsPassed{},
+	}
+
+	if len(input.Tests) == 0 {
+		// Nothing to run, don't bother invoking the engine
+		return &testResults, nil
+	}
+
 	var results *enginemodels.PolicyEngineOutput
 	// Build the policy engine request
 	if input.AnalysisType == models.AnalysisTypeRULE {
-		ruleResults, errResponse := getRuleResults(input)
+		ruleResults, errResponse := getRuleResults(input, globals)
 		if errResponse != nil {
-			return errResponse
+			return nil, errResponse
 		}
 		results = &enginemodels.PolicyEngineOutput{
 			Resources: make([]enginemodels.Result, 0, len(ruleResults.Events)),
@@ -70,21 +100,12 @@ func TestPolicy(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyR
 		}
 	} else {
 		var errResponse *events.APIGatewayProxyResponse
-		results, errResponse = getPolicyResults(input)
+		results, errResponse = getPolicyResults(input, globals)
 		if errResponse != nil {
-			return errResponse
+			return nil, errResponse
 		}
 	}
 
-	// Determine the results of the tests
-	var testResults = models.TestPolicyResult{
-		TestSummary: true,
-		// initialize as empty slices (not null) so they serialize correctly
-		TestsErrored: models.TestsErrored{},
-		TestsFailed:  models.TestsFailed{},
-		TestsPassed:  models.TestsPassed{},
-	}
-
 	for _, result := range results.Resources {
 		// Determine which test case this result corresponds to. We constructed resourceID with the
 		// format Panther:Test:Resource:TestNumber
@@ -94,7 +115,7 @@ func TestPolicy(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyR
 			// mangled by us somehow
 			zap.L().Error("unable to extract test number from test result resourceID",
 				zap.String("resourceID", result.ID))
-			return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+			return nil, &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
 		}
 		test := input.Tests[testIndex]
 		switch {
@@ -125,12 +146,96 @@ func TestPolicy(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyR
 		}
 	}
 
-	// Return the number of passing, failing, and error-ing tests
-	return gatewayapi.MarshalResponse(&testResults, http.StatusOK)
+	return &testResults, nil
+}
+
+// Run the unit tests of an enabled policy or rule before it is saved.
+//
+// Returns nil if the item can be saved as is, otherwise a summary of the failed and errored tests.
+func testEnabledItem(item *tableItem, globals []enginemodels.Global) (*models.TestFailure, *events.APIGatewayProxyResponse) {
+	if !item.Enabled || (item.Type != typePolicy && item.Type != typeRule) {
+		return nil, nil
+	}
+
+	testResults, errResponse := runTests(&models.TestPolicy{
+		AnalysisType:  models.AnalysisType(item.Type),
+		Body:          item.Body,
+		ResourceTypes: item.ResourceTypes,
+		Tests:         item.Tests,
+	}, globals)
+	if errResponse != nil {
+		return nil, errResponse
+	}
+	if testResults.TestSummary {
+		return nil, nil
+	}
+
+	return &models.TestFailure{
+		ID: item.ID,
+		Message: aws.String(fmt.Sprintf("%s %s can not be enabled, unit tests failed: %s",
+			strings.ToLower(item.Type), item.ID, failedTests(testResults))),
+		TestResults: testResults,
+	}, nil
+}
+
+// List the names of the failed and errored tests, with the error of each errored test
+func failedTests(testResults *models.TestPolicyResult) string {
+	failed := make([]string, 0, len(testResults.TestsFailed)+len(testResults.TestsErrored))
+	failed = append(failed, testResults.TestsFailed...)
+	for _, result := range testResults.TestsErrored {
+		failed = append(failed, result.Name+" ("+result.ErrorMessage+")")
+	}
+	return strings.Join(failed, ", ")
+}
+
+// Run the unit tests of a policy or rule from CreatePolicy, ModifyPolicy, CreateRule or ModifyRule.
+//
+// Returns a response if the item must not be saved: 422 if a test failed or 403 if a user
+// without admin permissions tried to override the tests.
+func checkTests(
+	request *events.APIGatewayProxyRequest, item *tableItem, override models.OverrideTests) *events.APIGatewayProxyResponse {
+
+	if override {
+		return authorizeTestOverride(request)
+	}
+
+	failure, errResponse := testEnabledItem(item, nil)
+	if errResponse != nil {
+		return errResponse
+	}
+	if failure != nil {
+		return gatewayapi.MarshalResponse(failure, http.StatusUnprocessableEntity)
+	}
+	return nil
+}
+
+// Only administrators (users holding every permission) can skip the unit tests.
+//
+// Requests without a Panther user come from other Panther services, which are trusted.
+func authorizeTestOverride(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	userID := gatewayapi.CallerID(request)
+	if userID == "" {
+		return nil
+	}
+
+	err := authz.Check(PermissionChecker, userID, authz.AllPermissions...)
+	if err == nil {
+		zap.L().Info("unit tests overridden", zap.String("userId", userID))
+		return nil
+	}
+
+	var denied *authz.DeniedError
+	if errors.As(err, &denied) {
+		return failedRequest("only administrators can override failing unit tests", http.StatusForbidden)
+	}
+	zap.L().Error("failed to check permissions", zap.String("userId", userID), zap.Error(err))
+	return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
 }
 
 //nolint:dupl
-func getRuleResults(input *models.TestPolicy) (*enginemodels.RulesEngineOutput, *events.APIGatewayProxyResponse) {
+func getRuleResults(
+	input *models.TestPolicy, globals []enginemodels.Global) (*enginemodels.RulesEngineOutput, *events.APIGatewayProxyResponse) {
+
 	// Build the list of events to run the rule against
 	inputEvents := make([]enginemodels.Event, len(input.Tests))
 	for i, test := range input.Tests {
@@ -148,6 +253,7 @@ func getRuleResults(input *models.TestPolicy) (*enginemodels.RulesEngineOutput,
 	}
 
 	testRequest := enginemodels.RulesEngineInput{
+		Globals: globals,
 		Rules: []enginemodels.Rule{
 			{
 				Body: string(input.Body),
@@ -183,7 +289,9 @@ func getRuleResults(input *models.TestPolicy) (*enginemodels.RulesEngineOutput,
 }
 
 //nolint:dupl
-func getPolicyResults(input *models.TestPolicy) (*enginemodels.PolicyEngineOutput, *events.APIGatewayProxyResponse) {
+func getPolicyResults(
+	input *models.TestPolicy, globals []enginemodels.Global) (*enginemodels.PolicyEngineOutput, *events.APIGatewayProxyResponse) {
+
 	// Build the list of resources to run the policy against
 	resources := make([]enginemodels.Resource, len(input.Tests))
 	for i, test := range input.Tests {
@@ -201,6 +309,7 @@ func getPolicyResults(input *models.TestPolicy) (*enginemodels.PolicyEngineOutpu
 	}
 
 	testRequest := enginemodels.PolicyEngineInput{
+		Globals: globals,
 		Policies: []enginemodels.Policy{
 			{
 				Body: string(input.Body),
@@ -0,0 +1,189 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	enginemodels "github.com/panther-labs/panther/api/gateway/analysis"
+	"github.com/panther-labs/panther/api/gateway/analysis/models"
+	"github.com/panther-labs/panther/pkg/authz"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
+	"github.com/panther-labs/panther/pkg/testutils"
+)
+
+type staticChecker map[string][]authz.Permission
+
+func (c staticChecker) UserPermissions(userID string) ([]authz.Permission, error) {
+	return c[userID], nil
+}
+
+var testedPolicy = &tableItem{
+	Body:          "def policy(resource): return resource['encrypted']",
+	Enabled:       true,
+	ID:            "Bucket.Encrypted",
+	ResourceTypes: []string{"AWS.S3.Bucket"},
+	Tests: []*models.UnitTest{
+		{
+			ExpectedResult: true,
+			Name:           "Encrypted",
+			Resource:       `{"encrypted": true}`,
+			ResourceType:   "AWS.S3.Bucket",
+		},
+		{
+			ExpectedResult: true,
+			Name:           "Unencrypted",
+			Resource:       `{"encrypted": false}`,
+			ResourceType:   "AWS.S3.Bucket",
+		},
+	},
+	Type: typePolicy,
+}
+
+// Mock a policy engine which passes the first test resource and fails the second
+func mockPolicyEngine(t *testing.T) *testutils.LambdaMock {
+	payload, err := jsoniter.Marshal(&enginemodels.PolicyEngineOutput{
+		Resources: []enginemodels.Result{
+			{ID: testResourceID + "0", Passed: []string{testPolicyID}},
+			{ID: testResourceID + "1", Failed: []string{testPolicyID}},
+		},
+	})
+	require.NoError(t, err)
+
+	mockLambda := &testutils.LambdaMock{}
+	mockLambda.On("Invoke", mock.Anything).Return(&lambda.InvokeOutput{Payload: payload}, nil)
+	lambdaClient = mockLambda
+	return mockLambda
+}
+
+func TestRunTestsWithoutTests(t *testing.T) {
+	mockLambda := &testutils.LambdaMock{}
+	lambdaClient = mockLambda
+
+	result, errResponse := runTests(&models.TestPolicy{AnalysisType: models.AnalysisTypeRULE}, nil)
+	require.Nil(t, errResponse)
+	assert.True(t, bool(result.TestSummary))
+	mockLambda.AssertNotCalled(t, "Invoke", mock.Anything)
+}
+
+func TestTestEnabledItem(t *testing.T) {
+	mockLambda := mockPolicyEngine(t)
+
+	failure, errResponse := testEnabledItem(testedPolicy, nil)
+	require.Nil(t, errResponse)
+	require.NotNil(t, failure)
+	assert.Equal(t, models.ID("Bucket.Encrypted"), failure.ID)
+	assert.Equal(t, "policy Bucket.Encrypted can not be enabled, unit tests failed: Unencrypted", *failure.Message)
+	assert.Equal(t, models.TestsPassed{"Encrypted"}, failure.TestResults.TestsPassed)
+	assert.Equal(t, models.TestsFailed{"Unencrypted"}, failure.TestResults.TestsFailed)
+	mockLambda.AssertExpectations(t)
+}
+
+func TestTestEnabledItemDisabled(t *testing.T) {
+	mockLambda := &testutils.LambdaMock{}
+	lambdaClient = mockLambda
+
+	item := *testedPolicy
+	item.Enabled = false
+	failure, errResponse := testEnabledItem(&item, nil)
+	assert.Nil(t, failure)
+	assert.Nil(t, errResponse)
+	mockLambda.AssertNotCalled(t, "Invoke", mock.Anything)
+}
+
+func TestCheckTests(t *testing.T) {
+	mockPolicyEngine(t)
+
+	response := checkTests(&events.APIGatewayProxyRequest{}, testedPolicy, false)
+	require.NotNil(t, response)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func TestCheckTestsOverride(t *testing.T) {
+	mockLambda := &testutils.LambdaMock{}
+	lambdaClient = mockLambda
+	PermissionChecker = staticChecker{
+		"admin":   authz.AllPermissions,
+		"analyst": authz.BuiltInRoles[authz.RoleAnalyst],
+	}
+
+	request := &events.APIGatewayProxyRequest{Headers: map[string]string{gatewayapi.UserIDHeader: "admin"}}
+	assert.Nil(t, checkTests(request, testedPolicy, true))
+
+	request.Headers[gatewayapi.UserIDHeader] = "analyst"
+	response := checkTests(request, testedPolicy, true)
+	require.NotNil(t, response)
+	assert.Equal(t, http.StatusForbidden, response.StatusCode)
+
+	// Other Panther services are trusted
+	assert.Nil(t, checkTests(&events.APIGatewayProxyRequest{}, testedPolicy, true))
+	mockLambda.AssertNotCalled(t, "Invoke", mock.Anything)
+}
+
+func TestDisableFailingItems(t *testing.T) {
+	mockLambda := mockPolicyEngine(t)
+
+	policy := *testedPolicy
+	disabled := *testedPolicy
+	disabled.ID = "Bucket.Disabled"
+	disabled.Enabled = false
+	global := &tableItem{Body: "def helper(): return True", ID: "panther", Type: typeGlobal}
+	items := map[models.ID]*tableItem{policy.ID: &policy, disabled.ID: &disabled, global.ID: global}
+
+	failures, errResponse := disableFailingItems(items, testGlobals(items), func(models.ID) (*tableItem, error) {
+		return nil, nil
+	})
+	require.Nil(t, errResponse)
+	require.Len(t, failures, 1)
+	assert.Equal(t, models.ID("Bucket.Encrypted"), failures[0].ID)
+	assert.False(t, bool(policy.Enabled))
+	assert.Len(t, items, 3)
+
+	// The tests run with the uploaded globals
+	var engineInput enginemodels.PolicyEngineInput
+	payload := mockLambda.Calls[0].Arguments.Get(0).(*lambda.InvokeInput).Payload
+	require.NoError(t, jsoniter.Unmarshal(payload, &engineInput))
+	assert.Equal(t, []enginemodels.Global{{Body: "def helper(): return True", ID: "panther"}}, engineInput.Globals)
+}
+
+func TestDisableFailingItemsKeepsEnabledVersion(t *testing.T) {
+	mockPolicyEngine(t)
+
+	policy := *testedPolicy
+	items := map[models.ID]*tableItem{policy.ID: &policy}
+
+	failures, errResponse := disableFailingItems(items, nil, func(models.ID) (*tableItem, error) {
+		return testedPolicy, nil
+	})
+	require.Nil(t, errResponse)
+	require.Len(t, failures, 1)
+	assert.Equal(t, "policy Bucket.Encrypted was not updated and the current version stays enabled, "+
+		"unit tests failed: Unencrypted", *failures[0].Message)
+	// The update is not written, so the enabled version keeps running
+	assert.Empty(t, items)
+	assert.True(t, bool(policy.Enabled))
+}
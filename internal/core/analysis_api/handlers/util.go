@@ -210,7 +210,8 @@ func itemUpdated(oldItem, newItem *tableItem) bool {
 		oldItem.Description == newItem.Description && oldItem.DisplayName == newItem.DisplayName &&
 		oldItem.Enabled == newItem.Enabled && oldItem.Reference == newItem.Reference &&
 		oldItem.Runbook == newItem.Runbook && oldItem.Severity == newItem.Severity &&
-		oldItem.DedupPeriodMinutes == newItem.DedupPeriodMinutes &&
+		oldItem.DedupPeriodMinutes == newItem.DedupPeriodMinutes && oldItem.Threshold == newItem.Threshold &&
+		oldItem.DigestPeriodMinutes == newItem.DigestPeriodMinutes &&
 		setEquality(oldItem.ResourceTypes, newItem.ResourceTypes) &&
 		setEquality(oldItem.Suppressions, newItem.Suppressions) && setEquality(oldItem.Tags, newItem.Tags) &&
 		len(oldItem.AutoRemediationParameters) == len(newItem.AutoRemediationParameters) &&
@@ -0,0 +1,456 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pmezard/go-difflib/difflib"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/gateway/analysis/models"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
+)
+
+const maxVersionPageSize = 100
+
+// Fields which are set on every write: they describe a version rather than its content.
+var versionMetadata = []string{
+	"commitSha", "createdAt", "createdBy", "lastModified", "lastModifiedBy",
+	"lowerDisplayName", "lowerId", "lowerTags", "versionId",
+}
+
+// Map keys are sorted so that equal items always have identical JSON
+var versionJSON = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// ListVersions pages through the version history of a policy, rule or global, newest first.
+func ListVersions(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	id, err := parseAnalysisID(request)
+	if err != nil {
+		return badRequest(err)
+	}
+
+	page, pageSize, err := parseVersionPage(request)
+	if err != nil {
+		return badRequest(err)
+	}
+
+	versions, err := s3ListVersions(id)
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+	if len(versions) == 0 {
+		return failedRequest(fmt.Sprintf("Cannot find versions of %s", id), http.StatusNotFound)
+	}
+
+	totalPages := len(versions) / pageSize
+	if len(versions)%pageSize > 0 {
+		totalPages++ // Add one more to page count if there is an incomplete page at the end
+	}
+
+	paging := &models.Paging{
+		ThisPage:   aws.Int64(int64(page)),
+		TotalItems: aws.Int64(int64(len(versions))),
+		TotalPages: aws.Int64(int64(totalPages)),
+	}
+
+	lowerBound := intMin((page-1)*pageSize, len(versions))
+	upperBound := intMin(page*pageSize, len(versions))
+
+	// Load one extra version so we can tell what changed in the oldest version of the page
+	items, err := s3GetVersions(id, versions[lowerBound:intMin(upperBound+1, len(versions))])
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	summaries := make([]*models.VersionSummary, 0, upperBound-lowerBound)
+	for i := 0; i < upperBound-lowerBound; i++ {
+		var previous *tableItem
+		if i+1 < len(items) {
+			previous = items[i+1]
+		}
+
+		summary, err := versionSummary(items[i], previous, aws.BoolValue(versions[lowerBound+i].IsLatest))
+		if err != nil {
+			return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return gatewayapi.MarshalResponse(&models.VersionList{Paging: paging, Versions: summaries}, http.StatusOK)
+}
+
+// DiffVersions compares two versions of a policy, rule or global.
+func DiffVersions(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	id, err := parseAnalysisID(request)
+	if err != nil {
+		return badRequest(err)
+	}
+
+	fromVersion := models.VersionID(request.QueryStringParameters["fromVersionId"])
+	if err := fromVersion.Validate(nil); err != nil {
+		return badRequest(errors.New("invalid fromVersionId: " + err.Error()))
+	}
+	toVersion := models.VersionID(request.QueryStringParameters["toVersionId"])
+	if toVersion != "" {
+		if err := toVersion.Validate(nil); err != nil {
+			return badRequest(errors.New("invalid toVersionId: " + err.Error()))
+		}
+	}
+
+	fromItem, err := s3Get(id, fromVersion)
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+	if fromItem == nil {
+		return failedRequest(fmt.Sprintf("Cannot find version %s of %s", fromVersion, id), http.StatusNotFound)
+	}
+
+	var toItem *tableItem
+	if toVersion == "" {
+		// Compare with the current version
+		toItem, err = dynamoGet(id, false)
+	} else {
+		toItem, err = s3Get(id, toVersion)
+	}
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+	if toItem == nil {
+		if toVersion == "" {
+			return failedRequest(fmt.Sprintf("Cannot find %s", id), http.StatusNotFound)
+		}
+		return failedRequest(fmt.Sprintf("Cannot find version %s of %s", toVersion, id), http.StatusNotFound)
+	}
+
+	versions, err := s3ListVersions(id)
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	result := &models.VersionDiff{}
+	if result.From, err = summarizeVersion(fromItem, versions); err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+	if result.To, err = summarizeVersion(toItem, versions); err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+	if result.Changes, err = diffItems(fromItem, toItem); err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	bodyDiff, err := diffBody(fromItem, toItem)
+	if err != nil {
+		zap.L().Error("failed to diff body", zap.Error(err))
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+	result.BodyDiff = &bodyDiff
+
+	return gatewayapi.MarshalResponse(result, http.StatusOK)
+}
+
+// Rollback restores a previous version of a policy, rule or global.
+//
+// The old content is written as a new version, just like any other update.
+func Rollback(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+	input, err := parseRollback(request)
+	if err != nil {
+		return badRequest(err)
+	}
+
+	current, err := dynamoGet(input.ID, true)
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+	if current == nil {
+		return failedRequest(fmt.Sprintf("Cannot find %s", input.ID), http.StatusNotFound)
+	}
+
+	item, err := s3Get(input.ID, input.VersionID)
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+	if item == nil {
+		return failedRequest(fmt.Sprintf("Cannot find version %s of %s", input.VersionID, input.ID), http.StatusNotFound)
+	}
+
+	if item.VersionID == current.VersionID {
+		return badRequest(fmt.Errorf("%s is already the current version of %s", item.VersionID, input.ID))
+	}
+	if item.Type != current.Type {
+		// The ID was deleted and reused for a different type of analysis
+		return badRequest(fmt.Errorf("version %s of %s is a %s, not a %s",
+			item.VersionID, input.ID, item.Type, current.Type))
+	}
+
+	// The restored version no longer matches any commit in the analysis repository
+	item.CommitSha = ""
+
+	if errResponse := checkTests(request, item, input.OverrideTests); errResponse != nil {
+		return errResponse
+	}
+
+	if _, err := writeItem(item, input.UserID, aws.Bool(true)); err != nil {
+		if err == errNotExists || err == errWrongType {
+			// The item was deleted or replaced since we read it
+			return &events.APIGatewayProxyResponse{StatusCode: http.StatusNotFound}
+		}
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+
+	if item.Type == typeGlobal {
+		if err = updateLayer(typeGlobal); err != nil {
+			return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+		}
+	}
+
+	zap.L().Info("rolled back analysis",
+		zap.String("id", string(input.ID)),
+		zap.String("fromVersionId", string(current.VersionID)),
+		zap.String("restoredVersionId", string(input.VersionID)),
+		zap.String("newVersionId", string(item.VersionID)),
+		zap.String("userId", string(input.UserID)))
+
+	summary, err := versionSummary(item, current, true)
+	if err != nil {
+		return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+	}
+	return gatewayapi.MarshalResponse(summary, http.StatusOK)
+}
+
+func parseAnalysisID(request *events.APIGatewayProxyRequest) (models.ID, error) {
+	id, err := url.QueryUnescape(request.QueryStringParameters["id"])
+	if err != nil {
+		return "", fmt.Errorf("invalid id: %s", err)
+	}
+
+	result := models.ID(id)
+	if err := result.Validate(nil); err != nil {
+		return "", fmt.Errorf("invalid id: %s", err)
+	}
+	return result, nil
+}
+
+func parseVersionPage(request *events.APIGatewayProxyRequest) (int, int, error) {
+	page := defaultPage
+	if requestPage := request.QueryStringParameters["page"]; requestPage != "" {
+		var err error
+		if page, err = strconv.Atoi(requestPage); err != nil || page < 1 {
+			return 0, 0, fmt.Errorf("invalid page: %s", requestPage)
+		}
+	}
+
+	pageSize := defaultPageSize
+	if requestPageSize := request.QueryStringParameters["pageSize"]; requestPageSize != "" {
+		var err error
+		if pageSize, err = strconv.Atoi(requestPageSize); err != nil || pageSize < 1 || pageSize > maxVersionPageSize {
+			return 0, 0, fmt.Errorf("invalid pageSize: %s", requestPageSize)
+		}
+	}
+
+	return page, pageSize, nil
+}
+
+func parseRollback(request *events.APIGatewayProxyRequest) (*models.Rollback, error) {
+	var result models.Rollback
+	if err := jsoniter.UnmarshalFromString(request.Body, &result); err != nil {
+		return nil, err
+	}
+
+	if err := result.Validate(nil); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Load several versions of an item from S3 in parallel.
+func s3GetVersions(id models.ID, versions []*s3.ObjectVersion) ([]*tableItem, error) {
+	items := make([]*tableItem, len(versions))
+	errs := make([]error, len(versions))
+
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(len(versions))
+	for i, version := range versions {
+		go func(i int, versionID models.VersionID) {
+			defer waitGroup.Done()
+			items[i], errs[i] = s3Get(id, versionID)
+			if errs[i] == nil && items[i] == nil {
+				errs[i] = fmt.Errorf("listed version %s of %s does not exist", versionID, id)
+			}
+		}(i, models.VersionID(aws.StringValue(version.VersionId)))
+	}
+	waitGroup.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			zap.L().Error("failed to load versions", zap.String("id", string(id)), zap.Error(err))
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// Summarize a version, using the version history to find out what changed in it.
+func summarizeVersion(item *tableItem, versions []*s3.ObjectVersion) (*models.VersionSummary, error) {
+	for i, version := range versions {
+		if aws.StringValue(version.VersionId) != string(item.VersionID) {
+			continue
+		}
+
+		var previous *tableItem
+		if i+1 < len(versions) {
+			var err error
+			previous, err = s3Get(item.ID, models.VersionID(aws.StringValue(versions[i+1].VersionId)))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return versionSummary(item, previous, aws.BoolValue(version.IsLatest))
+	}
+
+	// The version history is missing from S3
+	return versionSummary(item, nil, false)
+}
+
+// Describe who changed what in a version and when.
+//
+// previous is the version before this one, or nil if this is the first version.
+func versionSummary(item, previous *tableItem, latest bool) (*models.VersionSummary, error) {
+	changedFields := make([]string, 0)
+	if previous != nil {
+		changes, err := diffItems(previous, item)
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range changes {
+			changedFields = append(changedFields, aws.StringValue(change.Field))
+		}
+	}
+
+	return &models.VersionSummary{
+		ChangedFields:  changedFields,
+		CommitSha:      item.CommitSha,
+		ID:             item.ID,
+		LastModified:   item.LastModified,
+		LastModifiedBy: item.LastModifiedBy,
+		Latest:         aws.Bool(latest),
+		Type:           models.AnalysisType(item.Type),
+		VersionID:      item.VersionID,
+	}, nil
+}
+
+// List the fields whose content differs between two versions, sorted by name.
+//
+// Values are JSON encoded; a field which is not set has an empty value.
+func diffItems(from, to *tableItem) ([]*models.FieldChange, error) {
+	fromFields, err := versionFields(from)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := versionFields(to)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(fromFields)+len(toFields))
+	for name := range fromFields {
+		names = append(names, name)
+	}
+	for name := range toFields {
+		if _, ok := fromFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := make([]*models.FieldChange, 0)
+	for _, name := range names {
+		if bytes.Equal(fromFields[name], toFields[name]) {
+			continue
+		}
+		changes = append(changes, &models.FieldChange{
+			Field: aws.String(name),
+			From:  aws.String(string(fromFields[name])),
+			To:    aws.String(string(toFields[name])),
+		})
+	}
+	return changes, nil
+}
+
+// The JSON encoding of each content field of an item, keyed by field name.
+func versionFields(item *tableItem) (map[string]jsoniter.RawMessage, error) {
+	normalized := *item
+	normalized.normalize()
+
+	body, err := versionJSON.Marshal(&normalized)
+	if err != nil {
+		zap.L().Error("version marshal failed", zap.Error(err))
+		return nil, err
+	}
+
+	var fields map[string]jsoniter.RawMessage
+	if err := versionJSON.Unmarshal(body, &fields); err != nil {
+		zap.L().Error("version unmarshal failed", zap.Error(err))
+		return nil, err
+	}
+
+	for _, name := range versionMetadata {
+		delete(fields, name)
+	}
+	return fields, nil
+}
+
+// Unified diff of the Python body of two versions.
+func diffBody(from, to *tableItem) (string, error) {
+	if from.Body == to.Body {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        bodyLines(from.Body),
+		B:        bodyLines(to.Body),
+		FromFile: fmt.Sprintf("%s@%s", from.ID, from.VersionID),
+		ToFile:   fmt.Sprintf("%s@%s", to.ID, to.VersionID),
+		Context:  3,
+	})
+}
+
+// Split a body into lines which all end with a newline, as the unified diff expects.
+func bodyLines(body models.Body) []string {
+	lines := strings.SplitAfter(string(body), "\n")
+	last := len(lines) - 1
+	if lines[last] == "" {
+		return lines[:last]
+	}
+	lines[last] += "\n"
+	return lines
+}
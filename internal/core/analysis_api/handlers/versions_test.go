@@ -0,0 +1,188 @@
+package handlers
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/api/gateway/analysis/models"
+)
+
+const (
+	testUserA = models.UserID("a7a4bbe9-4f6c-4a3c-b6a5-6a1c3f59a2d1")
+	testUserB = models.UserID("0b2e4a8e-2d1c-4c5a-9a3e-7f6b5d4c3b2a")
+)
+
+// Serves the versions of a single S3 key, newest first
+type versionsS3 struct {
+	s3iface.S3API
+	items []*tableItem
+}
+
+func (m *versionsS3) ListObjectVersionsPages(
+	input *s3.ListObjectVersionsInput, handler func(*s3.ListObjectVersionsOutput, bool) bool) error {
+
+	output := &s3.ListObjectVersionsOutput{
+		// A longer ID which shares the prefix must not be listed
+		Versions: []*s3.ObjectVersion{{Key: aws.String(*input.Prefix + ".Other"), VersionId: aws.String(testVersion(99))}},
+	}
+	for i, item := range m.items {
+		output.Versions = append(output.Versions, &s3.ObjectVersion{
+			IsLatest:  aws.Bool(i == 0),
+			Key:       aws.String(string(item.ID)),
+			VersionId: aws.String(string(item.VersionID)),
+		})
+	}
+	handler(output, true)
+	return nil
+}
+
+func (m *versionsS3) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	for _, item := range m.items {
+		if string(item.VersionID) == *input.VersionId {
+			body, err := jsoniter.Marshal(item)
+			if err != nil {
+				return nil, err
+			}
+			return &s3.GetObjectOutput{Body: ioutil.NopCloser(bytes.NewReader(body))}, nil
+		}
+	}
+	panic("unexpected version " + *input.VersionId)
+}
+
+func testVersion(i int) string {
+	version := "version" + strconv.Itoa(i)
+	return version + string(bytes.Repeat([]byte("x"), 32-len(version)))
+}
+
+func versionTestItem(version int, user models.UserID, severity models.Severity, tags ...string) *tableItem {
+	return &tableItem{
+		Body:           "def rule(event): return True",
+		Enabled:        true,
+		ID:             "Test.Rule",
+		LastModifiedBy: user,
+		ResourceTypes:  []string{"AWS.CloudTrail"},
+		Severity:       severity,
+		Tags:           tags,
+		Type:           typeRule,
+		VersionID:      models.VersionID(testVersion(version)),
+	}
+}
+
+func TestDiffItems(t *testing.T) {
+	from := versionTestItem(1, testUserA, models.SeverityINFO, "b", "a")
+	from.LowerTags = []string{"a", "b"}
+	to := versionTestItem(2, testUserB, models.SeverityHIGH, "a", "b", "c")
+	to.CommitSha = "5b4b03a1e2d9a5c4e8e1f0a7c3d6b2e9f8a1c0d7"
+	to.Runbook = "Call the on-call"
+
+	changes, err := diffItems(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, []*models.FieldChange{
+		{Field: aws.String("runbook"), From: aws.String(""), To: aws.String(`"Call the on-call"`)},
+		{Field: aws.String("severity"), From: aws.String(`"INFO"`), To: aws.String(`"HIGH"`)},
+		{Field: aws.String("tags"), From: aws.String(`["a","b"]`), To: aws.String(`["a","b","c"]`)},
+	}, changes)
+
+	// Metadata and the order of sets are not changes
+	same := versionTestItem(3, testUserB, models.SeverityINFO, "a", "b")
+	changes, err = diffItems(from, same)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestDiffBody(t *testing.T) {
+	from := versionTestItem(1, testUserA, models.SeverityINFO)
+	from.Body = "def rule(event):\n    return True\n"
+	to := versionTestItem(2, testUserB, models.SeverityINFO)
+	to.Body = "def rule(event):\n    return False\n"
+
+	diff, err := diffBody(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, "--- Test.Rule@"+testVersion(1)+"\n+++ Test.Rule@"+testVersion(2)+"\n"+
+		"@@ -1,2 +1,2 @@\n def rule(event):\n-    return True\n+    return False\n", diff)
+
+	diff, err = diffBody(from, from)
+	require.NoError(t, err)
+	assert.Equal(t, "", diff)
+}
+
+func TestListVersions(t *testing.T) {
+	mock := &versionsS3{items: []*tableItem{
+		versionTestItem(3, testUserB, models.SeverityHIGH, "a"),
+		versionTestItem(2, testUserA, models.SeverityINFO, "a"),
+		versionTestItem(1, testUserA, models.SeverityINFO),
+	}}
+	s3Client = mock
+
+	response := ListVersions(&events.APIGatewayProxyRequest{
+		QueryStringParameters: map[string]string{"id": "Test.Rule", "pageSize": "2"},
+	})
+	require.Equal(t, http.StatusOK, response.StatusCode, response.Body)
+
+	var result models.VersionList
+	require.NoError(t, jsoniter.UnmarshalFromString(response.Body, &result))
+	assert.Equal(t, &models.Paging{ThisPage: aws.Int64(1), TotalItems: aws.Int64(3), TotalPages: aws.Int64(2)}, result.Paging)
+	require.Len(t, result.Versions, 2)
+
+	assert.Equal(t, models.VersionID(testVersion(3)), result.Versions[0].VersionID)
+	assert.Equal(t, testUserB, result.Versions[0].LastModifiedBy)
+	assert.Equal(t, []string{"severity"}, result.Versions[0].ChangedFields)
+	assert.True(t, *result.Versions[0].Latest)
+
+	// The oldest version of the page is compared with the first version of the next page
+	assert.Equal(t, models.VersionID(testVersion(2)), result.Versions[1].VersionID)
+	assert.Equal(t, []string{"tags"}, result.Versions[1].ChangedFields)
+	assert.False(t, *result.Versions[1].Latest)
+
+	response = ListVersions(&events.APIGatewayProxyRequest{
+		QueryStringParameters: map[string]string{"id": "Test.Rule", "pageSize": "2", "page": "2"},
+	})
+	require.Equal(t, http.StatusOK, response.StatusCode, response.Body)
+	require.NoError(t, jsoniter.UnmarshalFromString(response.Body, &result))
+	require.Len(t, result.Versions, 1)
+	assert.Equal(t, []string{}, result.Versions[0].ChangedFields)
+}
+
+func TestListVersionsNotFound(t *testing.T) {
+	s3Client = &versionsS3{}
+	response := ListVersions(&events.APIGatewayProxyRequest{
+		QueryStringParameters: map[string]string{"id": "Test.Rule"},
+	})
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestListVersionsInvalidPageSize(t *testing.T) {
+	response := ListVersions(&events.APIGatewayProxyRequest{
+		QueryStringParameters: map[string]string{"id": "Test.Rule", "pageSize": "101"},
+	})
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
@@ -908,6 +908,8 @@ func bulkUploadSuccess(t *testing.T) {
 		ModifiedGlobals: aws.Int64(0),
 		NewGlobals:      aws.Int64(0),
 		TotalGlobals:    aws.Int64(0),
+
+		TestFailures: []*models.TestFailure{},
 	}
 	assert.Equal(t, expected, result.Payload)
 
@@ -22,6 +22,7 @@ import (
 	"github.com/aws/aws-lambda-go/lambda"
 
 	"github.com/panther-labs/panther/internal/core/analysis_api/handlers"
+	"github.com/panther-labs/panther/pkg/authz"
 	"github.com/panther-labs/panther/pkg/gatewayapi"
 )
 
@@ -35,10 +36,12 @@ var methodHandlers = map[string]gatewayapi.RequestHandler{
 	"POST /upload":   handlers.BulkUpload,
 
 	// Rules only
-	"GET /rule":         handlers.GetRule,
-	"POST /rule":        handlers.CreateRule,
-	"GET /rule/list":    handlers.ListRules,
-	"POST /rule/update": handlers.ModifyRule,
+	"GET /rule":           handlers.GetRule,
+	"POST /rule":          handlers.CreateRule,
+	"GET /rule/list":      handlers.ListRules,
+	"POST /rule/update":   handlers.ModifyRule,
+	"GET /rule/backtest":  handlers.GetBacktest,
+	"POST /rule/backtest": handlers.StartBacktest,
 
 	// Globals only
 	"GET /global":         handlers.GetGlobal,
@@ -50,10 +53,47 @@ var methodHandlers = map[string]gatewayapi.RequestHandler{
 	// Rules and Policies
 	"POST /delete": handlers.DeletePolicies,
 	"GET /enabled": handlers.GetEnabledAnalyses,
+	"POST /sync":   handlers.SyncAnalysis,
 	"POST /test":   handlers.TestPolicy,
+
+	// Version history of rules, policies and globals
+	"GET /version/list":      handlers.ListVersions,
+	"GET /version/diff":      handlers.DiffVersions,
+	"POST /version/rollback": handlers.Rollback,
+}
+
+var (
+	policyModify = []authz.Permission{authz.PolicyModify}
+	ruleModify   = []authz.Permission{authz.RuleModify}
+	bothModify   = []authz.Permission{authz.PolicyModify, authz.RuleModify}
+)
+
+// Permissions required when a Panther user calls the API through AppSync
+var permissions = map[string][]authz.Permission{
+	"POST /policy":   policyModify,
+	"POST /suppress": policyModify,
+	"POST /update":   policyModify,
+
+	"POST /rule":          ruleModify,
+	"POST /rule/update":   ruleModify,
+	"POST /rule/backtest": ruleModify,
+
+	// Globals, bulk uploads, syncs, rollbacks and deletes can affect both rules and policies
+	"POST /upload":           bothModify,
+	"POST /sync":             bothModify,
+	"POST /global":           bothModify,
+	"POST /global/update":    bothModify,
+	"POST /global/delete":    bothModify,
+	"POST /delete":           bothModify,
+	"POST /version/rollback": bothModify,
 }
 
+// Protected methods which other Panther services invoke without a caller:
+// the initial upload of the Python analysis pack and the periodic Git sync.
+var serviceMethods = []string{"POST /sync", "POST /upload"}
+
 func main() {
 	handlers.Setup()
-	lambda.Start(gatewayapi.LambdaProxy(methodHandlers))
+	lambda.Start(gatewayapi.LambdaProxy(
+		gatewayapi.RequirePermissions(handlers.PermissionChecker, permissions, methodHandlers, serviceMethods...)))
 }
@@ -0,0 +1,63 @@
+package gitsync
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/kelseyhightower/envconfig"
+
+	analysisapi "github.com/panther-labs/panther/api/gateway/analysis/client"
+	"github.com/panther-labs/panther/pkg/gatewayapi"
+)
+
+type envConfig struct {
+	AnalysisAPIHost  string `required:"true" split_words:"true"`
+	AnalysisAPIPath  string `required:"true" split_words:"true"`
+	RepositoryURL    string `required:"true" split_words:"true"`
+	RepositoryBranch string `default:"master" split_words:"true"`
+	// Optional directory within the repository which holds the detections
+	RepositoryPath string `split_words:"true"`
+	// Optional Secrets Manager secret with the credentials of a private https repository
+	RepositoryCredentialsSecretArn string `split_words:"true"`
+}
+
+var (
+	env envConfig
+
+	awsSession     *session.Session
+	httpClient     *http.Client
+	analysisClient *analysisapi.PantherAnalysis
+	secretsClient  secretsmanageriface.SecretsManagerAPI
+)
+
+// Setup parses the environment and initializes the analysis API client.
+func Setup() {
+	envconfig.MustProcess("", &env)
+
+	awsSession = session.Must(session.NewSession())
+	httpClient = gatewayapi.GatewayClient(awsSession)
+	secretsClient = secretsmanager.New(awsSession)
+	analysisClient = analysisapi.NewHTTPClientWithConfig(
+		nil, analysisapi.DefaultTransportConfig().
+			WithHost(env.AnalysisAPIHost).WithBasePath("/"+env.AnalysisAPIPath))
+}
@@ -0,0 +1,161 @@
+package gitsync
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The sync request (base64-encoded) has to fit in the 6MB Lambda payload limit
+const maxSnapshotSize = 4 * 1024 * 1024
+
+// Credentials for an https repository, stored in Secrets Manager as a JSON secret:
+// {"username": "x-access-token", "password": "<token>"}
+type credentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// The git configuration which sends the credentials with every https request.
+//
+// They are passed as an HTTP header so they never appear in the repository URL or the checkout.
+func (c *credentials) gitConfig() []string {
+	if c == nil {
+		return nil
+	}
+	token := base64.StdEncoding.EncodeToString([]byte(c.Username + ":" + c.Password))
+	return []string{"http.extraHeader=Authorization: Basic " + token}
+}
+
+// Clone the head of a branch into an empty directory and return its commit SHA.
+//
+// The repository can be any URL understood by git, including the path of a local (bare) repository.
+// creds is nil for public repositories.
+func checkout(ctx context.Context, repository, branch, dir string, creds *credentials) (string, error) {
+	if _, err := runGit(ctx, repository, "", creds.gitConfig(), "clone", "--quiet", "--depth", "1",
+		"--single-branch", "--branch", branch, "--", repository, dir); err != nil {
+		return "", err
+	}
+
+	sha, err := runGit(ctx, repository, dir, nil, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(sha), nil
+}
+
+// Run a git command with extra configuration ("key=value") and return its stdout.
+//
+// The repository URL may contain credentials, they are removed from any error message.
+func runGit(ctx context.Context, repository, dir string, config []string, command string, args ...string) (string, error) {
+	var gitArgs []string
+	for _, entry := range config {
+		gitArgs = append(gitArgs, "-c", entry)
+	}
+	cmd := exec.CommandContext(ctx, "git", append(append(gitArgs, command), args...)...)
+	cmd.Dir = dir
+	// Never wait for a password prompt, and give git a writable HOME (there is none in Lambda)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "HOME="+os.TempDir())
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.ReplaceAll(strings.TrimSpace(stderr.String()), repository, redactURL(repository))
+		return "", fmt.Errorf("git %s failed: %v: %s", command, err, msg)
+	}
+	return stdout.String(), nil
+}
+
+// Remove the credentials (if any) from a repository URL.
+func redactURL(repository string) string {
+	parsed, err := url.Parse(repository)
+	if err != nil || parsed.User == nil {
+		return repository
+	}
+	parsed.User = url.User("REDACTED")
+	return parsed.String()
+}
+
+// Zip the analysis files (Python, YAML and JSON) under the root directory, except hidden ones.
+//
+// The result has the same layout as a panther_analysis_tool upload.
+func zipAnalysis(root string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		// Skip hidden files and directories (.git, CI configs, etc), which are not analysis specs
+		if strings.HasPrefix(info.Name(), ".") && path != root {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".py", ".yml", ".yaml", ".json":
+		default:
+			return nil
+		}
+
+		name, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		w, err := writer.Create(filepath.ToSlash(name))
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(contents)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to zip repository")
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to zip repository")
+	}
+	if buf.Len() > maxSnapshotSize {
+		return nil, fmt.Errorf("zipped analysis files are %d bytes, the limit is %d", buf.Len(), maxSnapshotSize)
+	}
+	return buf.Bytes(), nil
+}
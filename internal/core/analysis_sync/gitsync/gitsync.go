@@ -0,0 +1,133 @@
+package gitsync
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/gateway/analysis/client/operations"
+	"github.com/panther-labs/panther/api/gateway/analysis/models"
+)
+
+// Changes made by the sync are attributed to the Panther system user
+const systemUserID = "00000000-0000-4000-8000-000000000000"
+
+// Input is the (optional) payload of a manual invocation.
+//
+// Scheduled invocations apply every change which does not conflict with an edit made in Panther.
+type Input struct {
+	DryRun             bool `json:"dryRun"`
+	OverwriteConflicts bool `json:"overwriteConflicts"`
+}
+
+// Sync applies the latest commit of the configured repository to the analysis API.
+func Sync(ctx context.Context, input *Input) (*models.SyncResult, error) {
+	dir, err := ioutil.TempDir("", "analysis-sync")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			zap.L().Error("failed to remove repository checkout", zap.Error(err))
+		}
+	}()
+
+	creds, err := loadCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	repository := filepath.Join(dir, "repository")
+	sha, err := checkout(ctx, env.RepositoryURL, env.RepositoryBranch, repository, creds)
+	if err != nil {
+		return nil, err
+	}
+	zap.L().Info("checked out repository", zap.String("url", redactURL(env.RepositoryURL)),
+		zap.String("branch", env.RepositoryBranch), zap.String("commitSha", sha))
+
+	snapshot, err := zipAnalysis(filepath.Join(repository, env.RepositoryPath))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := analysisClient.Operations.SyncAnalysis(&operations.SyncAnalysisParams{
+		Body: &models.SyncAnalysis{
+			CommitSha:          models.CommitSha(sha),
+			Data:               models.Base64zipfile(base64.StdEncoding.EncodeToString(snapshot)),
+			DryRun:             input.DryRun,
+			OverwriteConflicts: input.OverwriteConflicts,
+			UserID:             systemUserID,
+		},
+		Context:    ctx,
+		HTTPClient: httpClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := response.Payload
+	for _, conflict := range result.Conflicts {
+		zap.L().Warn("sync conflict", zap.String("id", string(conflict.ID)),
+			zap.String("lastModifiedBy", string(conflict.LastModifiedBy)), zap.String("message", *conflict.Message))
+	}
+	for _, failure := range result.TestFailures {
+		zap.L().Warn("unit tests failed, saved as disabled",
+			zap.String("id", string(failure.ID)), zap.String("message", *failure.Message))
+	}
+	zap.L().Info("repository synced", zap.String("commitSha", sha), zap.Bool("dryRun", input.DryRun),
+		zap.Int("created", len(result.Created)), zap.Int("updated", len(result.Updated)),
+		zap.Int("deleted", len(result.Deleted)), zap.Int("conflicts", len(result.Conflicts)))
+	return result, nil
+}
+
+// Read the repository credentials, if configured.
+//
+// The secret is read on every sync so a rotated token is picked up without a redeploy.
+func loadCredentials(ctx context.Context) (*credentials, error) {
+	if env.RepositoryCredentialsSecretArn == "" {
+		return nil, nil
+	}
+
+	response, err := secretsClient.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: &env.RepositoryCredentialsSecretArn,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read repository credentials")
+	}
+
+	var result credentials
+	if err := jsoniter.UnmarshalFromString(aws.StringValue(response.SecretString), &result); err != nil {
+		// Do not include the error, it could quote the secret
+		return nil, errors.New("repository credentials must be a JSON object with a username and a password")
+	}
+	if result.Password == "" {
+		return nil, errors.New("repository credentials have no password")
+	}
+	return &result, nil
+}
@@ -129,8 +129,11 @@ func initializeAnalysisSets(sets []string, apiClient *client.PantherAnalysis, ht
 		encoded := base64.StdEncoding.EncodeToString(contents)
 		response, err := apiClient.Operations.BulkUpload(&operations.BulkUploadParams{
 			Body: &analysismodels.BulkUpload{
-				Data:   analysismodels.Base64zipfile(encoded),
-				UserID: systemUserID,
+				Data: analysismodels.Base64zipfile(encoded),
+				// Released packs are tested before they are published, and their tests may depend on
+				// globals which are not in the layer until the layer manager rebuilds it.
+				OverrideTests: true,
+				UserID:        systemUserID,
 			},
 			HTTPClient: httpClient,
 		})
@@ -246,4 +246,11 @@ var CustomResources = map[string]cfn.CustomResourceFunction{
 	// Outputs: None
 	// PhysicalId: custom:alarms:sqs:$QUEUE_NAME
 	"Custom::SQSAlarms": customSQSAlarms,
+
+	// Assigns the Admin role to existing users which do not have a role yet.
+	//
+	// Parameters: None
+	// Outputs: None
+	// PhysicalId: custom:user-roles:backfill
+	"Custom::UserRoles": customUserRoles,
 }
@@ -24,8 +24,10 @@ import (
 	"strings"
 
 	"github.com/aws/aws-lambda-go/cfn"
+	"github.com/aws/aws-sdk-go/aws"
 
 	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/pkg/authz"
 	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
@@ -75,12 +77,15 @@ func customPantherUser(_ context.Context, event cfn.Event) (string, map[string]i
 }
 
 // Returns the Panther userID
+//
+// The first user is an admin: otherwise nobody would be allowed to manage Panther.
 func inviteUser(props PantherUserProperties) (string, error) {
 	input := models.LambdaInput{
 		InviteUser: &models.InviteUserInput{
 			GivenName:  &props.GivenName,
 			FamilyName: &props.FamilyName,
 			Email:      &props.Email,
+			Role:       aws.String(authz.RoleAdmin),
 		},
 	}
 	var output models.InviteUserOutput
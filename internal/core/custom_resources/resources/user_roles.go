@@ -0,0 +1,50 @@
+package resources
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/cfn"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+const userRolesPhysicalID = "custom:user-roles:backfill"
+
+func customUserRoles(_ context.Context, event cfn.Event) (string, map[string]interface{}, error) {
+	switch event.RequestType {
+	case cfn.RequestCreate:
+		// Users created before roles existed had full access, they keep it after the upgrade.
+		input := models.LambdaInput{BackfillUserRoles: &models.BackfillUserRolesInput{}}
+		var output models.BackfillUserRolesOutput
+		if err := genericapi.Invoke(lambdaClient, "panther-users-api", &input, &output); err != nil {
+			return "", nil, err
+		}
+
+		zap.L().Info("assigned Admin role to existing users", zap.Strings("userIds", output.IDs))
+		return userRolesPhysicalID, nil, nil
+
+	default:
+		// skip updates and deletes - the backfill only runs once, assigned roles are left in place
+		return userRolesPhysicalID, nil, nil
+	}
+}
@@ -23,13 +23,19 @@ import (
 	"os"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/panther-labs/panther/internal/core/organization_api/table"
+	"github.com/panther-labs/panther/pkg/authz"
 )
 
 var (
 	awsSession           = session.Must(session.NewSession())
 	orgTable   table.API = table.New(os.Getenv("ORG_TABLE_NAME"), awsSession)
+
+	// PermissionChecker resolves the permissions of API callers.
+	PermissionChecker = authz.NewTableChecker(
+		dynamodb.New(awsSession), os.Getenv("USER_ROLES_TABLE"), os.Getenv("ROLES_TABLE"))
 )
 
 // API has all of the handlers as receiver methods.
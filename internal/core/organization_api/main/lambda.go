@@ -25,15 +25,29 @@ import (
 
 	"github.com/panther-labs/panther/api/lambda/organization/models"
 	"github.com/panther-labs/panther/internal/core/organization_api/api"
+	"github.com/panther-labs/panther/pkg/authz"
 	"github.com/panther-labs/panther/pkg/genericapi"
 	"github.com/panther-labs/panther/pkg/lambdalogger"
 )
 
-var router = genericapi.NewRouter("api", "organization", nil, api.API{})
+var router = genericapi.NewRouter("api", "organization", nil, api.API{}).RequirePermissions(
+	api.PermissionChecker,
+	map[string][]authz.Permission{
+		"UpdateSettings": {authz.SettingsModify},
+	},
+	// The initial settings are applied by the cfn-custom-resources during deployment
+	"UpdateSettings",
+)
+
+// The Lambda invocation event: AppSync adds the ID of the calling user
+type lambdaInput struct {
+	models.LambdaInput
+	CallerID string `json:"callerId"`
+}
 
-func lambdaHandler(ctx context.Context, input *models.LambdaInput) (interface{}, error) {
+func lambdaHandler(ctx context.Context, input *lambdaInput) (interface{}, error) {
 	lambdalogger.ConfigureGlobal(ctx, nil)
-	return router.Handle(input)
+	return router.HandleAs(input.CallerID, &input.LambdaInput)
 }
 
 func main() {
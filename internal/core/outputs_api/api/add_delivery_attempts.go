@@ -0,0 +1,50 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"time"
+
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
+	"github.com/panther-labs/panther/internal/core/outputs_api/table"
+)
+
+// How long delivery attempts are kept before they are removed by the table TTL
+const deliveryHistoryRetention = 7 * 24 * time.Hour
+
+// AddDeliveryAttempts records the result of alert delivery attempts
+func (API) AddDeliveryAttempts(input *models.AddDeliveryAttemptsInput) error {
+	items := make([]*table.DeliveryAttemptItem, len(input.Attempts))
+	for i, attempt := range input.Attempts {
+		items[i] = &table.DeliveryAttemptItem{
+			OutputID:     attempt.OutputID,
+			AttemptID:    table.NewAttemptID(attempt.AttemptedAt),
+			AnalysisID:   attempt.AnalysisID,
+			AlertID:      attempt.AlertID,
+			Success:      attempt.Success,
+			Permanent:    attempt.Permanent,
+			StatusCode:   attempt.StatusCode,
+			LatencyMs:    attempt.LatencyMs,
+			ErrorMessage: attempt.ErrorMessage,
+			AttemptedAt:  attempt.AttemptedAt,
+			ExpiresAt:    attempt.AttemptedAt.Add(deliveryHistoryRetention).Unix(),
+		}
+	}
+	return historyTable.PutDeliveryAttempts(items)
+}
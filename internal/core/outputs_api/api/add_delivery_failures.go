@@ -0,0 +1,49 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/google/uuid"
+
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
+	"github.com/panther-labs/panther/internal/core/outputs_api/table"
+)
+
+// How long failed deliveries are kept before they are removed by the table TTL
+const deliveryFailureRetention = 30 * 24 * time.Hour
+
+// AddDeliveryFailures stores alerts which could not be delivered so they can be replayed later
+func (API) AddDeliveryFailures(input *models.AddDeliveryFailuresInput) error {
+	items := make([]*table.DeliveryFailureItem, len(input.Failures))
+	for i, failure := range input.Failures {
+		items[i] = &table.DeliveryFailureItem{
+			FailureID:    aws.String(uuid.New().String()),
+			OutputID:     failure.OutputID,
+			Alert:        failure.Alert,
+			ErrorMessage: failure.ErrorMessage,
+			Permanent:    failure.Permanent,
+			FailedAt:     failure.FailedAt,
+			ExpiresAt:    failure.FailedAt.Add(deliveryFailureRetention).Unix(),
+		}
+	}
+	return failuresTable.PutDeliveryFailures(items)
+}
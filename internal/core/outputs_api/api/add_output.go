@@ -51,6 +51,15 @@ func (API) AddOutput(input *models.AddOutputInput) (*models.AddOutputOutput, err
 		return nil, &genericapi.InvalidInputError{Message: err.Error()}
 	}
 
+	if err = validateMessageTemplate(input.MessageTemplate); err != nil {
+		return nil, err
+	}
+
+	// Empty routing rules are the same as no routing rules
+	if input.RoutingRules.IsEmpty() {
+		input.RoutingRules = nil
+	}
+
 	alertOutput := &models.AlertOutput{
 		OutputID:           aws.String(uuid.New().String()),
 		DisplayName:        input.DisplayName,
@@ -61,6 +70,8 @@ func (API) AddOutput(input *models.AddOutputInput) (*models.AddOutputOutput, err
 		OutputType:         outputType,
 		OutputConfig:       input.OutputConfig,
 		DefaultForSeverity: input.DefaultForSeverity,
+		RoutingRules:       input.RoutingRules,
+		MessageTemplate:    input.MessageTemplate,
 	}
 
 	alertOutputItem, err := AlertOutputToItem(alertOutput)
@@ -30,6 +30,7 @@ import (
 
 	"github.com/panther-labs/panther/api/lambda/outputs/models"
 	"github.com/panther-labs/panther/internal/core/outputs_api/table"
+	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
 func TestAddOutputSameNameAlreadyExists(t *testing.T) {
@@ -278,3 +279,28 @@ func TestAddOutputAsana(t *testing.T) {
 	_, err = uuid.Parse(*result.OutputID)
 	assert.NoError(t, err)
 }
+
+func TestAddOutputInvalidMessageTemplate(t *testing.T) {
+	mockEncryptionKey := &mockEncryptionKey{}
+	encryptionKey = mockEncryptionKey
+	mockOutputTable := &mockOutputTable{}
+	outputsTable = mockOutputTable
+
+	mockOutputTable.On("GetOutputByName", aws.String("my-channel")).Return(nil, nil)
+
+	input := &models.AddOutputInput{
+		UserID:          aws.String("userId"),
+		DisplayName:     aws.String("my-channel"),
+		OutputConfig:    &models.OutputConfig{Slack: &models.SlackConfig{WebhookURL: "hooks.slack.com"}},
+		MessageTemplate: aws.String("{{.NoSuchField}}"),
+	}
+
+	result, err := (API{}).AddOutput(input)
+	assert.Nil(t, result)
+	require.Error(t, err)
+	assert.IsType(t, &genericapi.InvalidInputError{}, err)
+
+	// Nothing is encrypted or stored
+	mockOutputTable.AssertExpectations(t)
+	mockEncryptionKey.AssertExpectations(t)
+}
@@ -23,8 +23,12 @@ import (
 	"os"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 
 	"github.com/panther-labs/panther/internal/core/outputs_api/table"
+	"github.com/panther-labs/panther/pkg/authz"
 	"github.com/panther-labs/panther/pkg/encryption"
 )
 
@@ -40,4 +44,20 @@ var (
 		os.Getenv("OUTPUTS_TABLE_NAME"),
 		os.Getenv("OUTPUTS_DISPLAY_NAME_INDEX_NAME"),
 		awsSession)
+
+	failuresTable table.DeliveryFailuresAPI = table.NewDeliveryFailures(
+		os.Getenv("DELIVERY_FAILURES_TABLE_NAME"),
+		os.Getenv("DELIVERY_FAILURES_OUTPUT_INDEX_NAME"),
+		awsSession)
+
+	historyTable table.DeliveryHistoryAPI = table.NewDeliveryHistory(
+		os.Getenv("DELIVERY_HISTORY_TABLE_NAME"),
+		awsSession)
+
+	sqsClient     sqsiface.SQSAPI = sqs.New(awsSession)
+	alertQueueURL                 = os.Getenv("ALERT_QUEUE_URL")
+
+	// PermissionChecker resolves the permissions of API callers.
+	PermissionChecker = authz.NewTableChecker(
+		dynamodb.New(awsSession), os.Getenv("USER_ROLES_TABLE"), os.Getenv("ROLES_TABLE"))
 )
@@ -19,6 +19,10 @@ package api
  */
 
 import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/mock"
 
@@ -81,3 +85,50 @@ func (m *mockEncryptionKey) EncryptConfig(config interface{}) ([]byte, error) {
 	args := m.Called(config)
 	return args.Get(0).([]byte), args.Error(1)
 }
+
+type mockFailuresTable struct {
+	table.DeliveryFailuresTable
+	mock.Mock
+}
+
+func (m *mockFailuresTable) PutDeliveryFailures(failures []*table.DeliveryFailureItem) error {
+	args := m.Called(failures)
+	return args.Error(0)
+}
+
+func (m *mockFailuresTable) GetDeliveryFailures(outputID *string) ([]*table.DeliveryFailureItem, error) {
+	args := m.Called(outputID)
+	return args.Get(0).([]*table.DeliveryFailureItem), args.Error(1)
+}
+
+func (m *mockFailuresTable) DeleteDeliveryFailures(failureIDs []*string) error {
+	args := m.Called(failureIDs)
+	return args.Error(0)
+}
+
+type mockHistoryTable struct {
+	table.DeliveryHistoryTable
+	mock.Mock
+}
+
+func (m *mockHistoryTable) PutDeliveryAttempts(attempts []*table.DeliveryAttemptItem) error {
+	args := m.Called(attempts)
+	return args.Error(0)
+}
+
+func (m *mockHistoryTable) GetDeliveryAttempts(
+	outputID *string, since time.Time, limit int) ([]*table.DeliveryAttemptItem, error) {
+
+	args := m.Called(outputID, since, limit)
+	return args.Get(0).([]*table.DeliveryAttemptItem), args.Error(1)
+}
+
+type mockSQSClient struct {
+	sqsiface.SQSAPI
+	mock.Mock
+}
+
+func (m *mockSQSClient) SendMessageBatch(input *sqs.SendMessageBatchInput) (*sqs.SendMessageBatchOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(*sqs.SendMessageBatchOutput), args.Error(1)
+}
@@ -0,0 +1,236 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
+	"github.com/panther-labs/panther/internal/core/outputs_api/table"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+var (
+	failedAt        = time.Date(2020, 1, 1, 0, 30, 0, 0, time.UTC)
+	failedOutputID  = aws.String("7d1c5854-f3ea-491c-8a52-0aa0d58cb456")
+	deliveryFailure = &table.DeliveryFailureItem{
+		FailureID: aws.String("0a1d4c2e-5bd7-4c6f-9a0e-3a4e3d0b2f11"),
+		OutputID:  failedOutputID,
+		Alert: &alertmodels.Alert{
+			AnalysisID: "AWS.CloudTrail.RootActivity",
+			Type:       alertmodels.RuleType,
+			Severity:   "HIGH",
+			OutputIDs:  []string{"some-other-output"},
+		},
+		ErrorMessage: aws.String("request failed: 401 Unauthorized"),
+		Permanent:    true,
+		FailedAt:     failedAt,
+	}
+	otherDeliveryFailure = &table.DeliveryFailureItem{
+		FailureID: aws.String("ca3e8de0-8ff2-4e5a-a7f3-e5e8b8bb1a2b"),
+		OutputID:  aws.String("9d1c5854-f3ea-491c-8a52-0aa0d58cb456"),
+		Alert:     &alertmodels.Alert{AnalysisID: "my.policy", Type: alertmodels.PolicyType, Severity: "LOW"},
+		FailedAt:  failedAt,
+	}
+)
+
+func TestAddDeliveryFailures(t *testing.T) {
+	mockFailuresTable := &mockFailuresTable{}
+	failuresTable = mockFailuresTable
+
+	input := &models.AddDeliveryFailuresInput{
+		Failures: []*models.DeliveryFailure{{
+			OutputID:     failedOutputID,
+			Alert:        deliveryFailure.Alert,
+			ErrorMessage: deliveryFailure.ErrorMessage,
+			Permanent:    true,
+			FailedAt:     failedAt,
+		}},
+	}
+
+	mockFailuresTable.On("PutDeliveryFailures", mock.Anything).Return(nil)
+	require.NoError(t, (API{}).AddDeliveryFailures(input))
+	mockFailuresTable.AssertExpectations(t)
+
+	items := mockFailuresTable.Calls[0].Arguments.Get(0).([]*table.DeliveryFailureItem)
+	require.Len(t, items, 1)
+	assert.NotEmpty(t, *items[0].FailureID)
+	assert.Equal(t, failedOutputID, items[0].OutputID)
+	assert.Equal(t, deliveryFailure.Alert, items[0].Alert)
+	assert.Equal(t, deliveryFailure.ErrorMessage, items[0].ErrorMessage)
+	assert.True(t, items[0].Permanent)
+	assert.Equal(t, failedAt.Add(30*24*time.Hour).Unix(), items[0].ExpiresAt)
+}
+
+func TestListDeliveryFailures(t *testing.T) {
+	mockFailuresTable := &mockFailuresTable{}
+	failuresTable = mockFailuresTable
+
+	mockFailuresTable.On("GetDeliveryFailures", failedOutputID).Return(
+		[]*table.DeliveryFailureItem{deliveryFailure}, nil)
+
+	result, err := (API{}).ListDeliveryFailures(&models.ListDeliveryFailuresInput{OutputID: failedOutputID})
+	require.NoError(t, err)
+	assert.Equal(t, models.ListDeliveryFailuresOutput{{
+		FailureID:    deliveryFailure.FailureID,
+		OutputID:     failedOutputID,
+		Alert:        deliveryFailure.Alert,
+		ErrorMessage: deliveryFailure.ErrorMessage,
+		Permanent:    true,
+		FailedAt:     failedAt,
+	}}, result)
+	mockFailuresTable.AssertExpectations(t)
+}
+
+func TestReplayDeliveryFailures(t *testing.T) {
+	mockFailuresTable := &mockFailuresTable{}
+	failuresTable = mockFailuresTable
+	mockSQSClient := &mockSQSClient{}
+	sqsClient = mockSQSClient
+	alertQueueURL = "https://sqs.us-east-1.amazonaws.com/123456789012/panther-alerts-queue"
+
+	mockFailuresTable.On("GetDeliveryFailures", (*string)(nil)).Return(
+		[]*table.DeliveryFailureItem{deliveryFailure, otherDeliveryFailure}, nil)
+	mockSQSClient.On("SendMessageBatch", mock.Anything).Return(
+		&sqs.SendMessageBatchOutput{Successful: make([]*sqs.SendMessageBatchResultEntry, 1)}, nil)
+	mockFailuresTable.On("DeleteDeliveryFailures", []*string{deliveryFailure.FailureID}).Return(nil)
+
+	result, err := (API{}).ReplayDeliveryFailures(&models.ReplayDeliveryFailuresInput{
+		FailureIDs: []string{*deliveryFailure.FailureID},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &models.ReplayDeliveryFailuresOutput{FailureIDs: []string{*deliveryFailure.FailureID}}, result)
+	mockFailuresTable.AssertExpectations(t)
+	mockSQSClient.AssertExpectations(t)
+
+	// The alert is only re-sent to the output it failed for
+	sqsInput := mockSQSClient.Calls[0].Arguments.Get(0).(*sqs.SendMessageBatchInput)
+	assert.Equal(t, alertQueueURL, *sqsInput.QueueUrl)
+	require.Len(t, sqsInput.Entries, 1)
+	var alert alertmodels.Alert
+	require.NoError(t, jsoniter.UnmarshalFromString(*sqsInput.Entries[0].MessageBody, &alert))
+	assert.Equal(t, "AWS.CloudTrail.RootActivity", alert.AnalysisID)
+	assert.Equal(t, []string{*failedOutputID}, alert.OutputIDs)
+	// The stored alert is not modified
+	assert.Equal(t, []string{"some-other-output"}, deliveryFailure.Alert.OutputIDs)
+}
+
+func TestReplayDeliveryFailuresForOutput(t *testing.T) {
+	mockFailuresTable := &mockFailuresTable{}
+	failuresTable = mockFailuresTable
+	mockSQSClient := &mockSQSClient{}
+	sqsClient = mockSQSClient
+
+	mockFailuresTable.On("GetDeliveryFailures", failedOutputID).Return(
+		[]*table.DeliveryFailureItem{deliveryFailure}, nil)
+	mockSQSClient.On("SendMessageBatch", mock.Anything).Return(
+		&sqs.SendMessageBatchOutput{Successful: make([]*sqs.SendMessageBatchResultEntry, 1)}, nil)
+	mockFailuresTable.On("DeleteDeliveryFailures", []*string{deliveryFailure.FailureID}).Return(nil)
+
+	result, err := (API{}).ReplayDeliveryFailures(&models.ReplayDeliveryFailuresInput{OutputID: failedOutputID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{*deliveryFailure.FailureID}, result.FailureIDs)
+	mockFailuresTable.AssertExpectations(t)
+	mockSQSClient.AssertExpectations(t)
+}
+
+func TestReplayDeliveryFailuresNothingToReplay(t *testing.T) {
+	mockFailuresTable := &mockFailuresTable{}
+	failuresTable = mockFailuresTable
+	mockSQSClient := &mockSQSClient{}
+	sqsClient = mockSQSClient
+
+	mockFailuresTable.On("GetDeliveryFailures", failedOutputID).Return([]*table.DeliveryFailureItem{}, nil)
+
+	result, err := (API{}).ReplayDeliveryFailures(&models.ReplayDeliveryFailuresInput{OutputID: failedOutputID})
+	require.NoError(t, err)
+	assert.Equal(t, &models.ReplayDeliveryFailuresOutput{FailureIDs: []string{}}, result)
+	mockFailuresTable.AssertExpectations(t)
+	mockSQSClient.AssertExpectations(t)
+}
+
+func TestReplayDeliveryFailuresDoesNotExist(t *testing.T) {
+	mockFailuresTable := &mockFailuresTable{}
+	failuresTable = mockFailuresTable
+	mockSQSClient := &mockSQSClient{}
+	sqsClient = mockSQSClient
+
+	mockFailuresTable.On("GetDeliveryFailures", (*string)(nil)).Return(
+		[]*table.DeliveryFailureItem{deliveryFailure}, nil)
+
+	result, err := (API{}).ReplayDeliveryFailures(&models.ReplayDeliveryFailuresInput{
+		FailureIDs: []string{*otherDeliveryFailure.FailureID},
+	})
+	assert.Nil(t, result)
+	assert.IsType(t, &genericapi.DoesNotExistError{}, err)
+	mockFailuresTable.AssertExpectations(t)
+	mockSQSClient.AssertExpectations(t)
+}
+
+func TestReplayDeliveryFailuresQueueError(t *testing.T) {
+	mockFailuresTable := &mockFailuresTable{}
+	failuresTable = mockFailuresTable
+	mockSQSClient := &mockSQSClient{}
+	sqsClient = mockSQSClient
+
+	mockFailuresTable.On("GetDeliveryFailures", failedOutputID).Return(
+		[]*table.DeliveryFailureItem{deliveryFailure}, nil)
+	mockSQSClient.On("SendMessageBatch", mock.Anything).Return(
+		(*sqs.SendMessageBatchOutput)(nil), errors.New("access denied"))
+
+	// The failures are kept if they could not be queued
+	result, err := (API{}).ReplayDeliveryFailures(&models.ReplayDeliveryFailuresInput{OutputID: failedOutputID})
+	assert.Nil(t, result)
+	assert.Error(t, err)
+	mockFailuresTable.AssertExpectations(t)
+	mockSQSClient.AssertExpectations(t)
+}
+
+func TestReplayDeliveryFailuresPartiallyQueued(t *testing.T) {
+	mockFailuresTable := &mockFailuresTable{}
+	failuresTable = mockFailuresTable
+	mockSQSClient := &mockSQSClient{}
+	sqsClient = mockSQSClient
+
+	// The alert of this failure is too big to be queued, the other one is queued
+	bigDeliveryFailure := *otherDeliveryFailure
+	bigDeliveryFailure.Alert = &alertmodels.Alert{Title: aws.String(strings.Repeat("a", 300000))}
+	mockFailuresTable.On("GetDeliveryFailures", (*string)(nil)).Return(
+		[]*table.DeliveryFailureItem{&bigDeliveryFailure, deliveryFailure}, nil)
+	mockSQSClient.On("SendMessageBatch", mock.Anything).Return(
+		&sqs.SendMessageBatchOutput{Successful: make([]*sqs.SendMessageBatchResultEntry, 1)}, nil)
+
+	// No failure is removed since the batch sender does not reliably tell which alerts were queued
+	result, err := (API{}).ReplayDeliveryFailures(&models.ReplayDeliveryFailuresInput{})
+	assert.Nil(t, result)
+	assert.Error(t, err)
+	mockFailuresTable.AssertExpectations(t)
+	mockSQSClient.AssertExpectations(t)
+}
@@ -0,0 +1,153 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
+	"github.com/panther-labs/panther/internal/core/outputs_api/table"
+)
+
+func TestAddDeliveryAttempts(t *testing.T) {
+	mockHistoryTable := &mockHistoryTable{}
+	historyTable = mockHistoryTable
+
+	attemptedAt := time.Date(2020, 1, 1, 0, 30, 0, 0, time.UTC)
+	input := &models.AddDeliveryAttemptsInput{
+		Attempts: []*models.DeliveryAttempt{{
+			OutputID:     aws.String("outputId"),
+			AnalysisID:   "rule.id",
+			AlertID:      aws.String("alertId"),
+			StatusCode:   503,
+			LatencyMs:    1250,
+			ErrorMessage: aws.String("service unavailable"),
+			AttemptedAt:  attemptedAt,
+		}},
+	}
+
+	mockHistoryTable.On("PutDeliveryAttempts", mock.Anything).Return(nil)
+	require.NoError(t, (API{}).AddDeliveryAttempts(input))
+	mockHistoryTable.AssertExpectations(t)
+
+	items := mockHistoryTable.Calls[0].Arguments.Get(0).([]*table.DeliveryAttemptItem)
+	require.Len(t, items, 1)
+	assert.Contains(t, *items[0].AttemptID, "2020-01-01T00:30:00.000000000Z-")
+	assert.Equal(t, "outputId", *items[0].OutputID)
+	assert.Equal(t, 503, items[0].StatusCode)
+	assert.Equal(t, int64(1250), items[0].LatencyMs)
+	assert.Equal(t, attemptedAt.Add(7*24*time.Hour).Unix(), items[0].ExpiresAt)
+}
+
+func TestGetDeliveryHistory(t *testing.T) {
+	mockHistoryTable := &mockHistoryTable{}
+	historyTable = mockHistoryTable
+
+	attemptedAt := time.Date(2020, 1, 1, 0, 30, 0, 0, time.UTC)
+	mockHistoryTable.On("GetDeliveryAttempts", aws.String("outputId"), mock.Anything, 10).Return(
+		[]*table.DeliveryAttemptItem{{
+			OutputID:    aws.String("outputId"),
+			AttemptID:   aws.String("attemptId"),
+			AnalysisID:  "rule.id",
+			Success:     true,
+			LatencyMs:   100,
+			AttemptedAt: attemptedAt,
+			ExpiresAt:   1,
+		}}, nil)
+
+	result, err := (API{}).GetDeliveryHistory(&models.GetDeliveryHistoryInput{
+		OutputID: aws.String("outputId"),
+		Limit:    aws.Int(10),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.GetDeliveryHistoryOutput{{
+		OutputID:    aws.String("outputId"),
+		AnalysisID:  "rule.id",
+		Success:     true,
+		LatencyMs:   100,
+		AttemptedAt: attemptedAt,
+	}}, result)
+	mockHistoryTable.AssertExpectations(t)
+}
+
+func TestGetOutputsHealth(t *testing.T) {
+	mockHistoryTable := &mockHistoryTable{}
+	historyTable = mockHistoryTable
+	mockOutputTable := &mockOutputTable{}
+	outputsTable = mockOutputTable
+
+	now := time.Now().UTC()
+	lastFailure := now.Add(-time.Hour)
+	lastSuccess := now.Add(-2 * time.Hour)
+	mockOutputTable.On("GetOutputs").Return([]*table.AlertOutputItem{
+		{OutputID: aws.String("healthy")},
+		{OutputID: aws.String("broken")},
+	}, nil)
+	mockHistoryTable.On("GetDeliveryAttempts", aws.String("healthy"), mock.Anything, 0).Return(
+		[]*table.DeliveryAttemptItem{}, nil)
+	mockHistoryTable.On("GetDeliveryAttempts", aws.String("broken"), mock.Anything, 0).Return(
+		[]*table.DeliveryAttemptItem{
+			{Success: false, ErrorMessage: aws.String("invalid token"), AttemptedAt: lastFailure},
+			{Success: false, ErrorMessage: aws.String("timeout"), AttemptedAt: now.Add(-90 * time.Minute)},
+			{Success: true, AttemptedAt: lastSuccess},
+			{Success: true, AttemptedAt: now.Add(-3 * time.Hour)},
+			// Outside of the health window
+			{Success: true, AttemptedAt: now.Add(-48 * time.Hour)},
+		}, nil)
+
+	result, err := (API{}).GetOutputsHealth(&models.GetOutputsHealthInput{})
+	require.NoError(t, err)
+	assert.Equal(t, models.GetOutputsHealthOutput{
+		{OutputID: aws.String("healthy")},
+		{
+			OutputID:          aws.String("broken"),
+			LastSuccess:       &lastSuccess,
+			LastFailure:       &lastFailure,
+			LastErrorMessage:  aws.String("invalid token"),
+			RecentAttempts:    4,
+			RecentFailures:    2,
+			RecentFailureRate: 0.5,
+		},
+	}, result)
+	mockOutputTable.AssertExpectations(t)
+	mockHistoryTable.AssertExpectations(t)
+}
+
+func TestGetOutputsHealthSelectedOutputs(t *testing.T) {
+	mockHistoryTable := &mockHistoryTable{}
+	historyTable = mockHistoryTable
+	mockOutputTable := &mockOutputTable{}
+	outputsTable = mockOutputTable
+
+	mockHistoryTable.On("GetDeliveryAttempts", aws.String("outputId"), mock.Anything, 0).Return(
+		[]*table.DeliveryAttemptItem{}, nil)
+
+	result, err := (API{}).GetOutputsHealth(&models.GetOutputsHealthInput{OutputIDs: []string{"outputId"}})
+	require.NoError(t, err)
+	assert.Equal(t, models.GetOutputsHealthOutput{{OutputID: aws.String("outputId")}}, result)
+	// The outputs are not listed
+	mockOutputTable.AssertExpectations(t)
+	mockHistoryTable.AssertExpectations(t)
+}
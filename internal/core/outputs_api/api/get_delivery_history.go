@@ -0,0 +1,47 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"time"
+
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
+)
+
+const defaultDeliveryHistoryLimit = 100
+
+// GetDeliveryHistory returns the most recent delivery attempts of an output
+func (API) GetDeliveryHistory(input *models.GetDeliveryHistoryInput) (models.GetDeliveryHistoryOutput, error) {
+	limit := defaultDeliveryHistoryLimit
+	if input.Limit != nil {
+		limit = *input.Limit
+	}
+
+	since := time.Now().Add(-deliveryHistoryRetention)
+	items, err := historyTable.GetDeliveryAttempts(input.OutputID, since, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(models.GetDeliveryHistoryOutput, len(items))
+	for i, item := range items {
+		result[i] = itemToDeliveryAttempt(item)
+	}
+	return result, nil
+}
@@ -0,0 +1,85 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
+	"github.com/panther-labs/panther/internal/core/outputs_api/table"
+)
+
+// The failure rate of an output is computed over this period
+const outputHealthWindow = 24 * time.Hour
+
+// GetOutputsHealth summarizes the recent delivery attempts of each output
+func (API) GetOutputsHealth(input *models.GetOutputsHealthInput) (models.GetOutputsHealthOutput, error) {
+	outputIDs := input.OutputIDs
+	if len(outputIDs) == 0 {
+		outputItems, err := outputsTable.GetOutputs()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range outputItems {
+			outputIDs = append(outputIDs, *item.OutputID)
+		}
+	}
+
+	now := time.Now()
+	result := make(models.GetOutputsHealthOutput, len(outputIDs))
+	for i, outputID := range outputIDs {
+		// The full retained history is needed to find the last success and failure
+		items, err := historyTable.GetDeliveryAttempts(aws.String(outputID), now.Add(-deliveryHistoryRetention), 0)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = summarizeDeliveryAttempts(outputID, items, now.Add(-outputHealthWindow))
+	}
+	return result, nil
+}
+
+// summarizeDeliveryAttempts computes the health of an output from its attempts, sorted newest first
+func summarizeDeliveryAttempts(outputID string, items []*table.DeliveryAttemptItem, since time.Time) *models.OutputHealth {
+	health := &models.OutputHealth{OutputID: aws.String(outputID)}
+	for _, item := range items {
+		attemptedAt := item.AttemptedAt
+		if item.Success {
+			if health.LastSuccess == nil {
+				health.LastSuccess = &attemptedAt
+			}
+		} else if health.LastFailure == nil {
+			health.LastFailure = &attemptedAt
+			health.LastErrorMessage = item.ErrorMessage
+		}
+
+		if !attemptedAt.Before(since) {
+			health.RecentAttempts++
+			if !item.Success {
+				health.RecentFailures++
+			}
+		}
+	}
+
+	if health.RecentAttempts > 0 {
+		health.RecentFailureRate = float64(health.RecentFailures) / float64(health.RecentAttempts)
+	}
+	return health
+}
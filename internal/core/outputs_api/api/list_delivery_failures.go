@@ -0,0 +1,37 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
+)
+
+// ListDeliveryFailures returns the alerts which could not be delivered, newest first
+func (API) ListDeliveryFailures(input *models.ListDeliveryFailuresInput) (models.ListDeliveryFailuresOutput, error) {
+	items, err := failuresTable.GetDeliveryFailures(input.OutputID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(models.ListDeliveryFailuresOutput, len(items))
+	for i, item := range items {
+		result[i] = itemToDeliveryFailure(item)
+	}
+	return result, nil
+}
@@ -0,0 +1,41 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
+	"github.com/panther-labs/panther/internal/core/alert_delivery/outputs"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+// PreviewTemplate renders a message template for a sample alert
+func (API) PreviewTemplate(input *models.PreviewTemplateInput) (*models.PreviewTemplateOutput, error) {
+	alert := input.Alert
+	if alert == nil {
+		alert = outputs.SampleAlert()
+	}
+
+	message, err := outputs.RenderMessageTemplate(*input.MessageTemplate, alert)
+	if err != nil {
+		return nil, &genericapi.InvalidInputError{Message: "invalid message template: " + err.Error()}
+	}
+	return &models.PreviewTemplateOutput{Message: aws.String(message)}, nil
+}
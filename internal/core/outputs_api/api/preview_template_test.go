@@ -0,0 +1,60 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+func TestPreviewTemplateSampleAlert(t *testing.T) {
+	result, err := (API{}).PreviewTemplate(&models.PreviewTemplateInput{
+		MessageTemplate: aws.String("{{.Name}} ({{.Severity}})"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &models.PreviewTemplateOutput{Message: aws.String("Sample Rule (HIGH)")}, result)
+}
+
+func TestPreviewTemplateGivenAlert(t *testing.T) {
+	result, err := (API{}).PreviewTemplate(&models.PreviewTemplateInput{
+		MessageTemplate: aws.String("{{.AnalysisID}}: {{join .Tags \",\"}}"),
+		Alert: &alertmodels.Alert{
+			AnalysisID: "my.policy",
+			Type:       alertmodels.PolicyType,
+			Tags:       []string{"a", "b"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &models.PreviewTemplateOutput{Message: aws.String("my.policy: a,b")}, result)
+}
+
+func TestPreviewTemplateInvalid(t *testing.T) {
+	result, err := (API{}).PreviewTemplate(&models.PreviewTemplateInput{
+		MessageTemplate: aws.String("{{.Name"),
+	})
+	assert.Nil(t, result)
+	assert.IsType(t, &genericapi.InvalidInputError{}, err)
+}
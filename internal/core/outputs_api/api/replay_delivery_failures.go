@@ -0,0 +1,111 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
+	"github.com/panther-labs/panther/internal/core/outputs_api/table"
+	"github.com/panther-labs/panther/pkg/awsbatch/sqsbatch"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+const maxSQSBackoff = 30 * time.Second
+
+// ReplayDeliveryFailures puts failed alerts back on the alert queue, each targeting the output it failed for
+func (API) ReplayDeliveryFailures(input *models.ReplayDeliveryFailuresInput) (*models.ReplayDeliveryFailuresOutput, error) {
+	items, err := failuresTable.GetDeliveryFailures(input.OutputID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(input.FailureIDs) > 0 {
+		items, err = selectDeliveryFailures(items, input.FailureIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &models.ReplayDeliveryFailuresOutput{FailureIDs: []string{}}
+	if len(items) == 0 {
+		return result, nil
+	}
+
+	sqsInput := &sqs.SendMessageBatchInput{
+		Entries:  make([]*sqs.SendMessageBatchRequestEntry, len(items)),
+		QueueUrl: aws.String(alertQueueURL),
+	}
+	failureIDs := make([]*string, len(items))
+	for i, item := range items {
+		// Only re-send the alert to the output it failed for
+		alert := *item.Alert
+		alert.OutputIDs = []string{*item.OutputID}
+
+		body, err := jsoniter.MarshalToString(&alert)
+		if err != nil {
+			return nil, &genericapi.InternalError{Message: "failed to marshal alert: " + err.Error()}
+		}
+		sqsInput.Entries[i] = &sqs.SendMessageBatchRequestEntry{
+			Id:          aws.String(strconv.Itoa(i)),
+			MessageBody: aws.String(body),
+		}
+		failureIDs[i] = item.FailureID
+		result.FailureIDs = append(result.FailureIDs, *item.FailureID)
+	}
+
+	if _, err = sqsbatch.SendMessageBatch(sqsClient, maxSQSBackoff, sqsInput); err != nil {
+		// Some alerts may have been queued, but the batch sender does not reliably tell which ones.
+		// Keep all the failures so that none is lost: replaying an alert twice is safe.
+		return nil, &genericapi.AWSError{Method: "sqsbatch.SendMessageBatch", Err: err}
+	}
+
+	// The alerts are queued: if they fail again, the alert delivery will store a new failure
+	if err = failuresTable.DeleteDeliveryFailures(failureIDs); err != nil {
+		// Don't fail the request, the alerts have already been queued for delivery
+		zap.L().Error("failed to remove replayed delivery failures", zap.Error(err))
+	}
+
+	return result, nil
+}
+
+// selectDeliveryFailures returns the failures with the given IDs, all of which must exist
+func selectDeliveryFailures(items []*table.DeliveryFailureItem, failureIDs []string) ([]*table.DeliveryFailureItem, error) {
+	byID := make(map[string]*table.DeliveryFailureItem, len(items))
+	for _, item := range items {
+		byID[*item.FailureID] = item
+	}
+
+	result := make([]*table.DeliveryFailureItem, 0, len(failureIDs))
+	for _, failureID := range failureIDs {
+		item, ok := byID[failureID]
+		if !ok {
+			return nil, &genericapi.DoesNotExistError{Message: "failureId=" + failureID + " does not exist"}
+		}
+		result = append(result, item)
+		delete(byID, failureID) // ignore duplicate IDs
+	}
+	return result, nil
+}
@@ -0,0 +1,60 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
+)
+
+// TestRouting returns the outputs a sample alert would be delivered to
+func (API) TestRouting(input *models.TestRoutingInput) (models.TestRoutingOutput, error) {
+	outputItems, err := outputsTable.GetOutputs()
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []*models.AlertOutput{}
+	for _, item := range outputItems {
+		alertOutput, err := ItemToAlertOutput(item)
+		if err != nil {
+			return nil, err
+		}
+		if !isRouted(alertOutput, input) {
+			continue
+		}
+		redactOutput(alertOutput.OutputConfig)
+		outputs = append(outputs, alertOutput)
+	}
+
+	return outputs, nil
+}
+
+// isRouted mirrors the output selection of the alert delivery: explicit output IDs take precedence
+// over the output defaults
+func isRouted(output *models.AlertOutput, input *models.TestRoutingInput) bool {
+	if len(input.Alert.OutputIDs) == 0 {
+		return output.ShouldRoute(input.Alert)
+	}
+	for _, outputID := range input.Alert.OutputIDs {
+		if *output.OutputID == outputID {
+			return true
+		}
+	}
+	return false
+}
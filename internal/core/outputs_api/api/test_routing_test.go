@@ -0,0 +1,79 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
+	"github.com/panther-labs/panther/internal/core/outputs_api/table"
+)
+
+func TestTestRouting(t *testing.T) {
+	mockOutputsTable := &mockOutputTable{}
+	outputsTable = mockOutputsTable
+	mockEncryptionKey := new(mockEncryptionKey)
+	encryptionKey = mockEncryptionKey
+
+	highSeverityOutput := &table.AlertOutputItem{
+		OutputID:           aws.String("high-severity"),
+		EncryptedConfig:    make([]byte, 1),
+		DefaultForSeverity: aws.StringSlice([]string{"HIGH"}),
+	}
+	policyOutput := &table.AlertOutputItem{
+		OutputID:        aws.String("policies"),
+		EncryptedConfig: make([]byte, 1),
+		RoutingRules: &models.RoutingRules{
+			Operator:   models.RoutingOperatorOr,
+			Conditions: []*models.RoutingCondition{{Field: models.RoutingFieldAlertType, Values: []string{"POLICY"}}},
+		},
+	}
+	mockOutputsTable.On("GetOutputs").Return([]*table.AlertOutputItem{highSeverityOutput, policyOutput}, nil)
+	mockEncryptionKey.On("DecryptConfig", make([]byte, 1), mock.Anything).Return(nil)
+
+	input := &models.TestRoutingInput{
+		Alert: &alertmodels.Alert{AnalysisID: "policy.id", Type: alertmodels.PolicyType, Severity: "LOW"},
+	}
+	result, err := (API{}).TestRouting(input)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, aws.String("policies"), result[0].OutputID)
+
+	input.Alert.Severity = "HIGH"
+	result, err = (API{}).TestRouting(input)
+	require.NoError(t, err)
+	assert.Len(t, result, 2)
+
+	// Explicit output IDs take precedence
+	input.Alert.OutputIDs = []string{"high-severity"}
+	input.Alert.Severity = "LOW"
+	result, err = (API{}).TestRouting(input)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, aws.String("high-severity"), result[0].OutputID)
+
+	mockOutputsTable.AssertExpectations(t)
+	mockEncryptionKey.AssertExpectations(t)
+}
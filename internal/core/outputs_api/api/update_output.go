@@ -40,6 +40,10 @@ func (API) UpdateOutput(input *models.UpdateOutputInput) (*models.UpdateOutputOu
 			Message: "A destination with the name" + *input.DisplayName + " already exists, please choose another display name"}
 	}
 
+	if err = validateMessageTemplate(input.MessageTemplate); err != nil {
+		return nil, err
+	}
+
 	// Next check the outputConfig, this is to support partial updates of the outputConfig
 	var newConfig *models.OutputConfig
 	if input.OutputConfig != nil {
@@ -71,6 +75,8 @@ func (API) UpdateOutput(input *models.UpdateOutputInput) (*models.UpdateOutputOu
 		OutputID:           input.OutputID,
 		OutputConfig:       newConfig,
 		DefaultForSeverity: input.DefaultForSeverity,
+		RoutingRules:       input.RoutingRules,
+		MessageTemplate:    input.MessageTemplate,
 	}
 
 	alertOutputItem, err := AlertOutputToItem(alertOutput)
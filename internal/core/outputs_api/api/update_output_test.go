@@ -128,3 +128,35 @@ func TestUpdateSameOutputOutput(t *testing.T) {
 
 	mockOutputsTable.AssertExpectations(t)
 }
+
+func TestMergeConfigsEmail(t *testing.T) {
+	oldConfig := &models.OutputConfig{
+		Email: &models.EmailConfig{
+			Host:        "smtp.example.com",
+			Port:        587,
+			Username:    "user",
+			Password:    "secret",
+			FromAddress: "panther@example.com",
+			ToAddresses: []string{"security@example.com"},
+		},
+	}
+	// The password is redacted when returned to the frontend, so it is empty on update
+	newConfig := &models.OutputConfig{
+		Email: &models.EmailConfig{
+			Host:        "smtp.example.com",
+			FromAddress: "panther@example.com",
+			ToAddresses: []string{"security@example.com", "compliance@example.com"},
+		},
+	}
+
+	result, err := mergeConfigs(oldConfig, newConfig)
+	require.NoError(t, err)
+	assert.Equal(t, &models.EmailConfig{
+		Host:        "smtp.example.com",
+		Port:        587,
+		Username:    "user",
+		Password:    "secret",
+		FromAddress: "panther@example.com",
+		ToAddresses: []string{"security@example.com", "compliance@example.com"},
+	}, result.Email)
+}
@@ -25,6 +25,7 @@ import (
 	jsoniter "github.com/json-iterator/go"
 
 	"github.com/panther-labs/panther/api/lambda/outputs/models"
+	"github.com/panther-labs/panther/internal/core/alert_delivery/outputs"
 	"github.com/panther-labs/panther/internal/core/outputs_api/table"
 	"github.com/panther-labs/panther/pkg/genericapi"
 )
@@ -42,6 +43,8 @@ func AlertOutputToItem(input *models.AlertOutput) (*table.AlertOutputItem, error
 		OutputID:           input.OutputID,
 		OutputType:         input.OutputType,
 		DefaultForSeverity: input.DefaultForSeverity,
+		RoutingRules:       input.RoutingRules,
+		MessageTemplate:    input.MessageTemplate,
 	}
 
 	if input.OutputConfig != nil {
@@ -66,6 +69,8 @@ func ItemToAlertOutput(input *table.AlertOutputItem) (alertOutput *models.AlertO
 		OutputID:           input.OutputID,
 		OutputType:         input.OutputType,
 		DefaultForSeverity: input.DefaultForSeverity,
+		RoutingRules:       input.RoutingRules,
+		MessageTemplate:    input.MessageTemplate,
 	}
 
 	// Decrypt the output before returning to the caller
@@ -78,6 +83,31 @@ func ItemToAlertOutput(input *table.AlertOutputItem) (alertOutput *models.AlertO
 	return alertOutput, nil
 }
 
+func itemToDeliveryFailure(input *table.DeliveryFailureItem) *models.DeliveryFailure {
+	return &models.DeliveryFailure{
+		FailureID:    input.FailureID,
+		OutputID:     input.OutputID,
+		Alert:        input.Alert,
+		ErrorMessage: input.ErrorMessage,
+		Permanent:    input.Permanent,
+		FailedAt:     input.FailedAt,
+	}
+}
+
+func itemToDeliveryAttempt(input *table.DeliveryAttemptItem) *models.DeliveryAttempt {
+	return &models.DeliveryAttempt{
+		OutputID:     input.OutputID,
+		AnalysisID:   input.AnalysisID,
+		AlertID:      input.AlertID,
+		Success:      input.Success,
+		Permanent:    input.Permanent,
+		StatusCode:   input.StatusCode,
+		LatencyMs:    input.LatencyMs,
+		ErrorMessage: input.ErrorMessage,
+		AttemptedAt:  input.AttemptedAt,
+	}
+}
+
 func redactOutput(outputConfig *models.OutputConfig) {
 	if outputConfig.Slack != nil {
 		outputConfig.Slack.WebhookURL = redacted
@@ -103,6 +133,9 @@ func redactOutput(outputConfig *models.OutputConfig) {
 	if outputConfig.CustomWebhook != nil {
 		outputConfig.CustomWebhook.WebhookURL = redacted
 	}
+	if outputConfig.Email != nil {
+		outputConfig.Email.Password = redacted
+	}
 }
 
 func getOutputType(outputConfig *models.OutputConfig) (*string, error) {
@@ -136,6 +169,9 @@ func getOutputType(outputConfig *models.OutputConfig) (*string, error) {
 	if outputConfig.CustomWebhook != nil {
 		return aws.String("customwebhook"), nil
 	}
+	if outputConfig.Email != nil {
+		return aws.String("email"), nil
+	}
 
 	return nil, errors.New("no valid output configuration specified for alert output")
 }
@@ -152,7 +188,7 @@ func mergeConfigs(oldConfig, newConfig *models.OutputConfig) (*models.OutputConf
 		}
 	}
 	// Turn the bytes into a map so we can work with it more easily
-	var oldMap map[string]map[string]string
+	var oldMap map[string]map[string]interface{}
 	err = jsoniter.Unmarshal(oldBytes, &oldMap)
 	if err != nil {
 		return nil, &genericapi.InternalError{
@@ -167,7 +203,7 @@ func mergeConfigs(oldConfig, newConfig *models.OutputConfig) (*models.OutputConf
 			Message: "Unable to extract the new configuration",
 		}
 	}
-	var newMap map[string]map[string]string
+	var newMap map[string]map[string]interface{}
 	err = jsoniter.Unmarshal(newBytes, &newMap)
 	if err != nil {
 		return nil, &genericapi.InternalError{
@@ -178,7 +214,8 @@ func mergeConfigs(oldConfig, newConfig *models.OutputConfig) (*models.OutputConf
 	// Overwrite the existing configurations with the new configurations
 	for configType, configMap := range newMap {
 		for configKey, configValue := range configMap {
-			if configValue == "" {
+			// Unset values (including redacted secrets) keep the existing configuration
+			if configValue == nil || configValue == "" || configValue == float64(0) {
 				continue
 			}
 			oldMap[configType][configKey] = configValue
@@ -205,6 +242,17 @@ func mergeConfigs(oldConfig, newConfig *models.OutputConfig) (*models.OutputConf
 	return combinedConfig, nil
 }
 
+// validateMessageTemplate makes sure a message template can be rendered before it is saved
+func validateMessageTemplate(messageTemplate *string) error {
+	if aws.StringValue(messageTemplate) == "" {
+		return nil
+	}
+	if err := outputs.ValidateMessageTemplate(*messageTemplate); err != nil {
+		return &genericapi.InvalidInputError{Message: "invalid message template: " + err.Error()}
+	}
+	return nil
+}
+
 func validateConfigByType(config *models.OutputConfig, outputType *string) error {
 	switch *outputType {
 	case "slack":
@@ -248,6 +296,10 @@ func validateConfigByType(config *models.OutputConfig, outputType *string) error
 		if config.CustomWebhook.WebhookURL != "" {
 			return nil
 		}
+	case "email":
+		if config.Email.Host != "" && config.Email.FromAddress != "" && len(config.Email.ToAddresses) != 0 {
+			return nil
+		}
 	}
 
 	return errors.New("invalid output configuration specified for alert output, missing required fields")
@@ -26,23 +26,39 @@ import (
 	"github.com/panther-labs/panther/api/lambda/outputs/models"
 	"github.com/panther-labs/panther/internal/core/outputs_api/api"
 	"github.com/panther-labs/panther/internal/core/outputs_api/validator"
+	"github.com/panther-labs/panther/pkg/authz"
 	"github.com/panther-labs/panther/pkg/genericapi"
 	"github.com/panther-labs/panther/pkg/lambdalogger"
 )
 
 var router *genericapi.Router
 
+var destinationModify = []authz.Permission{authz.DestinationModify}
+
+// The Lambda invocation event: AppSync adds the ID of the calling user
+type lambdaInput struct {
+	models.LambdaInput
+	CallerID string `json:"callerId"`
+}
+
 func init() {
 	validator, err := validator.Validator()
 	if err != nil {
 		panic(err)
 	}
-	router = genericapi.NewRouter("api", "outputs", validator, api.API{})
+	router = genericapi.NewRouter("api", "outputs", validator, api.API{}).RequirePermissions(
+		api.PermissionChecker,
+		map[string][]authz.Permission{
+			"AddOutput":    destinationModify,
+			"DeleteOutput": destinationModify,
+			"UpdateOutput": destinationModify,
+		},
+	)
 }
 
-func lambdaHandler(ctx context.Context, input *models.LambdaInput) (interface{}, error) {
+func lambdaHandler(ctx context.Context, input *lambdaInput) (interface{}, error) {
 	lambdalogger.ConfigureGlobal(ctx, nil)
-	return router.Handle(input)
+	return router.HandleAs(input.CallerID, &input.LambdaInput)
 }
 
 func main() {
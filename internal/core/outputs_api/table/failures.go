@@ -0,0 +1,179 @@
+package table
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
+	"github.com/panther-labs/panther/pkg/awsbatch/dynamodbbatch"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+const maxWriteBackoff = 30 * time.Second
+
+// DeliveryFailuresAPI defines the interface for the delivery failures table which can be used for mocking.
+type DeliveryFailuresAPI interface {
+	PutDeliveryFailures([]*DeliveryFailureItem) error
+	GetDeliveryFailures(outputID *string) ([]*DeliveryFailureItem, error)
+	DeleteDeliveryFailures(failureIDs []*string) error
+}
+
+// DeliveryFailuresTable encapsulates a connection to the Dynamo delivery failures table.
+type DeliveryFailuresTable struct {
+	Name          *string
+	OutputIDIndex *string
+	client        dynamodbiface.DynamoDBAPI
+}
+
+// NewDeliveryFailures creates an AWS client to interface with the delivery failures table.
+func NewDeliveryFailures(name string, outputIDIndex string, sess *session.Session) *DeliveryFailuresTable {
+	return &DeliveryFailuresTable{
+		Name:          aws.String(name),
+		OutputIDIndex: aws.String(outputIDIndex),
+		client:        dynamodb.New(sess),
+	}
+}
+
+// DeliveryFailureItem is an alert which could not be delivered to an output, stored in DynamoDB.
+type DeliveryFailureItem struct {
+	// Identifies uniquely a delivery failure (table partition key)
+	FailureID *string `json:"failureId"`
+
+	// The output the alert failed to be delivered to (index partition key)
+	OutputID *string `json:"outputId"`
+
+	// The alert as it was received by the alert delivery function
+	Alert *alertmodels.Alert `json:"alert"`
+
+	// The error returned by the last delivery attempt
+	ErrorMessage *string `json:"errorMessage"`
+
+	// Whether the last error was not retryable
+	Permanent bool `json:"permanent"`
+
+	// The time of the last delivery attempt (index sort key)
+	FailedAt time.Time `json:"failedAt"`
+
+	// The time in epoch seconds when the item is removed by the DynamoDB TTL
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+// PutDeliveryFailures saves a batch of delivery failures to the table.
+func (table *DeliveryFailuresTable) PutDeliveryFailures(failures []*DeliveryFailureItem) error {
+	requests := make([]*dynamodb.WriteRequest, len(failures))
+	for i, failure := range failures {
+		item, err := dynamodbattribute.MarshalMap(failure)
+		if err != nil {
+			return &genericapi.InternalError{
+				Message: "failed to marshal DeliveryFailureItem to a dynamo item: " + err.Error()}
+		}
+		requests[i] = &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: item}}
+	}
+
+	return table.batchWrite(requests)
+}
+
+// GetDeliveryFailures returns the delivery failures of an output, or of all outputs if outputID is nil.
+//
+// Failures are sorted by the time they failed, newest first.
+func (table *DeliveryFailuresTable) GetDeliveryFailures(outputID *string) ([]*DeliveryFailureItem, error) {
+	var result []*DeliveryFailureItem
+	var unmarshalErr error
+	unmarshalPage := func(items []map[string]*dynamodb.AttributeValue) bool {
+		var page []*DeliveryFailureItem
+		if unmarshalErr = dynamodbattribute.UnmarshalListOfMaps(items, &page); unmarshalErr != nil {
+			return false
+		}
+		result = append(result, page...)
+		return true
+	}
+
+	if outputID == nil {
+		scanInput := &dynamodb.ScanInput{TableName: table.Name}
+		err := table.client.ScanPages(scanInput, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+			return unmarshalPage(page.Items)
+		})
+		if err != nil {
+			return nil, &genericapi.AWSError{Method: "dynamodb.ScanPages", Err: err}
+		}
+	} else {
+		keyCondition := expression.Key("outputId").Equal(expression.Value(outputID))
+		queryExpression, err := expression.NewBuilder().WithKeyCondition(keyCondition).Build()
+		if err != nil {
+			return nil, &genericapi.InternalError{Message: "failed to build expression " + err.Error()}
+		}
+
+		queryInput := &dynamodb.QueryInput{
+			TableName:                 table.Name,
+			IndexName:                 table.OutputIDIndex,
+			ExpressionAttributeNames:  queryExpression.Names(),
+			ExpressionAttributeValues: queryExpression.Values(),
+			KeyConditionExpression:    queryExpression.KeyCondition(),
+		}
+		err = table.client.QueryPages(queryInput, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+			return unmarshalPage(page.Items)
+		})
+		if err != nil {
+			return nil, &genericapi.AWSError{Method: "dynamodb.QueryPages", Err: err}
+		}
+	}
+
+	if unmarshalErr != nil {
+		return nil, &genericapi.InternalError{
+			Message: "failed to unmarshal dynamo item to a DeliveryFailureItem: " + unmarshalErr.Error()}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool { return result[i].FailedAt.After(result[j].FailedAt) })
+	return result, nil
+}
+
+// DeleteDeliveryFailures removes a batch of delivery failures from the table.
+func (table *DeliveryFailuresTable) DeleteDeliveryFailures(failureIDs []*string) error {
+	requests := make([]*dynamodb.WriteRequest, len(failureIDs))
+	for i, failureID := range failureIDs {
+		requests[i] = &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{Key: DynamoItem{"failureId": {S: failureID}}},
+		}
+	}
+
+	return table.batchWrite(requests)
+}
+
+func (table *DeliveryFailuresTable) batchWrite(requests []*dynamodb.WriteRequest) error {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	input := &dynamodb.BatchWriteItemInput{
+		RequestItems: map[string][]*dynamodb.WriteRequest{*table.Name: requests},
+	}
+	if err := dynamodbbatch.BatchWriteItem(table.client, maxWriteBackoff, input); err != nil {
+		return &genericapi.AWSError{Method: "dynamodbbatch.BatchWriteItem", Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package table
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	alertmodels "github.com/panther-labs/panther/api/lambda/delivery/models"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+func TestPutDeliveryFailures(t *testing.T) {
+	dynamoDBClient := &mockDynamoDB{}
+	table := &DeliveryFailuresTable{client: dynamoDBClient, Name: aws.String("TableName")}
+
+	failure := &DeliveryFailureItem{
+		FailureID: aws.String("failureId"),
+		OutputID:  aws.String("outputId"),
+		Alert:     &alertmodels.Alert{AnalysisID: "rule.id", Type: alertmodels.RuleType, Severity: "HIGH"},
+		FailedAt:  time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExpiresAt: 1580515200,
+	}
+	dynamoDBClient.On("BatchWriteItem", mock.Anything).Return(&dynamodb.BatchWriteItemOutput{}, nil)
+
+	require.NoError(t, table.PutDeliveryFailures([]*DeliveryFailureItem{failure}))
+	dynamoDBClient.AssertExpectations(t)
+
+	input := dynamoDBClient.Calls[0].Arguments.Get(0).(*dynamodb.BatchWriteItemInput)
+	require.Len(t, input.RequestItems["TableName"], 1)
+	item := input.RequestItems["TableName"][0].PutRequest.Item
+	assert.Equal(t, "failureId", *item["failureId"].S)
+	assert.Equal(t, "outputId", *item["outputId"].S)
+	assert.Equal(t, "2020-01-01T00:00:00Z", *item["failedAt"].S)
+	assert.Equal(t, "1580515200", *item["expiresAt"].N)
+	assert.Equal(t, "rule.id", *item["alert"].M["analysisId"].S)
+}
+
+func TestDeleteDeliveryFailures(t *testing.T) {
+	dynamoDBClient := &mockDynamoDB{}
+	table := &DeliveryFailuresTable{client: dynamoDBClient, Name: aws.String("TableName")}
+
+	expectedInput := &dynamodb.BatchWriteItemInput{
+		RequestItems: map[string][]*dynamodb.WriteRequest{
+			"TableName": {
+				{DeleteRequest: &dynamodb.DeleteRequest{Key: DynamoItem{"failureId": {S: aws.String("failureId")}}}},
+			},
+		},
+	}
+	dynamoDBClient.On("BatchWriteItem", expectedInput).Return(&dynamodb.BatchWriteItemOutput{}, nil)
+
+	require.NoError(t, table.DeleteDeliveryFailures(aws.StringSlice([]string{"failureId"})))
+	dynamoDBClient.AssertExpectations(t)
+}
+
+func TestDeleteDeliveryFailuresEmpty(t *testing.T) {
+	dynamoDBClient := &mockDynamoDB{}
+	table := &DeliveryFailuresTable{client: dynamoDBClient, Name: aws.String("TableName")}
+
+	require.NoError(t, table.DeleteDeliveryFailures(nil))
+	dynamoDBClient.AssertExpectations(t)
+}
+
+func TestGetDeliveryFailuresByOutput(t *testing.T) {
+	dynamoDBClient := &mockDynamoDB{}
+	table := &DeliveryFailuresTable{
+		client:        dynamoDBClient,
+		Name:          aws.String("TableName"),
+		OutputIDIndex: aws.String("IndexName"),
+	}
+
+	dynamoDBClient.On("QueryPages", mock.Anything, mock.Anything).Return(nil)
+
+	result, err := table.GetDeliveryFailures(aws.String("outputId"))
+	require.NoError(t, err)
+	assert.Len(t, result, 1)
+	dynamoDBClient.AssertExpectations(t)
+
+	input := dynamoDBClient.Calls[0].Arguments.Get(0).(*dynamodb.QueryInput)
+	assert.Equal(t, "TableName", *input.TableName)
+	assert.Equal(t, "IndexName", *input.IndexName)
+}
+
+func TestGetDeliveryFailuresAllOutputs(t *testing.T) {
+	dynamoDBClient := &mockDynamoDB{}
+	table := &DeliveryFailuresTable{client: dynamoDBClient, Name: aws.String("TableName")}
+
+	dynamoDBClient.On("ScanPages", &dynamodb.ScanInput{TableName: aws.String("TableName")}, mock.Anything).Return(nil)
+
+	result, err := table.GetDeliveryFailures(nil)
+	require.NoError(t, err)
+	assert.Len(t, result, 1)
+	dynamoDBClient.AssertExpectations(t)
+}
+
+func TestGetDeliveryFailuresServiceError(t *testing.T) {
+	dynamoDBClient := &mockDynamoDB{}
+	table := &DeliveryFailuresTable{client: dynamoDBClient, Name: aws.String("TableName")}
+
+	dynamoDBClient.On("ScanPages", mock.Anything, mock.Anything).Return(errors.New("service unavailable"))
+
+	result, err := table.GetDeliveryFailures(nil)
+	assert.Nil(t, result)
+	assert.IsType(t, &genericapi.AWSError{}, err)
+	dynamoDBClient.AssertExpectations(t)
+}
@@ -0,0 +1,157 @@
+package table
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	"github.com/google/uuid"
+
+	"github.com/panther-labs/panther/pkg/awsbatch/dynamodbbatch"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+// Fixed width timestamp layout so attempt IDs are sorted chronologically
+const attemptTimeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// DeliveryHistoryAPI defines the interface for the delivery history table which can be used for mocking.
+type DeliveryHistoryAPI interface {
+	PutDeliveryAttempts([]*DeliveryAttemptItem) error
+	GetDeliveryAttempts(outputID *string, since time.Time, limit int) ([]*DeliveryAttemptItem, error)
+}
+
+// DeliveryHistoryTable encapsulates a connection to the Dynamo delivery history table.
+type DeliveryHistoryTable struct {
+	Name   *string
+	client dynamodbiface.DynamoDBAPI
+}
+
+// NewDeliveryHistory creates an AWS client to interface with the delivery history table.
+func NewDeliveryHistory(name string, sess *session.Session) *DeliveryHistoryTable {
+	return &DeliveryHistoryTable{
+		Name:   aws.String(name),
+		client: dynamodb.New(sess),
+	}
+}
+
+// DeliveryAttemptItem is the result of sending one alert to one output, stored in DynamoDB.
+type DeliveryAttemptItem struct {
+	// The output the alert was sent to (table partition key)
+	OutputID *string `json:"outputId"`
+
+	// The attempt time followed by a random suffix (table sort key)
+	AttemptID *string `json:"attemptId"`
+
+	AnalysisID   string    `json:"analysisId"`
+	AlertID      *string   `json:"alertId,omitempty"`
+	Success      bool      `json:"success"`
+	Permanent    bool      `json:"permanent"`
+	StatusCode   int       `json:"statusCode,omitempty"`
+	LatencyMs    int64     `json:"latencyMs"`
+	ErrorMessage *string   `json:"errorMessage,omitempty"`
+	AttemptedAt  time.Time `json:"attemptedAt"`
+
+	// The time in epoch seconds when the item is removed by the DynamoDB TTL
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+// NewAttemptID returns a unique attempt ID which sorts by the given time.
+func NewAttemptID(attemptedAt time.Time) *string {
+	return aws.String(attemptedAt.UTC().Format(attemptTimeLayout) + "-" + uuid.New().String())
+}
+
+// PutDeliveryAttempts saves a batch of delivery attempts to the table.
+func (table *DeliveryHistoryTable) PutDeliveryAttempts(attempts []*DeliveryAttemptItem) error {
+	if len(attempts) == 0 {
+		return nil
+	}
+
+	requests := make([]*dynamodb.WriteRequest, len(attempts))
+	for i, attempt := range attempts {
+		item, err := dynamodbattribute.MarshalMap(attempt)
+		if err != nil {
+			return &genericapi.InternalError{
+				Message: "failed to marshal DeliveryAttemptItem to a dynamo item: " + err.Error()}
+		}
+		requests[i] = &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: item}}
+	}
+
+	input := &dynamodb.BatchWriteItemInput{
+		RequestItems: map[string][]*dynamodb.WriteRequest{*table.Name: requests},
+	}
+	if err := dynamodbbatch.BatchWriteItem(table.client, maxWriteBackoff, input); err != nil {
+		return &genericapi.AWSError{Method: "dynamodbbatch.BatchWriteItem", Err: err}
+	}
+	return nil
+}
+
+// GetDeliveryAttempts returns the delivery attempts of an output since the given time, newest first.
+//
+// At most limit attempts are returned, or all of them if limit is 0.
+func (table *DeliveryHistoryTable) GetDeliveryAttempts(
+	outputID *string, since time.Time, limit int) ([]*DeliveryAttemptItem, error) {
+
+	keyCondition := expression.Key("outputId").Equal(expression.Value(outputID)).
+		And(expression.Key("attemptId").GreaterThanEqual(
+			expression.Value(since.UTC().Format(attemptTimeLayout))))
+	queryExpression, err := expression.NewBuilder().WithKeyCondition(keyCondition).Build()
+	if err != nil {
+		return nil, &genericapi.InternalError{Message: "failed to build expression " + err.Error()}
+	}
+
+	queryInput := &dynamodb.QueryInput{
+		TableName:                 table.Name,
+		ExpressionAttributeNames:  queryExpression.Names(),
+		ExpressionAttributeValues: queryExpression.Values(),
+		KeyConditionExpression:    queryExpression.KeyCondition(),
+		ScanIndexForward:          aws.Bool(false),
+	}
+	if limit > 0 {
+		queryInput.Limit = aws.Int64(int64(limit))
+	}
+
+	var result []*DeliveryAttemptItem
+	var unmarshalErr error
+	err = table.client.QueryPages(queryInput, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+		var items []*DeliveryAttemptItem
+		if unmarshalErr = dynamodbattribute.UnmarshalListOfMaps(page.Items, &items); unmarshalErr != nil {
+			return false
+		}
+		result = append(result, items...)
+		return limit == 0 || len(result) < limit
+	})
+	if err != nil {
+		return nil, &genericapi.AWSError{Method: "dynamodb.QueryPages", Err: err}
+	}
+	if unmarshalErr != nil {
+		return nil, &genericapi.InternalError{
+			Message: "failed to unmarshal dynamo item to a DeliveryAttemptItem: " + unmarshalErr.Error()}
+	}
+
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package table
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAttemptIDSortsChronologically(t *testing.T) {
+	first := time.Date(2020, 1, 1, 0, 0, 1, 0, time.UTC)
+	second := first.Add(500 * time.Millisecond)
+	assert.Less(t, *NewAttemptID(first), *NewAttemptID(second))
+}
+
+func TestPutDeliveryAttempts(t *testing.T) {
+	dynamoDBClient := &mockDynamoDB{}
+	table := &DeliveryHistoryTable{client: dynamoDBClient, Name: aws.String("TableName")}
+
+	attempt := &DeliveryAttemptItem{
+		OutputID:    aws.String("outputId"),
+		AttemptID:   aws.String("attemptId"),
+		AnalysisID:  "rule.id",
+		StatusCode:  503,
+		AttemptedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	dynamoDBClient.On("BatchWriteItem", mock.Anything).Return(&dynamodb.BatchWriteItemOutput{}, nil)
+
+	require.NoError(t, table.PutDeliveryAttempts([]*DeliveryAttemptItem{attempt}))
+	dynamoDBClient.AssertExpectations(t)
+
+	input := dynamoDBClient.Calls[0].Arguments.Get(0).(*dynamodb.BatchWriteItemInput)
+	item := input.RequestItems["TableName"][0].PutRequest.Item
+	assert.Equal(t, "outputId", *item["outputId"].S)
+	assert.Equal(t, "attemptId", *item["attemptId"].S)
+	assert.Equal(t, "503", *item["statusCode"].N)
+	assert.NotContains(t, item, "alertId")
+}
+
+func TestGetDeliveryAttempts(t *testing.T) {
+	dynamoDBClient := &mockDynamoDB{}
+	table := &DeliveryHistoryTable{client: dynamoDBClient, Name: aws.String("TableName")}
+
+	dynamoDBClient.On("QueryPages", mock.Anything, mock.Anything).Return(nil)
+
+	since := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	result, err := table.GetDeliveryAttempts(aws.String("outputId"), since, 10)
+	require.NoError(t, err)
+	assert.Len(t, result, 1)
+	dynamoDBClient.AssertExpectations(t)
+
+	input := dynamoDBClient.Calls[0].Arguments.Get(0).(*dynamodb.QueryInput)
+	assert.Equal(t, "TableName", *input.TableName)
+	assert.False(t, *input.ScanIndexForward)
+	assert.Equal(t, int64(10), *input.Limit)
+	assert.Contains(t, input.ExpressionAttributeValues, ":1")
+	assert.Equal(t, "2020-01-01T00:00:00.000000000Z", *input.ExpressionAttributeValues[":1"].S)
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
 )
 
 // OutputsAPI defines the interface for the outputs table which can be used for mocking.
@@ -84,4 +86,10 @@ type AlertOutputItem struct {
 	OutputType *string `json:"outputType"`
 
 	DefaultForSeverity []*string `json:"defaultForSeverity" dynamodbav:"defaultForSeverity,stringset"`
+
+	// RoutingRules further restrict the alerts that will be forwarded through this output
+	RoutingRules *models.RoutingRules `json:"routingRules,omitempty"`
+
+	// MessageTemplate is an optional Go text/template replacing the default message body of the output
+	MessageTemplate *string `json:"messageTemplate,omitempty"`
 }
@@ -44,6 +44,22 @@ func (table *OutputsTable) UpdateOutput(alertOutput *AlertOutputItem) (*AlertOut
 	if alertOutput.DefaultForSeverity != nil {
 		updateExpression.Set(expression.Name("defaultForSeverity"), expression.Value(alertOutput.DefaultForSeverity))
 	}
+	if alertOutput.RoutingRules != nil {
+		// Empty routing rules remove the existing ones
+		if alertOutput.RoutingRules.IsEmpty() {
+			updateExpression.Remove(expression.Name("routingRules"))
+		} else {
+			updateExpression.Set(expression.Name("routingRules"), expression.Value(alertOutput.RoutingRules))
+		}
+	}
+	if alertOutput.MessageTemplate != nil {
+		// An empty template resets the output to its default message
+		if *alertOutput.MessageTemplate == "" {
+			updateExpression.Remove(expression.Name("messageTemplate"))
+		} else {
+			updateExpression.Set(expression.Name("messageTemplate"), expression.Value(alertOutput.MessageTemplate))
+		}
+	}
 
 	conditionExpression := expression.Name("outputId").Equal(expression.Value(alertOutput.OutputID))
 	combinedExpression, err := expression.NewBuilder().
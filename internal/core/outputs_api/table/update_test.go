@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
 	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
@@ -88,6 +89,45 @@ func TestUpdateOutput(t *testing.T) {
 	dynamoDBClient.AssertExpectations(t)
 }
 
+func TestUpdateOutputRemovesRoutingRules(t *testing.T) {
+	dynamoDBClient := &mockDynamoDB{}
+	table := &OutputsTable{client: dynamoDBClient, Name: aws.String("TableName")}
+
+	item := &AlertOutputItem{
+		OutputID:         aws.String("outputId"),
+		LastModifiedBy:   aws.String("lastModifiedBy"),
+		LastModifiedTime: aws.String("lastModifiedTime"),
+		RoutingRules:     &models.RoutingRules{},
+	}
+	expectedUpdateExpression := expression.
+		Set(expression.Name("lastModifiedBy"), expression.Value(item.LastModifiedBy)).
+		Set(expression.Name("lastModifiedTime"), expression.Value(item.LastModifiedTime)).
+		Remove(expression.Name("routingRules"))
+	expectedExpression, _ := expression.NewBuilder().
+		WithCondition(expression.Name("outputId").Equal(expression.Value(item.OutputID))).
+		WithUpdate(expectedUpdateExpression).
+		Build()
+
+	dynamoDBClient.On("UpdateItem", &dynamodb.UpdateItemInput{
+		Key: DynamoItem{
+			"outputId": {S: aws.String("outputId")},
+		},
+		TableName:                 aws.String("TableName"),
+		UpdateExpression:          expectedExpression.Update(),
+		ConditionExpression:       expectedExpression.Condition(),
+		ExpressionAttributeNames:  expectedExpression.Names(),
+		ExpressionAttributeValues: expectedExpression.Values(),
+		ReturnValues:              aws.String(dynamodb.ReturnValueAllNew),
+	}).Return(&dynamodb.UpdateItemOutput{
+		Attributes: map[string]*dynamodb.AttributeValue{"outputId": {S: aws.String("outputId")}},
+	}, nil)
+
+	result, err := table.UpdateOutput(item)
+	assert.NoError(t, err)
+	assert.Nil(t, result.RoutingRules)
+	dynamoDBClient.AssertExpectations(t)
+}
+
 func TestUpdateOutputDoesNotExist(t *testing.T) {
 	dynamoDBClient := &mockDynamoDB{}
 	table := &OutputsTable{client: dynamoDBClient, Name: aws.String("TableName")}
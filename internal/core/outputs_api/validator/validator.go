@@ -21,6 +21,8 @@ package validator
 import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"gopkg.in/go-playground/validator.v9"
+
+	"github.com/panther-labs/panther/api/lambda/outputs/models"
 )
 
 // Validator builds a custom struct validator.
@@ -29,6 +31,7 @@ func Validator() (*validator.Validate, error) {
 	if err := result.RegisterValidation("snsArn", validateAwsArn); err != nil {
 		return nil, err
 	}
+	result.RegisterStructValidation(validateEmailConfig, models.EmailConfig{})
 	return result, nil
 }
 
@@ -36,3 +39,11 @@ func validateAwsArn(fl validator.FieldLevel) bool {
 	fieldArn, err := arn.Parse(fl.Field().String())
 	return err == nil && fieldArn.Service == "sns"
 }
+
+// validateEmailConfig ensures SMTP credentials are complete: a password is useless without a username.
+func validateEmailConfig(sl validator.StructLevel) {
+	config := sl.Current().Interface().(models.EmailConfig)
+	if config.Password != "" && config.Username == "" {
+		sl.ReportError(config.Username, "Username", "Username", "required_with", "Password")
+	}
+}
@@ -87,3 +87,60 @@ func TestAddNonSnsArn(t *testing.T) {
 	require.Error(t, err)
 	assert.Equal(t, expectedMsg("AddOutputInput.OutputConfig.Sns", "TopicArn", "snsArn"), err.Error())
 }
+
+func TestAddEmailValid(t *testing.T) {
+	validator, err := Validator()
+	require.NoError(t, err)
+	assert.NoError(t, validator.Struct(&models.AddOutputInput{
+		UserID:      aws.String("3601990c-b566-404b-b367-3c6eacd6fe60"),
+		DisplayName: aws.String("myemail"),
+		OutputConfig: &models.OutputConfig{
+			Email: &models.EmailConfig{
+				Host:        "smtp.example.com",
+				Port:        465,
+				TLSMode:     "tls",
+				Username:    "user",
+				Password:    "secret",
+				FromAddress: "panther@example.com",
+				ToAddresses: []string{"security@example.com"},
+			},
+		},
+	}))
+}
+
+func TestAddEmailInvalidRecipient(t *testing.T) {
+	validator, err := Validator()
+	require.NoError(t, err)
+	err = validator.Struct(&models.AddOutputInput{
+		UserID:      aws.String("3601990c-b566-404b-b367-3c6eacd6fe60"),
+		DisplayName: aws.String("myemail"),
+		OutputConfig: &models.OutputConfig{
+			Email: &models.EmailConfig{
+				Host:        "smtp.example.com",
+				FromAddress: "panther@example.com",
+				ToAddresses: []string{"security@example.com\r\nBcc: attacker@example.com"},
+			},
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t, expectedMsg("AddOutputInput.OutputConfig.Email", "ToAddresses[0]", "email"), err.Error())
+}
+
+func TestAddEmailPasswordWithoutUsername(t *testing.T) {
+	validator, err := Validator()
+	require.NoError(t, err)
+	err = validator.Struct(&models.AddOutputInput{
+		UserID:      aws.String("3601990c-b566-404b-b367-3c6eacd6fe60"),
+		DisplayName: aws.String("myemail"),
+		OutputConfig: &models.OutputConfig{
+			Email: &models.EmailConfig{
+				Host:        "smtp.example.com",
+				Password:    "secret",
+				FromAddress: "panther@example.com",
+				ToAddresses: []string{"security@example.com"},
+			},
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t, expectedMsg("AddOutputInput.OutputConfig.Email", "Username", "required_with"), err.Error())
+}
@@ -21,16 +21,19 @@ package api
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"go.uber.org/zap"
 
 	"github.com/panther-labs/panther/api/lambda/source/models"
+	gcppoller "github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/gcp"
 	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
@@ -49,11 +52,18 @@ var (
 // CheckIntegration adds a set of new integrations in a batch.
 func (API) CheckIntegration(input *models.CheckIntegrationInput) (*models.SourceIntegrationHealth, error) {
 	zap.L().Debug("beginning source configuration check")
+	if err := checkRequiredFields(input); err != nil {
+		return nil, err
+	}
 	switch aws.StringValue(input.IntegrationType) {
 	case models.IntegrationTypeAWSScan:
 		return checkAwsScanIntegration(input), nil
 	case models.IntegrationTypeAWS3:
 		return checkAwsS3Integration(input), nil
+	case models.IntegrationTypeAWSKinesis:
+		return checkAwsKinesisIntegration(input), nil
+	case models.IntegrationTypeGCPScan:
+		return checkGcpScanIntegration(input), nil
 	default:
 		return nil, checkIntegrationInternalError
 	}
@@ -92,6 +102,95 @@ func checkAwsS3Integration(input *models.CheckIntegrationInput) *models.SourceIn
 	return out
 }
 
+func checkAwsKinesisIntegration(input *models.CheckIntegrationInput) *models.SourceIntegrationHealth {
+	return &models.SourceIntegrationHealth{
+		AWSAccountID:        aws.StringValue(input.AWSAccountID),
+		IntegrationType:     aws.StringValue(input.IntegrationType),
+		KinesisStreamStatus: checkStream(input.KinesisStreamArn),
+	}
+}
+
+func checkGcpScanIntegration(input *models.CheckIntegrationInput) *models.SourceIntegrationHealth {
+	out := &models.SourceIntegrationHealth{
+		GCPProjectID:    aws.StringValue(input.GCPProjectID),
+		IntegrationType: aws.StringValue(input.IntegrationType),
+	}
+
+	client, err := gcppoller.NewClient([]byte(aws.StringValue(input.GCPServiceAccountKey)))
+	if err == nil {
+		err = client.Authenticate()
+	}
+	out.ServiceAccountKeyStatus = itemStatus(err)
+	if err == nil {
+		out.GCPProjectStatus = itemStatus(client.CheckProject(*input.GCPProjectID))
+	}
+	return out
+}
+
+// checkRequiredFields verifies the fields needed by the type of a source are set,
+// the struct validator cannot express requirements which depend on another field.
+func checkRequiredFields(input *models.CheckIntegrationInput) error {
+	integrationType := aws.StringValue(input.IntegrationType)
+	switch integrationType {
+	case models.IntegrationTypeGCPScan:
+		if input.GCPProjectID == nil || input.GCPServiceAccountKey == nil {
+			return &genericapi.InvalidInputError{
+				Message: "gcpProjectId and gcpServiceAccountKey are required for " + integrationType + " sources",
+			}
+		}
+	default:
+		if input.AWSAccountID == nil {
+			return &genericapi.InvalidInputError{
+				Message: "awsAccountId is required for " + integrationType + " sources",
+			}
+		}
+	}
+	return nil
+}
+
+func itemStatus(err error) models.SourceIntegrationItemStatus {
+	if err != nil {
+		return models.SourceIntegrationItemStatus{
+			Healthy:      aws.Bool(false),
+			ErrorMessage: aws.String(err.Error()),
+		}
+	}
+	return models.SourceIntegrationItemStatus{
+		Healthy: aws.Bool(true),
+	}
+}
+
+func checkStream(streamArn *string) models.SourceIntegrationItemStatus {
+	if streamArn == nil {
+		return models.SourceIntegrationItemStatus{
+			Healthy:      aws.Bool(false),
+			ErrorMessage: aws.String("kinesis stream arn is required"),
+		}
+	}
+
+	// The stream name is the last part of the stream ARN
+	streamName := (*streamArn)[strings.LastIndex(*streamArn, "/")+1:]
+	info, err := kinesisClient.DescribeStreamSummary(&kinesis.DescribeStreamSummaryInput{StreamName: &streamName})
+	if err != nil {
+		return models.SourceIntegrationItemStatus{
+			Healthy:      aws.Bool(false),
+			ErrorMessage: aws.String(err.Error()),
+		}
+	}
+
+	status := aws.StringValue(info.StreamDescriptionSummary.StreamStatus)
+	if status != kinesis.StreamStatusActive && status != kinesis.StreamStatusUpdating {
+		return models.SourceIntegrationItemStatus{
+			Healthy:      aws.Bool(false),
+			ErrorMessage: aws.String("stream is " + strings.ToLower(status)),
+		}
+	}
+
+	return models.SourceIntegrationItemStatus{
+		Healthy: aws.Bool(true),
+	}
+}
+
 func checkKey(roleCredentials *credentials.Credentials, key *string) models.SourceIntegrationItemStatus {
 	if key == nil {
 		// KMS key is optional
@@ -198,6 +297,20 @@ func evaluateIntegration(api API, integration *models.CheckIntegrationInput) (st
 			return "log processing role cannot access kms key", aws.BoolValue(status.KMSKeyStatus.Healthy), nil
 		}
 		return "", true, nil
+	case models.IntegrationTypeAWSKinesis:
+		if !aws.BoolValue(status.KinesisStreamStatus.Healthy) {
+			return "cannot access kinesis stream", false, nil
+		}
+		return "", true, nil
+	case models.IntegrationTypeGCPScan:
+		if !aws.BoolValue(status.ServiceAccountKeyStatus.Healthy) {
+			return "invalid service account key", false, nil
+		}
+
+		if !aws.BoolValue(status.GCPProjectStatus.Healthy) {
+			return "service account cannot access gcp project", false, nil
+		}
+		return "", true, nil
 	default:
 		return "", false, errors.New("invalid integration type")
 	}
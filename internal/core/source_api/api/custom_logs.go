@@ -0,0 +1,169 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/lambda/source/models"
+	"github.com/panther-labs/panther/internal/core/source_api/ddb"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/customlogs"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/registry"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+var (
+	putCustomLogInternalError    = &genericapi.InternalError{Message: "Failed to save custom log, please try again later"}
+	deleteCustomLogInternalError = &genericapi.InternalError{Message: "Failed to delete custom log, please try again later"}
+)
+
+// PutCustomLog creates or updates a custom log type from its YAML schema.
+//
+// The Glue tables of the log type are created or updated once the schema is stored.
+func (API) PutCustomLog(input *models.PutCustomLogInput) (*models.CustomLog, error) {
+	schema, err := customlogs.ParseSchema([]byte(*input.LogSpec))
+	if err != nil {
+		return nil, &genericapi.InvalidInputError{Message: err.Error()}
+	}
+	config := schema.Config()
+	if err := config.Validate(); err != nil {
+		return nil, &genericapi.InvalidInputError{Message: err.Error()}
+	}
+
+	existing, err := dynamoClient.GetCustomLog(&schema.Name)
+	if err != nil {
+		zap.L().Error("failed to get custom log", zap.String("logType", schema.Name), zap.Error(err))
+		return nil, putCustomLogInternalError
+	}
+
+	now := time.Now().UTC()
+	item := &ddb.CustomLog{
+		LogType:     aws.String(schema.Name),
+		Description: aws.String(schema.Description),
+		LogSpec:     input.LogSpec,
+		Revision:    aws.Int64(1),
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+		UpdatedBy:   input.UserID,
+	}
+	if existing != nil {
+		item.Revision = aws.Int64(aws.Int64Value(existing.Revision) + 1)
+		item.CreatedAt = existing.CreatedAt
+	}
+	if err := dynamoClient.PutCustomLog(item); err != nil {
+		zap.L().Error("failed to store custom log", zap.String("logType", schema.Name), zap.Error(err))
+		return nil, putCustomLogInternalError
+	}
+
+	if err := addGlueTables([]*string{item.LogType}); err != nil {
+		zap.L().Error("failed to add custom log glue tables", zap.String("logType", schema.Name), zap.Error(err))
+		return nil, putCustomLogInternalError
+	}
+	return customLogItemToModel(item), nil
+}
+
+// GetCustomLog returns a custom log type
+func (API) GetCustomLog(input *models.GetCustomLogInput) (*models.CustomLog, error) {
+	item, err := dynamoClient.GetCustomLog(input.LogType)
+	if err != nil {
+		return nil, &genericapi.InternalError{Message: "Failed to get custom log"}
+	}
+	if item == nil {
+		return nil, &genericapi.DoesNotExistError{Message: "Custom log does not exist"}
+	}
+	return customLogItemToModel(item), nil
+}
+
+// ListCustomLogs returns all custom log types.
+//
+// The output of this handler is used by the log processor to register custom log types.
+func (API) ListCustomLogs(_ *models.ListCustomLogsInput) ([]*models.CustomLog, error) {
+	items, err := dynamoClient.ScanCustomLogs()
+	if err != nil {
+		return nil, &genericapi.InternalError{Message: "Failed to list custom logs"}
+	}
+
+	result := make([]*models.CustomLog, len(items))
+	for i, item := range items {
+		result[i] = customLogItemToModel(item)
+	}
+	return result, nil
+}
+
+// DeleteCustomLog deletes a custom log type if no source uses it.
+//
+// The Glue tables of the log type are kept so that data already processed can still be queried.
+func (API) DeleteCustomLog(input *models.DeleteCustomLogInput) error {
+	integrations, err := dynamoClient.ScanIntegrations(nil)
+	if err != nil {
+		zap.L().Error("failed to list integrations", zap.Error(err))
+		return deleteCustomLogInternalError
+	}
+	for _, integration := range integrations {
+		for _, logType := range integration.LogTypes {
+			if aws.StringValue(logType) == *input.LogType {
+				return &genericapi.InvalidInputError{
+					Message: fmt.Sprintf("Custom log %s is used by source %s",
+						*input.LogType, aws.StringValue(integration.IntegrationLabel)),
+				}
+			}
+		}
+	}
+
+	if err := dynamoClient.DeleteCustomLog(input.LogType); err != nil {
+		zap.L().Error("failed to delete custom log", zap.String("logType", *input.LogType), zap.Error(err))
+		return deleteCustomLogInternalError
+	}
+	registry.Default().Del(*input.LogType)
+	return nil
+}
+
+// loadCustomLogTypes registers the stored custom log types to the default registry
+func loadCustomLogTypes() error {
+	items, err := dynamoClient.ScanCustomLogs()
+	if err != nil {
+		return err
+	}
+	customLogs := make([]*models.CustomLog, len(items))
+	for i, item := range items {
+		customLogs[i] = customLogItemToModel(item)
+	}
+	schemas, err := customlogs.ParseCustomLogs(customLogs)
+	if err != nil {
+		return errors.Wrap(err, "failed to load custom logs")
+	}
+	return customlogs.Sync(registry.Default(), schemas...)
+}
+
+func customLogItemToModel(item *ddb.CustomLog) *models.CustomLog {
+	return &models.CustomLog{
+		LogType:     item.LogType,
+		Description: item.Description,
+		LogSpec:     item.LogSpec,
+		Revision:    item.Revision,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+		UpdatedBy:   item.UpdatedBy,
+	}
+}
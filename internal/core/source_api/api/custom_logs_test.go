@@ -0,0 +1,83 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/api/lambda/source/models"
+	"github.com/panther-labs/panther/internal/core/source_api/ddb"
+	"github.com/panther-labs/panther/pkg/genericapi"
+	"github.com/panther-labs/panther/pkg/testutils"
+)
+
+func TestPutCustomLogInvalidSpec(t *testing.T) {
+	mockClient := &testutils.DynamoDBMock{}
+	dynamoClient = &ddb.DDB{Client: mockClient, TableName: "test", CustomLogsTableName: "test-custom-logs"}
+
+	result, err := apiTest.PutCustomLog(&models.PutCustomLogInput{
+		UserID:  aws.String(testUserID),
+		LogSpec: aws.String("name: MyApp\ndescription: Missing the custom prefix"),
+	})
+	require.Error(t, err)
+	assert.IsType(t, &genericapi.InvalidInputError{}, err)
+	assert.Nil(t, result)
+	// Nothing is stored for an invalid schema
+	mockClient.AssertExpectations(t)
+}
+
+func TestDeleteCustomLogInUse(t *testing.T) {
+	mockClient := &testutils.DynamoDBMock{}
+	dynamoClient = &ddb.DDB{Client: mockClient, TableName: "test", CustomLogsTableName: "test-custom-logs"}
+
+	integration := generateDDBAttributes(models.IntegrationTypeAWS3)
+	integration["integrationLabel"] = &dynamodb.AttributeValue{S: aws.String("my-source")}
+	integration["logTypes"] = &dynamodb.AttributeValue{SS: aws.StringSlice([]string{"Custom.MyApp"})}
+	mockClient.On("Scan", mock.Anything).Return(&dynamodb.ScanOutput{
+		Items: []map[string]*dynamodb.AttributeValue{integration},
+	}, nil)
+
+	err := apiTest.DeleteCustomLog(&models.DeleteCustomLogInput{
+		LogType: aws.String("Custom.MyApp"),
+	})
+	require.Error(t, err)
+	assert.IsType(t, &genericapi.InvalidInputError{}, err)
+	assert.Contains(t, err.Error(), "my-source")
+	mockClient.AssertExpectations(t)
+}
+
+func TestDeleteCustomLog(t *testing.T) {
+	mockClient := &testutils.DynamoDBMock{}
+	dynamoClient = &ddb.DDB{Client: mockClient, TableName: "test", CustomLogsTableName: "test-custom-logs"}
+
+	mockClient.On("Scan", mock.Anything).Return(&dynamodb.ScanOutput{}, nil)
+	mockClient.On("DeleteItem", mock.Anything).Return(&dynamodb.DeleteItemOutput{}, nil)
+
+	err := apiTest.DeleteCustomLog(&models.DeleteCustomLogInput{
+		LogType: aws.String("Custom.MyApp"),
+	})
+	require.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
@@ -92,11 +92,27 @@ func (API) DeleteIntegration(input *models.DeleteIntegrationInput) (err error) {
 			}
 			integrationForDeletePermissions = itemToIntegration(integrationItem)
 		}
+	case models.IntegrationTypeAWSKinesis:
+		if err = DisableKinesisStreamProcessing(*integrationItem.KinesisStreamArn); err != nil {
+			zap.L().Error("failed to remove kinesis event source mapping for integrationItem",
+				zap.String("integrationId", *input.IntegrationID),
+				zap.Error(err))
+			return deleteIntegrationInternalError
+		}
 	}
 
 	err = dynamoClient.DeleteItem(input.IntegrationID)
 	if err != nil {
 		return deleteIntegrationInternalError
 	}
+
+	if *integrationItem.IntegrationType == models.IntegrationTypeGCPScan {
+		// The source is already gone, a leftover key only needs cleaning up
+		if keyErr := DeleteServiceAccountKey(*input.IntegrationID); keyErr != nil {
+			zap.L().Error("failed to delete service account key for integration. Secret has to be removed manually",
+				zap.String("integrationId", *input.IntegrationID),
+				zap.Error(keyErr))
+		}
+	}
 	return nil
 }
@@ -0,0 +1,89 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/lambda/source/models"
+)
+
+// The service account keys of gcp-scan sources are stored in Secrets Manager, one secret per source.
+// The snapshot pollers read them using the integration ID from the scan request.
+func serviceAccountKeySecretName(integrationID string) string {
+	return models.GCPServiceAccountKeySecretPrefix + integrationID
+}
+
+// CreateServiceAccountKey stores the service account key of a new gcp-scan source.
+func CreateServiceAccountKey(integrationID, key string) error {
+	_, err := secretsClient.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         aws.String(serviceAccountKeySecretName(integrationID)),
+		Description:  aws.String("Service account key used by Panther to scan a GCP project"),
+		SecretString: aws.String(key),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to store service account key of integration %s", integrationID)
+	}
+	return nil
+}
+
+// UpdateServiceAccountKey replaces the service account key of a gcp-scan source.
+func UpdateServiceAccountKey(integrationID, key string) error {
+	_, err := secretsClient.PutSecretValue(&secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(serviceAccountKeySecretName(integrationID)),
+		SecretString: aws.String(key),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to update service account key of integration %s", integrationID)
+	}
+	return nil
+}
+
+// GetServiceAccountKey returns the service account key of a gcp-scan source.
+func GetServiceAccountKey(integrationID string) (string, error) {
+	out, err := secretsClient.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(serviceAccountKeySecretName(integrationID)),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get service account key of integration %s", integrationID)
+	}
+	return aws.StringValue(out.SecretString), nil
+}
+
+// DeleteServiceAccountKey deletes the service account key of a gcp-scan source immediately.
+func DeleteServiceAccountKey(integrationID string) error {
+	_, err := secretsClient.DeleteSecret(&secretsmanager.DeleteSecretInput{
+		SecretId:                   aws.String(serviceAccountKeySecretName(integrationID)),
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+			zap.L().Warn("didn't find expected service account key",
+				zap.String("integrationId", integrationID),
+			)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to delete service account key of integration %s", integrationID)
+	}
+	return nil
+}
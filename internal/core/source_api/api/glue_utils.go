@@ -19,11 +19,24 @@ package api
  */
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
+
 	"github.com/panther-labs/panther/internal/log_analysis/athenaviews"
 	"github.com/panther-labs/panther/internal/log_analysis/gluetables"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/customlogs"
 )
 
 func addGlueTables(logTypes []*string) error {
+	// custom log types need to be registered before their tables can be created
+	for _, logType := range logTypes {
+		if customlogs.IsCustomLogType(aws.StringValue(logType)) {
+			if err := loadCustomLogTypes(); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
 	for _, logType := range logTypes {
 		_, _, err := gluetables.CreateOrUpdateGlueTablesForLogType(glueClient, *logType, env.ProcessedDataBucket)
 		if err != nil {
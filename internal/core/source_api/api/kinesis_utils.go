@@ -0,0 +1,141 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// Maximum number of Kinesis records the log processor receives in a single invocation
+	kinesisBatchSize = 1000
+	// Maximum time in seconds records are buffered before the log processor is invoked
+	kinesisMaxBatchingWindow = 60
+	// Number of times a failed batch is retried (split in half each time) before it is sent to the DLQ
+	kinesisMaxRetryAttempts = 5
+)
+
+// EnableKinesisStreamProcessing connects a Kinesis data stream to the Log Processor
+// by creating an event source mapping. It returns false if the mapping already existed.
+//
+// Failed batches are split and retried a bounded number of times, after which the metadata of the failed
+// records is sent to the Kinesis DLQ, so that a bad record cannot block its shard until it expires.
+func EnableKinesisStreamProcessing(streamArn string) (bool, error) {
+	mappings, err := listKinesisEventSourceMappings(streamArn)
+	if err != nil {
+		return false, err
+	}
+	if len(mappings) > 0 {
+		// Mappings created by older versions have no retry limit, bring them up to date
+		for _, mapping := range mappings {
+			if err := updateKinesisEventSourceMapping(mapping); err != nil {
+				return false, err
+			}
+		}
+		return false, nil
+	}
+
+	_, err = lambdaClient.CreateEventSourceMapping(&lambda.CreateEventSourceMappingInput{
+		BatchSize:                      aws.Int64(kinesisBatchSize),
+		MaximumBatchingWindowInSeconds: aws.Int64(kinesisMaxBatchingWindow),
+		MaximumRetryAttempts:           aws.Int64(kinesisMaxRetryAttempts),
+		BisectBatchOnFunctionError:     aws.Bool(true),
+		DestinationConfig:              kinesisDestinationConfig(),
+		Enabled:                        aws.Bool(true),
+		EventSourceArn:                 aws.String(streamArn),
+		FunctionName:                   aws.String(env.LogProcessorFunctionName),
+		StartingPosition:               aws.String(lambda.EventSourcePositionLatest),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create event source mapping for stream %s", streamArn)
+	}
+	return true, nil
+}
+
+func updateKinesisEventSourceMapping(mapping *lambda.EventSourceMappingConfiguration) error {
+	if aws.Int64Value(mapping.MaximumRetryAttempts) == kinesisMaxRetryAttempts &&
+		aws.BoolValue(mapping.BisectBatchOnFunctionError) &&
+		mapping.DestinationConfig != nil && mapping.DestinationConfig.OnFailure != nil &&
+		aws.StringValue(mapping.DestinationConfig.OnFailure.Destination) == env.LogProcessorKinesisDlqArn {
+
+		return nil
+	}
+
+	_, err := lambdaClient.UpdateEventSourceMapping(&lambda.UpdateEventSourceMappingInput{
+		UUID:                       mapping.UUID,
+		MaximumRetryAttempts:       aws.Int64(kinesisMaxRetryAttempts),
+		BisectBatchOnFunctionError: aws.Bool(true),
+		DestinationConfig:          kinesisDestinationConfig(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to update event source mapping %s", aws.StringValue(mapping.UUID))
+	}
+	return nil
+}
+
+func kinesisDestinationConfig() *lambda.DestinationConfig {
+	return &lambda.DestinationConfig{
+		OnFailure: &lambda.OnFailure{
+			Destination: aws.String(env.LogProcessorKinesisDlqArn),
+		},
+	}
+}
+
+// DisableKinesisStreamProcessing removes the event source mappings between a Kinesis data stream
+// and the Log Processor
+func DisableKinesisStreamProcessing(streamArn string) error {
+	mappings, err := listKinesisEventSourceMappings(streamArn)
+	if err != nil {
+		return err
+	}
+	if len(mappings) == 0 {
+		zap.L().Warn("didn't find expected event source mapping for stream",
+			zap.String("streamArn", streamArn),
+		)
+		return nil
+	}
+
+	for _, mapping := range mappings {
+		_, err = lambdaClient.DeleteEventSourceMapping(&lambda.DeleteEventSourceMappingInput{UUID: mapping.UUID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to delete event source mapping %s", aws.StringValue(mapping.UUID))
+		}
+	}
+	return nil
+}
+
+func listKinesisEventSourceMappings(streamArn string) ([]*lambda.EventSourceMappingConfiguration, error) {
+	var mappings []*lambda.EventSourceMappingConfiguration
+	input := &lambda.ListEventSourceMappingsInput{
+		EventSourceArn: aws.String(streamArn),
+		FunctionName:   aws.String(env.LogProcessorFunctionName),
+	}
+	err := lambdaClient.ListEventSourceMappingsPages(input,
+		func(page *lambda.ListEventSourceMappingsOutput, _ bool) bool {
+			mappings = append(mappings, page.EventSourceMappings...)
+			return true
+		})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list event source mappings for stream %s", streamArn)
+	}
+	return mappings, nil
+}
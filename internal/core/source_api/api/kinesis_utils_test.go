@@ -0,0 +1,84 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/pkg/testutils"
+)
+
+const (
+	testStreamArn = "arn:aws:kinesis:us-west-2:123456789012:stream/test-stream"
+	testDlqArn    = "arn:aws:sqs:us-west-2:123456789012:panther-log-processor-kinesis-dlq"
+)
+
+func TestEnableKinesisStreamProcessing(t *testing.T) {
+	lambdaMock := &testutils.LambdaMock{}
+	lambdaClient = lambdaMock
+	env.LogProcessorFunctionName = "panther-log-processor"
+	env.LogProcessorKinesisDlqArn = testDlqArn
+
+	lambdaMock.On("ListEventSourceMappingsPages", mock.Anything, mock.Anything).
+		Return(&lambda.ListEventSourceMappingsOutput{}, nil).Once()
+	lambdaMock.On("CreateEventSourceMapping", mock.Anything).
+		Return(&lambda.EventSourceMappingConfiguration{}, nil).Once()
+
+	created, err := EnableKinesisStreamProcessing(testStreamArn)
+	require.NoError(t, err)
+	require.True(t, created)
+	lambdaMock.AssertExpectations(t)
+
+	input := lambdaMock.Calls[1].Arguments.Get(0).(*lambda.CreateEventSourceMappingInput)
+	require.Equal(t, testStreamArn, aws.StringValue(input.EventSourceArn))
+	require.Equal(t, int64(kinesisMaxRetryAttempts), aws.Int64Value(input.MaximumRetryAttempts))
+	require.True(t, aws.BoolValue(input.BisectBatchOnFunctionError))
+	require.Equal(t, testDlqArn, aws.StringValue(input.DestinationConfig.OnFailure.Destination))
+}
+
+func TestEnableKinesisStreamProcessingUpdatesExistingMapping(t *testing.T) {
+	lambdaMock := &testutils.LambdaMock{}
+	lambdaClient = lambdaMock
+	env.LogProcessorFunctionName = "panther-log-processor"
+	env.LogProcessorKinesisDlqArn = testDlqArn
+
+	lambdaMock.On("ListEventSourceMappingsPages", mock.Anything, mock.Anything).
+		Return(&lambda.ListEventSourceMappingsOutput{
+			EventSourceMappings: []*lambda.EventSourceMappingConfiguration{
+				// created without a retry limit
+				{UUID: aws.String("old-mapping"), MaximumRetryAttempts: aws.Int64(-1)},
+			},
+		}, nil).Once()
+	lambdaMock.On("UpdateEventSourceMapping", &lambda.UpdateEventSourceMappingInput{
+		UUID:                       aws.String("old-mapping"),
+		MaximumRetryAttempts:       aws.Int64(kinesisMaxRetryAttempts),
+		BisectBatchOnFunctionError: aws.Bool(true),
+		DestinationConfig:          kinesisDestinationConfig(),
+	}).Return(&lambda.EventSourceMappingConfiguration{}, nil).Once()
+
+	created, err := EnableKinesisStreamProcessing(testStreamArn)
+	require.NoError(t, err)
+	require.False(t, created)
+	lambdaMock.AssertExpectations(t)
+}
@@ -33,10 +33,14 @@ import (
 	"github.com/panther-labs/panther/api/lambda/source/models"
 	pollermodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/poller"
 	awspoller "github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/aws"
+	gcppoller "github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/gcp"
 	"github.com/panther-labs/panther/pkg/awsbatch/sqsbatch"
 	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
+// GCP projects are scanned daily unless the source specifies an interval
+const defaultGCPScanIntervalMins = 1440
+
 var (
 	putIntegrationInternalError = &genericapi.InternalError{Message: "Failed to add source. Please try again later"}
 )
@@ -44,16 +48,23 @@ var (
 // PutIntegration adds a set of new integrations in a batch.
 func (api API) PutIntegration(input *models.PutIntegrationInput) (*models.SourceIntegration, error) {
 	// Validate the new integration
-	reason, passing, err := evaluateIntegrationFunc(api, &models.CheckIntegrationInput{
-		AWSAccountID:      input.AWSAccountID,
-		IntegrationType:   input.IntegrationType,
-		IntegrationLabel:  input.IntegrationLabel,
-		EnableCWESetup:    input.CWEEnabled,
-		EnableRemediation: input.RemediationEnabled,
-		S3Bucket:          input.S3Bucket,
-		S3Prefix:          input.S3Prefix,
-		KmsKey:            input.KmsKey,
-	})
+	checkInput := &models.CheckIntegrationInput{
+		AWSAccountID:         input.AWSAccountID,
+		IntegrationType:      input.IntegrationType,
+		IntegrationLabel:     input.IntegrationLabel,
+		EnableCWESetup:       input.CWEEnabled,
+		EnableRemediation:    input.RemediationEnabled,
+		S3Bucket:             input.S3Bucket,
+		S3Prefix:             input.S3Prefix,
+		KmsKey:               input.KmsKey,
+		KinesisStreamArn:     input.KinesisStreamArn,
+		GCPProjectID:         input.GCPProjectID,
+		GCPServiceAccountKey: input.GCPServiceAccountKey,
+	}
+	if err := checkRequiredFields(checkInput); err != nil {
+		return nil, err
+	}
+	reason, passing, err := evaluateIntegrationFunc(api, checkInput)
 	if err != nil {
 		return nil, putIntegrationInternalError
 	}
@@ -68,13 +79,22 @@ func (api API) PutIntegration(input *models.PutIntegrationInput) (*models.Source
 		}
 	}
 
+	if aws.StringValue(input.IntegrationType) == models.IntegrationTypeAWS3 {
+		if err := validatePrefixLogTypes(input.S3PrefixLogTypes, input.LogTypes); err != nil {
+			return nil, err
+		}
+	}
+
 	// Filter out existing integrations
 	if err := api.integrationAlreadyExists(input); err != nil {
 		return nil, err
 	}
 
+	// Generate the new integration
+	newIntegration := generateNewIntegration(input)
+
 	// Get ready to add appropriate permissions to the SQS queue
-	permissionAdded := false
+	permissionAdded, streamMappingAdded, keyStored := false, false, false
 	defer func() {
 		if err != nil {
 			zap.L().Error("failed to put integration", zap.Error(err))
@@ -86,6 +106,22 @@ func (api API) PutIntegration(input *models.PutIntegrationInput) (*models.Source
 						zap.Error(err))
 				}
 			}
+			// Likewise, stop the log processor from reading the stream
+			if streamMappingAdded {
+				if undoErr := DisableKinesisStreamProcessing(*input.KinesisStreamArn); undoErr != nil {
+					zap.L().Error("failed to remove kinesis event source mapping for integration. Mapping has to be removed manually",
+						zap.Error(undoErr),
+						zap.Error(err))
+				}
+			}
+			// And remove the key of a GCP source which was not added
+			if keyStored {
+				if undoErr := DeleteServiceAccountKey(*newIntegration.IntegrationID); undoErr != nil {
+					zap.L().Error("failed to delete service account key for integration. Secret has to be removed manually",
+						zap.Error(undoErr),
+						zap.Error(err))
+				}
+			}
 		}
 	}()
 
@@ -101,11 +137,26 @@ func (api API) PutIntegration(input *models.PutIntegrationInput) (*models.Source
 			zap.L().Error("Failed to add glue tables to glue catalog", zap.Error(errors.WithStack(err)))
 			return nil, putIntegrationInternalError
 		}
+	case models.IntegrationTypeAWSKinesis:
+		err = addGlueTables(input.LogTypes)
+		if err != nil {
+			zap.L().Error("Failed to add glue tables to glue catalog", zap.Error(errors.WithStack(err)))
+			return nil, putIntegrationInternalError
+		}
+		streamMappingAdded, err = EnableKinesisStreamProcessing(*input.KinesisStreamArn)
+		if err != nil {
+			zap.L().Error("Failed to connect kinesis stream to log processor", zap.Error(errors.WithStack(err)))
+			return nil, putIntegrationInternalError
+		}
+	case models.IntegrationTypeGCPScan:
+		err = CreateServiceAccountKey(*newIntegration.IntegrationID, *input.GCPServiceAccountKey)
+		if err != nil {
+			zap.L().Error("Failed to store service account key", zap.Error(err))
+			return nil, putIntegrationInternalError
+		}
+		keyStored = true
 	}
 
-	// Generate the new integration
-	newIntegration := generateNewIntegration(input)
-
 	// Write to DynamoDB
 	if err = dynamoClient.PutItem(integrationToItem(newIntegration)); err != nil {
 		err = errors.Wrap(err, "Failed to store source integration in DDB")
@@ -117,7 +168,7 @@ func (api API) PutIntegration(input *models.PutIntegrationInput) (*models.Source
 		return newIntegration, nil
 	}
 
-	if *input.IntegrationType == models.IntegrationTypeAWSScan {
+	if *input.IntegrationType == models.IntegrationTypeAWSScan || *input.IntegrationType == models.IntegrationTypeGCPScan {
 		err = api.FullScan(&models.FullScanInput{Integrations: []*models.SourceIntegrationMetadata{&newIntegration.SourceIntegrationMetadata}})
 		if err != nil {
 			err = errors.Wrap(err, "failed to trigger scanning of resources")
@@ -156,6 +207,21 @@ func (api API) integrationAlreadyExists(input *models.PutIntegrationInput) error
 							*input.IntegrationLabel),
 					}
 				}
+			case models.IntegrationTypeGCPScan:
+				if aws.StringValue(existingIntegration.GCPProjectID) == aws.StringValue(input.GCPProjectID) {
+					// We can only have one cloudsec integration for each project
+					return &genericapi.InvalidInputError{
+						Message: fmt.Sprintf("Source project %s already onboarded", aws.StringValue(input.GCPProjectID)),
+					}
+				}
+			case models.IntegrationTypeAWSKinesis:
+				if aws.StringValue(existingIntegration.KinesisStreamArn) == aws.StringValue(input.KinesisStreamArn) {
+					// A stream can only be read by a single log source
+					return &genericapi.InvalidInputError{
+						Message: fmt.Sprintf("Log source for stream %s already onboarded",
+							aws.StringValue(input.KinesisStreamArn)),
+					}
+				}
 			}
 		}
 	}
@@ -171,11 +237,12 @@ func (api API) FullScan(input *models.FullScanInput) error {
 
 	// For each integration, add a ScanMsg to the queue per service
 	for _, integration := range input.Integrations {
-		for resourceType := range awspoller.ServicePollers {
+		for _, resourceType := range scanResourceTypes(integration) {
 			scanMsg := &pollermodels.ScanMsg{
 				Entries: []*pollermodels.ScanEntry{
 					{
 						AWSAccountID:  integration.AWSAccountID,
+						GCPProjectID:  integration.GCPProjectID,
 						IntegrationID: integration.IntegrationID,
 						ResourceType:  aws.String(resourceType),
 					},
@@ -211,6 +278,21 @@ func (api API) FullScan(input *models.FullScanInput) error {
 	return err
 }
 
+// scanResourceTypes returns the resource types polled by a full scan of an integration
+func scanResourceTypes(integration *models.SourceIntegrationMetadata) []string {
+	var resourceTypes []string
+	if aws.StringValue(integration.IntegrationType) == models.IntegrationTypeGCPScan {
+		for resourceType := range gcppoller.ServicePollers {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+		return resourceTypes
+	}
+	for resourceType := range awspoller.ServicePollers {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	return resourceTypes
+}
+
 func generateNewIntegration(input *models.PutIntegrationInput) *models.SourceIntegration {
 	metadata := models.SourceIntegrationMetadata{
 		CreatedAtTime:    aws.Time(time.Now()),
@@ -233,8 +315,19 @@ func generateNewIntegration(input *models.PutIntegrationInput) *models.SourceInt
 		metadata.S3Prefix = input.S3Prefix
 		metadata.KmsKey = input.KmsKey
 		metadata.LogTypes = input.LogTypes
+		metadata.S3PrefixLogTypes = input.S3PrefixLogTypes
 		metadata.StackName = aws.String(getStackName(*input.IntegrationType, *input.IntegrationLabel))
 		metadata.LogProcessingRole = aws.String(generateLogProcessingRoleArn(*input.AWSAccountID, *input.IntegrationLabel))
+	case models.IntegrationTypeAWSKinesis:
+		metadata.AWSAccountID = input.AWSAccountID
+		metadata.KinesisStreamArn = input.KinesisStreamArn
+		metadata.LogTypes = input.LogTypes
+	case models.IntegrationTypeGCPScan:
+		metadata.GCPProjectID = input.GCPProjectID
+		metadata.ScanIntervalMins = input.ScanIntervalMins
+		if metadata.ScanIntervalMins == nil {
+			metadata.ScanIntervalMins = aws.Int(defaultGCPScanIntervalMins)
+		}
 	}
 	return &models.SourceIntegration{
 		SourceIntegrationMetadata: metadata,
@@ -27,6 +27,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
@@ -39,6 +41,7 @@ import (
 	"github.com/panther-labs/panther/api/lambda/source/models"
 	pollermodels "github.com/panther-labs/panther/internal/compliance/snapshot_poller/models/poller"
 	awspoller "github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/aws"
+	gcppoller "github.com/panther-labs/panther/internal/compliance/snapshot_poller/pollers/gcp"
 	"github.com/panther-labs/panther/internal/core/source_api/ddb"
 	"github.com/panther-labs/panther/internal/core/source_api/ddb/modelstest"
 	"github.com/panther-labs/panther/internal/log_analysis/log_processor/registry"
@@ -143,6 +146,81 @@ func TestPutCloudSecIntegration(t *testing.T) {
 	mockSQS.AssertExpectations(t)
 }
 
+type mockSecretsClient struct {
+	secretsmanageriface.SecretsManagerAPI
+	mock.Mock
+}
+
+func (m *mockSecretsClient) CreateSecret(input *secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(*secretsmanager.CreateSecretOutput), args.Error(1)
+}
+
+func TestPutGcpScanIntegration(t *testing.T) {
+	mockSQS := &testutils.SqsMock{}
+	mockSQS.On("SendMessageBatch", mock.Anything).Return(&sqs.SendMessageBatchOutput{}, nil)
+	sqsClient = mockSQS
+	mockSecrets := &mockSecretsClient{}
+	mockSecrets.On("CreateSecret", mock.Anything).Return(&secretsmanager.CreateSecretOutput{}, nil)
+	secretsClient = mockSecrets
+	dynamoClient = &ddb.DDB{Client: &modelstest.MockDDBClient{TestErr: false}, TableName: "test"}
+	evaluateIntegrationFunc = func(_ API, _ *models.CheckIntegrationInput) (string, bool, error) { return "", true, nil }
+
+	out, err := apiTest.PutIntegration(&models.PutIntegrationInput{
+		PutIntegrationSettings: models.PutIntegrationSettings{
+			GCPProjectID:         aws.String("example-project"),
+			GCPServiceAccountKey: aws.String(`{"type":"service_account"}`),
+			IntegrationLabel:     aws.String(testIntegrationLabel),
+			IntegrationType:      aws.String(models.IntegrationTypeGCPScan),
+			UserID:               aws.String(testUserID),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "example-project", *out.GCPProjectID)
+	assert.Nil(t, out.AWSAccountID)
+	assert.Equal(t, 1440, *out.ScanIntervalMins)
+
+	createInput := mockSecrets.Calls[0].Arguments.Get(0).(*secretsmanager.CreateSecretInput)
+	assert.Equal(t, "panther-gcp-scan-"+*out.IntegrationID, *createInput.Name)
+	assert.Equal(t, `{"type":"service_account"}`, *createInput.SecretString)
+	mockSQS.AssertExpectations(t)
+	mockSecrets.AssertExpectations(t)
+}
+
+func TestPutIntegrationMissingRequiredFields(t *testing.T) {
+	evaluateIntegrationFunc = func(_ API, _ *models.CheckIntegrationInput) (string, bool, error) { return "", true, nil }
+
+	out, err := apiTest.PutIntegration(&models.PutIntegrationInput{
+		PutIntegrationSettings: models.PutIntegrationSettings{
+			GCPProjectID:     aws.String("example-project"),
+			IntegrationLabel: aws.String(testIntegrationLabel),
+			IntegrationType:  aws.String(models.IntegrationTypeGCPScan),
+			UserID:           aws.String(testUserID),
+		},
+	})
+	require.Error(t, err)
+	assert.Empty(t, out)
+
+	out, err = apiTest.PutIntegration(&models.PutIntegrationInput{
+		PutIntegrationSettings: models.PutIntegrationSettings{
+			IntegrationLabel: aws.String(testIntegrationLabel),
+			IntegrationType:  aws.String(models.IntegrationTypeAWSScan),
+			UserID:           aws.String(testUserID),
+		},
+	})
+	require.Error(t, err)
+	assert.Empty(t, out)
+}
+
+func TestScanResourceTypes(t *testing.T) {
+	assert.Len(t, scanResourceTypes(&models.SourceIntegrationMetadata{
+		IntegrationType: aws.String(models.IntegrationTypeAWSScan),
+	}), len(awspoller.ServicePollers))
+	assert.Len(t, scanResourceTypes(&models.SourceIntegrationMetadata{
+		IntegrationType: aws.String(models.IntegrationTypeGCPScan),
+	}), len(gcppoller.ServicePollers))
+}
+
 func TestPutLogIntegrationExists(t *testing.T) {
 	evaluateIntegrationFunc = func(_ API, _ *models.CheckIntegrationInput) (string, bool, error) { return "", true, nil }
 
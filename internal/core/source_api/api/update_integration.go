@@ -44,10 +44,22 @@ func (api API) UpdateIntegrationSettings(input *models.UpdateIntegrationSettings
 		return nil, err
 	}
 
+	// GCP sources are checked with the stored key unless a new one is provided
+	serviceAccountKey := input.GCPServiceAccountKey
+	if aws.StringValue(existingIntegrationItem.IntegrationType) == models.IntegrationTypeGCPScan && serviceAccountKey == nil {
+		key, err := GetServiceAccountKey(*input.IntegrationID)
+		if err != nil {
+			zap.L().Error("Failed to read service account key", zap.Error(err))
+			return nil, updateIntegrationInternalError
+		}
+		serviceAccountKey = &key
+	}
+
 	// Validate the updated existingIntegrationItem settings
 	reason, passing, err := evaluateIntegrationFunc(api, &models.CheckIntegrationInput{
 		// From existing existingIntegrationItem
 		AWSAccountID:    existingIntegrationItem.AWSAccountID,
+		GCPProjectID:    existingIntegrationItem.GCPProjectID,
 		IntegrationType: existingIntegrationItem.IntegrationType,
 
 		// From update existingIntegrationItem request
@@ -57,6 +69,9 @@ func (api API) UpdateIntegrationSettings(input *models.UpdateIntegrationSettings
 		S3Bucket:          input.S3Bucket,
 		S3Prefix:          input.S3Prefix,
 		KmsKey:            input.KmsKey,
+		KinesisStreamArn:  existingIntegrationItem.KinesisStreamArn,
+
+		GCPServiceAccountKey: serviceAccountKey,
 	})
 	if err != nil {
 		return nil, err
@@ -68,7 +83,7 @@ func (api API) UpdateIntegrationSettings(input *models.UpdateIntegrationSettings
 			zap.Any("input", input))
 		return nil, &genericapi.InvalidInputError{
 			Message: fmt.Sprintf("existingIntegrationItem %s did not pass configuration check because of %s",
-				*existingIntegrationItem.AWSAccountID, reason),
+				integrationAccount(existingIntegrationItem), reason),
 		}
 	}
 
@@ -78,11 +93,35 @@ func (api API) UpdateIntegrationSettings(input *models.UpdateIntegrationSettings
 		existingIntegrationItem.ScanIntervalMins = input.ScanIntervalMins
 		existingIntegrationItem.CWEEnabled = input.CWEEnabled
 		existingIntegrationItem.RemediationEnabled = input.RemediationEnabled
+	case models.IntegrationTypeGCPScan:
+		existingIntegrationItem.IntegrationLabel = input.IntegrationLabel
+		if input.ScanIntervalMins != nil {
+			existingIntegrationItem.ScanIntervalMins = input.ScanIntervalMins
+		}
+		if input.GCPServiceAccountKey != nil {
+			if err = UpdateServiceAccountKey(*input.IntegrationID, *input.GCPServiceAccountKey); err != nil {
+				zap.L().Error("Failed to update service account key", zap.Error(err))
+				return nil, updateIntegrationInternalError
+			}
+		}
 	case models.IntegrationTypeAWS3:
+		if err = validatePrefixLogTypes(input.S3PrefixLogTypes, input.LogTypes); err != nil {
+			return nil, err
+		}
 		existingIntegrationItem.S3Bucket = input.S3Bucket
 		existingIntegrationItem.S3Prefix = input.S3Prefix
 		existingIntegrationItem.KmsKey = input.KmsKey
 		existingIntegrationItem.LogTypes = input.LogTypes
+		existingIntegrationItem.S3PrefixLogTypes = prefixLogTypesToItems(input.S3PrefixLogTypes)
+
+		err = addGlueTables(input.LogTypes)
+		if err != nil {
+			zap.L().Error("Failed to add glue tables to glue catalog", zap.Error(errors.WithStack(err)))
+			return nil, updateIntegrationInternalError
+		}
+	case models.IntegrationTypeAWSKinesis:
+		existingIntegrationItem.IntegrationLabel = input.IntegrationLabel
+		existingIntegrationItem.LogTypes = input.LogTypes
 
 		err = addGlueTables(input.LogTypes)
 		if err != nil {
@@ -100,6 +139,14 @@ func (api API) UpdateIntegrationSettings(input *models.UpdateIntegrationSettings
 	return existingIntegration, nil
 }
 
+// integrationAccount returns the AWS account or GCP project monitored by an integration
+func integrationAccount(item *ddb.Integration) string {
+	if item.GCPProjectID != nil {
+		return *item.GCPProjectID
+	}
+	return aws.StringValue(item.AWSAccountID)
+}
+
 // UpdateIntegrationLastScanStart updates an integration when a new scan is started.
 func (API) UpdateIntegrationLastScanStart(input *models.UpdateIntegrationLastScanStartInput) error {
 	existingIntegration, err := getItem(input.IntegrationID)
@@ -33,6 +33,7 @@ import (
 	"github.com/panther-labs/panther/api/lambda/source/models"
 	"github.com/panther-labs/panther/internal/core/source_api/ddb"
 	"github.com/panther-labs/panther/internal/log_analysis/log_processor/registry"
+	"github.com/panther-labs/panther/pkg/genericapi"
 	"github.com/panther-labs/panther/pkg/testutils"
 )
 
@@ -121,6 +122,30 @@ func TestUpdateIntegrationSettingsAwsS3Type(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+func TestUpdateIntegrationSettingsPrefixLogTypeNotEnabled(t *testing.T) {
+	mockClient := &testutils.DynamoDBMock{}
+	dynamoClient = &ddb.DDB{Client: mockClient, TableName: "test"}
+	evaluateIntegrationFunc = func(_ API, _ *models.CheckIntegrationInput) (string, bool, error) { return "", true, nil }
+
+	getResponse := &dynamodb.GetItemOutput{Item: map[string]*dynamodb.AttributeValue{
+		"integrationId":   {S: aws.String(testIntegrationID)},
+		"integrationType": {S: aws.String("aws-s3")},
+	}}
+	mockClient.On("GetItem", mock.Anything).Return(getResponse, nil)
+
+	result, err := apiTest.UpdateIntegrationSettings(&models.UpdateIntegrationSettingsInput{
+		S3Bucket: aws.String("test-bucket-1"),
+		LogTypes: aws.StringSlice([]string{"AWS.VPCFlow"}),
+		S3PrefixLogTypes: []*models.S3PrefixLogType{
+			{Prefix: aws.String("cloudtrail/"), LogType: aws.String("AWS.CloudTrail")},
+		},
+	})
+	assert.Nil(t, result)
+	assert.IsType(t, &genericapi.InvalidInputError{}, err)
+	// the integration is not stored
+	mockClient.AssertExpectations(t)
+}
+
 func TestUpdateIntegrationValidTime(t *testing.T) {
 	now := time.Now()
 	validator, err := models.Validator()
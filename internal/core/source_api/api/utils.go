@@ -19,10 +19,13 @@ package api
  */
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 
 	"github.com/panther-labs/panther/api/lambda/source/models"
 	"github.com/panther-labs/panther/internal/core/source_api/ddb"
+	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
 func integrationToItem(input *models.SourceIntegration) *ddb.Integration {
@@ -43,8 +46,13 @@ func integrationToItem(input *models.SourceIntegration) *ddb.Integration {
 		item.S3Prefix = input.S3Prefix
 		item.KmsKey = input.KmsKey
 		item.LogTypes = input.LogTypes
+		item.S3PrefixLogTypes = prefixLogTypesToItems(input.S3PrefixLogTypes)
 		item.StackName = input.StackName
 		item.LogProcessingRole = aws.String(generateLogProcessingRoleArn(*input.AWSAccountID, *input.IntegrationLabel))
+	case models.IntegrationTypeAWSKinesis:
+		item.AWSAccountID = input.AWSAccountID
+		item.KinesisStreamArn = input.KinesisStreamArn
+		item.LogTypes = input.LogTypes
 	case models.IntegrationTypeAWSScan:
 		item.AWSAccountID = input.AWSAccountID
 		item.CWEEnabled = input.CWEEnabled
@@ -56,6 +64,14 @@ func integrationToItem(input *models.SourceIntegration) *ddb.Integration {
 		item.LastScanStartTime = input.LastScanStartTime
 		item.LastScanEndTime = input.LastScanEndTime
 		item.StackName = input.StackName
+	case models.IntegrationTypeGCPScan:
+		item.GCPProjectID = input.GCPProjectID
+		item.ScanIntervalMins = input.ScanIntervalMins
+		item.ScanStatus = input.ScanStatus
+		item.EventStatus = input.EventStatus
+		item.LastScanErrorMessage = input.LastScanErrorMessage
+		item.LastScanStartTime = input.LastScanStartTime
+		item.LastScanEndTime = input.LastScanEndTime
 	}
 	return item
 }
@@ -77,8 +93,13 @@ func itemToIntegration(item *ddb.Integration) *models.SourceIntegration {
 		integration.S3Prefix = item.S3Prefix
 		integration.KmsKey = item.KmsKey
 		integration.LogTypes = item.LogTypes
+		integration.S3PrefixLogTypes = itemsToPrefixLogTypes(item.S3PrefixLogTypes)
 		integration.StackName = item.StackName
 		integration.LogProcessingRole = item.LogProcessingRole
+	case models.IntegrationTypeAWSKinesis:
+		integration.AWSAccountID = item.AWSAccountID
+		integration.KinesisStreamArn = item.KinesisStreamArn
+		integration.LogTypes = item.LogTypes
 	case models.IntegrationTypeAWSScan:
 		integration.AWSAccountID = item.AWSAccountID
 		integration.CWEEnabled = item.CWEEnabled
@@ -90,6 +111,66 @@ func itemToIntegration(item *ddb.Integration) *models.SourceIntegration {
 		integration.LastScanEndTime = item.LastScanEndTime
 		integration.LastScanErrorMessage = item.LastScanErrorMessage
 		integration.StackName = item.StackName
+	case models.IntegrationTypeGCPScan:
+		integration.GCPProjectID = item.GCPProjectID
+		integration.ScanIntervalMins = item.ScanIntervalMins
+		integration.ScanStatus = item.ScanStatus
+		integration.EventStatus = item.EventStatus
+		integration.LastScanStartTime = item.LastScanStartTime
+		integration.LastScanEndTime = item.LastScanEndTime
+		integration.LastScanErrorMessage = item.LastScanErrorMessage
 	}
 	return integration
 }
+
+func prefixLogTypesToItems(rules []*models.S3PrefixLogType) []*ddb.S3PrefixLogType {
+	if len(rules) == 0 {
+		return nil
+	}
+	items := make([]*ddb.S3PrefixLogType, len(rules))
+	for i, rule := range rules {
+		items[i] = &ddb.S3PrefixLogType{
+			Prefix:  rule.Prefix,
+			LogType: rule.LogType,
+		}
+	}
+	return items
+}
+
+func itemsToPrefixLogTypes(items []*ddb.S3PrefixLogType) []*models.S3PrefixLogType {
+	if len(items) == 0 {
+		return nil
+	}
+	rules := make([]*models.S3PrefixLogType, len(items))
+	for i, item := range items {
+		rules[i] = &models.S3PrefixLogType{
+			Prefix:  item.Prefix,
+			LogType: item.LogType,
+		}
+	}
+	return rules
+}
+
+// validatePrefixLogTypes checks that the routing rules of a source only use log types enabled for it
+func validatePrefixLogTypes(rules []*models.S3PrefixLogType, logTypes []*string) error {
+	enabled := make(map[string]bool, len(logTypes))
+	for _, logType := range logTypes {
+		enabled[aws.StringValue(logType)] = true
+	}
+	prefixes := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		prefix, logType := aws.StringValue(rule.Prefix), aws.StringValue(rule.LogType)
+		if !enabled[logType] {
+			return &genericapi.InvalidInputError{
+				Message: fmt.Sprintf("log type %s of prefix %q is not enabled for the source", logType, prefix),
+			}
+		}
+		if prefixes[prefix] {
+			return &genericapi.InvalidInputError{
+				Message: fmt.Sprintf("prefix %q is mapped to more than one log type", prefix),
+			}
+		}
+		prefixes[prefix] = true
+	}
+	return nil
+}
@@ -26,15 +26,23 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/athena/athenaiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/aws/aws-sdk-go/service/glue/glueiface"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/kelseyhightower/envconfig"
 
 	"github.com/panther-labs/panther/internal/core/source_api/ddb"
+	"github.com/panther-labs/panther/pkg/authz"
 )
 
 const (
@@ -51,14 +59,25 @@ var (
 	templateS3Client s3iface.S3API
 	glueClient       glueiface.GlueAPI
 	athenaClient     athenaiface.AthenaAPI
+	kinesisClient    kinesisiface.KinesisAPI
+	lambdaClient     lambdaiface.LambdaAPI
+	secretsClient    secretsmanageriface.SecretsManagerAPI
+
+	// PermissionChecker resolves the permissions of API callers.
+	PermissionChecker authz.Checker
 )
 
 type envConfig struct {
-	SnapshotPollersQueueURL string `required:"true" split_words:"true"`
-	LogProcessorQueueURL    string `required:"true" split_words:"true"`
-	LogProcessorQueueArn    string `required:"true" split_words:"true"`
-	ProcessedDataBucket     string `required:"true" split_words:"true"`
-	TableName               string `required:"true" split_words:"true"`
+	SnapshotPollersQueueURL   string `required:"true" split_words:"true"`
+	LogProcessorQueueURL      string `required:"true" split_words:"true"`
+	LogProcessorQueueArn      string `required:"true" split_words:"true"`
+	LogProcessorFunctionName  string `required:"true" split_words:"true"`
+	LogProcessorKinesisDlqArn string `required:"true" split_words:"true"`
+	ProcessedDataBucket       string `required:"true" split_words:"true"`
+	TableName                 string `required:"true" split_words:"true"`
+	CustomLogsTableName       string `required:"true" split_words:"true"`
+	UserRolesTable            string `required:"true" split_words:"true"`
+	RolesTable                string `required:"true" split_words:"true"`
 }
 
 // Setup parses the environment and constructs AWS and http clients on a cold Lambda start.
@@ -68,12 +87,17 @@ func Setup() {
 
 	awsSession = session.Must(session.NewSession())
 	dynamoClient = ddb.New(env.TableName)
+	dynamoClient.CustomLogsTableName = env.CustomLogsTableName
 	sqsClient = sqs.New(awsSession)
 	templateS3Client = s3.New(awsSession, &aws.Config{
 		Region: aws.String(templateBucketRegion),
 	})
 	glueClient = glue.New(awsSession)
 	athenaClient = athena.New(awsSession)
+	kinesisClient = kinesis.New(awsSession)
+	lambdaClient = lambda.New(awsSession)
+	secretsClient = secretsmanager.New(awsSession)
+	PermissionChecker = authz.NewTableChecker(dynamodb.New(awsSession), env.UserRolesTable, env.RolesTable)
 }
 
 // API provides receiver methods for each route handler.
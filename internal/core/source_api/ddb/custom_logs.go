@@ -0,0 +1,110 @@
+package ddb
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/pkg/errors"
+
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+// GetCustomLog returns a custom log schema by its log type
+func (ddb *DDB) GetCustomLog(logType *string) (*CustomLog, error) {
+	output, err := ddb.Client.GetItem(&dynamodb.GetItemInput{
+		TableName: aws.String(ddb.CustomLogsTableName),
+		Key: map[string]*dynamodb.AttributeValue{
+			customLogHashKey: {S: logType},
+		},
+	})
+	if err != nil {
+		return nil, &genericapi.AWSError{Err: err, Method: "Dynamodb.GetItem"}
+	}
+
+	if output.Item == nil {
+		return nil, nil
+	}
+	var customLog CustomLog
+	if err := dynamodbattribute.UnmarshalMap(output.Item, &customLog); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal DDB item")
+	}
+	return &customLog, nil
+}
+
+// PutCustomLog stores a custom log schema.
+// The write fails if the stored revision is not the one preceding the new revision.
+func (ddb *DDB) PutCustomLog(input *CustomLog) error {
+	item, err := dynamodbattribute.MarshalMap(input)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal custom log")
+	}
+
+	putRequest := &dynamodb.PutItemInput{
+		TableName: aws.String(ddb.CustomLogsTableName),
+		Item:      item,
+	}
+	if revision := aws.Int64Value(input.Revision); revision > 1 {
+		putRequest.ConditionExpression = aws.String("revision = :previous")
+		putRequest.ExpressionAttributeValues = map[string]*dynamodb.AttributeValue{
+			":previous": {N: aws.String(strconv.FormatInt(revision-1, 10))},
+		}
+	} else {
+		putRequest.ConditionExpression = aws.String("attribute_not_exists(logType)")
+	}
+	if _, err = ddb.Client.PutItem(putRequest); err != nil {
+		return errors.Wrap(err, "failed to put custom log")
+	}
+	return nil
+}
+
+// ScanCustomLogs returns all custom log schemas
+func (ddb *DDB) ScanCustomLogs() ([]*CustomLog, error) {
+	output, err := ddb.Client.Scan(&dynamodb.ScanInput{
+		TableName: aws.String(ddb.CustomLogsTableName),
+		// Custom log types are loaded right after being stored to create their tables
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan custom logs")
+	}
+
+	var customLogs []*CustomLog
+	if err := dynamodbattribute.UnmarshalListOfMaps(output.Items, &customLogs); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal scan results")
+	}
+	return customLogs, nil
+}
+
+// DeleteCustomLog deletes a custom log schema
+func (ddb *DDB) DeleteCustomLog(logType *string) error {
+	_, err := ddb.Client.DeleteItem(&dynamodb.DeleteItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			customLogHashKey: {S: logType},
+		},
+		TableName: aws.String(ddb.CustomLogsTableName),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete custom log from DDB")
+	}
+	return nil
+}
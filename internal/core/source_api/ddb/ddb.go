@@ -25,13 +25,16 @@ import (
 )
 
 const (
-	hashKey = "integrationId"
+	hashKey          = "integrationId"
+	customLogHashKey = "logType"
 )
 
 // DDB is a struct containing the DynamoDB client, and the table name to retrieve data.
 type DDB struct {
 	Client    dynamodbiface.DynamoDBAPI
 	TableName string
+	// CustomLogsTableName is the table storing custom log schemas
+	CustomLogsTableName string
 }
 
 // New instantiates a new client.
@@ -32,6 +32,8 @@ type Integration struct {
 	RemediationEnabled *bool   `json:"remediationEnabled"`
 	CWEEnabled         *bool   `json:"cweEnabled"`
 
+	GCPProjectID *string `json:"gcpProjectId,omitempty"`
+
 	LastScanEndTime      *time.Time `json:"lastScanEndTime"`
 	LastScanErrorMessage *string    `json:"lastScanErrorMessage"`
 	LastScanStartTime    *time.Time `json:"lastScanStartTime"`
@@ -41,9 +43,18 @@ type Integration struct {
 	S3Bucket          *string   `json:"s3Bucket"`
 	S3Prefix          *string   `json:"s3Prefix"`
 	KmsKey            *string   `json:"kmsKey"`
+	KinesisStreamArn  *string   `json:"kinesisStreamArn,omitempty"`
 	LogTypes          []*string `json:"logTypes" dynamodbav:"logTypes,stringset"`
 	StackName         *string   `json:"stackName,omitempty"`
 	LogProcessingRole *string   `json:"logProcessingRole,omitempty"`
+
+	S3PrefixLogTypes []*S3PrefixLogType `json:"s3PrefixLogTypes,omitempty"`
+}
+
+// S3PrefixLogType is a rule routing the objects under an S3 prefix to a log type.
+type S3PrefixLogType struct {
+	Prefix  *string `json:"prefix"`
+	LogType *string `json:"logType"`
 }
 
 type IntegrationStatus struct {
@@ -51,3 +62,14 @@ type IntegrationStatus struct {
 	EventStatus       *string    `json:"eventStatus"`
 	LastEventReceived *time.Time `json:"lastEventReceived"`
 }
+
+// CustomLog represents a custom log schema as it is stored in DynamoDB.
+type CustomLog struct {
+	LogType     *string    `json:"logType"`
+	Description *string    `json:"description"`
+	LogSpec     *string    `json:"logSpec"`
+	Revision    *int64     `json:"revision"`
+	CreatedAt   *time.Time `json:"createdAt"`
+	UpdatedAt   *time.Time `json:"updatedAt"`
+	UpdatedBy   *string    `json:"updatedBy"`
+}
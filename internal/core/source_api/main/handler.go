@@ -25,12 +25,33 @@ import (
 
 	"github.com/panther-labs/panther/api/lambda/source/models"
 	"github.com/panther-labs/panther/internal/core/source_api/api"
+	"github.com/panther-labs/panther/pkg/authz"
 	"github.com/panther-labs/panther/pkg/genericapi"
 	"github.com/panther-labs/panther/pkg/lambdalogger"
 )
 
 var router *genericapi.Router
 
+var sourceModify = []authz.Permission{authz.SourceModify}
+
+// Routes which only Panther users with the SourceModify permission can invoke
+var permissions = map[string][]authz.Permission{
+	"DeleteCustomLog":           sourceModify,
+	"DeleteIntegration":         sourceModify,
+	"PutCustomLog":              sourceModify,
+	"PutIntegration":            sourceModify,
+	"UpdateIntegrationSettings": sourceModify,
+}
+
+// Protected routes which the cfn-custom-resources invoke to onboard Panther's own account
+var serviceRoutes = []string{"DeleteIntegration", "PutIntegration", "UpdateIntegrationSettings"}
+
+// The Lambda invocation event: AppSync adds the ID of the calling user
+type lambdaInput struct {
+	models.LambdaInput
+	CallerID string `json:"callerId"`
+}
+
 func init() {
 	validator, err := models.Validator()
 	if err != nil {
@@ -39,12 +60,13 @@ func init() {
 	router = genericapi.NewRouter("cloudsec", "snapshot", validator, api.API{})
 }
 
-func lambdaHandler(ctx context.Context, request *models.LambdaInput) (interface{}, error) {
+func lambdaHandler(ctx context.Context, request *lambdaInput) (interface{}, error) {
 	lambdalogger.ConfigureGlobal(ctx, nil)
-	return router.Handle(request)
+	return router.HandleAs(request.CallerID, &request.LambdaInput)
 }
 
 func main() {
 	api.Setup()
+	router.RequirePermissions(api.PermissionChecker, permissions, serviceRoutes...)
 	lambda.Start(lambdaHandler)
 }
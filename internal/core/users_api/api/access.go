@@ -0,0 +1,123 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/pkg/authz"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+// The role assigned to invited users unless another one is requested.
+const defaultInviteRole = authz.RoleReadOnly
+
+// accessState is a snapshot of every user, their role and the custom roles.
+//
+// Changes to roles are applied to the snapshot first to verify that at least one user
+// would still be able to manage users afterwards. The change is then written at the snapshot's
+// version, so it fails if another change was made in the meantime.
+type accessState struct {
+	version     int64
+	users       []*models.User
+	assignments map[string]string             // userID => role name
+	customRoles map[string][]authz.Permission // custom role name => permissions
+}
+
+func loadAccess() (*accessState, error) {
+	// The version must be read first: any change made while loading the rest will then be detected
+	version, err := roleGateway.AccessVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := userGateway.ListUsers(&models.ListUsersInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := roleGateway.ListUserRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := roleGateway.ListRoles()
+	if err != nil {
+		return nil, err
+	}
+	customRoles := make(map[string][]authz.Permission, len(items))
+	for _, item := range items {
+		customRoles[item.Name] = item.Permissions
+	}
+
+	return &accessState{version: version, users: users, assignments: assignments, customRoles: customRoles}, nil
+}
+
+// Returns true if any user other than excludeUserID holds the UserModify permission.
+func (s *accessState) hasUserAdmin(excludeUserID string) bool {
+	for _, user := range s.users {
+		if aws.StringValue(user.ID) == excludeUserID {
+			continue
+		}
+
+		role, ok := s.assignments[aws.StringValue(user.ID)]
+		if !ok {
+			role = authz.DefaultRole
+		}
+
+		permissions, ok := authz.BuiltInRoles[role]
+		if !ok {
+			permissions = s.customRoles[role]
+		}
+		if authz.Has(permissions, authz.UserModify) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns an error unless the role is built-in or an existing custom role.
+func verifyRoleExists(name string) error {
+	if authz.IsBuiltInRole(name) {
+		return nil
+	}
+
+	role, err := roleGateway.GetRole(name)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		return &genericapi.DoesNotExistError{Message: "role " + name + " does not exist"}
+	}
+	return nil
+}
+
+// The role of a user with no explicit assignment grants no permissions.
+func effectiveRole(assigned string) string {
+	if assigned == "" {
+		return authz.DefaultRole
+	}
+	return assigned
+}
+
+func noUserAdminError() error {
+	return &genericapi.InUseError{
+		Message: "at least one other user must have the " + string(authz.UserModify) + " permission"}
+}
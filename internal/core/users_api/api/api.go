@@ -24,8 +24,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/panther-labs/panther/internal/core/users_api/cognito"
+	"github.com/panther-labs/panther/internal/core/users_api/roles"
+	"github.com/panther-labs/panther/pkg/authz"
 )
 
 // The API has receiver methods for each of the handlers.
@@ -35,4 +38,12 @@ var (
 	awsSession               = session.Must(session.NewSession(aws.NewConfig().WithMaxRetries(10)))
 	appDomainURL             = os.Getenv("APP_DOMAIN_URL")
 	userGateway  cognito.API = cognito.New(awsSession, os.Getenv("USER_POOL_ID"))
+	userRoles                = os.Getenv("USER_ROLES_TABLE")
+	customRoles              = os.Getenv("ROLES_TABLE")
+	roleGateway  roles.API   = roles.New(awsSession, userRoles, customRoles)
+
+	// PermissionChecker resolves the permissions of API callers.
+	//
+	// It is shared with the router so role changes made here take effect immediately in this Lambda.
+	PermissionChecker = authz.NewTableChecker(dynamodb.New(awsSession), userRoles, customRoles)
 )
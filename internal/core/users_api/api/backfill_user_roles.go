@@ -0,0 +1,48 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/pkg/authz"
+)
+
+// BackfillUserRoles assigns the Admin role to every user who has no role yet.
+func (API) BackfillUserRoles(_ *models.BackfillUserRolesInput) (*models.BackfillUserRolesOutput, error) {
+	users, err := userGateway.ListUsers(&models.ListUsersInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.BackfillUserRolesOutput{IDs: []string{}}
+	for _, user := range users {
+		userID := aws.StringValue(user.ID)
+		assigned, err := roleGateway.PutUserRoleIfMissing(userID, authz.RoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if assigned {
+			result.IDs = append(result.IDs, userID)
+			PermissionChecker.Invalidate(userID)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,68 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/internal/core/users_api/cognito"
+	"github.com/panther-labs/panther/internal/core/users_api/roles"
+)
+
+func TestBackfillUserRoles(t *testing.T) {
+	mockGateway := &cognito.MockUserGateway{}
+	userGateway = mockGateway
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+
+	mockGateway.On("ListUsers", &models.ListUsersInput{}).Return(
+		[]*models.User{{ID: aws.String("user-1")}, {ID: aws.String("user-2")}}, nil)
+	mockRoles.On("PutUserRoleIfMissing", "user-1", "Admin").Return(true, nil)
+	// user-2 already has a role, which is kept
+	mockRoles.On("PutUserRoleIfMissing", "user-2", "Admin").Return(false, nil)
+
+	result, err := API{}.BackfillUserRoles(&models.BackfillUserRolesInput{})
+	require.NoError(t, err)
+	assert.Equal(t, &models.BackfillUserRolesOutput{IDs: []string{"user-1"}}, result)
+	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
+}
+
+func TestBackfillUserRolesError(t *testing.T) {
+	mockGateway := &cognito.MockUserGateway{}
+	userGateway = mockGateway
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+
+	mockGateway.On("ListUsers", &models.ListUsersInput{}).Return([]*models.User{{ID: aws.String("user-1")}}, nil)
+	mockRoles.On("PutUserRoleIfMissing", "user-1", "Admin").Return(false, errors.New("dynamo error"))
+
+	result, err := API{}.BackfillUserRoles(&models.BackfillUserRolesInput{})
+	assert.Nil(t, result)
+	assert.Error(t, err)
+	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
+}
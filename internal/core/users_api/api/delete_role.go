@@ -0,0 +1,48 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/pkg/authz"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+// DeleteRole deletes a custom role which is not assigned to any user.
+func (API) DeleteRole(input *models.DeleteRoleInput) (*models.DeleteRoleOutput, error) {
+	if authz.IsBuiltInRole(*input.Name) {
+		return nil, &genericapi.InvalidInputError{Message: "built-in role " + *input.Name + " can not be deleted"}
+	}
+
+	assignments, err := roleGateway.ListUserRoles()
+	if err != nil {
+		return nil, err
+	}
+	for userID, role := range assignments {
+		if role == *input.Name {
+			return nil, &genericapi.InUseError{Message: "role " + *input.Name + " is assigned to user " + userID}
+		}
+	}
+
+	if err := roleGateway.DeleteRole(*input.Name); err != nil {
+		return nil, err
+	}
+	PermissionChecker.InvalidateAll()
+	return &models.DeleteRoleOutput{Name: input.Name}, nil
+}
@@ -0,0 +1,60 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/internal/core/users_api/roles"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+func TestDeleteRole(t *testing.T) {
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+	mockRoles.On("ListUserRoles").Return(map[string]string{"user-1": "Analyst"}, nil)
+	mockRoles.On("DeleteRole", "Responder").Return(nil)
+
+	result, err := API{}.DeleteRole(&models.DeleteRoleInput{Name: aws.String("Responder")})
+	require.NoError(t, err)
+	assert.Equal(t, &models.DeleteRoleOutput{Name: aws.String("Responder")}, result)
+	mockRoles.AssertExpectations(t)
+}
+
+func TestDeleteRoleInUse(t *testing.T) {
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+	mockRoles.On("ListUserRoles").Return(map[string]string{"user-1": "Responder"}, nil)
+
+	result, err := API{}.DeleteRole(&models.DeleteRoleInput{Name: aws.String("Responder")})
+	assert.Nil(t, result)
+	assert.Equal(t, &genericapi.InUseError{Message: "role Responder is assigned to user user-1"}, err)
+	mockRoles.AssertExpectations(t)
+}
+
+func TestDeleteRoleBuiltIn(t *testing.T) {
+	result, err := API{}.DeleteRole(&models.DeleteRoleInput{Name: aws.String("ReadOnly")})
+	assert.Nil(t, result)
+	assert.IsType(t, &genericapi.InvalidInputError{}, err)
+}
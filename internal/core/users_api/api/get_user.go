@@ -19,10 +19,22 @@ package api
  */
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
+
 	"github.com/panther-labs/panther/api/lambda/users/models"
 )
 
 // GetUser calls userGateway to get user information.
 func (API) GetUser(input *models.GetUserInput) (*models.GetUserOutput, error) {
-	return userGateway.GetUser(input.ID)
+	user, err := userGateway.GetUser(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := roleGateway.GetUserRole(*input.ID)
+	if err != nil {
+		return nil, err
+	}
+	user.Role = aws.String(effectiveRole(role))
+	return user, nil
 }
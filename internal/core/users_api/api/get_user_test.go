@@ -27,6 +27,7 @@ import (
 
 	"github.com/panther-labs/panther/api/lambda/users/models"
 	"github.com/panther-labs/panther/internal/core/users_api/cognito"
+	"github.com/panther-labs/panther/internal/core/users_api/roles"
 )
 
 func TestGetUserHandle(t *testing.T) {
@@ -39,9 +40,14 @@ func TestGetUserHandle(t *testing.T) {
 		ID:         userID,
 	}
 	mockGateway.On("GetUser", userID).Return(user, nil)
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+	mockRoles.On("GetUserRole", "test-user-id").Return("ReadOnly", nil)
 
 	result, err := (API{}).GetUser(&models.GetUserInput{ID: userID})
 	require.NoError(t, err)
 	assert.Equal(t, user, result)
+	assert.Equal(t, aws.String("ReadOnly"), result.Role)
 	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
 }
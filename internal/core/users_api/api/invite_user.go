@@ -19,10 +19,36 @@ package api
  */
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
+	"go.uber.org/zap"
+
 	"github.com/panther-labs/panther/api/lambda/users/models"
 )
 
-// InviteUser adds a new user to the Cognito user pool.
+// InviteUser adds a new user to the Cognito user pool and assigns their role.
 func (API) InviteUser(input *models.InviteUserInput) (*models.InviteUserOutput, error) {
-	return userGateway.CreateUser(input)
+	role := defaultInviteRole
+	if input.Role != nil {
+		role = *input.Role
+	}
+	if err := verifyRoleExists(role); err != nil {
+		return nil, err
+	}
+
+	user, err := userGateway.CreateUser(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := roleGateway.PutUserRole(*user.ID, role); err != nil {
+		// A user without a role would be treated as an admin: remove them again
+		if deleteErr := userGateway.DeleteUser(user.ID); deleteErr != nil {
+			zap.L().Error("failed to remove user without a role",
+				zap.String("userId", *user.ID), zap.Error(deleteErr))
+		}
+		return nil, err
+	}
+
+	user.Role = aws.String(role)
+	return user, nil
 }
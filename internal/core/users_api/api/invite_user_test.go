@@ -27,6 +27,9 @@ import (
 
 	"github.com/panther-labs/panther/api/lambda/users/models"
 	"github.com/panther-labs/panther/internal/core/users_api/cognito"
+	"github.com/panther-labs/panther/internal/core/users_api/roles"
+	"github.com/panther-labs/panther/pkg/authz"
+	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
 var inviteInput = &models.InviteUserInput{
@@ -40,7 +43,11 @@ func TestInviteUser(t *testing.T) {
 	mockGateway := &cognito.MockUserGateway{}
 	userGateway = mockGateway
 
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+
 	mockGateway.On("CreateUser", inviteInput).Return(&models.User{ID: userID}, nil)
+	mockRoles.On("PutUserRole", *userID, "ReadOnly").Return(nil)
 
 	// call the code we are testing
 	result, err := (API{}).InviteUser(inviteInput)
@@ -48,5 +55,60 @@ func TestInviteUser(t *testing.T) {
 	// assert that the expectations were met
 	require.NoError(t, err)
 	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
 	assert.Equal(t, userID, result.ID)
+	assert.Equal(t, aws.String("ReadOnly"), result.Role)
+}
+
+func TestInviteUserCustomRole(t *testing.T) {
+	mockGateway := &cognito.MockUserGateway{}
+	userGateway = mockGateway
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+
+	input := *inviteInput
+	input.Role = aws.String("Responder")
+	mockRoles.On("GetRole", "Responder").Return(&authz.RoleItem{Name: "Responder"}, nil)
+	mockGateway.On("CreateUser", &input).Return(&models.User{ID: userID}, nil)
+	mockRoles.On("PutUserRole", *userID, "Responder").Return(nil)
+
+	result, err := (API{}).InviteUser(&input)
+	require.NoError(t, err)
+	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
+	assert.Equal(t, aws.String("Responder"), result.Role)
+}
+
+func TestInviteUserUnknownRole(t *testing.T) {
+	mockGateway := &cognito.MockUserGateway{}
+	userGateway = mockGateway
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+
+	input := *inviteInput
+	input.Role = aws.String("Responder")
+	mockRoles.On("GetRole", "Responder").Return((*authz.RoleItem)(nil), nil)
+
+	result, err := (API{}).InviteUser(&input)
+	assert.Nil(t, result)
+	assert.Equal(t, &genericapi.DoesNotExistError{Message: "role Responder does not exist"}, err)
+	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
+}
+
+func TestInviteUserRoleFailure(t *testing.T) {
+	mockGateway := &cognito.MockUserGateway{}
+	userGateway = mockGateway
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+
+	mockGateway.On("CreateUser", inviteInput).Return(&models.User{ID: userID}, nil)
+	mockRoles.On("PutUserRole", *userID, "ReadOnly").Return(&genericapi.AWSError{})
+	mockGateway.On("DeleteUser", userID).Return(nil)
+
+	result, err := (API{}).InviteUser(inviteInput)
+	assert.Nil(t, result)
+	assert.Error(t, err)
+	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
 }
@@ -0,0 +1,75 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/pkg/authz"
+)
+
+var builtInRoleDescriptions = map[string]string{
+	authz.RoleAdmin:    "Full access to Panther",
+	authz.RoleAnalyst:  "Manage rules and policies",
+	authz.RoleReadOnly: "View everything, change nothing",
+}
+
+// ListRoles returns the built-in and custom roles.
+func (API) ListRoles(*models.ListRolesInput) (*models.ListRolesOutput, error) {
+	items, err := roleGateway.ListRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.Role, 0, len(authz.BuiltInRoles)+len(items))
+	for name, permissions := range authz.BuiltInRoles {
+		result = append(result, &models.Role{
+			Name:        aws.String(name),
+			Description: aws.String(builtInRoleDescriptions[name]),
+			Permissions: permissionStrings(permissions),
+			BuiltIn:     aws.Bool(true),
+		})
+	}
+	for _, item := range items {
+		result = append(result, toRole(item))
+	}
+
+	sort.Slice(result, func(i, j int) bool { return *result[i].Name < *result[j].Name })
+	return &models.ListRolesOutput{Roles: result}, nil
+}
+
+func toRole(item *authz.RoleItem) *models.Role {
+	return &models.Role{
+		Name:        aws.String(item.Name),
+		Description: aws.String(item.Description),
+		Permissions: permissionStrings(item.Permissions),
+		BuiltIn:     aws.Bool(false),
+	}
+}
+
+func permissionStrings(permissions []authz.Permission) []string {
+	result := make([]string, len(permissions))
+	for i, p := range permissions {
+		result[i] = string(p)
+	}
+	return result
+}
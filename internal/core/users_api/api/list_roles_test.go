@@ -0,0 +1,61 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/internal/core/users_api/roles"
+	"github.com/panther-labs/panther/pkg/authz"
+)
+
+func TestListRoles(t *testing.T) {
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+	mockRoles.On("ListRoles").Return([]*authz.RoleItem{
+		{Name: "Responder", Description: "On call", Permissions: []authz.Permission{authz.DestinationModify}},
+	}, nil)
+
+	result, err := API{}.ListRoles(&models.ListRolesInput{})
+	require.NoError(t, err)
+
+	names := make([]string, len(result.Roles))
+	for i, role := range result.Roles {
+		names[i] = *role.Name
+	}
+	assert.Equal(t, []string{"Admin", "Analyst", "ReadOnly", "Responder"}, names)
+	assert.Equal(t, &models.Role{
+		Name:        aws.String("Analyst"),
+		Description: aws.String("Manage rules and policies"),
+		Permissions: []string{"PolicyModify", "RuleModify"},
+		BuiltIn:     aws.Bool(true),
+	}, result.Roles[1])
+	assert.Equal(t, &models.Role{
+		Name:        aws.String("Responder"),
+		Description: aws.String("On call"),
+		Permissions: []string{"DestinationModify"},
+		BuiltIn:     aws.Bool(false),
+	}, result.Roles[3])
+	mockRoles.AssertExpectations(t)
+}
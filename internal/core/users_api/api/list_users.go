@@ -45,5 +45,13 @@ func (API) ListUsers(input *models.ListUsersInput) (*models.ListUsersOutput, err
 		return nil, err
 	}
 
+	assignments, err := roleGateway.ListUserRoles()
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		user.Role = aws.String(effectiveRole(assignments[*user.ID]))
+	}
+
 	return &models.ListUsersOutput{Users: users}, nil
 }
@@ -27,6 +27,7 @@ import (
 
 	"github.com/panther-labs/panther/api/lambda/users/models"
 	"github.com/panther-labs/panther/internal/core/users_api/cognito"
+	"github.com/panther-labs/panther/internal/core/users_api/roles"
 	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
@@ -45,11 +46,20 @@ func TestListUsersGatewayErr(t *testing.T) {
 func TestListUsers(t *testing.T) {
 	mockGateway := &cognito.MockUserGateway{}
 	userGateway = mockGateway
-	users := []*models.User{{ID: aws.String("test-user-id")}}
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+	users := []*models.User{{ID: aws.String("test-user-id")}, {ID: aws.String("unassigned-user-id")}}
 	mockGateway.On("ListUsers", &models.ListUsersInput{}).Return(users, nil)
+	mockRoles.On("ListUserRoles").Return(map[string]string{"test-user-id": "Analyst"}, nil)
 
 	result, err := (API{}).ListUsers(&models.ListUsersInput{})
 	require.NoError(t, err)
-	assert.Equal(t, &models.ListUsersOutput{Users: users}, result)
+	expected := []*models.User{
+		{ID: aws.String("test-user-id"), Role: aws.String("Analyst")},
+		// users without a role, e.g. created outside of Panther, get no permissions
+		{ID: aws.String("unassigned-user-id"), Role: aws.String("ReadOnly")},
+	}
+	assert.Equal(t, &models.ListUsersOutput{Users: expected}, result)
 	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
 }
@@ -0,0 +1,60 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/pkg/authz"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+// PutRole creates or replaces a custom role.
+func (API) PutRole(input *models.PutRoleInput) (*models.PutRoleOutput, error) {
+	if authz.IsBuiltInRole(*input.Name) {
+		return nil, &genericapi.InvalidInputError{Message: "built-in role " + *input.Name + " can not be modified"}
+	}
+
+	permissions := make([]authz.Permission, len(input.Permissions))
+	for i, p := range input.Permissions {
+		permissions[i] = authz.Permission(p)
+	}
+	item := &authz.RoleItem{
+		Name:        *input.Name,
+		Description: aws.StringValue(input.Description),
+		Permissions: authz.Normalize(permissions),
+	}
+
+	// Removing a permission from a role in use must not lock everyone out of user management
+	access, err := loadAccess()
+	if err != nil {
+		return nil, err
+	}
+	access.customRoles[item.Name] = item.Permissions
+	if !access.hasUserAdmin("") {
+		return nil, noUserAdminError()
+	}
+
+	if err := roleGateway.PutRoleAtVersion(item, access.version); err != nil {
+		return nil, err
+	}
+	PermissionChecker.InvalidateAll()
+	return toRole(item), nil
+}
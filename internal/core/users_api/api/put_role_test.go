@@ -0,0 +1,89 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/internal/core/users_api/cognito"
+	"github.com/panther-labs/panther/internal/core/users_api/roles"
+	"github.com/panther-labs/panther/pkg/authz"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+func TestPutRole(t *testing.T) {
+	mockGateway := &cognito.MockUserGateway{}
+	userGateway = mockGateway
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+
+	mockGateway.On("ListUsers", &models.ListUsersInput{}).Return([]*models.User{{ID: aws.String("user-1")}}, nil)
+	mockRoles.On("AccessVersion").Return(int64(3), nil)
+	mockRoles.On("ListUserRoles").Return(map[string]string{"user-1": "Admin"}, nil)
+	mockRoles.On("ListRoles").Return([]*authz.RoleItem{}, nil)
+	mockRoles.On("PutRoleAtVersion", &authz.RoleItem{
+		Name:        "Responder",
+		Permissions: []authz.Permission{authz.DestinationModify, authz.RuleModify},
+	}, int64(3)).Return(nil)
+
+	result, err := API{}.PutRole(&models.PutRoleInput{
+		Name:        aws.String("Responder"),
+		Permissions: []string{"RuleModify", "DestinationModify", "RuleModify"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &models.Role{
+		Name:        aws.String("Responder"),
+		Description: aws.String(""),
+		Permissions: []string{"DestinationModify", "RuleModify"},
+		BuiltIn:     aws.Bool(false),
+	}, result)
+	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
+}
+
+func TestPutRoleBuiltIn(t *testing.T) {
+	result, err := API{}.PutRole(&models.PutRoleInput{Name: aws.String("Admin")})
+	assert.Nil(t, result)
+	assert.Equal(t, &genericapi.InvalidInputError{Message: "built-in role Admin can not be modified"}, err)
+}
+
+func TestPutRoleRemovesLastUserModify(t *testing.T) {
+	mockGateway := &cognito.MockUserGateway{}
+	userGateway = mockGateway
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+
+	mockGateway.On("ListUsers", &models.ListUsersInput{}).Return([]*models.User{{ID: aws.String("user-1")}}, nil)
+	mockRoles.On("AccessVersion").Return(int64(3), nil)
+	mockRoles.On("ListUserRoles").Return(map[string]string{"user-1": "Manager"}, nil)
+	mockRoles.On("ListRoles").Return([]*authz.RoleItem{
+		{Name: "Manager", Permissions: []authz.Permission{authz.UserModify}},
+	}, nil)
+
+	result, err := API{}.PutRole(&models.PutRoleInput{Name: aws.String("Manager")})
+	assert.Nil(t, result)
+	assert.IsType(t, &genericapi.InUseError{}, err)
+	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
+}
@@ -20,23 +20,40 @@ package api
 
 import (
 	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/pkg/authz"
 	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
-// RemoveUser deletes a user from cognito.
+// RemoveUser deletes a user from cognito along with their role.
 func (API) RemoveUser(input *models.RemoveUserInput) (*models.RemoveUserOutput, error) {
-	users, err := userGateway.ListUsers(&models.ListUsersInput{})
+	access, err := loadAccess()
 	if err != nil {
 		return nil, err
 	}
 
-	if len(users) == 1 {
+	if len(access.users) == 1 {
 		return nil, &genericapi.InUseError{Message: "can't delete the last user"}
 	}
 
+	if !access.hasUserAdmin(*input.ID) {
+		return nil, noUserAdminError()
+	}
+
+	// Take the user's permissions away first, guarded against concurrent changes:
+	// a user without a role would be treated as an admin by requests checking for other admins.
+	if err := roleGateway.PutUserRoleAtVersion(*input.ID, authz.RoleReadOnly, access.version); err != nil {
+		return nil, err
+	}
+	PermissionChecker.Invalidate(*input.ID)
+
 	// Delete user from Cognito user pool
 	if err := userGateway.DeleteUser(input.ID); err != nil {
 		return nil, err
 	}
+
+	if err := roleGateway.DeleteUserRole(*input.ID); err != nil {
+		return nil, err
+	}
+	PermissionChecker.Invalidate(*input.ID)
 	return &models.RemoveUserOutput{ID: input.ID}, nil
 }
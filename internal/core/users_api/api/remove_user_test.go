@@ -27,6 +27,9 @@ import (
 
 	"github.com/panther-labs/panther/api/lambda/users/models"
 	"github.com/panther-labs/panther/internal/core/users_api/cognito"
+	"github.com/panther-labs/panther/internal/core/users_api/roles"
+	"github.com/panther-labs/panther/pkg/authz"
+	"github.com/panther-labs/panther/pkg/genericapi"
 )
 
 func TestRemoveUser(t *testing.T) {
@@ -43,10 +46,49 @@ func TestRemoveUser(t *testing.T) {
 		nil,
 	)
 
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+	mockRoles.On("AccessVersion").Return(int64(3), nil)
+	mockRoles.On("ListUserRoles").Return(map[string]string{*userID: "Analyst", *otherUserID: "Admin"}, nil)
+	mockRoles.On("ListRoles").Return([]*authz.RoleItem{}, nil)
+
+	mockRoles.On("PutUserRoleAtVersion", *userID, "ReadOnly", int64(3)).Return(nil)
 	mockGateway.On("DeleteUser", userID).Return(nil)
+	mockRoles.On("DeleteUserRole", *userID).Return(nil)
 
 	result, err := API{}.RemoveUser(&models.RemoveUserInput{ID: userID})
 	require.NoError(t, err)
 	assert.Equal(t, &models.RemoveUserOutput{ID: userID}, result)
 	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
+}
+
+func TestRemoveUserLastAdmin(t *testing.T) {
+	userID := aws.String("user-remove")
+	otherUserID := aws.String("user-other")
+
+	mockGateway := &cognito.MockUserGateway{}
+	userGateway = mockGateway
+	mockGateway.On("ListUsers", &models.ListUsersInput{}).Return(
+		[]*models.User{
+			{ID: userID},
+			{ID: otherUserID},
+		},
+		nil,
+	)
+
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+	mockRoles.On("AccessVersion").Return(int64(3), nil)
+	mockRoles.On("ListUserRoles").Return(
+		map[string]string{*userID: "Admin", *otherUserID: "Responder"}, nil)
+	mockRoles.On("ListRoles").Return(
+		[]*authz.RoleItem{{Name: "Responder", Permissions: []authz.Permission{authz.DestinationModify}}}, nil)
+
+	result, err := API{}.RemoveUser(&models.RemoveUserInput{ID: userID})
+	assert.Nil(t, result)
+	assert.Equal(t, &genericapi.InUseError{
+		Message: "at least one other user must have the UserModify permission"}, err)
+	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
 }
@@ -0,0 +1,54 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/panther-labs/panther/api/lambda/users/models"
+)
+
+// UpdateUserRole assigns a built-in or custom role to a user.
+func (API) UpdateUserRole(input *models.UpdateUserRoleInput) (*models.UpdateUserRoleOutput, error) {
+	if err := verifyRoleExists(*input.Role); err != nil {
+		return nil, err
+	}
+
+	user, err := userGateway.GetUser(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := loadAccess()
+	if err != nil {
+		return nil, err
+	}
+	access.assignments[*input.ID] = *input.Role
+	if !access.hasUserAdmin("") {
+		return nil, noUserAdminError()
+	}
+
+	if err := roleGateway.PutUserRoleAtVersion(*input.ID, *input.Role, access.version); err != nil {
+		return nil, err
+	}
+	PermissionChecker.Invalidate(*input.ID)
+
+	user.Role = aws.String(*input.Role)
+	return user, nil
+}
@@ -0,0 +1,111 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/internal/core/users_api/cognito"
+	"github.com/panther-labs/panther/internal/core/users_api/roles"
+	"github.com/panther-labs/panther/pkg/authz"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+func TestUpdateUserRole(t *testing.T) {
+	mockGateway := &cognito.MockUserGateway{}
+	userGateway = mockGateway
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+
+	userID := aws.String("user-1")
+	mockGateway.On("GetUser", userID).Return(&models.User{ID: userID}, nil)
+	mockGateway.On("ListUsers", &models.ListUsersInput{}).Return(
+		[]*models.User{{ID: userID}, {ID: aws.String("user-2")}}, nil)
+	mockRoles.On("AccessVersion").Return(int64(3), nil)
+	mockRoles.On("ListUserRoles").Return(map[string]string{"user-2": "Admin"}, nil)
+	mockRoles.On("ListRoles").Return([]*authz.RoleItem{}, nil)
+	mockRoles.On("PutUserRoleAtVersion", "user-1", "Analyst", int64(3)).Return(nil)
+
+	result, err := API{}.UpdateUserRole(&models.UpdateUserRoleInput{ID: userID, Role: aws.String("Analyst")})
+	require.NoError(t, err)
+	assert.Equal(t, &models.User{ID: userID, Role: aws.String("Analyst")}, result)
+	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
+}
+
+func TestUpdateUserRoleLastAdmin(t *testing.T) {
+	mockGateway := &cognito.MockUserGateway{}
+	userGateway = mockGateway
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+
+	userID := aws.String("user-1")
+	mockGateway.On("GetUser", userID).Return(&models.User{ID: userID}, nil)
+	mockGateway.On("ListUsers", &models.ListUsersInput{}).Return(
+		[]*models.User{{ID: userID}, {ID: aws.String("user-2")}}, nil)
+	mockRoles.On("AccessVersion").Return(int64(3), nil)
+	mockRoles.On("ListUserRoles").Return(map[string]string{"user-2": "ReadOnly"}, nil)
+	mockRoles.On("ListRoles").Return([]*authz.RoleItem{}, nil)
+
+	result, err := API{}.UpdateUserRole(&models.UpdateUserRoleInput{ID: userID, Role: aws.String("Analyst")})
+	assert.Nil(t, result)
+	assert.IsType(t, &genericapi.InUseError{}, err)
+	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
+}
+
+func TestUpdateUserRoleConcurrentChange(t *testing.T) {
+	mockGateway := &cognito.MockUserGateway{}
+	userGateway = mockGateway
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+
+	userID := aws.String("user-1")
+	conflict := &genericapi.InUseError{Message: "roles were changed by another request since version 3, please try again"}
+	mockGateway.On("GetUser", userID).Return(&models.User{ID: userID}, nil)
+	mockGateway.On("ListUsers", &models.ListUsersInput{}).Return(
+		[]*models.User{{ID: userID}, {ID: aws.String("user-2")}}, nil)
+	mockRoles.On("AccessVersion").Return(int64(3), nil)
+	mockRoles.On("ListUserRoles").Return(map[string]string{"user-2": "Admin"}, nil)
+	mockRoles.On("ListRoles").Return([]*authz.RoleItem{}, nil)
+	mockRoles.On("PutUserRoleAtVersion", "user-1", "Analyst", int64(3)).Return(conflict)
+
+	result, err := API{}.UpdateUserRole(&models.UpdateUserRoleInput{ID: userID, Role: aws.String("Analyst")})
+	assert.Nil(t, result)
+	assert.Equal(t, conflict, err)
+	mockGateway.AssertExpectations(t)
+	mockRoles.AssertExpectations(t)
+}
+
+func TestUpdateUserRoleUnknownRole(t *testing.T) {
+	mockRoles := &roles.MockRolesGateway{}
+	roleGateway = mockRoles
+	mockRoles.On("GetRole", "Responder").Return((*authz.RoleItem)(nil), nil)
+
+	result, err := API{}.UpdateUserRole(
+		&models.UpdateUserRoleInput{ID: aws.String("user-1"), Role: aws.String("Responder")})
+	assert.Nil(t, result)
+	assert.IsType(t, &genericapi.DoesNotExistError{}, err)
+	mockRoles.AssertExpectations(t)
+}
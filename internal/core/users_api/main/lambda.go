@@ -26,16 +26,36 @@ import (
 
 	"github.com/panther-labs/panther/api/lambda/users/models"
 	"github.com/panther-labs/panther/internal/core/users_api/api"
+	"github.com/panther-labs/panther/pkg/authz"
 	"github.com/panther-labs/panther/pkg/genericapi"
 	"github.com/panther-labs/panther/pkg/lambdalogger"
 )
 
-var router = genericapi.NewRouter("api", "users", models.Validator(), &api.API{})
+var userModify = []authz.Permission{authz.UserModify}
+
+var router = genericapi.NewRouter("api", "users", models.Validator(), &api.API{}).RequirePermissions(
+	api.PermissionChecker,
+	map[string][]authz.Permission{
+		"BackfillUserRoles": userModify,
+		"DeleteRole":        userModify,
+		"InviteUser":        userModify,
+		"PutRole":           userModify,
+		"RemoveUser":        userModify,
+		"ResetUserPassword": userModify,
+		"UpdateUser":        userModify,
+		"UpdateUserRole":    userModify,
+	},
+	// The first user and the roles of existing users are managed by the cfn-custom-resources during deployment
+	"BackfillUserRoles", "InviteUser", "RemoveUser", "UpdateUser",
+)
 
 // The users-api also handles custom Cognito triggers
 type lambdaInput struct {
 	models.LambdaInput
 	events.CognitoEventUserPoolsCustomMessage
+
+	// The Panther user making the request, set by AppSync
+	CallerID string `json:"callerId"`
 }
 
 func lambdaHandler(ctx context.Context, input *lambdaInput) (interface{}, error) {
@@ -45,7 +65,11 @@ func lambdaHandler(ctx context.Context, input *lambdaInput) (interface{}, error)
 		return api.CognitoTrigger(&input.CognitoEventUserPoolsCustomMessage)
 	}
 
-	return router.Handle(&input.LambdaInput)
+	// Everyone can update their own profile
+	if update := input.UpdateUser; update != nil && update.ID != nil && *update.ID == input.CallerID {
+		return router.Handle(&input.LambdaInput)
+	}
+	return router.HandleAs(input.CallerID, &input.LambdaInput)
 }
 
 func main() {
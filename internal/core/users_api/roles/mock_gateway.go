@@ -0,0 +1,103 @@
+package roles
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/panther-labs/panther/pkg/authz"
+)
+
+// MockRolesGateway is a mocked object that implements the API interface.
+type MockRolesGateway struct {
+	API
+	mock.Mock
+}
+
+// DeleteUserRole mocks DeleteUserRole for testing
+func (m *MockRolesGateway) DeleteUserRole(userID string) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+// GetUserRole mocks GetUserRole for testing
+func (m *MockRolesGateway) GetUserRole(userID string) (string, error) {
+	args := m.Called(userID)
+	return args.String(0), args.Error(1)
+}
+
+// ListUserRoles mocks ListUserRoles for testing
+func (m *MockRolesGateway) ListUserRoles() (map[string]string, error) {
+	args := m.Called()
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
+// PutUserRole mocks PutUserRole for testing
+func (m *MockRolesGateway) PutUserRole(userID, role string) error {
+	args := m.Called(userID, role)
+	return args.Error(0)
+}
+
+// PutUserRoleIfMissing mocks PutUserRoleIfMissing for testing
+func (m *MockRolesGateway) PutUserRoleIfMissing(userID, role string) (bool, error) {
+	args := m.Called(userID, role)
+	return args.Bool(0), args.Error(1)
+}
+
+// DeleteRole mocks DeleteRole for testing
+func (m *MockRolesGateway) DeleteRole(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+// GetRole mocks GetRole for testing
+func (m *MockRolesGateway) GetRole(name string) (*authz.RoleItem, error) {
+	args := m.Called(name)
+	return args.Get(0).(*authz.RoleItem), args.Error(1)
+}
+
+// ListRoles mocks ListRoles for testing
+func (m *MockRolesGateway) ListRoles() ([]*authz.RoleItem, error) {
+	args := m.Called()
+	return args.Get(0).([]*authz.RoleItem), args.Error(1)
+}
+
+// PutRole mocks PutRole for testing
+func (m *MockRolesGateway) PutRole(role *authz.RoleItem) error {
+	args := m.Called(role)
+	return args.Error(0)
+}
+
+// AccessVersion mocks AccessVersion for testing
+func (m *MockRolesGateway) AccessVersion() (int64, error) {
+	args := m.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// PutUserRoleAtVersion mocks PutUserRoleAtVersion for testing
+func (m *MockRolesGateway) PutUserRoleAtVersion(userID, role string, version int64) error {
+	args := m.Called(userID, role, version)
+	return args.Error(0)
+}
+
+// PutRoleAtVersion mocks PutRoleAtVersion for testing
+func (m *MockRolesGateway) PutRoleAtVersion(role *authz.RoleItem, version int64) error {
+	args := m.Called(role, version)
+	return args.Error(0)
+}
@@ -0,0 +1,321 @@
+package roles
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
+	"github.com/panther-labs/panther/pkg/authz"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+// API defines the interface for the roles gateway which can be used for mocking.
+type API interface {
+	// Role assignments: userID => role name
+	DeleteUserRole(userID string) error
+	GetUserRole(userID string) (string, error)
+	ListUserRoles() (map[string]string, error)
+	PutUserRole(userID, role string) error
+	PutUserRoleIfMissing(userID, role string) (bool, error)
+
+	// Custom roles
+	DeleteRole(name string) error
+	GetRole(name string) (*authz.RoleItem, error)
+	ListRoles() ([]*authz.RoleItem, error)
+	PutRole(*authz.RoleItem) error
+
+	// Writes which can take permissions away only succeed if nothing changed since AccessVersion was read
+	AccessVersion() (int64, error)
+	PutUserRoleAtVersion(userID, role string, version int64) error
+	PutRoleAtVersion(role *authz.RoleItem, version int64) error
+}
+
+// The item in the user roles table counting the writes which can take permissions away.
+//
+// User IDs are Cognito UUIDs, so the key can't clash with a real user.
+const accessVersionID = "#accessVersion"
+
+// RolesGateway stores role assignments and custom roles in Dynamo.
+type RolesGateway struct {
+	client     dynamodbiface.DynamoDBAPI
+	usersTable string
+	rolesTable string
+}
+
+// The RolesGateway must satisfy the API interface.
+var _ API = (*RolesGateway)(nil)
+
+// New creates a gateway for the given user roles and custom roles tables.
+func New(sess *session.Session, usersTable, rolesTable string) *RolesGateway {
+	return &RolesGateway{
+		client:     dynamodb.New(sess),
+		usersTable: usersTable,
+		rolesTable: rolesTable,
+	}
+}
+
+// DeleteUserRole removes the role assignment of a user.
+func (g *RolesGateway) DeleteUserRole(userID string) error {
+	_, err := g.client.DeleteItem(&dynamodb.DeleteItemInput{
+		Key:       map[string]*dynamodb.AttributeValue{"userId": {S: &userID}},
+		TableName: &g.usersTable,
+	})
+	if err != nil {
+		return &genericapi.AWSError{Method: "dynamodb.DeleteItem", Err: err}
+	}
+	return nil
+}
+
+// GetUserRole returns the role assigned to a user, or an empty string if there is none.
+func (g *RolesGateway) GetUserRole(userID string) (string, error) {
+	response, err := g.client.GetItem(&dynamodb.GetItemInput{
+		ConsistentRead: aws.Bool(true),
+		Key:            map[string]*dynamodb.AttributeValue{"userId": {S: &userID}},
+		TableName:      &g.usersTable,
+	})
+	if err != nil {
+		return "", &genericapi.AWSError{Method: "dynamodb.GetItem", Err: err}
+	}
+
+	var item authz.UserRoleItem
+	if err := dynamodbattribute.UnmarshalMap(response.Item, &item); err != nil {
+		return "", &genericapi.InternalError{Message: "failed to unmarshal user role: " + err.Error()}
+	}
+	return item.Role, nil
+}
+
+// ListUserRoles returns the role assigned to each user who has one.
+func (g *RolesGateway) ListUserRoles() (map[string]string, error) {
+	var items []*authz.UserRoleItem
+	if err := g.scan(g.usersTable, &items); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(items))
+	for _, item := range items {
+		if item.UserID == accessVersionID {
+			continue
+		}
+		result[item.UserID] = item.Role
+	}
+	return result, nil
+}
+
+// PutUserRole assigns a role to a user.
+func (g *RolesGateway) PutUserRole(userID, role string) error {
+	return g.put(g.usersTable, &authz.UserRoleItem{UserID: userID, Role: role})
+}
+
+// PutUserRoleIfMissing assigns a role to a user who has none.
+//
+// Returns false if the user already had a role, which is left unchanged.
+func (g *RolesGateway) PutUserRoleIfMissing(userID, role string) (bool, error) {
+	attributes, err := dynamodbattribute.MarshalMap(&authz.UserRoleItem{UserID: userID, Role: role})
+	if err != nil {
+		return false, &genericapi.InternalError{Message: "failed to marshal item: " + err.Error()}
+	}
+
+	expr, err := expression.NewBuilder().
+		WithCondition(expression.AttributeNotExists(expression.Name("userId"))).
+		Build()
+	if err != nil {
+		return false, &genericapi.InternalError{Message: "failed to build condition expression: " + err.Error()}
+	}
+
+	_, err = g.client.PutItem(&dynamodb.PutItemInput{
+		ConditionExpression:      expr.Condition(),
+		ExpressionAttributeNames: expr.Names(),
+		Item:                     attributes,
+		TableName:                &g.usersTable,
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			return false, nil
+		}
+		return false, &genericapi.AWSError{Method: "dynamodb.PutItem", Err: err}
+	}
+	return true, nil
+}
+
+// DeleteRole deletes a custom role.
+func (g *RolesGateway) DeleteRole(name string) error {
+	_, err := g.client.DeleteItem(&dynamodb.DeleteItemInput{
+		Key:       map[string]*dynamodb.AttributeValue{"name": {S: &name}},
+		TableName: &g.rolesTable,
+	})
+	if err != nil {
+		return &genericapi.AWSError{Method: "dynamodb.DeleteItem", Err: err}
+	}
+	return nil
+}
+
+// GetRole returns a custom role, or nil if it does not exist.
+func (g *RolesGateway) GetRole(name string) (*authz.RoleItem, error) {
+	response, err := g.client.GetItem(&dynamodb.GetItemInput{
+		ConsistentRead: aws.Bool(true),
+		Key:            map[string]*dynamodb.AttributeValue{"name": {S: &name}},
+		TableName:      &g.rolesTable,
+	})
+	if err != nil {
+		return nil, &genericapi.AWSError{Method: "dynamodb.GetItem", Err: err}
+	}
+	if len(response.Item) == 0 {
+		return nil, nil
+	}
+
+	var item authz.RoleItem
+	if err := dynamodbattribute.UnmarshalMap(response.Item, &item); err != nil {
+		return nil, &genericapi.InternalError{Message: "failed to unmarshal role: " + err.Error()}
+	}
+	return &item, nil
+}
+
+// ListRoles returns all custom roles.
+func (g *RolesGateway) ListRoles() ([]*authz.RoleItem, error) {
+	var items []*authz.RoleItem
+	if err := g.scan(g.rolesTable, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// PutRole creates or replaces a custom role.
+func (g *RolesGateway) PutRole(role *authz.RoleItem) error {
+	return g.put(g.rolesTable, role)
+}
+
+// AccessVersion returns the number of guarded writes made to the user roles and custom roles so far.
+func (g *RolesGateway) AccessVersion() (int64, error) {
+	response, err := g.client.GetItem(&dynamodb.GetItemInput{
+		ConsistentRead: aws.Bool(true),
+		Key:            map[string]*dynamodb.AttributeValue{"userId": {S: aws.String(accessVersionID)}},
+		TableName:      &g.usersTable,
+	})
+	if err != nil {
+		return 0, &genericapi.AWSError{Method: "dynamodb.GetItem", Err: err}
+	}
+
+	var item struct {
+		Version int64 `json:"version"`
+	}
+	if err := dynamodbattribute.UnmarshalMap(response.Item, &item); err != nil {
+		return 0, &genericapi.InternalError{Message: "failed to unmarshal access version: " + err.Error()}
+	}
+	return item.Version, nil
+}
+
+// PutUserRoleAtVersion assigns a role to a user unless the access version changed.
+func (g *RolesGateway) PutUserRoleAtVersion(userID, role string, version int64) error {
+	return g.putAtVersion(g.usersTable, &authz.UserRoleItem{UserID: userID, Role: role}, version)
+}
+
+// PutRoleAtVersion creates or replaces a custom role unless the access version changed.
+func (g *RolesGateway) PutRoleAtVersion(role *authz.RoleItem, version int64) error {
+	return g.putAtVersion(g.rolesTable, role, version)
+}
+
+// Put an item and increment the access version in a single transaction, conditional on the version.
+//
+// Concurrent requests which read the same version can't both succeed: this is what keeps them
+// from removing the last user admin between them.
+func (g *RolesGateway) putAtVersion(table string, item interface{}, version int64) error {
+	attributes, err := dynamodbattribute.MarshalMap(item)
+	if err != nil {
+		return &genericapi.InternalError{Message: "failed to marshal item: " + err.Error()}
+	}
+
+	condition := expression.Name("version").Equal(expression.Value(version))
+	if version == 0 {
+		condition = expression.AttributeNotExists(expression.Name("version"))
+	}
+	expr, err := expression.NewBuilder().
+		WithCondition(condition).
+		WithUpdate(expression.Set(expression.Name("version"), expression.Value(version+1))).
+		Build()
+	if err != nil {
+		return &genericapi.InternalError{Message: "failed to build update expression: " + err.Error()}
+	}
+
+	_, err = g.client.TransactWriteItems(&dynamodb.TransactWriteItemsInput{
+		TransactItems: []*dynamodb.TransactWriteItem{
+			{Put: &dynamodb.Put{Item: attributes, TableName: &table}},
+			{
+				Update: &dynamodb.Update{
+					ConditionExpression:       expr.Condition(),
+					ExpressionAttributeNames:  expr.Names(),
+					ExpressionAttributeValues: expr.Values(),
+					Key:                       map[string]*dynamodb.AttributeValue{"userId": {S: aws.String(accessVersionID)}},
+					TableName:                 &g.usersTable,
+					UpdateExpression:          expr.Update(),
+				},
+			},
+		},
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodb.ErrCodeTransactionCanceledException {
+			return &genericapi.InUseError{
+				Message: "roles were changed by another request since version " + strconv.FormatInt(version, 10) +
+					", please try again"}
+		}
+		return &genericapi.AWSError{Method: "dynamodb.TransactWriteItems", Err: err}
+	}
+	return nil
+}
+
+func (g *RolesGateway) put(table string, item interface{}) error {
+	attributes, err := dynamodbattribute.MarshalMap(item)
+	if err != nil {
+		return &genericapi.InternalError{Message: "failed to marshal item: " + err.Error()}
+	}
+
+	if _, err := g.client.PutItem(&dynamodb.PutItemInput{Item: attributes, TableName: &table}); err != nil {
+		return &genericapi.AWSError{Method: "dynamodb.PutItem", Err: err}
+	}
+	return nil
+}
+
+// Scan a (small) table and unmarshal every item into the given slice pointer.
+func (g *RolesGateway) scan(table string, items interface{}) error {
+	var all []map[string]*dynamodb.AttributeValue
+	input := &dynamodb.ScanInput{ConsistentRead: aws.Bool(true), TableName: &table}
+	for {
+		response, err := g.client.Scan(input)
+		if err != nil {
+			return &genericapi.AWSError{Method: "dynamodb.Scan", Err: err}
+		}
+		all = append(all, response.Items...)
+		if len(response.LastEvaluatedKey) == 0 {
+			break
+		}
+		input.ExclusiveStartKey = response.LastEvaluatedKey
+	}
+
+	if err := dynamodbattribute.UnmarshalListOfMaps(all, items); err != nil {
+		return &genericapi.InternalError{Message: "failed to unmarshal items: " + err.Error()}
+	}
+	return nil
+}
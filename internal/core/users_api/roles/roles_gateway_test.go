@@ -0,0 +1,204 @@
+package roles
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/pkg/authz"
+	"github.com/panther-labs/panther/pkg/genericapi"
+	"github.com/panther-labs/panther/pkg/testutils"
+)
+
+func newTestGateway() (*RolesGateway, *testutils.DynamoDBMock) {
+	client := &testutils.DynamoDBMock{}
+	return &RolesGateway{client: client, usersTable: "users", rolesTable: "roles"}, client
+}
+
+func TestPutRole(t *testing.T) {
+	gateway, client := newTestGateway()
+	client.On("PutItem", &dynamodb.PutItemInput{
+		Item: map[string]*dynamodb.AttributeValue{
+			"name":        {S: aws.String("Responder")},
+			"description": {S: aws.String("Manage destinations")},
+			"permissions": {SS: aws.StringSlice([]string{"DestinationModify"})},
+		},
+		TableName: aws.String("roles"),
+	}).Return(&dynamodb.PutItemOutput{}, nil)
+
+	require.NoError(t, gateway.PutRole(&authz.RoleItem{
+		Name:        "Responder",
+		Description: "Manage destinations",
+		Permissions: []authz.Permission{authz.DestinationModify},
+	}))
+	client.AssertExpectations(t)
+}
+
+func TestPutRoleNoPermissions(t *testing.T) {
+	gateway, client := newTestGateway()
+	client.On("PutItem", &dynamodb.PutItemInput{
+		Item:      map[string]*dynamodb.AttributeValue{"name": {S: aws.String("Auditor")}},
+		TableName: aws.String("roles"),
+	}).Return(&dynamodb.PutItemOutput{}, nil)
+
+	require.NoError(t, gateway.PutRole(&authz.RoleItem{Name: "Auditor", Permissions: []authz.Permission{}}))
+	client.AssertExpectations(t)
+}
+
+func TestGetRoleNotFound(t *testing.T) {
+	gateway, client := newTestGateway()
+	client.On("GetItem", mock.Anything).Return(&dynamodb.GetItemOutput{}, nil)
+
+	role, err := gateway.GetRole("Responder")
+	require.NoError(t, err)
+	assert.Nil(t, role)
+}
+
+func TestListUserRoles(t *testing.T) {
+	gateway, client := newTestGateway()
+	client.On("Scan", &dynamodb.ScanInput{ConsistentRead: aws.Bool(true), TableName: aws.String("users")}).Return(
+		&dynamodb.ScanOutput{
+			Items: []map[string]*dynamodb.AttributeValue{
+				{"userId": {S: aws.String("user-1")}, "role": {S: aws.String("Analyst")}},
+			},
+			LastEvaluatedKey: map[string]*dynamodb.AttributeValue{"userId": {S: aws.String("user-1")}},
+		}, nil).Once()
+	client.On("Scan", &dynamodb.ScanInput{
+		ConsistentRead:    aws.Bool(true),
+		ExclusiveStartKey: map[string]*dynamodb.AttributeValue{"userId": {S: aws.String("user-1")}},
+		TableName:         aws.String("users"),
+	}).Return(
+		&dynamodb.ScanOutput{
+			Items: []map[string]*dynamodb.AttributeValue{
+				{"userId": {S: aws.String("user-2")}, "role": {S: aws.String("Responder")}},
+				{"userId": {S: aws.String(accessVersionID)}, "version": {N: aws.String("4")}},
+			},
+		}, nil).Once()
+
+	result, err := gateway.ListUserRoles()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"user-1": "Analyst", "user-2": "Responder"}, result)
+	client.AssertExpectations(t)
+}
+
+func TestDeleteUserRoleError(t *testing.T) {
+	gateway, client := newTestGateway()
+	client.On("DeleteItem", mock.Anything).Return(&dynamodb.DeleteItemOutput{}, errors.New("throttled"))
+
+	err := gateway.DeleteUserRole("user-1")
+	assert.Equal(t, &genericapi.AWSError{Method: "dynamodb.DeleteItem", Err: errors.New("throttled")}, err)
+}
+
+func TestAccessVersion(t *testing.T) {
+	gateway, client := newTestGateway()
+	client.On("GetItem", &dynamodb.GetItemInput{
+		ConsistentRead: aws.Bool(true),
+		Key:            map[string]*dynamodb.AttributeValue{"userId": {S: aws.String(accessVersionID)}},
+		TableName:      aws.String("users"),
+	}).Return(&dynamodb.GetItemOutput{
+		Item: map[string]*dynamodb.AttributeValue{
+			"userId":  {S: aws.String(accessVersionID)},
+			"version": {N: aws.String("4")},
+		},
+	}, nil)
+
+	version, err := gateway.AccessVersion()
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), version)
+	client.AssertExpectations(t)
+}
+
+func TestPutUserRoleAtVersion(t *testing.T) {
+	gateway, client := newTestGateway()
+	client.On("TransactWriteItems", &dynamodb.TransactWriteItemsInput{
+		TransactItems: []*dynamodb.TransactWriteItem{
+			{
+				Put: &dynamodb.Put{
+					Item: map[string]*dynamodb.AttributeValue{
+						"userId": {S: aws.String("user-1")},
+						"role":   {S: aws.String("Analyst")},
+					},
+					TableName: aws.String("users"),
+				},
+			},
+			{
+				Update: &dynamodb.Update{
+					ConditionExpression:      aws.String("#0 = :0"),
+					ExpressionAttributeNames: map[string]*string{"#0": aws.String("version")},
+					ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+						":0": {N: aws.String("4")},
+						":1": {N: aws.String("5")},
+					},
+					Key:              map[string]*dynamodb.AttributeValue{"userId": {S: aws.String(accessVersionID)}},
+					TableName:        aws.String("users"),
+					UpdateExpression: aws.String("SET #0 = :1\n"),
+				},
+			},
+		},
+	}).Return(&dynamodb.TransactWriteItemsOutput{}, nil)
+
+	require.NoError(t, gateway.PutUserRoleAtVersion("user-1", "Analyst", 4))
+	client.AssertExpectations(t)
+}
+
+func TestPutUserRoleAtVersionConflict(t *testing.T) {
+	gateway, client := newTestGateway()
+	client.On("TransactWriteItems", mock.Anything).Return(&dynamodb.TransactWriteItemsOutput{},
+		awserr.New(dynamodb.ErrCodeTransactionCanceledException, "ConditionalCheckFailed", nil))
+
+	err := gateway.PutUserRoleAtVersion("user-1", "Analyst", 0)
+	assert.Equal(t, &genericapi.InUseError{
+		Message: "roles were changed by another request since version 0, please try again"}, err)
+}
+
+func TestPutUserRoleIfMissing(t *testing.T) {
+	gateway, client := newTestGateway()
+	client.On("PutItem", &dynamodb.PutItemInput{
+		ConditionExpression:      aws.String("attribute_not_exists (#0)"),
+		ExpressionAttributeNames: map[string]*string{"#0": aws.String("userId")},
+		Item: map[string]*dynamodb.AttributeValue{
+			"userId": {S: aws.String("user-1")},
+			"role":   {S: aws.String("Admin")},
+		},
+		TableName: aws.String("users"),
+	}).Return(&dynamodb.PutItemOutput{}, nil)
+
+	assigned, err := gateway.PutUserRoleIfMissing("user-1", "Admin")
+	require.NoError(t, err)
+	assert.True(t, assigned)
+	client.AssertExpectations(t)
+}
+
+func TestPutUserRoleIfMissingAlreadyAssigned(t *testing.T) {
+	gateway, client := newTestGateway()
+	client.On("PutItem", mock.Anything).Return(&dynamodb.PutItemOutput{},
+		awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "role exists", nil))
+
+	assigned, err := gateway.PutUserRoleIfMissing("user-1", "Admin")
+	require.NoError(t, err)
+	assert.False(t, assigned)
+}
@@ -24,16 +24,19 @@ import (
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
 	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
 	policiesoperations "github.com/panther-labs/panther/api/gateway/analysis/client/operations"
 	"github.com/panther-labs/panther/api/gateway/analysis/models"
-	alertModel "github.com/panther-labs/panther/internal/core/alert_delivery/models"
+	alertsapimodels "github.com/panther-labs/panther/api/lambda/alerts/models"
+	alertModel "github.com/panther-labs/panther/api/lambda/delivery/models"
 )
 
 const defaultTimePartition = "defaultPartition"
@@ -55,6 +58,16 @@ func handleNewAlert(event *AlertDedupEvent) error {
 		return errors.Wrap(err, "failed to get rule information")
 	}
 
+	// Hold back the alert until enough events have matched within the dedup period.
+	// Once the threshold is crossed, the next update to the dedup entry will create the alert.
+	if !thresholdReached(ruleInfo, event) {
+		zap.L().Debug("rule threshold not reached yet, skipping alert",
+			zap.String("ruleId", event.RuleID),
+			zap.Int64("eventCount", event.EventCount),
+			zap.Int64("threshold", int64(ruleInfo.Threshold)))
+		return nil
+	}
+
 	if err := storeNewAlert(ruleInfo, event); err != nil {
 		return errors.Wrap(err, "failed to store new alert in DDB")
 	}
@@ -70,7 +83,9 @@ func updateExistingAlert(event *AlertDedupEvent) error {
 		Set(expression.Name(alertTableEventCountAttribute), expression.Value(aws.Int64(event.EventCount))).
 		Set(expression.Name(alertTableLogTypesAttribute), expression.Value(aws.StringSlice(event.LogTypes))).
 		Set(expression.Name(alertTableUpdateTimeAttribute), expression.Value(aws.Time(event.UpdateTime)))
-	expr, err := expression.NewBuilder().WithUpdate(updateExpression).Build()
+	// Only update alerts that already exist. An alert is missing if its rule threshold has not been reached yet.
+	condition := expression.AttributeExists(expression.Name(alertTablePartitionKey))
+	expr, err := expression.NewBuilder().WithUpdate(updateExpression).WithCondition(condition).Build()
 	if err != nil {
 		return errors.Wrap(err, "failed to build update expression")
 	}
@@ -78,6 +93,7 @@ func updateExistingAlert(event *AlertDedupEvent) error {
 	updateInput := &dynamodb.UpdateItemInput{
 		TableName:                 aws.String(env.AlertsTable),
 		UpdateExpression:          expr.Update(),
+		ConditionExpression:       expr.Condition(),
 		ExpressionAttributeNames:  expr.Names(),
 		ExpressionAttributeValues: expr.Values(),
 		Key: map[string]*dynamodb.AttributeValue{
@@ -87,6 +103,10 @@ func updateExistingAlert(event *AlertDedupEvent) error {
 
 	_, err = ddbClient.UpdateItem(updateInput)
 	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			// The alert has been held back so far, check if it should be created now
+			return handleNewAlert(event)
+		}
 		return errors.Wrap(err, "failed to update alert")
 	}
 	return nil
@@ -99,8 +119,12 @@ func storeNewAlert(rule *models.Rule, alertDedup *AlertDedupEvent) error {
 		Severity:        string(rule.Severity),
 		RuleDisplayName: getRuleDisplayName(rule),
 		Title:           getAlertTitle(rule, alertDedup),
+		Status:          alertsapimodels.AlertStatusOpen,
 		AlertDedupEvent: *alertDedup,
 	}
+	if rule.Threshold > 0 {
+		alert.TriggerCount = aws.Int64(alertDedup.EventCount)
+	}
 
 	marshaledAlert, err := dynamodbattribute.MarshalMap(alert)
 	if err != nil {
@@ -127,6 +151,7 @@ func sendAlertNotification(rule *models.Rule, alertDedup *AlertDedupEvent) error
 		Runbook:             aws.String(string(rule.Runbook)),
 		Severity:            string(rule.Severity),
 		Tags:                rule.Tags,
+		LogTypes:            alertDedup.LogTypes,
 		Type:                alertModel.RuleType,
 		AlertID:             aws.String(generateAlertID(alertDedup)),
 		Title:               aws.String(getAlertTitle(rule, alertDedup)),
@@ -148,6 +173,12 @@ func sendAlertNotification(rule *models.Rule, alertDedup *AlertDedupEvent) error
 	return nil
 }
 
+// thresholdReached returns true if enough events have matched for the rule to generate an alert.
+// Rules without a threshold alert on the first matching event.
+func thresholdReached(rule *models.Rule, alertDedup *AlertDedupEvent) bool {
+	return alertDedup.EventCount >= int64(rule.Threshold)
+}
+
 func getAlertTitle(rule *models.Rule, alertDedup *AlertDedupEvent) string {
 	if alertDedup.GeneratedTitle != nil {
 		return *alertDedup.GeneratedTitle
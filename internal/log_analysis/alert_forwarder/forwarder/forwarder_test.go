@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
 	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
@@ -38,7 +39,7 @@ import (
 
 	policiesclient "github.com/panther-labs/panther/api/gateway/analysis/client"
 	"github.com/panther-labs/panther/api/gateway/analysis/models"
-	alertModel "github.com/panther-labs/panther/internal/core/alert_delivery/models"
+	alertModel "github.com/panther-labs/panther/api/lambda/delivery/models"
 	"github.com/panther-labs/panther/pkg/testutils"
 )
 
@@ -115,6 +116,7 @@ func TestHandleStoreAndSendNotification(t *testing.T) {
 		Runbook:             aws.String(string(testRuleResponse.Runbook)),
 		Severity:            string(testRuleResponse.Severity),
 		Tags:                []string{"Tag"},
+		LogTypes:            newAlertDedupEvent.LogTypes,
 		Type:                alertModel.RuleType,
 		AlertID:             aws.String("b25dc23fb2a0b362da8428dbec1381a8"),
 		Title:               newAlertDedupEvent.GeneratedTitle,
@@ -135,6 +137,7 @@ func TestHandleStoreAndSendNotification(t *testing.T) {
 		Severity:        string(testRuleResponse.Severity),
 		RuleDisplayName: aws.String(string(testRuleResponse.DisplayName)),
 		Title:           aws.StringValue(newAlertDedupEvent.GeneratedTitle),
+		Status:          "OPEN",
 		AlertDedupEvent: *newAlertDedupEvent,
 	}
 
@@ -187,6 +190,7 @@ func TestHandleStoreAndSendNotificationNoRuleDisplayNameNoTitle(t *testing.T) {
 		Runbook:             aws.String(string(testRuleResponse.Runbook)),
 		Severity:            string(testRuleResponse.Severity),
 		Tags:                []string{"Tag"},
+		LogTypes:            newAlertDedupEvent.LogTypes,
 		Type:                alertModel.RuleType,
 		AlertID:             aws.String("b25dc23fb2a0b362da8428dbec1381a8"),
 		Title:               aws.String(newAlertDedupEventWithoutTitle.RuleID),
@@ -214,6 +218,7 @@ func TestHandleStoreAndSendNotificationNoRuleDisplayNameNoTitle(t *testing.T) {
 		TimePartition:   "defaultPartition",
 		Severity:        string(testRuleResponse.Severity),
 		Title:           newAlertDedupEventWithoutTitle.RuleID,
+		Status:          "OPEN",
 		AlertDedupEvent: *newAlertDedupEventWithoutTitle,
 	}
 
@@ -256,6 +261,7 @@ func TestHandleStoreAndSendNotificationNoGeneratedTitle(t *testing.T) {
 		Runbook:             aws.String(string(testRuleResponse.Runbook)),
 		Severity:            string(testRuleResponse.Severity),
 		Tags:                []string{"Tag"},
+		LogTypes:            newAlertDedupEvent.LogTypes,
 		Type:                alertModel.RuleType,
 		AlertID:             aws.String("b25dc23fb2a0b362da8428dbec1381a8"),
 		Title:               aws.String("DisplayName"),
@@ -276,6 +282,7 @@ func TestHandleStoreAndSendNotificationNoGeneratedTitle(t *testing.T) {
 		Severity:        string(testRuleResponse.Severity),
 		RuleDisplayName: aws.String(string(testRuleResponse.DisplayName)),
 		Title:           "DisplayName",
+		Status:          "OPEN",
 		AlertDedupEvent: *newAlertDedupEvent,
 	}
 
@@ -329,6 +336,7 @@ func TestHandleStoreAndSendNotificationNilOldDedup(t *testing.T) {
 		Runbook:             aws.String(string(testRuleResponse.Runbook)),
 		Severity:            string(testRuleResponse.Severity),
 		Tags:                []string{"Tag"},
+		LogTypes:            newAlertDedupEvent.LogTypes,
 		Type:                alertModel.RuleType,
 		AlertID:             aws.String("b25dc23fb2a0b362da8428dbec1381a8"),
 		Title:               newAlertDedupEvent.GeneratedTitle,
@@ -349,6 +357,7 @@ func TestHandleStoreAndSendNotificationNilOldDedup(t *testing.T) {
 		Severity:        string(testRuleResponse.Severity),
 		Title:           aws.StringValue(newAlertDedupEvent.GeneratedTitle),
 		RuleDisplayName: aws.String(string(testRuleResponse.DisplayName)),
+		Status:          "OPEN",
 		AlertDedupEvent: *newAlertDedupEvent,
 	}
 
@@ -388,7 +397,8 @@ func TestHandleUpdateAlert(t *testing.T) {
 		Set(expression.Name("eventCount"), expression.Value(aws.Int64(dedupEventWithUpdatedFields.EventCount))).
 		Set(expression.Name("logTypes"), expression.Value(aws.StringSlice(dedupEventWithUpdatedFields.LogTypes))).
 		Set(expression.Name("updateTime"), expression.Value(aws.Time(dedupEventWithUpdatedFields.UpdateTime)))
-	expr, err := expression.NewBuilder().WithUpdate(updateExpression).Build()
+	condition := expression.AttributeExists(expression.Name("id"))
+	expr, err := expression.NewBuilder().WithUpdate(updateExpression).WithCondition(condition).Build()
 	require.NoError(t, err)
 
 	expectedUpdateItemInput := &dynamodb.UpdateItemInput{
@@ -397,6 +407,7 @@ func TestHandleUpdateAlert(t *testing.T) {
 			"id": {S: aws.String("b25dc23fb2a0b362da8428dbec1381a8")},
 		},
 		UpdateExpression:          expr.Update(),
+		ConditionExpression:       expr.Condition(),
 		ExpressionAttributeValues: expr.Values(),
 		ExpressionAttributeNames:  expr.Names(),
 	}
@@ -427,6 +438,92 @@ func TestHandleUpdateAlertDDBError(t *testing.T) {
 	assert.Error(t, Handle(newAlertDedupEvent, dedupEventWithUpdatedFields))
 }
 
+func TestHandleThresholdNotReached(t *testing.T) {
+	ddbMock := &testutils.DynamoDBMock{}
+	ddbClient = ddbMock
+
+	sqsMock := &testutils.SqsMock{}
+	sqsClient = sqsMock
+
+	mockRoundTripper := &mockRoundTripper{}
+	httpClient = &http.Client{Transport: mockRoundTripper}
+	policyConfig = policiesclient.DefaultTransportConfig().
+		WithHost("host").
+		WithBasePath("path")
+	policyClient = policiesclient.NewHTTPClientWithConfig(nil, policyConfig)
+
+	testRuleWithThreshold := *testRuleResponse
+	testRuleWithThreshold.Threshold = models.Threshold(newAlertDedupEvent.EventCount + 1)
+
+	mockRoundTripper.On("RoundTrip", mock.Anything).Return(generateResponse(&testRuleWithThreshold, http.StatusOK), nil).Once()
+	require.NoError(t, Handle(oldAlertDedupEvent, newAlertDedupEvent))
+
+	// No alert should be stored and no notification should be sent
+	ddbMock.AssertExpectations(t)
+	sqsMock.AssertExpectations(t)
+	mockRoundTripper.AssertExpectations(t)
+}
+
+func TestHandleThresholdReachedOnUpdate(t *testing.T) {
+	ddbMock := &testutils.DynamoDBMock{}
+	ddbClient = ddbMock
+
+	sqsMock := &testutils.SqsMock{}
+	sqsClient = sqsMock
+
+	mockRoundTripper := &mockRoundTripper{}
+	httpClient = &http.Client{Transport: mockRoundTripper}
+	policyConfig = policiesclient.DefaultTransportConfig().
+		WithHost("host").
+		WithBasePath("path")
+	policyClient = policiesclient.NewHTTPClientWithConfig(nil, policyConfig)
+
+	testRuleWithThreshold := *testRuleResponse
+	testRuleWithThreshold.Threshold = models.Threshold(newAlertDedupEvent.EventCount + 5)
+
+	dedupEventWithUpdatedFields := &AlertDedupEvent{
+		RuleID:              newAlertDedupEvent.RuleID,
+		RuleVersion:         newAlertDedupEvent.RuleVersion,
+		DeduplicationString: newAlertDedupEvent.DeduplicationString,
+		AlertCount:          newAlertDedupEvent.AlertCount,
+		CreationTime:        newAlertDedupEvent.CreationTime,
+		UpdateTime:          newAlertDedupEvent.UpdateTime.Add(1 * time.Minute),
+		EventCount:          newAlertDedupEvent.EventCount + 10,
+		LogTypes:            newAlertDedupEvent.LogTypes,
+		GeneratedTitle:      newAlertDedupEvent.GeneratedTitle,
+	}
+
+	// The alert doesn't exist yet since the threshold was not reached before
+	ddbMock.On("UpdateItem", mock.Anything).Return(&dynamodb.UpdateItemOutput{},
+		awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "", nil)).Once()
+	mockRoundTripper.On("RoundTrip", mock.Anything).Return(generateResponse(&testRuleWithThreshold, http.StatusOK), nil).Once()
+	sqsMock.On("SendMessage", mock.Anything).Return(&sqs.SendMessageOutput{}, nil).Once()
+
+	expectedAlert := &Alert{
+		ID:              "b25dc23fb2a0b362da8428dbec1381a8",
+		TimePartition:   "defaultPartition",
+		Severity:        string(testRuleResponse.Severity),
+		RuleDisplayName: aws.String(string(testRuleResponse.DisplayName)),
+		Title:           aws.StringValue(newAlertDedupEvent.GeneratedTitle),
+		Status:          "OPEN",
+		TriggerCount:    aws.Int64(dedupEventWithUpdatedFields.EventCount),
+		AlertDedupEvent: *dedupEventWithUpdatedFields,
+	}
+	expectedMarshaledAlert, err := dynamodbattribute.MarshalMap(expectedAlert)
+	require.NoError(t, err)
+	expectedPutItemRequest := &dynamodb.PutItemInput{
+		Item:      expectedMarshaledAlert,
+		TableName: aws.String("alertsTable"),
+	}
+	ddbMock.On("PutItem", expectedPutItemRequest).Return(&dynamodb.PutItemOutput{}, nil).Once()
+
+	require.NoError(t, Handle(newAlertDedupEvent, dedupEventWithUpdatedFields))
+
+	ddbMock.AssertExpectations(t)
+	sqsMock.AssertExpectations(t)
+	mockRoundTripper.AssertExpectations(t)
+}
+
 func generateResponse(body interface{}, httpCode int) *http.Response {
 	serializedBody, _ := jsoniter.MarshalToString(body)
 	return &http.Response{StatusCode: httpCode, Body: ioutil.NopCloser(strings.NewReader(serializedBody))}
@@ -54,6 +54,8 @@ type Alert struct {
 	RuleDisplayName *string `dynamodbav:"ruleDisplayName,string"`
 	Title           string  `dynamodbav:"title,string"` // The alert title. It will be the Python-generated title or a default one if
 	// no Python-generated title is available.
+	Status       string `dynamodbav:"status,string"`                 // The triage status of the alert, new alerts are always open
+	TriggerCount *int64 `dynamodbav:"triggerCount,number,omitempty"` // The event count that crossed the rule threshold
 	AlertDedupEvent
 }
 
@@ -97,20 +97,11 @@ func (API) GetAlert(input *models.GetAlertInput) (result *models.GetAlertOutput,
 		return nil, err
 	}
 	result = &models.Alert{
-		AlertSummary: models.AlertSummary{
-			AlertID:         &alertItem.AlertID,
-			RuleID:          &alertItem.RuleID,
-			DedupString:     &alertItem.DedupString,
-			CreationTime:    &alertItem.CreationTime,
-			UpdateTime:      &alertItem.UpdateTime,
-			EventsMatched:   &alertItem.EventCount,
-			RuleDisplayName: alertItem.RuleDisplayName,
-			Title:           getAlertTitle(alertItem),
-			RuleVersion:     &alertItem.RuleVersion,
-			Severity:        &alertItem.Severity,
-		},
+		AlertSummary:           *alertItemToAlertSummary(alertItem),
 		Events:                 aws.StringSlice(events),
 		EventsLastEvaluatedKey: aws.String(encodedToken),
+		Comments:               alertCommentsToModel(alertItem.Comments),
+		History:                alertHistoryToModel(alertItem.History),
 	}
 
 	gatewayapi.ReplaceMapSliceNils(result)
@@ -129,6 +120,14 @@ func getAlertTitle(alert *table.AlertItem) *string {
 	return &alert.RuleID
 }
 
+// Alerts created before triage was introduced have no status
+func getAlertStatus(alert *table.AlertItem) *string {
+	if alert.Status == "" {
+		return aws.String(models.AlertStatusOpen)
+	}
+	return &alert.Status
+}
+
 // This method returns events from a specific log type that are associated to a given alert.
 // It will only return up to `maxResults` events
 func getEventsForLogType(
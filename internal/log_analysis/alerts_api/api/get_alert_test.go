@@ -180,8 +180,11 @@ func TestGetAlert(t *testing.T) {
 			CreationTime:  aws.Time(time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)),
 			UpdateTime:    aws.Time(time.Date(2020, 1, 1, 1, 59, 0, 0, time.UTC)),
 			EventsMatched: aws.Int(5),
+			Status:        aws.String("OPEN"),
 		},
-		Events: aws.StringSlice([]string{"testEvent"}),
+		Events:   aws.StringSlice([]string{"testEvent"}),
+		Comments: []*models.AlertComment{},
+		History:  []*models.AlertHistoryEntry{},
 		EventsLastEvaluatedKey:
 		// nolint
 		aws.String("eyJsb2dUeXBlVG9Ub2tlbiI6eyJsb2d0eXBlIjp7InMzT2JqZWN0S2V5IjoicnVsZXMvbG9ndHlwZS95ZWFyPTIwMjAvbW9udGg9MDEvZGF5PTAxL2hvdXI9MDEvcnVsZV9pZD1ydWxlSWQvMjAyMDAxMDFUMDEwMTAwWi11dWlkNC5qc29uLmd6IiwiZXZlbnRJbmRleCI6MX19fQ=="),
@@ -230,8 +233,11 @@ func TestGetAlert(t *testing.T) {
 			CreationTime:  aws.Time(time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)),
 			UpdateTime:    aws.Time(time.Date(2020, 1, 1, 1, 59, 0, 0, time.UTC)),
 			EventsMatched: aws.Int(5),
+			Status:        aws.String("OPEN"),
 		},
-		Events: aws.StringSlice([]string{}),
+		Events:   aws.StringSlice([]string{}),
+		Comments: []*models.AlertComment{},
+		History:  []*models.AlertHistoryEntry{},
 		EventsLastEvaluatedKey:
 		// nolint
 		aws.String("eyJsb2dUeXBlVG9Ub2tlbiI6eyJsb2d0eXBlIjp7InMzT2JqZWN0S2V5IjoicnVsZXMvbG9ndHlwZS95ZWFyPTIwMjAvbW9udGg9MDEvZGF5PTAxL2hvdXI9MDEvcnVsZV9pZD1ydWxlSWQvMjAyMDAxMDFUMDEwMTAwWi11dWlkNC5qc29uLmd6IiwiZXZlbnRJbmRleCI6MH19fQ=="),
@@ -302,8 +308,11 @@ func TestGetAlertFilterOutS3KeysOutsideTheTimePeriod(t *testing.T) {
 			EventsMatched: aws.Int(5),
 			Severity:      aws.String("INFO"),
 			DedupString:   aws.String("dedupString"),
+			Status:        aws.String("OPEN"),
 		},
-		Events: aws.StringSlice([]string{"testEvent"}),
+		Events:   aws.StringSlice([]string{"testEvent"}),
+		Comments: []*models.AlertComment{},
+		History:  []*models.AlertHistoryEntry{},
 		EventsLastEvaluatedKey:
 		// nolint
 		aws.String("eyJsb2dUeXBlVG9Ub2tlbiI6eyJsb2d0eXBlIjp7InMzT2JqZWN0S2V5IjoicnVsZXMvbG9ndHlwZS95ZWFyPTIwMjAvbW9udGg9MDEvZGF5PTAxL2hvdXI9MDEvcnVsZV9pZD1ydWxlSWQvMjAyMDAxMDFUMDEwNTAwWi11dWlkNC5qc29uLmd6IiwiZXZlbnRJbmRleCI6MX19fQ=="),
@@ -64,19 +64,26 @@ func alertItemsToAlertSummary(items []*table.AlertItem) []*models.AlertSummary {
 	result := make([]*models.AlertSummary, len(items))
 
 	for i, item := range items {
-		result[i] = &models.AlertSummary{
-			AlertID:         &item.AlertID,
-			RuleID:          &item.RuleID,
-			DedupString:     &item.DedupString,
-			CreationTime:    &item.CreationTime,
-			Severity:        &item.Severity,
-			UpdateTime:      &item.UpdateTime,
-			EventsMatched:   &item.EventCount,
-			RuleDisplayName: item.RuleDisplayName,
-			Title:           getAlertTitle(item),
-			RuleVersion:     &item.RuleVersion,
-		}
+		result[i] = alertItemToAlertSummary(item)
 	}
 
 	return result
 }
+
+func alertItemToAlertSummary(item *table.AlertItem) *models.AlertSummary {
+	return &models.AlertSummary{
+		AlertID:         &item.AlertID,
+		RuleID:          &item.RuleID,
+		DedupString:     &item.DedupString,
+		CreationTime:    &item.CreationTime,
+		Severity:        &item.Severity,
+		UpdateTime:      &item.UpdateTime,
+		EventsMatched:   &item.EventCount,
+		TriggerCount:    item.TriggerCount,
+		RuleDisplayName: item.RuleDisplayName,
+		Title:           getAlertTitle(item),
+		RuleVersion:     &item.RuleVersion,
+		Status:          getAlertStatus(item),
+		AssigneeID:      item.AssigneeID,
+	}
+}
@@ -61,6 +61,7 @@ var (
 			DedupString:     aws.String("dedupString"),
 			EventsMatched:   aws.Int(100),
 			Title:           aws.String("title"),
+			Status:          aws.String("OPEN"),
 		},
 	}
 )
@@ -157,6 +158,7 @@ func TestListAllAlertsWithoutTitle(t *testing.T) {
 			DedupString:   aws.String("dedupString"),
 			EventsMatched: aws.Int(100),
 			Title:         aws.String("ruleId"),
+			Status:        aws.String("OPEN"),
 		},
 		{
 			RuleID:          aws.String("ruleId"),
@@ -170,7 +172,8 @@ func TestListAllAlertsWithoutTitle(t *testing.T) {
 			RuleDisplayName: aws.String("ruleDisplayName"),
 			// Since there is no dynamically generated title,
 			// we return the display name
-			Title: aws.String("ruleDisplayName"),
+			Title:  aws.String("ruleDisplayName"),
+			Status: aws.String("OPEN"),
 		},
 	}
 
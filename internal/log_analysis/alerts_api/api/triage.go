@@ -0,0 +1,187 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/api/lambda/alerts/models"
+	usermodels "github.com/panther-labs/panther/api/lambda/users/models"
+	"github.com/panther-labs/panther/internal/log_analysis/alerts_api/table"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/common"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+const usersAPIFunctionName = "panther-users-api"
+
+// UpdateAlertStatus changes the triage status of an alert
+func (API) UpdateAlertStatus(input *models.UpdateAlertStatusInput) (result *models.UpdateAlertStatusOutput, err error) {
+	operation := common.OpLogManager.Start("updateAlertStatus")
+	defer func() {
+		operation.Stop()
+		operation.Log(err)
+	}()
+
+	alertItem, err := getAlertItem(input.AlertID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := newHistoryEntry(input.UserID, models.AlertActionUpdateStatus)
+	entry.PreviousValue = getAlertStatus(alertItem)
+	entry.NewValue = input.Status
+
+	alertItem, err = alertsDB.UpdateAlertStatus(input, entry)
+	if err != nil {
+		return nil, err
+	}
+	if alertItem == nil {
+		return nil, alertDoesNotExistError(input.AlertID)
+	}
+	return alertItemToAlertSummary(alertItem), nil
+}
+
+// AssignAlert assigns an alert to a Panther user or unassigns it
+func (API) AssignAlert(input *models.AssignAlertInput) (result *models.AssignAlertOutput, err error) {
+	operation := common.OpLogManager.Start("assignAlert")
+	defer func() {
+		operation.Stop()
+		operation.Log(err)
+	}()
+
+	alertItem, err := getAlertItem(input.AlertID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.AssigneeID != nil {
+		if err = checkUserExists(input.AssigneeID); err != nil {
+			return nil, err
+		}
+	}
+
+	entry := newHistoryEntry(input.UserID, models.AlertActionAssign)
+	entry.PreviousValue = alertItem.AssigneeID
+	entry.NewValue = input.AssigneeID
+
+	alertItem, err = alertsDB.UpdateAlertAssignee(input, entry)
+	if err != nil {
+		return nil, err
+	}
+	if alertItem == nil {
+		return nil, alertDoesNotExistError(input.AlertID)
+	}
+	return alertItemToAlertSummary(alertItem), nil
+}
+
+// AddAlertComment adds a timestamped comment to an alert
+func (API) AddAlertComment(input *models.AddAlertCommentInput) (result *models.AddAlertCommentOutput, err error) {
+	operation := common.OpLogManager.Start("addAlertComment")
+	defer func() {
+		operation.Stop()
+		operation.Log(err)
+	}()
+
+	entry := newHistoryEntry(input.UserID, models.AlertActionAddComment)
+	comment := &table.AlertComment{
+		CreatedAt: entry.Timestamp,
+		CreatedBy: *input.UserID,
+		Comment:   *input.Comment,
+	}
+	alertItem, err := alertsDB.AddAlertComment(comment, input.AlertID, entry)
+	if err != nil {
+		return nil, err
+	}
+	if alertItem == nil {
+		return nil, alertDoesNotExistError(input.AlertID)
+	}
+	return alertCommentToModel(comment), nil
+}
+
+func getAlertItem(alertID *string) (*table.AlertItem, error) {
+	alertItem, err := alertsDB.GetAlert(alertID)
+	if err != nil {
+		return nil, err
+	}
+	if alertItem == nil {
+		return nil, alertDoesNotExistError(alertID)
+	}
+	return alertItem, nil
+}
+
+func alertDoesNotExistError(alertID *string) error {
+	return &genericapi.DoesNotExistError{Message: "alertId=" + *alertID + " does not exist"}
+}
+
+// checkUserExists verifies that the user is known to the users-api
+func checkUserExists(userID *string) error {
+	input := &usermodels.LambdaInput{
+		GetUser: &usermodels.GetUserInput{ID: userID},
+	}
+	err := genericapi.Invoke(lambdaClient, usersAPIFunctionName, input, nil)
+	if err == nil {
+		return nil
+	}
+	if lambdaErr, ok := err.(*genericapi.LambdaError); ok && aws.StringValue(lambdaErr.ErrorType) == "DoesNotExistError" {
+		return &genericapi.InvalidInputError{Message: "userId=" + *userID + " does not exist"}
+	}
+	zap.L().Error("failed to get user", zap.String("userId", *userID), zap.Error(err))
+	return err
+}
+
+func newHistoryEntry(userID *string, action string) *table.AlertHistoryEntry {
+	return &table.AlertHistoryEntry{
+		Timestamp: time.Now().UTC(),
+		UserID:    *userID,
+		Action:    action,
+	}
+}
+
+func alertCommentToModel(comment *table.AlertComment) *models.AlertComment {
+	return &models.AlertComment{
+		CreatedAt: &comment.CreatedAt,
+		CreatedBy: &comment.CreatedBy,
+		Comment:   &comment.Comment,
+	}
+}
+
+func alertCommentsToModel(comments []*table.AlertComment) []*models.AlertComment {
+	result := make([]*models.AlertComment, len(comments))
+	for i, comment := range comments {
+		result[i] = alertCommentToModel(comment)
+	}
+	return result
+}
+
+func alertHistoryToModel(history []*table.AlertHistoryEntry) []*models.AlertHistoryEntry {
+	result := make([]*models.AlertHistoryEntry, len(history))
+	for i, entry := range history {
+		result[i] = &models.AlertHistoryEntry{
+			Timestamp:     &entry.Timestamp,
+			UserID:        &entry.UserID,
+			Action:        &entry.Action,
+			PreviousValue: entry.PreviousValue,
+			NewValue:      entry.NewValue,
+		}
+	}
+	return result
+}
@@ -0,0 +1,196 @@
+package api
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/api/lambda/alerts/models"
+	"github.com/panther-labs/panther/internal/log_analysis/alerts_api/table"
+	"github.com/panther-labs/panther/pkg/genericapi"
+	"github.com/panther-labs/panther/pkg/testutils"
+)
+
+const (
+	triageAlertID = "2a1c6d5b1f6a8b9e4d3c2b1a0f9e8d7c"
+	triageUserID  = "97c4db4e-61d5-40a7-82de-6dd63b199bd2"
+	assigneeID    = "8304cc90-750d-4b8f-9a63-b90a4543c707"
+)
+
+func (m *tableMock) UpdateAlertStatus(
+	input *models.UpdateAlertStatusInput, entry *table.AlertHistoryEntry) (*table.AlertItem, error) {
+
+	args := m.Called(input, entry)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*table.AlertItem), args.Error(1)
+}
+
+func (m *tableMock) UpdateAlertAssignee(
+	input *models.AssignAlertInput, entry *table.AlertHistoryEntry) (*table.AlertItem, error) {
+
+	args := m.Called(input, entry)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*table.AlertItem), args.Error(1)
+}
+
+func (m *tableMock) AddAlertComment(
+	comment *table.AlertComment, alertID *string, entry *table.AlertHistoryEntry) (*table.AlertItem, error) {
+
+	args := m.Called(comment, alertID, entry)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*table.AlertItem), args.Error(1)
+}
+
+func TestUpdateAlertStatus(t *testing.T) {
+	tableMock := &tableMock{}
+	alertsDB = tableMock
+
+	input := &models.UpdateAlertStatusInput{
+		AlertID: aws.String(triageAlertID),
+		Status:  aws.String(models.AlertStatusTriaged),
+		UserID:  aws.String(triageUserID),
+	}
+	// alerts created before triage have no status
+	alertItem := &table.AlertItem{AlertID: triageAlertID, RuleID: "ruleId"}
+	updatedItem := &table.AlertItem{AlertID: triageAlertID, RuleID: "ruleId", Status: models.AlertStatusTriaged}
+
+	tableMock.On("GetAlert", aws.String(triageAlertID)).Return(alertItem, nil).Once()
+	tableMock.On("UpdateAlertStatus", input, mock.MatchedBy(func(entry *table.AlertHistoryEntry) bool {
+		return entry.UserID == triageUserID &&
+			entry.Action == models.AlertActionUpdateStatus &&
+			*entry.PreviousValue == models.AlertStatusOpen &&
+			*entry.NewValue == models.AlertStatusTriaged
+	})).Return(updatedItem, nil).Once()
+
+	result, err := API{}.UpdateAlertStatus(input)
+	require.NoError(t, err)
+	require.Equal(t, models.AlertStatusTriaged, *result.Status)
+	tableMock.AssertExpectations(t)
+}
+
+func TestUpdateAlertStatusDoesNotExist(t *testing.T) {
+	tableMock := &tableMock{}
+	alertsDB = tableMock
+
+	tableMock.On("GetAlert", aws.String(triageAlertID)).Return(nil, nil).Once()
+
+	result, err := API{}.UpdateAlertStatus(&models.UpdateAlertStatusInput{
+		AlertID: aws.String(triageAlertID),
+		Status:  aws.String(models.AlertStatusClosed),
+		UserID:  aws.String(triageUserID),
+	})
+	require.Nil(t, result)
+	require.IsType(t, &genericapi.DoesNotExistError{}, err)
+	tableMock.AssertExpectations(t)
+}
+
+func TestAssignAlert(t *testing.T) {
+	tableMock := &tableMock{}
+	alertsDB = tableMock
+	lambdaMock := &testutils.LambdaMock{}
+	lambdaClient = lambdaMock
+
+	input := &models.AssignAlertInput{
+		AlertID:    aws.String(triageAlertID),
+		AssigneeID: aws.String(assigneeID),
+		UserID:     aws.String(triageUserID),
+	}
+	alertItem := &table.AlertItem{AlertID: triageAlertID, RuleID: "ruleId", Status: models.AlertStatusOpen}
+	updatedItem := &table.AlertItem{
+		AlertID:    triageAlertID,
+		RuleID:     "ruleId",
+		Status:     models.AlertStatusOpen,
+		AssigneeID: aws.String(assigneeID),
+	}
+
+	tableMock.On("GetAlert", aws.String(triageAlertID)).Return(alertItem, nil).Once()
+	lambdaMock.On("Invoke", mock.Anything).Return(&lambda.InvokeOutput{Payload: []byte(`{}`)}, nil).Once()
+	tableMock.On("UpdateAlertAssignee", input, mock.MatchedBy(func(entry *table.AlertHistoryEntry) bool {
+		return entry.Action == models.AlertActionAssign && entry.PreviousValue == nil && *entry.NewValue == assigneeID
+	})).Return(updatedItem, nil).Once()
+
+	result, err := API{}.AssignAlert(input)
+	require.NoError(t, err)
+	require.Equal(t, assigneeID, *result.AssigneeID)
+	tableMock.AssertExpectations(t)
+	lambdaMock.AssertExpectations(t)
+}
+
+func TestAssignAlertUnknownUser(t *testing.T) {
+	tableMock := &tableMock{}
+	alertsDB = tableMock
+	lambdaMock := &testutils.LambdaMock{}
+	lambdaClient = lambdaMock
+
+	alertItem := &table.AlertItem{AlertID: triageAlertID, RuleID: "ruleId"}
+	tableMock.On("GetAlert", aws.String(triageAlertID)).Return(alertItem, nil).Once()
+	lambdaMock.On("Invoke", mock.Anything).Return(&lambda.InvokeOutput{
+		FunctionError: aws.String("Unhandled"),
+		Payload:       []byte(`{"errorMessage": "userID does not exist", "errorType": "DoesNotExistError"}`),
+	}, nil).Once()
+
+	result, err := API{}.AssignAlert(&models.AssignAlertInput{
+		AlertID:    aws.String(triageAlertID),
+		AssigneeID: aws.String(assigneeID),
+		UserID:     aws.String(triageUserID),
+	})
+	require.Nil(t, result)
+	require.IsType(t, &genericapi.InvalidInputError{}, err)
+	// the alert is not updated
+	tableMock.AssertExpectations(t)
+	lambdaMock.AssertExpectations(t)
+}
+
+func TestAddAlertComment(t *testing.T) {
+	tableMock := &tableMock{}
+	alertsDB = tableMock
+
+	input := &models.AddAlertCommentInput{
+		AlertID: aws.String(triageAlertID),
+		Comment: aws.String("False positive"),
+		UserID:  aws.String(triageUserID),
+	}
+	tableMock.On("AddAlertComment",
+		mock.MatchedBy(func(comment *table.AlertComment) bool {
+			return comment.Comment == "False positive" && comment.CreatedBy == triageUserID
+		}),
+		aws.String(triageAlertID),
+		mock.MatchedBy(func(entry *table.AlertHistoryEntry) bool {
+			return entry.Action == models.AlertActionAddComment
+		}),
+	).Return(&table.AlertItem{AlertID: triageAlertID}, nil).Once()
+
+	result, err := API{}.AddAlertComment(input)
+	require.NoError(t, err)
+	require.Equal(t, "False positive", *result.Comment)
+	require.Equal(t, triageUserID, *result.CreatedBy)
+	require.False(t, result.CreatedAt.IsZero())
+	tableMock.AssertExpectations(t)
+}
@@ -218,6 +218,8 @@ func (table *AlertsTable) applyFilters(builder *expression.Builder, input *model
 	// Then, apply our filters
 	filterBySeverity(&filter, input)
 	filterByEventCount(&filter, input)
+	filterByStatus(&filter, input)
+	filterByAssignee(&filter, input)
 
 	// Finally, overwrite the existing condition filter on the builder
 	*builder = builder.WithFilter(filter)
@@ -238,6 +240,34 @@ func filterBySeverity(filter *expression.ConditionBuilder, input *models.ListAle
 	}
 }
 
+// filterByStatus - filters by triage status(es)
+func filterByStatus(filter *expression.ConditionBuilder, input *models.ListAlertsInput) {
+	if len(input.Status) > 0 {
+		var multiFilter expression.ConditionBuilder
+		for i, status := range input.Status {
+			statusFilter := expression.Name(StatusKey).Equal(expression.Value(*status))
+			if *status == models.AlertStatusOpen {
+				// Alerts that were never triaged have no status
+				statusFilter = statusFilter.Or(expression.AttributeNotExists(expression.Name(StatusKey)))
+			}
+			if i == 0 {
+				multiFilter = statusFilter
+				continue
+			}
+			multiFilter = multiFilter.Or(statusFilter)
+		}
+
+		*filter = filter.And(multiFilter)
+	}
+}
+
+// filterByAssignee - filters by the user the alerts are assigned to
+func filterByAssignee(filter *expression.ConditionBuilder, input *models.ListAlertsInput) {
+	if input.AssigneeID != nil {
+		*filter = filter.And(expression.Name(AssigneeIDKey).Equal(expression.Value(*input.AssigneeID)))
+	}
+}
+
 // filterByTitleContains - fiters by a name that contains a string (case insensitive)
 func filterByTitleContains(input *models.ListAlertsInput, alert *AlertItem) *AlertItem {
 	if alert != nil && input.NameContains != nil && !strings.Contains(
@@ -37,12 +37,19 @@ const (
 	TitleKey           = "title"
 	SeverityKey        = "severity"
 	EventCountKey      = "eventCount"
+	StatusKey          = "status"
+	AssigneeIDKey      = "assigneeId"
+	CommentsKey        = "comments"
+	HistoryKey         = "history"
 )
 
 // API defines the interface for the alerts table which can be used for mocking.
 type API interface {
 	GetAlert(*string) (*AlertItem, error)
 	ListAll(*models.ListAlertsInput) ([]*AlertItem, *string, error)
+	UpdateAlertStatus(*models.UpdateAlertStatusInput, *AlertHistoryEntry) (*AlertItem, error)
+	UpdateAlertAssignee(*models.AssignAlertInput, *AlertHistoryEntry) (*AlertItem, error)
+	AddAlertComment(*AlertComment, *string, *AlertHistoryEntry) (*AlertItem, error)
 }
 
 // AlertsTable encapsulates a connection to the Dynamo alerts table.
@@ -72,4 +79,27 @@ type AlertItem struct {
 	Severity        string    `json:"severity"`
 	EventCount      int       `json:"eventCount"`
 	LogTypes        []string  `json:"logTypes"`
+	// The number of events that crossed the rule threshold, only set for rules with a threshold
+	TriggerCount *int `json:"triggerCount,omitempty"`
+	// Triage information, set by analysts after the alert is created
+	Status     string               `json:"status,omitempty"`
+	AssigneeID *string              `json:"assigneeId,omitempty"`
+	Comments   []*AlertComment      `json:"comments,omitempty"`
+	History    []*AlertHistoryEntry `json:"history,omitempty"`
+}
+
+// AlertComment is a DDB representation of a comment on an Alert
+type AlertComment struct {
+	CreatedAt time.Time `json:"createdAt"`
+	CreatedBy string    `json:"createdBy"`
+	Comment   string    `json:"comment"`
+}
+
+// AlertHistoryEntry is a DDB representation of a change to an Alert
+type AlertHistoryEntry struct {
+	Timestamp     time.Time `json:"timestamp"`
+	UserID        string    `json:"userId"`
+	Action        string    `json:"action"`
+	PreviousValue *string   `json:"previousValue,omitempty"`
+	NewValue      *string   `json:"newValue,omitempty"`
 }
@@ -0,0 +1,107 @@
+package table
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	"github.com/pkg/errors"
+
+	"github.com/panther-labs/panther/api/lambda/alerts/models"
+)
+
+// UpdateAlertStatus sets the triage status of an alert and records the change in its history.
+// It returns nil if the alert does not exist.
+func (table *AlertsTable) UpdateAlertStatus(
+	input *models.UpdateAlertStatusInput, entry *AlertHistoryEntry) (*AlertItem, error) {
+
+	update := expression.Set(expression.Name(StatusKey), expression.Value(input.Status))
+	return table.updateAlert(input.AlertID, update, entry)
+}
+
+// UpdateAlertAssignee sets or removes the assignee of an alert and records the change in its history.
+// It returns nil if the alert does not exist.
+func (table *AlertsTable) UpdateAlertAssignee(
+	input *models.AssignAlertInput, entry *AlertHistoryEntry) (*AlertItem, error) {
+
+	var update expression.UpdateBuilder
+	if input.AssigneeID == nil {
+		update = expression.Remove(expression.Name(AssigneeIDKey))
+	} else {
+		update = expression.Set(expression.Name(AssigneeIDKey), expression.Value(input.AssigneeID))
+	}
+	return table.updateAlert(input.AlertID, update, entry)
+}
+
+// AddAlertComment appends a comment to an alert and records the change in its history.
+// It returns nil if the alert does not exist.
+func (table *AlertsTable) AddAlertComment(
+	comment *AlertComment, alertID *string, entry *AlertHistoryEntry) (*AlertItem, error) {
+
+	update := expression.Set(expression.Name(CommentsKey), appendToList(CommentsKey, comment))
+	return table.updateAlert(alertID, update, entry)
+}
+
+// updateAlert applies an update to an existing alert, appending the entry to the alert history
+func (table *AlertsTable) updateAlert(
+	alertID *string, update expression.UpdateBuilder, entry *AlertHistoryEntry) (*AlertItem, error) {
+
+	update = update.Set(expression.Name(HistoryKey), appendToList(HistoryKey, entry))
+	condition := expression.AttributeExists(expression.Name(AlertIDKey))
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build update expression")
+	}
+
+	input := &dynamodb.UpdateItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			AlertIDKey: {S: alertID},
+		},
+		TableName:                 aws.String(table.AlertsTableName),
+		UpdateExpression:          expr.Update(),
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		ReturnValues:              aws.String(dynamodb.ReturnValueAllNew),
+	}
+	ddbResult, err := table.Client.UpdateItem(input)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "UpdateItem() failed for: "+*alertID)
+	}
+
+	alertItem := &AlertItem{}
+	if err = dynamodbattribute.UnmarshalMap(ddbResult.Attributes, alertItem); err != nil {
+		return nil, errors.Wrap(err, "UnmarshalMap() failed for: "+*alertID)
+	}
+	return alertItem, nil
+}
+
+// appendToList returns an operand appending a value to a list attribute that might not exist yet
+func appendToList(key string, value interface{}) expression.OperandBuilder {
+	return expression.ListAppend(
+		expression.IfNotExists(expression.Name(key), expression.Value([]interface{}{})),
+		expression.Value([]interface{}{value}),
+	)
+}
@@ -0,0 +1,115 @@
+package table
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/api/lambda/alerts/models"
+)
+
+func (m *mockDynamoDB) UpdateItem(input *dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(*dynamodb.UpdateItemOutput), args.Error(1)
+}
+
+func TestUpdateAlertStatus(t *testing.T) {
+	mockDdbClient := &mockDynamoDB{}
+	table := AlertsTable{
+		AlertsTableName: "alertsTableName",
+		Client:          mockDdbClient,
+	}
+
+	entry := &AlertHistoryEntry{
+		Timestamp:     time.Now().UTC(),
+		UserID:        "userId",
+		Action:        models.AlertActionUpdateStatus,
+		PreviousValue: aws.String(models.AlertStatusOpen),
+		NewValue:      aws.String(models.AlertStatusResolved),
+	}
+	expectedAlert := &AlertItem{
+		AlertID: "alertId",
+		RuleID:  "ruleId",
+		Status:  models.AlertStatusResolved,
+		History: []*AlertHistoryEntry{entry},
+	}
+	item, err := dynamodbattribute.MarshalMap(expectedAlert)
+	require.NoError(t, err)
+
+	mockDdbClient.On("UpdateItem", mock.MatchedBy(func(input *dynamodb.UpdateItemInput) bool {
+		return *input.TableName == "alertsTableName" &&
+			*input.Key[AlertIDKey].S == "alertId" &&
+			*input.ReturnValues == dynamodb.ReturnValueAllNew &&
+			input.ConditionExpression != nil
+	})).Return(&dynamodb.UpdateItemOutput{Attributes: item}, nil).Once()
+
+	result, err := table.UpdateAlertStatus(&models.UpdateAlertStatusInput{
+		AlertID: aws.String("alertId"),
+		Status:  aws.String(models.AlertStatusResolved),
+	}, entry)
+	require.NoError(t, err)
+	require.Equal(t, expectedAlert, result)
+	mockDdbClient.AssertExpectations(t)
+}
+
+func TestUpdateAlertStatusDoesNotExist(t *testing.T) {
+	mockDdbClient := &mockDynamoDB{}
+	table := AlertsTable{
+		AlertsTableName: "alertsTableName",
+		Client:          mockDdbClient,
+	}
+
+	mockDdbClient.On("UpdateItem", mock.Anything).Return(&dynamodb.UpdateItemOutput{},
+		awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "fail", nil)).Once()
+
+	result, err := table.UpdateAlertStatus(&models.UpdateAlertStatusInput{
+		AlertID: aws.String("alertId"),
+		Status:  aws.String(models.AlertStatusResolved),
+	}, &AlertHistoryEntry{})
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func TestUpdateAlertAssigneeRemove(t *testing.T) {
+	mockDdbClient := &mockDynamoDB{}
+	table := AlertsTable{
+		AlertsTableName: "alertsTableName",
+		Client:          mockDdbClient,
+	}
+
+	item, err := dynamodbattribute.MarshalMap(&AlertItem{AlertID: "alertId"})
+	require.NoError(t, err)
+	mockDdbClient.On("UpdateItem", mock.MatchedBy(func(input *dynamodb.UpdateItemInput) bool {
+		return strings.Contains(*input.UpdateExpression, "REMOVE")
+	})).Return(&dynamodb.UpdateItemOutput{Attributes: item}, nil).Once()
+
+	result, err := table.UpdateAlertAssignee(&models.AssignAlertInput{AlertID: aws.String("alertId")}, &AlertHistoryEntry{})
+	require.NoError(t, err)
+	require.Equal(t, "alertId", result.AlertID)
+	mockDdbClient.AssertExpectations(t)
+}
@@ -199,5 +199,6 @@ type ParserStats struct {
 	BytesProcessedCount    uint64 // input bytes
 	LogLineCount           uint64 // input records
 	EventCount             uint64 // output records
+	ParseFailureCount      uint64 // input records the parser failed to parse, only counted for logs of a known type
 	LogType                string
 }
@@ -0,0 +1,110 @@
+package classification
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers"
+)
+
+// NewLogTypeClassifier returns a ClassifierAPI for logs of a known type.
+// Every log line is parsed only with the parser of that log type and lines it fails to parse
+// are counted as parse failures of the parser instead of being tried against other parsers.
+func NewLogTypeClassifier(logType string, parser parsers.Interface) ClassifierAPI {
+	return &LogTypeClassifier{
+		logType: logType,
+		parser:  parser,
+		parserStats: ParserStats{
+			LogType: logType,
+		},
+	}
+}
+
+// LogTypeClassifier is the struct responsible for parsing logs of a known type
+type LogTypeClassifier struct {
+	logType string
+	parser  parsers.Interface
+	// aggregate stats
+	stats ClassifierStats
+	// stats of the single parser
+	parserStats ParserStats
+}
+
+func (c *LogTypeClassifier) Stats() *ClassifierStats {
+	return &c.stats
+}
+
+func (c *LogTypeClassifier) ParserStats() map[string]*ParserStats {
+	if c.parserStats.LogLineCount == 0 {
+		return map[string]*ParserStats{}
+	}
+	return map[string]*ParserStats{
+		c.logType: &c.parserStats,
+	}
+}
+
+// Classify parses the provided log line with the parser of the known log type
+func (c *LogTypeClassifier) Classify(log string) *ClassifierResult {
+	startClassify := time.Now().UTC()
+	result := &ClassifierResult{}
+
+	if len(log) == 0 { // likely empty file, nothing to do
+		return result
+	}
+
+	// update aggregate stats
+	defer func() {
+		c.stats.ClassifyTimeMicroseconds = uint64(time.Since(startClassify).Microseconds())
+		c.stats.BytesProcessedCount += uint64(len(log))
+		c.stats.LogLineCount++
+		c.stats.EventCount += uint64(len(result.Events))
+		if result.LogType == nil {
+			c.stats.ClassificationFailureCount++
+		} else {
+			c.stats.SuccessfullyClassifiedCount++
+		}
+	}()
+
+	log = strings.TrimSpace(log) // often the last line has \n only, could happen mid file tho
+
+	if len(log) == 0 { // we count above (because it is a line in the file) then skip
+		return result
+	}
+
+	startParseTime := time.Now().UTC()
+	parsedEvents := safeLogParse(c.logType, c.parser, log)
+	endParseTime := time.Now().UTC()
+
+	c.parserStats.ParserTimeMicroseconds += uint64(endParseTime.Sub(startParseTime).Microseconds())
+	c.parserStats.BytesProcessedCount += uint64(len(log))
+	c.parserStats.LogLineCount++
+	if parsedEvents == nil {
+		c.parserStats.ParseFailureCount++
+		return result
+	}
+
+	result.LogType = aws.String(c.logType)
+	result.Events = parsedEvents
+	c.parserStats.EventCount += uint64(len(result.Events))
+	return result
+}
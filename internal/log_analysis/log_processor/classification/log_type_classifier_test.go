@@ -0,0 +1,81 @@
+package classification
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/testutil"
+	"github.com/panther-labs/panther/pkg/box"
+)
+
+func TestLogTypeClassifier(t *testing.T) {
+	tm := time.Now().UTC()
+	expectResult := &parsers.Result{
+		LogType:   "known",
+		EventTime: tm,
+		JSON:      []byte(`{"p_log_type":"known"}`),
+	}
+	parser := testutil.ParserConfig{
+		"good": expectResult,
+		"bad":  errors.New("fail"),
+	}.Parser()
+
+	classifier := NewLogTypeClassifier("known", parser)
+	require.Empty(t, classifier.ParserStats())
+
+	result := classifier.Classify("good")
+	require.Equal(t, &ClassifierResult{
+		LogType: box.String("known"),
+		Events:  []*parsers.Result{expectResult},
+	}, result)
+	result = classifier.Classify("bad")
+	require.Equal(t, &ClassifierResult{}, result)
+	result = classifier.Classify("\n")
+	require.Equal(t, &ClassifierResult{}, result)
+
+	expectedStats := &ClassifierStats{
+		BytesProcessedCount:         uint64(len("good") + len("bad")), // blank lines are trimmed
+		LogLineCount:                3,
+		EventCount:                  1,
+		SuccessfullyClassifiedCount: 1,
+		ClassificationFailureCount:  2,
+	}
+	// skipping specifically validating the times
+	expectedStats.ClassifyTimeMicroseconds = classifier.Stats().ClassifyTimeMicroseconds
+	require.Equal(t, expectedStats, classifier.Stats())
+
+	parserStats := classifier.ParserStats()["known"]
+	require.NotNil(t, parserStats)
+	expectedParserStats := &ParserStats{
+		ParserTimeMicroseconds: parserStats.ParserTimeMicroseconds,
+		BytesProcessedCount:    uint64(len("good") + len("bad")),
+		LogLineCount:           2,
+		EventCount:             1,
+		ParseFailureCount:      1,
+		LogType:                "known",
+	}
+	require.Equal(t, expectedParserStats, parserStats)
+	parser.AssertNumberOfCalls(t, "Parse", 2)
+}
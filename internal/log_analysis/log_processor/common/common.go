@@ -81,7 +81,9 @@ type DataStream struct {
 
 // Used in a DataStream as meta data to describe the data
 type DataStreamHints struct {
-	S3 *S3DataStreamHints // if nil, no hint
+	S3      *S3DataStreamHints      // if nil, no hint
+	Kinesis *KinesisDataStreamHints // if nil, no hint
+	Archive *ArchiveDataStreamHints // if nil, the stream is not an archive member
 }
 
 // Used in a DataStreamHints as meta data to describe the S3 object backing the stream
@@ -89,4 +91,17 @@ type S3DataStreamHints struct {
 	Bucket      string
 	Key         string
 	ContentType string
+	// The prefix of the source rule that set the log type of the stream, if any
+	LogTypePrefix string
+}
+
+// Used in a DataStreamHints as meta data to describe the Kinesis stream backing the stream
+type KinesisDataStreamHints struct {
+	StreamARN string
+}
+
+// Used in a DataStreamHints as meta data to describe the archive member backing the stream
+type ArchiveDataStreamHints struct {
+	Format string
+	Member string
 }
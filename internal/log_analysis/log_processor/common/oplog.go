@@ -38,6 +38,7 @@ var (
 
 	OpLogLambdaServiceDim    = zap.String(OpLogServiceDim, "lambda")
 	OpLogS3ServiceDim        = zap.String(OpLogServiceDim, "s3")
+	OpLogKinesisServiceDim   = zap.String(OpLogServiceDim, "kinesis")
 	OpLogSNSServiceDim       = zap.String(OpLogServiceDim, "sns")
 	OpLogProcessorServiceDim = zap.String(OpLogServiceDim, "processor")
 	OpLogGlueServiceDim      = zap.String(OpLogServiceDim, "glue")
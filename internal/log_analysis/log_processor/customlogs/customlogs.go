@@ -0,0 +1,172 @@
+package customlogs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+
+	"github.com/panther-labs/panther/api/lambda/source/models"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/logtypes"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/timestamp"
+)
+
+var (
+	pantherLogType = reflect.TypeOf(parsers.PantherLog{})
+	// Indexes of the exported PantherLog fields, these are appended to the fields of each custom event
+	pantherLogFields = func() (indexes []int) {
+		for i := 0; i < pantherLogType.NumField(); i++ {
+			if pantherLogType.Field(i).PkgPath == "" {
+				indexes = append(indexes, i)
+			}
+		}
+		return indexes
+	}()
+)
+
+// Config builds the log type configuration for a custom log schema.
+// The schema of the log type is a struct type generated from the fields so that Glue tables
+// are inferred the same way as for built-in log types.
+func (schema *Schema) Config() logtypes.Config {
+	eventType := eventStructType(schema.Fields)
+	referenceURL := schema.ReferenceURL
+	if referenceURL == "" {
+		referenceURL = "-"
+	}
+	return logtypes.Config{
+		Name:         schema.Name,
+		Description:  schema.Description,
+		ReferenceURL: referenceURL,
+		Schema:       reflect.New(eventType).Interface(),
+		NewParser: func(_ interface{}) (parsers.Interface, error) {
+			return newParser(schema, eventType)
+		},
+	}
+}
+
+// Register adds custom log types to a registry, replacing any previous version of them
+func Register(registry *logtypes.Registry, schemas ...*Schema) error {
+	for _, schema := range schemas {
+		config := schema.Config()
+		if err := config.Validate(); err != nil {
+			return err
+		}
+		registry.Del(schema.Name)
+		if _, err := registry.Register(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Sync makes the custom log types in a registry match the schemas.
+// Custom log types that are not in schemas are removed from the registry.
+func Sync(registry *logtypes.Registry, schemas ...*Schema) error {
+	names := make(map[string]bool, len(schemas))
+	for _, schema := range schemas {
+		names[schema.Name] = true
+	}
+	for _, logType := range registry.LogTypes() {
+		if IsCustomLogType(logType) && !names[logType] {
+			registry.Del(logType)
+		}
+	}
+	return Register(registry, schemas...)
+}
+
+// ParseCustomLogs reads the schemas of custom logs stored by the source API
+func ParseCustomLogs(customLogs []*models.CustomLog) ([]*Schema, error) {
+	schemas := make([]*Schema, len(customLogs))
+	for i, customLog := range customLogs {
+		schema, err := ParseSchema([]byte(aws.StringValue(customLog.LogSpec)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid schema for custom log %q", aws.StringValue(customLog.LogType))
+		}
+		schemas[i] = schema
+	}
+	return schemas, nil
+}
+
+// IsCustomLogType checks if a log type was defined by a custom log schema
+func IsCustomLogType(logType string) bool {
+	return strings.HasPrefix(logType, LogTypePrefix)
+}
+
+// eventStructType builds the type of the events for a custom log.
+// It is the struct of the fields followed by all Panther fields.
+func eventStructType(fields []*FieldSchema) reflect.Type {
+	structFields := schemaStructFields(fields)
+	for _, i := range pantherLogFields {
+		structFields = append(structFields, pantherLogType.Field(i))
+	}
+	for i := range structFields {
+		structFields[i].Index = nil
+		structFields[i].Offset = 0
+	}
+	return reflect.StructOf(structFields)
+}
+
+func structType(fields []*FieldSchema) reflect.Type {
+	return reflect.StructOf(schemaStructFields(fields))
+}
+
+func schemaStructFields(fields []*FieldSchema) []reflect.StructField {
+	structFields := make([]reflect.StructField, len(fields))
+	for i, field := range fields {
+		tag := fmt.Sprintf(`json:"%s,omitempty" description:%q`, field.Name, field.Description)
+		if field.Required {
+			tag += ` validate:"required"`
+		}
+		structFields[i] = reflect.StructField{
+			// Go field names do not matter, the JSON tag is used for the column name
+			Name: fmt.Sprintf("Field%d", i),
+			Type: fieldType(field),
+			Tag:  reflect.StructTag(tag),
+		}
+	}
+	return structFields
+}
+
+// fieldType returns the Go type for a field. Scalars are pointers so that missing fields are omitted.
+func fieldType(field *FieldSchema) reflect.Type {
+	switch field.Type {
+	case TypeArray:
+		return reflect.SliceOf(fieldType(field.Element).Elem())
+	case TypeObject:
+		return reflect.PtrTo(structType(field.Fields))
+	case TypeJSON:
+		return reflect.TypeOf(jsoniter.RawMessage{})
+	case TypeTimestamp:
+		return reflect.TypeOf(&timestamp.RFC3339{})
+	case TypeInt:
+		return reflect.TypeOf(new(int64))
+	case TypeFloat:
+		return reflect.TypeOf(new(float64))
+	case TypeBoolean:
+		return reflect.TypeOf(new(bool))
+	default:
+		return reflect.TypeOf(new(string))
+	}
+}
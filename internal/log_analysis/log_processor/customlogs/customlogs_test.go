@@ -0,0 +1,195 @@
+package customlogs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/internal/log_analysis/awsglue"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/logtypes"
+)
+
+func mustLoadSchema(t *testing.T, filename string) *Schema {
+	t.Helper()
+	spec, err := ioutil.ReadFile(filename)
+	require.NoError(t, err)
+	schema, err := ParseSchema(spec)
+	require.NoError(t, err)
+	return schema
+}
+
+func TestJSONLog(t *testing.T) {
+	schema := mustLoadSchema(t, "testdata/myapp.yml")
+	registry := &logtypes.Registry{}
+	require.NoError(t, Register(registry, schema))
+
+	parser, err := registry.MustGet("Custom.MyApp").NewParser(nil)
+	require.NoError(t, err)
+
+	log := `{"time":1591796000123,"action":"login","client":{"ip":"10.0.0.1","host":"example.com"},` +
+		`"bytes":"42","success":true,"tags":["a","b"],"extra":{"key":[1,2]},"unknown":"ignored"}`
+	results, err := parser.ParseLog(log)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	result := results[0]
+	require.Equal(t, "Custom.MyApp", result.LogType)
+	require.Equal(t, "2020-06-10T13:33:20.123Z", result.EventTime.Format("2006-01-02T15:04:05.000Z07:00"))
+
+	actual := string(result.JSON)
+	require.Contains(t, actual, `"time":"2020-06-10 13:33:20.123000000"`)
+	require.Contains(t, actual, `"action":"login"`)
+	require.Contains(t, actual, `"client":{"ip":"10.0.0.1","host":"example.com"}`)
+	require.Contains(t, actual, `"bytes":42`)
+	require.Contains(t, actual, `"success":true`)
+	require.Contains(t, actual, `"tags":["a","b"]`)
+	require.Contains(t, actual, `"extra":{"key":[1,2]}`)
+	require.Contains(t, actual, `"p_log_type":"Custom.MyApp"`)
+	require.Contains(t, actual, `"p_event_time":"2020-06-10 13:33:20.123000000"`)
+	require.Contains(t, actual, `"p_any_ip_addresses":["10.0.0.1"]`)
+	require.Contains(t, actual, `"p_any_domain_names":["example.com"]`)
+	require.NotContains(t, actual, "unknown")
+
+	// required fields must be present
+	_, err = parser.ParseLog(`{"time":1591796000123}`)
+	require.Error(t, err)
+	_, err = parser.ParseLog(`{"time":1591796000123,"action":"login","bytes":"many"}`)
+	require.Error(t, err)
+	_, err = parser.ParseLog(`not json`)
+	require.Error(t, err)
+}
+
+func TestRegexLog(t *testing.T) {
+	schema := mustLoadSchema(t, "testdata/myapp_regex.yml")
+	registry := &logtypes.Registry{}
+	require.NoError(t, Register(registry, schema))
+
+	parser, err := registry.MustGet("Custom.MyApp.Access").NewParser(nil)
+	require.NoError(t, err)
+
+	results, err := parser.ParseLog(`2020-06-10 13:33:20 192.168.1.1 200 0.25`)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	actual := string(results[0].JSON)
+	require.Contains(t, actual, `"time":"2020-06-10 13:33:20.000000000"`)
+	require.Contains(t, actual, `"remoteAddr":"192.168.1.1"`)
+	require.Contains(t, actual, `"status":200`)
+	require.Contains(t, actual, `"latency":0.25`)
+	require.Contains(t, actual, `"p_any_ip_addresses":["192.168.1.1"]`)
+
+	_, err = parser.ParseLog(`{"time":"2020-06-10 13:33:20"}`)
+	require.Error(t, err)
+}
+
+func TestGlueTableMetadata(t *testing.T) {
+	schema := mustLoadSchema(t, "testdata/myapp.yml")
+	registry := &logtypes.Registry{}
+	require.NoError(t, Register(registry, schema))
+
+	tableMeta := registry.MustGet("Custom.MyApp").GlueTableMeta()
+	require.Equal(t, "custom_myapp", tableMeta.TableName())
+	require.Equal(t, "Audit logs of MyApp", tableMeta.Description())
+
+	columns, _ := awsglue.InferJSONColumns(tableMeta.EventStruct(), awsglue.GlueMappings...)
+	columnTypes := make(map[string]string, len(columns))
+	for _, column := range columns {
+		columnTypes[column.Name] = column.Type
+	}
+	require.Equal(t, map[string]string{
+		"time":                "timestamp",
+		"action":              "string",
+		"client":              "struct<ip:string,host:string>",
+		"bytes":               "bigint",
+		"success":             "boolean",
+		"tags":                "array<string>",
+		"extra":               "string",
+		"p_log_type":          "string",
+		"p_row_id":            "string",
+		"p_event_time":        "timestamp",
+		"p_parse_time":        "timestamp",
+		"p_any_ip_addresses":  "array<string>",
+		"p_any_domain_names":  "array<string>",
+		"p_any_sha1_hashes":   "array<string>",
+		"p_any_md5_hashes":    "array<string>",
+		"p_any_sha256_hashes": "array<string>",
+	}, columnTypes)
+	require.True(t, columns[0].Required)
+}
+
+func TestSync(t *testing.T) {
+	registry := &logtypes.Registry{}
+	require.NoError(t, Register(registry, mustLoadSchema(t, "testdata/myapp.yml")))
+	require.NoError(t, Register(registry, mustLoadSchema(t, "testdata/myapp.yml"))) // replaces existing
+
+	require.NoError(t, Sync(registry, mustLoadSchema(t, "testdata/myapp_regex.yml")))
+	require.Equal(t, []string{"Custom.MyApp.Access"}, registry.LogTypes())
+}
+
+func TestInvalidSchemas(t *testing.T) {
+	for name, spec := range map[string]string{
+		"bad name": `
+name: MyApp
+description: test
+fields: [{name: a, description: a, type: string, required: true}]`,
+		"no required field": `
+name: Custom.MyApp
+description: test
+fields: [{name: a, description: a, type: string}]`,
+		"missing field description": `
+name: Custom.MyApp
+description: test
+fields: [{name: a, type: string, required: true}]`,
+		"reserved field name": `
+name: Custom.MyApp
+description: test
+fields: [{name: p_a, description: a, type: string, required: true}]`,
+		"unknown type": `
+name: Custom.MyApp
+description: test
+fields: [{name: a, description: a, type: uuid, required: true}]`,
+		"nested event time": `
+name: Custom.MyApp
+description: test
+fields:
+  - name: a
+    description: a
+    type: object
+    required: true
+    fields: [{name: t, description: t, type: timestamp, isEventTime: true}]`,
+		"indicator on int": `
+name: Custom.MyApp
+description: test
+fields: [{name: a, description: a, type: int, indicators: [ip], required: true}]`,
+		"unknown regex group": `
+name: Custom.MyApp
+description: test
+regex: '(?P<b>.*)'
+fields: [{name: a, description: a, type: string, required: true}]`,
+		"unknown property": `
+name: Custom.MyApp
+description: test
+format: json
+fields: [{name: a, description: a, type: string, required: true}]`,
+	} {
+		_, err := ParseSchema([]byte(spec))
+		require.Error(t, err, name)
+	}
+}
@@ -0,0 +1,312 @@
+package customlogs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/timestamp"
+)
+
+// Numbers are read as json.Number so that they can be converted to the type of the field without loss
+var jsonAPI = jsoniter.Config{UseNumber: true}.Froze()
+
+type logParser struct {
+	schema         *Schema
+	regex          *regexp.Regexp
+	eventType      reflect.Type
+	eventTimeIndex int
+}
+
+var _ parsers.Interface = (*logParser)(nil)
+
+func newParser(schema *Schema, eventType reflect.Type) (*logParser, error) {
+	p := &logParser{
+		schema:         schema,
+		eventType:      eventType,
+		eventTimeIndex: -1,
+	}
+	if schema.Regex != "" {
+		re, err := regexp.Compile(schema.Regex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid regex for log type %q", schema.Name)
+		}
+		p.regex = re
+	}
+	for i, field := range schema.Fields {
+		if field.IsEventTime {
+			p.eventTimeIndex = i
+		}
+	}
+	return p, nil
+}
+
+// ParseLog implements parsers.Interface
+func (p *logParser) ParseLog(log string) ([]*parsers.Result, error) {
+	values, err := p.readValues(log)
+	if err != nil {
+		return nil, err
+	}
+
+	event := reflect.New(p.eventType)
+	pantherLog := parsers.PantherLog{}
+	if err := decodeFields(p.schema.Fields, values, event.Elem(), &pantherLog); err != nil {
+		return nil, err
+	}
+
+	var eventTime *timestamp.RFC3339
+	if p.eventTimeIndex >= 0 {
+		eventTime = event.Elem().Field(p.eventTimeIndex).Interface().(*timestamp.RFC3339)
+	}
+	pantherLog.SetCoreFields(p.schema.Name, eventTime, nil)
+
+	// copy the Panther fields after the schema fields of the event
+	pantherLogValue := reflect.ValueOf(&pantherLog).Elem()
+	for i, index := range pantherLogFields {
+		event.Elem().Field(len(p.schema.Fields) + i).Set(pantherLogValue.Field(index))
+	}
+	pantherLog.SetEvent(event.Interface())
+
+	result, err := pantherLog.Result()
+	if err != nil {
+		return nil, err
+	}
+	return result.Results(), nil
+}
+
+func (p *logParser) readValues(log string) (map[string]interface{}, error) {
+	if p.regex == nil {
+		var values map[string]interface{}
+		if err := jsonAPI.UnmarshalFromString(log, &values); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s log", p.schema.Name)
+		}
+		return values, nil
+	}
+
+	match := p.regex.FindStringSubmatch(log)
+	if match == nil {
+		return nil, errors.Errorf("log does not match %s regex", p.schema.Name)
+	}
+	values := make(map[string]interface{})
+	for i, name := range p.regex.SubexpNames() {
+		if name != "" && match[i] != "" {
+			values[name] = match[i]
+		}
+	}
+	return values, nil
+}
+
+// decodeFields sets the fields of the target struct from the values read from the log
+func decodeFields(fields []*FieldSchema, values map[string]interface{}, target reflect.Value, pl *parsers.PantherLog) error {
+	for i, field := range fields {
+		value := values[field.Name]
+		if value == nil {
+			if field.Required {
+				return errors.Errorf("missing required field %q", field.Name)
+			}
+			continue
+		}
+		fieldValue := target.Field(i)
+		decoded, err := decodeValue(field, field.Indicators, fieldValue.Type(), value, pl)
+		if err != nil {
+			return errors.Wrapf(err, "invalid field %q", field.Name)
+		}
+		fieldValue.Set(decoded)
+	}
+	return nil
+}
+
+// decodeValue converts a value read from the log to a value of type typ
+func decodeValue(field *FieldSchema, indicators []string, typ reflect.Type, value interface{},
+	pl *parsers.PantherLog) (reflect.Value, error) {
+
+	switch field.Type {
+	case TypeObject:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return reflect.Value{}, errors.Errorf("expected an object, got %T", value)
+		}
+		obj := reflect.New(typ.Elem())
+		if err := decodeFields(field.Fields, values, obj.Elem(), pl); err != nil {
+			return reflect.Value{}, err
+		}
+		return obj, nil
+	case TypeArray:
+		items, ok := value.([]interface{})
+		if !ok {
+			return reflect.Value{}, errors.Errorf("expected an array, got %T", value)
+		}
+		elementType := reflect.PtrTo(typ.Elem())
+		slice := reflect.MakeSlice(typ, 0, len(items))
+		for _, item := range items {
+			if item == nil {
+				continue
+			}
+			element, err := decodeValue(field.Element, indicators, elementType, item, pl)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			slice = reflect.Append(slice, element.Elem())
+		}
+		return slice, nil
+	case TypeJSON:
+		data, err := jsoniter.Marshal(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(jsoniter.RawMessage(data)), nil
+	case TypeTimestamp:
+		ts, err := parseTimestamp(field.TimeFormat, value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(&ts), nil
+	case TypeInt:
+		n, err := toInt64(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(&n), nil
+	case TypeFloat:
+		f, err := toFloat64(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(&f), nil
+	case TypeBoolean:
+		b, err := toBool(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(&b), nil
+	default:
+		s, err := toString(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		appendIndicators(pl, indicators, s)
+		return reflect.ValueOf(&s), nil
+	}
+}
+
+func appendIndicators(pl *parsers.PantherLog, indicators []string, value string) {
+	for _, indicator := range indicators {
+		switch indicator {
+		case IndicatorIP:
+			pl.AppendAnyIPAddress(value)
+		case IndicatorDomain:
+			pl.AppendAnyDomainNames(value)
+		case IndicatorMD5:
+			pl.AppendAnyMD5Hashes(value)
+		case IndicatorSHA1:
+			pl.AppendAnySHA1Hashes(value)
+		case IndicatorSHA256:
+			pl.AppendAnySHA256Hashes(value)
+		}
+	}
+}
+
+func parseTimestamp(format string, value interface{}) (timestamp.RFC3339, error) {
+	switch format {
+	case "", TimeFormatRFC3339:
+		s, err := toString(value)
+		if err != nil {
+			return timestamp.RFC3339{}, err
+		}
+		return timestamp.Parse(time.RFC3339Nano, s)
+	case TimeFormatUnix:
+		f, err := toFloat64(value)
+		if err != nil {
+			return timestamp.RFC3339{}, err
+		}
+		sec, frac := math.Modf(f)
+		return timestamp.Unix(int64(sec), int64(frac*float64(time.Second))), nil
+	case TimeFormatUnixMs:
+		n, err := toInt64(value)
+		return timestamp.Unix(0, n*int64(time.Millisecond)), err
+	case TimeFormatUnixUs:
+		n, err := toInt64(value)
+		return timestamp.Unix(0, n*int64(time.Microsecond)), err
+	case TimeFormatUnixNs:
+		n, err := toInt64(value)
+		return timestamp.Unix(0, n), err
+	default:
+		s, err := toString(value)
+		if err != nil {
+			return timestamp.RFC3339{}, err
+		}
+		return timestamp.Parse(format, s)
+	}
+}
+
+func toString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		return "", errors.Errorf("expected a string, got %T", value)
+	}
+}
+
+func toInt64(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.Int64()
+	case string:
+		return strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+	default:
+		return 0, errors.Errorf("expected an integer, got %T", value)
+	}
+}
+
+func toFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(v), 64)
+	default:
+		return 0, errors.Errorf("expected a number, got %T", value)
+	}
+}
+
+func toBool(value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(strings.TrimSpace(v))
+	default:
+		return false, errors.Errorf("expected a boolean, got %T", value)
+	}
+}
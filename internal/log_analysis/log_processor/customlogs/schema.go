@@ -0,0 +1,253 @@
+package customlogs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// LogTypePrefix is required for all custom log types so they never collide with built-in log types
+	LogTypePrefix = "Custom."
+
+	TypeString    = "string"
+	TypeInt       = "int"
+	TypeFloat     = "float"
+	TypeBoolean   = "boolean"
+	TypeTimestamp = "timestamp"
+	TypeJSON      = "json"
+	TypeObject    = "object"
+	TypeArray     = "array"
+
+	IndicatorIP     = "ip"
+	IndicatorDomain = "domain"
+	IndicatorMD5    = "md5"
+	IndicatorSHA1   = "sha1"
+	IndicatorSHA256 = "sha256"
+
+	// Timestamp formats besides Go time layouts
+	TimeFormatRFC3339 = "rfc3339"
+	TimeFormatUnix    = "unix"
+	TimeFormatUnixMs  = "unix_ms"
+	TimeFormatUnixUs  = "unix_us"
+	TimeFormatUnixNs  = "unix_ns"
+)
+
+var (
+	logTypeNameRegex = regexp.MustCompile(`^Custom\.[A-Z][a-zA-Z0-9]*(\.[A-Z][a-zA-Z0-9]*)*$`)
+	fieldNameRegex   = regexp.MustCompile(`^[a-zA-Z_@][a-zA-Z0-9_@]*$`)
+)
+
+// Schema is the declarative definition of a custom log type.
+//
+// A schema is written in YAML:
+//
+//   name: Custom.MyApp
+//   description: Audit logs of MyApp
+//   referenceURL: https://example.com/docs
+//   regex: '^(?P<time>\S+) (?P<remoteAddr>\S+) (?P<message>.*)$' # optional, logs are JSON if omitted
+//   fields:
+//     - name: time
+//       description: Event time
+//       type: timestamp
+//       timeFormat: rfc3339
+//       isEventTime: true
+//       required: true
+//     - name: remoteAddr
+//       description: Client address
+//       type: string
+//       indicators: [ip]
+//     - name: message
+//       description: Log message
+//       type: string
+type Schema struct {
+	Name         string         `yaml:"name"`
+	Description  string         `yaml:"description"`
+	ReferenceURL string         `yaml:"referenceURL,omitempty"`
+	Regex        string         `yaml:"regex,omitempty"`
+	Fields       []*FieldSchema `yaml:"fields"`
+}
+
+// FieldSchema describes a single field of a custom log
+type FieldSchema struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Type        string `yaml:"type"`
+	Required    bool   `yaml:"required,omitempty"`
+	// TimeFormat is one of rfc3339, unix, unix_ms, unix_us, unix_ns or a Go time layout
+	TimeFormat  string   `yaml:"timeFormat,omitempty"`
+	IsEventTime bool     `yaml:"isEventTime,omitempty"`
+	Indicators  []string `yaml:"indicators,omitempty"`
+	// Fields of an object
+	Fields []*FieldSchema `yaml:"fields,omitempty"`
+	// Element of an array
+	Element *FieldSchema `yaml:"element,omitempty"`
+}
+
+// ParseSchema reads and validates a schema from YAML
+func ParseSchema(spec []byte) (*Schema, error) {
+	schema := Schema{}
+	if err := yaml.UnmarshalStrict(spec, &schema); err != nil {
+		return nil, errors.Wrap(err, "invalid log schema YAML")
+	}
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+// Validate checks that a schema can be used to parse logs and create tables
+func (schema *Schema) Validate() error {
+	if !logTypeNameRegex.MatchString(schema.Name) {
+		return errors.Errorf("invalid log type name %q, it must be in the form %sName", schema.Name, LogTypePrefix)
+	}
+	if strings.TrimSpace(schema.Description) == "" {
+		return errors.Errorf("missing description for log type %q", schema.Name)
+	}
+	if len(schema.Fields) == 0 {
+		return errors.Errorf("no fields defined for log type %q", schema.Name)
+	}
+	if err := validateFields(schema.Fields, schema.Name, false); err != nil {
+		return err
+	}
+
+	numEventTimeFields, numRequiredFields := 0, 0
+	for _, field := range schema.Fields {
+		if field.IsEventTime {
+			numEventTimeFields++
+		}
+		if field.Required {
+			numRequiredFields++
+		}
+	}
+	if numEventTimeFields > 1 {
+		return errors.Errorf("log type %q has more than one event time field", schema.Name)
+	}
+	// Without required fields the parser would accept any JSON object and steal logs from other log types
+	if numRequiredFields == 0 {
+		return errors.Errorf("log type %q needs at least one required top level field", schema.Name)
+	}
+
+	if schema.Regex != "" {
+		return schema.validateRegex()
+	}
+	return nil
+}
+
+func (schema *Schema) validateRegex() error {
+	re, err := regexp.Compile(schema.Regex)
+	if err != nil {
+		return errors.Wrapf(err, "invalid regex for log type %q", schema.Name)
+	}
+	fields := make(map[string]*FieldSchema, len(schema.Fields))
+	for _, field := range schema.Fields {
+		fields[field.Name] = field
+	}
+	for _, name := range re.SubexpNames() {
+		if name == "" {
+			continue
+		}
+		field, ok := fields[name]
+		if !ok {
+			return errors.Errorf("regex group %q of log type %q does not match any field", name, schema.Name)
+		}
+		switch field.Type {
+		case TypeObject, TypeArray, TypeJSON:
+			return errors.Errorf("field %q of log type %q cannot be of type %s when using a regex", name, schema.Name, field.Type)
+		}
+	}
+	return nil
+}
+
+func validateFields(fields []*FieldSchema, path string, nested bool) error {
+	names := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		if field == nil {
+			return errors.Errorf("empty field in %s", path)
+		}
+		fieldPath := path + "." + field.Name
+		if !fieldNameRegex.MatchString(field.Name) || strings.HasPrefix(field.Name, "p_") {
+			return errors.Errorf("invalid field name %q in %s", field.Name, path)
+		}
+		if names[field.Name] {
+			return errors.Errorf("duplicate field %s", fieldPath)
+		}
+		names[field.Name] = true
+		if strings.TrimSpace(field.Description) == "" {
+			return errors.Errorf("missing description for field %s", fieldPath)
+		}
+		if err := validateField(field, fieldPath, nested); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateField(field *FieldSchema, path string, nested bool) error {
+	if field.IsEventTime && (field.Type != TypeTimestamp || nested) {
+		return errors.Errorf("event time field %s must be a top level timestamp", path)
+	}
+	if field.TimeFormat != "" && field.Type != TypeTimestamp {
+		return errors.Errorf("time format set for non timestamp field %s", path)
+	}
+	for _, indicator := range field.Indicators {
+		switch indicator {
+		case IndicatorIP, IndicatorDomain, IndicatorMD5, IndicatorSHA1, IndicatorSHA256:
+		default:
+			return errors.Errorf("invalid indicator %q for field %s", indicator, path)
+		}
+		if field.Type != TypeString && !(field.Type == TypeArray && field.Element != nil && field.Element.Type == TypeString) {
+			return errors.Errorf("indicators can only be set on string fields, %s is %s", path, field.Type)
+		}
+	}
+
+	switch field.Type {
+	case TypeString, TypeInt, TypeFloat, TypeBoolean, TypeJSON, TypeTimestamp:
+		if len(field.Fields) > 0 || field.Element != nil {
+			return errors.Errorf("field %s of type %s cannot have nested fields", path, field.Type)
+		}
+	case TypeObject:
+		if len(field.Fields) == 0 {
+			return errors.Errorf("object field %s has no fields", path)
+		}
+		return validateFields(field.Fields, path, true)
+	case TypeArray:
+		if field.Element == nil {
+			return errors.Errorf("array field %s has no element", path)
+		}
+		element := field.Element
+		switch element.Type {
+		case TypeString, TypeInt, TypeFloat, TypeBoolean:
+		case TypeObject:
+			if len(element.Fields) == 0 {
+				return errors.Errorf("object element of array field %s has no fields", path)
+			}
+			return validateFields(element.Fields, path+"[]", true)
+		default:
+			return errors.Errorf("array field %s cannot have elements of type %q", path, element.Type)
+		}
+	default:
+		return errors.Errorf("invalid type %q for field %s", field.Type, path)
+	}
+	return nil
+}
@@ -20,15 +20,19 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/aws/aws-lambda-go/lambdacontext"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
 	"github.com/panther-labs/panther/internal/log_analysis/log_processor/common"
 	"github.com/panther-labs/panther/internal/log_analysis/log_processor/processor"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/sources"
 	"github.com/panther-labs/panther/pkg/lambdalogger"
 )
 
@@ -37,10 +41,42 @@ func main() {
 	lambda.Start(handle)
 }
 
-func handle(ctx context.Context, event events.SQSEvent) error {
+const kinesisEventSource = "aws:kinesis"
+
+// The log processor is triggered both by the SQS queue with S3 notifications and by Kinesis streams
+type eventSourceRecords struct {
+	Records []struct {
+		EventSource string `json:"eventSource"`
+	} `json:"Records"`
+}
+
+func handle(ctx context.Context, event json.RawMessage) error {
 	lc, _ := lambdalogger.ConfigureGlobal(ctx, nil)
 	deadline, _ := ctx.Deadline()
-	return process(lc, deadline, event)
+
+	// Custom log types are defined by users at runtime, so they are refreshed periodically.
+	// A failure only affects custom logs, which will fail to classify, so we keep processing.
+	if err := sources.LoadCustomLogTypes(time.Now()); err != nil {
+		zap.L().Error("failed to load custom log types", zap.Error(err))
+	}
+
+	var records eventSourceRecords
+	if err := jsoniter.Unmarshal(event, &records); err != nil {
+		return errors.Wrap(err, "failed to read event source")
+	}
+	if len(records.Records) > 0 && records.Records[0].EventSource == kinesisEventSource {
+		var kinesisEvent events.KinesisEvent
+		if err := jsoniter.Unmarshal(event, &kinesisEvent); err != nil {
+			return errors.Wrap(err, "failed to read kinesis event")
+		}
+		return processKinesis(lc, kinesisEvent)
+	}
+
+	var sqsEvent events.SQSEvent
+	if err := jsoniter.Unmarshal(event, &sqsEvent); err != nil {
+		return errors.Wrap(err, "failed to read sqs event")
+	}
+	return process(lc, deadline, sqsEvent)
 }
 
 func process(lc *lambdacontext.LambdaContext, deadline time.Time, event events.SQSEvent) (err error) {
@@ -55,3 +91,16 @@ func process(lc *lambdacontext.LambdaContext, deadline time.Time, event events.S
 	sqsMessageCount, err = processor.StreamEvents(common.SqsClient, deadline, event)
 	return err
 }
+
+func processKinesis(lc *lambdacontext.LambdaContext, event events.KinesisEvent) (err error) {
+	operation := common.OpLogManager.Start(lc.InvokedFunctionArn, common.OpLogLambdaServiceDim).WithMemUsed(lambdacontext.MemoryLimitInMB)
+
+	var kinesisRecordCount int
+
+	defer func() {
+		operation.Stop().Log(err, zap.Int("kinesisRecordCount", kinesisRecordCount))
+	}()
+
+	kinesisRecordCount, err = processor.ProcessKinesisEvents(event)
+	return err
+}
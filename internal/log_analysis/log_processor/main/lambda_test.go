@@ -68,3 +68,28 @@ func TestProcessOpLog(t *testing.T) {
 		t.Errorf("unknown type for sqsMessageCount: %#v", sqsMessageCount)
 	}
 }
+
+func TestProcessKinesisOpLog(t *testing.T) {
+	common.Config.AwsLambdaFunctionMemorySize = 1024
+	logs := mockLogger()
+	functionName := "myfunction"
+	lc := lambdacontext.LambdaContext{
+		InvokedFunctionArn: functionName,
+	}
+	err := processKinesis(&lc, events.KinesisEvent{
+		Records: []events.KinesisEventRecord{}, // empty, should do no work
+	})
+	require.NoError(t, err)
+	message := common.OpLogNamespace + ":" + common.OpLogComponent + ":" + functionName
+	require.Equal(t, 1, len(logs.FilterMessage(message).All())) // should be just one like this
+	assert.Equal(t, zapcore.InfoLevel, logs.FilterMessage(message).All()[0].Level)
+	kinesisRecordCount := logs.FilterMessage(message).All()[0].ContextMap()["kinesisRecordCount"]
+	switch v := kinesisRecordCount.(type) {
+	case int64:
+		assert.Equal(t, int64(0), v)
+	case int32:
+		assert.Equal(t, int32(0), v)
+	default:
+		t.Errorf("unknown type for kinesisRecordCount: %#v", kinesisRecordCount)
+	}
+}
@@ -72,16 +72,8 @@ func (p *AuditLogParser) Parse(log string) ([]*parsers.PantherLog, error) {
 			AuditLogSystemLogID,
 		})
 	}
-	ts := entry.Timestamp
-	if ts == nil {
-		// Fallback to ReceiveTimestamp which is a required field to get a timestamp hopefully closer to the actual event timestamp.
-		ts = entry.ReceiveTimestamp
-	}
-	entry.SetCoreFields(TypeAuditLog, ts, &entry)
-	if entry.HTTPRequest != nil {
-		entry.AppendAnyIPAddressPtr(entry.HTTPRequest.RemoteIP)
-		entry.AppendAnyIPAddressPtr(entry.HTTPRequest.ServerIP)
-	}
+	entry.SetCoreFields(TypeAuditLog, entry.timestamp(), &entry)
+	entry.appendAnyIPAddresses(&entry.PantherLog)
 	if meta := entry.Payload.RequestMetadata; meta != nil {
 		entry.AppendAnyIPAddressPtr(meta.CallerIP)
 	}
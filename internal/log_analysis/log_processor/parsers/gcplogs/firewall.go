@@ -0,0 +1,107 @@
+package gcplogs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/numerics"
+)
+
+type LogEntryFirewall struct {
+	LogEntry
+	Payload Firewall `json:"jsonPayload" validate:"required" description:"The firewall rule log payload"`
+
+	parsers.PantherLog
+}
+
+const (
+	FirewallLogID = "compute.googleapis.com%2Ffirewall"
+)
+
+type FirewallParser struct{}
+
+var _ parsers.LogParser = (*FirewallParser)(nil)
+
+func NewFirewallParser() parsers.LogParser {
+	return &FirewallParser{}
+}
+
+func (p *FirewallParser) LogType() string {
+	return TypeFirewall
+}
+
+// New creates a new log parser instance
+func (p *FirewallParser) New() parsers.LogParser {
+	return &FirewallParser{}
+}
+
+// Parse implements parsers.LogParser interface
+func (p *FirewallParser) Parse(log string) ([]*parsers.PantherLog, error) {
+	entry := LogEntryFirewall{}
+	if err := jsoniter.UnmarshalFromString(log, &entry); err != nil {
+		return nil, err
+	}
+	if id := entry.LogID(); id != FirewallLogID {
+		return nil, errors.Errorf("invalid LogID %q != %q", id, FirewallLogID)
+	}
+	entry.SetCoreFields(TypeFirewall, entry.timestamp(), &entry)
+	entry.Payload.Connection.appendAnyIPAddresses(&entry.PantherLog)
+	if err := parsers.Validator.Struct(entry); err != nil {
+		return nil, err
+	}
+	return entry.Logs(), nil
+}
+
+// nolint:lll
+// Reference https://cloud.google.com/vpc/docs/firewall-rules-logging#log-format
+type Firewall struct {
+	Connection     *IPConnection       `json:"connection" validate:"required" description:"5-tuple describing the connection"`
+	Disposition    *string             `json:"disposition" validate:"required,oneof=ALLOWED DENIED" description:"Whether the connection was ALLOWED or DENIED"`
+	RuleDetails    *FirewallRule       `json:"rule_details,omitempty" description:"Details of the firewall rule that was applied to the connection"`
+	Instance       *InstanceDetails    `json:"instance,omitempty" description:"Details of the VM instance on which the firewall rule was applied"`
+	VPC            *VPCDetails         `json:"vpc,omitempty" description:"Details of the VPC network on which the firewall rule was applied"`
+	RemoteInstance *InstanceDetails    `json:"remote_instance,omitempty" description:"If the remote endpoint of the connection was a VM located in Compute Engine, this field is populated with VM instance details."`
+	RemoteVPC      *VPCDetails         `json:"remote_vpc,omitempty" description:"If the remote endpoint of the connection was a VM that is located in a VPC network, this field is populated with the network details."`
+	RemoteLocation *GeographicDetails  `json:"remote_location,omitempty" description:"If the remote endpoint of the connection was external to the VPC network, this field is populated with available location metadata."`
+	GKEDetails     jsoniter.RawMessage `json:"gke_details,omitempty" description:"GKE metadata for the endpoint. Only available if the endpoint is GKE."`
+}
+
+// nolint:lll
+type FirewallRule struct {
+	Reference            *string          `json:"reference,omitempty" description:"Reference to the firewall rule; format: network:{network name}/firewall:{firewall_name}"`
+	Priority             *numerics.Int64  `json:"priority,omitempty" description:"The priority for the firewall rule."`
+	Action               *string          `json:"action,omitempty" description:"ALLOW or DENY"`
+	Direction            *string          `json:"direction,omitempty" description:"INGRESS or EGRESS"`
+	IPPortInfo           []FirewallIPPort `json:"ip_port_info,omitempty" description:"List of IP protocols and applicable port ranges for rules"`
+	SourceRange          []string         `json:"source_range,omitempty" description:"List of source ranges that the firewall rule applies to"`
+	DestinationRange     []string         `json:"destination_range,omitempty" description:"List of destination ranges that the firewall applies to"`
+	SourceTag            []string         `json:"source_tag,omitempty" description:"List of all the source tags that the firewall rule applies to"`
+	TargetTag            []string         `json:"target_tag,omitempty" description:"List of all the target tags that the firewall rule applies to"`
+	SourceServiceAccount []string         `json:"source_service_account,omitempty" description:"List of all the source service accounts that the firewall rule applies to"`
+	TargetServiceAccount []string         `json:"target_service_account,omitempty" description:"List of all the target service accounts that the firewall rule applies to"`
+}
+
+// nolint:lll
+type FirewallIPPort struct {
+	IPProtocol *string  `json:"ip_protocol,omitempty" description:"IP protocol to which the rule applies. 'ALL' if applies to all protocols."`
+	PortRange  []string `json:"port_range,omitempty" description:"List of applicable port ranges for rules"`
+}
@@ -0,0 +1,149 @@
+package gcplogs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/testutil"
+)
+
+func TestFirewallParser(t *testing.T) {
+	log := `{
+		"insertId": "hmvdjsf8ih8b4",
+		"jsonPayload": {
+			"connection": {
+				"dest_ip": "10.128.0.5",
+				"dest_port": 3389,
+				"protocol": 6,
+				"src_ip": "198.51.100.42",
+				"src_port": 61000
+			},
+			"disposition": "DENIED",
+			"instance": {
+				"project_id": "some-project-id",
+				"region": "us-central1",
+				"vm_name": "bastion",
+				"zone": "us-central1-a"
+			},
+			"remote_location": {
+				"continent": "America",
+				"country": "usa"
+			},
+			"rule_details": {
+				"action": "DENY",
+				"direction": "INGRESS",
+				"ip_port_info": [
+					{
+						"ip_protocol": "TCP",
+						"port_range": ["3389"]
+					}
+				],
+				"priority": 1000,
+				"reference": "network:default/firewall:deny-rdp",
+				"source_range": ["0.0.0.0/0"],
+				"target_tag": ["bastion"]
+			},
+			"vpc": {
+				"project_id": "some-project-id",
+				"subnetwork_name": "default",
+				"vpc_name": "default"
+			}
+		},
+		"logName": "projects/some-project-id/logs/compute.googleapis.com%2Ffirewall",
+		"receiveTimestamp": "2020-06-10T14:30:08.104537734Z",
+		"resource": {
+			"labels": {
+				"location": "us-central1-a",
+				"project_id": "some-project-id",
+				"subnetwork_id": "5400221838651466741",
+				"subnetwork_name": "default"
+			},
+			"type": "gce_subnetwork"
+		},
+		"timestamp": "2020-06-10T14:30:02.337826471Z"
+	}`
+
+	ts := mustParseTimestamp(t, "2020-06-10T14:30:02.337826471Z")
+	tsReceive := mustParseTimestamp(t, "2020-06-10T14:30:08.104537734Z")
+
+	entry := &LogEntryFirewall{
+		LogEntry: LogEntry{
+			LogName:          aws.String("projects/some-project-id/logs/compute.googleapis.com%2Ffirewall"),
+			InsertID:         aws.String("hmvdjsf8ih8b4"),
+			Timestamp:        ts,
+			ReceiveTimestamp: tsReceive,
+			Resource: MonitoredResource{
+				Type: aws.String("gce_subnetwork"),
+				Labels: Labels{
+					"location":        "us-central1-a",
+					"project_id":      "some-project-id",
+					"subnetwork_id":   "5400221838651466741",
+					"subnetwork_name": "default",
+				},
+			},
+		},
+		Payload: Firewall{
+			Connection: &IPConnection{
+				SrcIP:    aws.String("198.51.100.42"),
+				SrcPort:  newInteger(61000),
+				DestIP:   aws.String("10.128.0.5"),
+				DestPort: newInteger(3389),
+				Protocol: newInteger(6),
+			},
+			Disposition: aws.String("DENIED"),
+			Instance: &InstanceDetails{
+				ProjectID: aws.String("some-project-id"),
+				Region:    aws.String("us-central1"),
+				VMName:    aws.String("bastion"),
+				Zone:      aws.String("us-central1-a"),
+			},
+			RemoteLocation: &GeographicDetails{
+				Continent: aws.String("America"),
+				Country:   aws.String("usa"),
+			},
+			RuleDetails: &FirewallRule{
+				Action:    aws.String("DENY"),
+				Direction: aws.String("INGRESS"),
+				IPPortInfo: []FirewallIPPort{
+					{
+						IPProtocol: aws.String("TCP"),
+						PortRange:  []string{"3389"},
+					},
+				},
+				Priority:    newInt64(1000),
+				Reference:   aws.String("network:default/firewall:deny-rdp"),
+				SourceRange: []string{"0.0.0.0/0"},
+				TargetTag:   []string{"bastion"},
+			},
+			VPC: &VPCDetails{
+				ProjectID:      aws.String("some-project-id"),
+				SubnetworkName: aws.String("default"),
+				VPCName:        aws.String("default"),
+			},
+		},
+	}
+
+	entry.SetCoreFields(TypeFirewall, ts, entry)
+	entry.AppendAnyIPAddress("198.51.100.42")
+	entry.AppendAnyIPAddress("10.128.0.5")
+	testutil.CheckPantherParser(t, log, NewFirewallParser(), &entry.PantherLog)
+}
@@ -29,10 +29,13 @@ import (
 )
 
 const (
-	TypeAuditLog = "GCP.AuditLog"
+	TypeAuditLog         = "GCP.AuditLog"
+	TypeFirewall         = "GCP.Firewall"
+	TypeHTTPLoadBalancer = "GCP.HTTPLoadBalancer"
+	TypeVPCFlow          = "GCP.VPCFlow"
 )
 
-//nolint: lll
+// nolint: lll
 func init() {
 	logtypes.MustRegister(
 		logtypes.Config{
@@ -41,9 +44,30 @@ func init() {
 Google Cloud services write audit log entries to these logs to help you answer the questions of "who did what, where, and when?" within your Google Cloud resources.
 `,
 			ReferenceURL: `https://cloud.google.com/logging/docs/audit`,
-			Schema:       AuditLog{},
+			Schema:       LogEntryAuditLog{},
 			NewParser:    parsers.AdapterFactory(&AuditLogParser{}),
 		},
+		logtypes.Config{
+			Name:         TypeFirewall,
+			Description:  `Firewall Rules Logging lets you audit, verify, and analyze the effects of your VPC firewall rules. Each time a firewall rule that has logging enabled is applied to a connection, a record is logged.`,
+			ReferenceURL: `https://cloud.google.com/vpc/docs/firewall-rules-logging`,
+			Schema:       LogEntryFirewall{},
+			NewParser:    parsers.AdapterFactory(&FirewallParser{}),
+		},
+		logtypes.Config{
+			Name:         TypeHTTPLoadBalancer,
+			Description:  `HTTP(S) Load Balancing logs every request sent to an external HTTP(S) load balancer, including the request details and the security policy enforced on it.`,
+			ReferenceURL: `https://cloud.google.com/load-balancing/docs/https/https-logging-monitoring`,
+			Schema:       LogEntryHTTPLoadBalancer{},
+			NewParser:    parsers.AdapterFactory(&HTTPLoadBalancerParser{}),
+		},
+		logtypes.Config{
+			Name:         TypeVPCFlow,
+			Description:  `VPC Flow Logs record a sample of network flows sent from and received by VM instances, including instances used as GKE nodes.`,
+			ReferenceURL: `https://cloud.google.com/vpc/docs/using-flow-logs`,
+			Schema:       LogEntryVPCFlow{},
+			NewParser:    parsers.AdapterFactory(&VPCFlowParser{}),
+		},
 	)
 }
 
@@ -82,6 +106,23 @@ func (entry *LogEntry) LogID() string {
 	return ""
 }
 
+// timestamp returns the time the log entry occurred.
+// It falls back to ReceiveTimestamp which is a required field to get a timestamp hopefully closer to the actual event timestamp.
+func (entry *LogEntry) timestamp() *timestamp.RFC3339 {
+	if entry.Timestamp != nil {
+		return entry.Timestamp
+	}
+	return entry.ReceiveTimestamp
+}
+
+// appendAnyIPAddresses adds the remote and server IP addresses of the HTTP request (if any) to a PantherLog.
+func (entry *LogEntry) appendAnyIPAddresses(pl *parsers.PantherLog) {
+	if entry.HTTPRequest != nil {
+		pl.AppendAnyIPAddressPtr(entry.HTTPRequest.RemoteIP)
+		pl.AppendAnyIPAddressPtr(entry.HTTPRequest.ServerIP)
+	}
+}
+
 // nolint:lll
 type MonitoredResource struct {
 	Type   *string `json:"type" validate:"required" description:"Type of resource that produced this log entry"`
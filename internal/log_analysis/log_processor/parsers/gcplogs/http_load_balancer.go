@@ -0,0 +1,96 @@
+package gcplogs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/numerics"
+)
+
+type LogEntryHTTPLoadBalancer struct {
+	LogEntry
+	Payload HTTPLoadBalancer `json:"jsonPayload" validate:"required" description:"The HTTP(S) load balancer log payload"`
+
+	parsers.PantherLog
+}
+
+const (
+	HTTPLoadBalancerLogID        = "requests"
+	HTTPLoadBalancerResourceType = "http_load_balancer"
+	HTTPLoadBalancerPayloadType  = "type.googleapis.com/google.cloud.loadbalancing.type.LoadBalancerLogEntry"
+)
+
+type HTTPLoadBalancerParser struct{}
+
+var _ parsers.LogParser = (*HTTPLoadBalancerParser)(nil)
+
+func NewHTTPLoadBalancerParser() parsers.LogParser {
+	return &HTTPLoadBalancerParser{}
+}
+
+func (p *HTTPLoadBalancerParser) LogType() string {
+	return TypeHTTPLoadBalancer
+}
+
+// New creates a new log parser instance
+func (p *HTTPLoadBalancerParser) New() parsers.LogParser {
+	return &HTTPLoadBalancerParser{}
+}
+
+// Parse implements parsers.LogParser interface
+func (p *HTTPLoadBalancerParser) Parse(log string) ([]*parsers.PantherLog, error) {
+	entry := LogEntryHTTPLoadBalancer{}
+	if err := jsoniter.UnmarshalFromString(log, &entry); err != nil {
+		return nil, err
+	}
+	if id := entry.LogID(); id != HTTPLoadBalancerLogID {
+		return nil, errors.Errorf("invalid LogID %q != %q", id, HTTPLoadBalancerLogID)
+	}
+	// The `requests` log ID is not specific to load balancers
+	if t := entry.Resource.Type; t == nil || *t != HTTPLoadBalancerResourceType {
+		return nil, errors.Errorf("invalid resource type != %q", HTTPLoadBalancerResourceType)
+	}
+	entry.SetCoreFields(TypeHTTPLoadBalancer, entry.timestamp(), &entry)
+	entry.appendAnyIPAddresses(&entry.PantherLog)
+	if err := parsers.Validator.Struct(entry); err != nil {
+		return nil, err
+	}
+	return entry.Logs(), nil
+}
+
+// nolint:lll
+// Reference https://cloud.google.com/load-balancing/docs/https/https-logging-monitoring#what_is_logged
+type HTTPLoadBalancer struct {
+	PayloadType            *string                 `json:"@type" validate:"required,eq=type.googleapis.com/google.cloud.loadbalancing.type.LoadBalancerLogEntry" description:"The type of payload"`
+	StatusDetails          *string                 `json:"statusDetails,omitempty" description:"A textual description of the response code"`
+	CacheID                *string                 `json:"cacheId,omitempty" description:"The location and cache instance that the cache response was served from"`
+	EnforcedSecurityPolicy *EnforcedSecurityPolicy `json:"enforcedSecurityPolicy,omitempty" description:"The Google Cloud Armor security policy rule that was enforced on the request"`
+	PreviewSecurityPolicy  *EnforcedSecurityPolicy `json:"previewSecurityPolicy,omitempty" description:"The Google Cloud Armor security policy rule that would have been enforced on the request if it was not in preview mode"`
+}
+
+// nolint:lll
+type EnforcedSecurityPolicy struct {
+	Name             *string         `json:"name,omitempty" description:"The name of the security policy"`
+	Priority         *numerics.Int64 `json:"priority,omitempty" description:"The priority of the matching rule in the security policy"`
+	ConfiguredAction *string         `json:"configuredAction,omitempty" description:"The name of the configured action in the matching rule, for example ALLOW or DENY"`
+	Outcome          *string         `json:"outcome,omitempty" description:"The outcome of executing the configured action, for example ACCEPT or DENY"`
+}
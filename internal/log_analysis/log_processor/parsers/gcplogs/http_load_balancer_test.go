@@ -0,0 +1,137 @@
+package gcplogs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/testutil"
+)
+
+func TestHTTPLoadBalancerParser(t *testing.T) {
+	log := `{
+		"httpRequest": {
+			"latency": "0.012617s",
+			"remoteIp": "192.0.2.10",
+			"requestMethod": "GET",
+			"requestSize": "146",
+			"requestUrl": "https://example.com/admin",
+			"responseSize": "267",
+			"serverIp": "10.128.0.7",
+			"status": 403,
+			"userAgent": "curl/7.64.1"
+		},
+		"insertId": "1bg2l0vf8s5kqr",
+		"jsonPayload": {
+			"@type": "type.googleapis.com/google.cloud.loadbalancing.type.LoadBalancerLogEntry",
+			"enforcedSecurityPolicy": {
+				"configuredAction": "DENY",
+				"name": "block-admin",
+				"outcome": "DENY",
+				"priority": 100
+			},
+			"statusDetails": "denied_by_security_policy"
+		},
+		"logName": "projects/some-project-id/logs/requests",
+		"receiveTimestamp": "2020-06-10T15:11:03.409581244Z",
+		"resource": {
+			"labels": {
+				"backend_service_name": "web-backend",
+				"forwarding_rule_name": "web-https",
+				"project_id": "some-project-id",
+				"target_proxy_name": "web-proxy",
+				"url_map_name": "web-map",
+				"zone": "global"
+			},
+			"type": "http_load_balancer"
+		},
+		"severity": "WARNING",
+		"spanId": "5b3a4c3c0b0e8f1d",
+		"timestamp": "2020-06-10T15:11:02.535941Z",
+		"trace": "projects/some-project-id/traces/8a7f3b7c4d3d9a1e4b0e2b6f0c9d1e2f"
+	}`
+
+	ts := mustParseTimestamp(t, "2020-06-10T15:11:02.535941Z")
+	tsReceive := mustParseTimestamp(t, "2020-06-10T15:11:03.409581244Z")
+
+	entry := &LogEntryHTTPLoadBalancer{
+		LogEntry: LogEntry{
+			LogName:          aws.String("projects/some-project-id/logs/requests"),
+			InsertID:         aws.String("1bg2l0vf8s5kqr"),
+			Severity:         aws.String("WARNING"),
+			SpanID:           aws.String("5b3a4c3c0b0e8f1d"),
+			Trace:            aws.String("projects/some-project-id/traces/8a7f3b7c4d3d9a1e4b0e2b6f0c9d1e2f"),
+			Timestamp:        ts,
+			ReceiveTimestamp: tsReceive,
+			Resource: MonitoredResource{
+				Type: aws.String("http_load_balancer"),
+				Labels: Labels{
+					"backend_service_name": "web-backend",
+					"forwarding_rule_name": "web-https",
+					"project_id":           "some-project-id",
+					"target_proxy_name":    "web-proxy",
+					"url_map_name":         "web-map",
+					"zone":                 "global",
+				},
+			},
+			HTTPRequest: &HTTPRequest{
+				Latency:       aws.String("0.012617s"),
+				RemoteIP:      aws.String("192.0.2.10"),
+				RequestMethod: aws.String("GET"),
+				RequestSize:   newInt64(146),
+				RequestURL:    aws.String("https://example.com/admin"),
+				ResponseSize:  newInt64(267),
+				ServerIP:      aws.String("10.128.0.7"),
+				Status:        aws.Int16(403),
+				UserAgent:     aws.String("curl/7.64.1"),
+			},
+		},
+		Payload: HTTPLoadBalancer{
+			PayloadType: aws.String("type.googleapis.com/google.cloud.loadbalancing.type.LoadBalancerLogEntry"),
+			EnforcedSecurityPolicy: &EnforcedSecurityPolicy{
+				ConfiguredAction: aws.String("DENY"),
+				Name:             aws.String("block-admin"),
+				Outcome:          aws.String("DENY"),
+				Priority:         newInt64(100),
+			},
+			StatusDetails: aws.String("denied_by_security_policy"),
+		},
+	}
+
+	entry.SetCoreFields(TypeHTTPLoadBalancer, ts, entry)
+	entry.AppendAnyIPAddress("192.0.2.10")
+	entry.AppendAnyIPAddress("10.128.0.7")
+	testutil.CheckPantherParser(t, log, NewHTTPLoadBalancerParser(), &entry.PantherLog)
+}
+
+func TestHTTPLoadBalancerParserInvalidResourceType(t *testing.T) {
+	log := `{
+		"jsonPayload": {
+			"@type": "type.googleapis.com/google.cloud.loadbalancing.type.LoadBalancerLogEntry"
+		},
+		"logName": "projects/some-project-id/logs/requests",
+		"receiveTimestamp": "2020-06-10T15:11:03.409581244Z",
+		"resource": {"type": "cloud_run_revision", "labels": {}}
+	}`
+	_, err := NewHTTPLoadBalancerParser().Parse(log)
+	require.Error(t, err)
+}
@@ -0,0 +1,138 @@
+package gcplogs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/numerics"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/timestamp"
+)
+
+type LogEntryVPCFlow struct {
+	LogEntry
+	Payload VPCFlow `json:"jsonPayload" validate:"required" description:"The VPC flow log payload"`
+
+	parsers.PantherLog
+}
+
+const (
+	VPCFlowLogID = "compute.googleapis.com%2Fvpc_flows"
+)
+
+type VPCFlowParser struct{}
+
+var _ parsers.LogParser = (*VPCFlowParser)(nil)
+
+func NewVPCFlowParser() parsers.LogParser {
+	return &VPCFlowParser{}
+}
+
+func (p *VPCFlowParser) LogType() string {
+	return TypeVPCFlow
+}
+
+// New creates a new log parser instance
+func (p *VPCFlowParser) New() parsers.LogParser {
+	return &VPCFlowParser{}
+}
+
+// Parse implements parsers.LogParser interface
+func (p *VPCFlowParser) Parse(log string) ([]*parsers.PantherLog, error) {
+	entry := LogEntryVPCFlow{}
+	if err := jsoniter.UnmarshalFromString(log, &entry); err != nil {
+		return nil, err
+	}
+	if id := entry.LogID(); id != VPCFlowLogID {
+		return nil, errors.Errorf("invalid LogID %q != %q", id, VPCFlowLogID)
+	}
+	ts := entry.Payload.StartTime
+	if ts == nil {
+		ts = entry.timestamp()
+	}
+	entry.SetCoreFields(TypeVPCFlow, ts, &entry)
+	entry.Payload.Connection.appendAnyIPAddresses(&entry.PantherLog)
+	if err := parsers.Validator.Struct(entry); err != nil {
+		return nil, err
+	}
+	return entry.Logs(), nil
+}
+
+// nolint:lll
+// Reference https://cloud.google.com/vpc/docs/using-flow-logs#record_format
+type VPCFlow struct {
+	Connection     *IPConnection       `json:"connection" validate:"required" description:"5-tuple describing this connection"`
+	Reporter       *string             `json:"reporter" validate:"required,oneof=SRC DEST" description:"The side which reported the flow. Can be either SRC or DEST."`
+	RTTMillis      *numerics.Int64     `json:"rtt_msec,omitempty" description:"Latency as measured during the time interval, for TCP flows only. The measured latency is the time elapsed between sending a SEQ and receiving a corresponding ACK."`
+	BytesSent      *numerics.Int64     `json:"bytes_sent,omitempty" description:"Amount of bytes sent from the source to the destination"`
+	PacketsSent    *numerics.Int64     `json:"packets_sent,omitempty" description:"Number of packets sent from the source to the destination"`
+	StartTime      *timestamp.RFC3339  `json:"start_time,omitempty" description:"Timestamp of the first observed packet during the aggregated time interval."`
+	EndTime        *timestamp.RFC3339  `json:"end_time,omitempty" description:"Timestamp of the last observed packet during the aggregated time interval."`
+	SrcInstance    *InstanceDetails    `json:"src_instance,omitempty" description:"If the source of the connection was a VM located on the same VPC, this field is populated with VM instance details."`
+	DestInstance   *InstanceDetails    `json:"dest_instance,omitempty" description:"If the destination of the connection was a VM located on the same VPC, this field is populated with VM instance details."`
+	SrcVPC         *VPCDetails         `json:"src_vpc,omitempty" description:"If the source of the connection was a VM located on the same VPC, this field is populated with VPC network details."`
+	DestVPC        *VPCDetails         `json:"dest_vpc,omitempty" description:"If the destination of the connection was a VM located on the same VPC, this field is populated with VPC network details."`
+	SrcLocation    *GeographicDetails  `json:"src_location,omitempty" description:"If the source of the connection was external to the VPC, this field is populated with available location metadata."`
+	DestLocation   *GeographicDetails  `json:"dest_location,omitempty" description:"If the destination of the connection was external to the VPC, this field is populated with available location metadata."`
+	SrcGKEDetails  jsoniter.RawMessage `json:"src_gke_details,omitempty" description:"GKE metadata for source endpoints. Only available if the endpoint is GKE."`
+	DestGKEDetails jsoniter.RawMessage `json:"dest_gke_details,omitempty" description:"GKE metadata for destination endpoints. Only available if the endpoint is GKE."`
+}
+
+// nolint:lll
+type IPConnection struct {
+	SrcIP    *string           `json:"src_ip" validate:"required" description:"Source IP address"`
+	SrcPort  *numerics.Integer `json:"src_port,omitempty" description:"Source port"`
+	DestIP   *string           `json:"dest_ip" validate:"required" description:"Destination IP address"`
+	DestPort *numerics.Integer `json:"dest_port,omitempty" description:"Destination port"`
+	Protocol *numerics.Integer `json:"protocol,omitempty" description:"The IANA protocol number"`
+}
+
+func (c *IPConnection) appendAnyIPAddresses(pl *parsers.PantherLog) {
+	if c == nil {
+		return
+	}
+	pl.AppendAnyIPAddressPtr(c.SrcIP)
+	pl.AppendAnyIPAddressPtr(c.DestIP)
+}
+
+// nolint:lll
+type InstanceDetails struct {
+	ProjectID *string `json:"project_id,omitempty" description:"ID of the project containing the VM"`
+	VMName    *string `json:"vm_name,omitempty" description:"Instance name of the VM"`
+	Region    *string `json:"region,omitempty" description:"Region of the VM"`
+	Zone      *string `json:"zone,omitempty" description:"Zone of the VM"`
+}
+
+// nolint:lll
+type VPCDetails struct {
+	ProjectID      *string `json:"project_id,omitempty" description:"ID of the project containing the VPC"`
+	VPCName        *string `json:"vpc_name,omitempty" description:"VPC on which the VM is operating"`
+	SubnetworkName *string `json:"subnetwork_name,omitempty" description:"Subnetwork on which the VM is operating"`
+}
+
+// nolint:lll
+type GeographicDetails struct {
+	Continent *string         `json:"continent,omitempty" description:"Continent for external endpoints"`
+	Country   *string         `json:"country,omitempty" description:"Country for external endpoints, represented as ISO 3166-1 Alpha-3 country codes"`
+	Region    *string         `json:"region,omitempty" description:"Region for external endpoints"`
+	City      *string         `json:"city,omitempty" description:"City for external endpoints"`
+	ASN       *numerics.Int64 `json:"asn,omitempty" description:"The autonomous system number (ASN) of the external network to which this endpoint belongs."`
+}
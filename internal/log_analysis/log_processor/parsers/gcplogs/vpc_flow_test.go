@@ -0,0 +1,171 @@
+package gcplogs
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/numerics"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/testutil"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/timestamp"
+)
+
+func TestVPCFlowParser(t *testing.T) {
+	log := `{
+		"insertId": "1s4gtgqf1lj3ei",
+		"jsonPayload": {
+			"bytes_sent": "1264",
+			"connection": {
+				"dest_ip": "10.128.0.5",
+				"dest_port": 22,
+				"protocol": 6,
+				"src_ip": "203.0.113.17",
+				"src_port": 53210
+			},
+			"dest_instance": {
+				"project_id": "some-project-id",
+				"region": "us-central1",
+				"vm_name": "bastion",
+				"zone": "us-central1-a"
+			},
+			"dest_vpc": {
+				"project_id": "some-project-id",
+				"subnetwork_name": "default",
+				"vpc_name": "default"
+			},
+			"end_time": "2020-06-10T14:02:43.563498465Z",
+			"packets_sent": "12",
+			"reporter": "DEST",
+			"rtt_msec": "31",
+			"src_location": {
+				"asn": 15169,
+				"city": "Athens",
+				"continent": "Europe",
+				"country": "grc"
+			},
+			"start_time": "2020-06-10T14:02:41.472536114Z"
+		},
+		"logName": "projects/some-project-id/logs/compute.googleapis.com%2Fvpc_flows",
+		"receiveTimestamp": "2020-06-10T14:02:51.712981577Z",
+		"resource": {
+			"labels": {
+				"location": "us-central1-a",
+				"project_id": "some-project-id",
+				"subnetwork_id": "5400221838651466741",
+				"subnetwork_name": "default"
+			},
+			"type": "gce_subnetwork"
+		},
+		"timestamp": "2020-06-10T14:02:51.712981577Z"
+	}`
+
+	tsStart := mustParseTimestamp(t, "2020-06-10T14:02:41.472536114Z")
+	tsEnd := mustParseTimestamp(t, "2020-06-10T14:02:43.563498465Z")
+	tsReceive := mustParseTimestamp(t, "2020-06-10T14:02:51.712981577Z")
+
+	entry := &LogEntryVPCFlow{
+		LogEntry: LogEntry{
+			LogName:          aws.String("projects/some-project-id/logs/compute.googleapis.com%2Fvpc_flows"),
+			InsertID:         aws.String("1s4gtgqf1lj3ei"),
+			Timestamp:        tsReceive,
+			ReceiveTimestamp: tsReceive,
+			Resource: MonitoredResource{
+				Type: aws.String("gce_subnetwork"),
+				Labels: Labels{
+					"location":        "us-central1-a",
+					"project_id":      "some-project-id",
+					"subnetwork_id":   "5400221838651466741",
+					"subnetwork_name": "default",
+				},
+			},
+		},
+		Payload: VPCFlow{
+			Connection: &IPConnection{
+				SrcIP:    aws.String("203.0.113.17"),
+				SrcPort:  newInteger(53210),
+				DestIP:   aws.String("10.128.0.5"),
+				DestPort: newInteger(22),
+				Protocol: newInteger(6),
+			},
+			Reporter:    aws.String("DEST"),
+			RTTMillis:   newInt64(31),
+			BytesSent:   newInt64(1264),
+			PacketsSent: newInt64(12),
+			StartTime:   tsStart,
+			EndTime:     tsEnd,
+			DestInstance: &InstanceDetails{
+				ProjectID: aws.String("some-project-id"),
+				Region:    aws.String("us-central1"),
+				VMName:    aws.String("bastion"),
+				Zone:      aws.String("us-central1-a"),
+			},
+			DestVPC: &VPCDetails{
+				ProjectID:      aws.String("some-project-id"),
+				SubnetworkName: aws.String("default"),
+				VPCName:        aws.String("default"),
+			},
+			SrcLocation: &GeographicDetails{
+				ASN:       newInt64(15169),
+				City:      aws.String("Athens"),
+				Continent: aws.String("Europe"),
+				Country:   aws.String("grc"),
+			},
+		},
+	}
+
+	entry.SetCoreFields(TypeVPCFlow, tsStart, entry)
+	entry.AppendAnyIPAddress("203.0.113.17")
+	entry.AppendAnyIPAddress("10.128.0.5")
+	testutil.CheckPantherParser(t, log, NewVPCFlowParser(), &entry.PantherLog)
+}
+
+func TestVPCFlowParserInvalidLogID(t *testing.T) {
+	log := `{
+		"jsonPayload": {
+			"connection": {"src_ip": "203.0.113.17", "dest_ip": "10.128.0.5"},
+			"reporter": "SRC"
+		},
+		"logName": "projects/some-project-id/logs/compute.googleapis.com%2Ffirewall",
+		"receiveTimestamp": "2020-06-10T14:02:51.712981577Z",
+		"resource": {"type": "gce_subnetwork", "labels": {}}
+	}`
+	_, err := NewVPCFlowParser().Parse(log)
+	require.Error(t, err)
+}
+
+func mustParseTimestamp(t *testing.T, value string) *timestamp.RFC3339 {
+	t.Helper()
+	ts, err := time.Parse(time.RFC3339Nano, value)
+	require.NoError(t, err)
+	return (*timestamp.RFC3339)(&ts)
+}
+
+func newInteger(i int) *numerics.Integer {
+	n := numerics.Integer(i)
+	return &n
+}
+
+func newInt64(i int64) *numerics.Int64 {
+	n := numerics.Int64(i)
+	return &n
+}
@@ -0,0 +1,59 @@
+package processor
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/common"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/destinations"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/registry"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/sources"
+)
+
+/*
+ProcessKinesisEvents processes the records of a Kinesis event. Lambda already batches the records of
+each shard, so unlike StreamEvents there is no need to read more data before writing to S3.
+*/
+func ProcessKinesisEvents(event events.KinesisEvent) (kinesisRecordCount int, err error) {
+	return processKinesisEvents(event, Process, sources.ReadKinesisRecords)
+}
+
+// entry point for unit testing, pass in read/process functions
+func processKinesisEvents(event events.KinesisEvent,
+	processFunc func(chan *common.DataStream, destinations.Destination) error,
+	generateDataStreamsFunc func([]events.KinesisEventRecord) ([]*common.DataStream, error)) (int, error) {
+
+	dataStreams, err := generateDataStreamsFunc(event.Records)
+	if err != nil {
+		return 0, err
+	}
+
+	streamChan := make(chan *common.DataStream, len(dataStreams))
+	for _, dataStream := range dataStreams {
+		streamChan <- dataStream
+	}
+	close(streamChan) // this will cause processFunc() to return once all streams are processed
+
+	err = processFunc(streamChan, destinations.CreateS3Destination(registry.Default()))
+	if err != nil {
+		return 0, err
+	}
+	return len(event.Records), nil
+}
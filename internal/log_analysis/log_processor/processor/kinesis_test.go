@@ -0,0 +1,76 @@
+package processor
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/common"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/destinations"
+)
+
+var kinesisTestEvent = events.KinesisEvent{
+	Records: []events.KinesisEventRecord{
+		{EventSourceArn: "arn:aws:kinesis:us-west-2:123456789012:stream/test"},
+		{EventSourceArn: "arn:aws:kinesis:us-west-2:123456789012:stream/test"},
+	},
+}
+
+func TestProcessKinesisEvents(t *testing.T) {
+	initTest()
+	var processedStreams int
+	processFunc := func(streamChan chan *common.DataStream, _ destinations.Destination) error {
+		for range streamChan {
+			processedStreams++
+		}
+		return nil
+	}
+	recordCount, err := processKinesisEvents(kinesisTestEvent, processFunc, noopReadKinesisRecordsFunc)
+	require.NoError(t, err)
+	require.Equal(t, 2, recordCount)
+	require.Equal(t, 1, processedStreams)
+}
+
+func TestProcessKinesisEventsReadEventError(t *testing.T) {
+	initTest()
+	recordCount, err := processKinesisEvents(kinesisTestEvent, noopProcessorFunc, failReadKinesisRecordsFunc)
+	require.EqualError(t, err, "readEventError")
+	require.Equal(t, 0, recordCount)
+}
+
+func TestProcessKinesisEventsProcessError(t *testing.T) {
+	initTest()
+	recordCount, err := processKinesisEvents(kinesisTestEvent, failProcessorFunc, noopReadKinesisRecordsFunc)
+	require.EqualError(t, err, "processError")
+	require.Equal(t, 0, recordCount)
+}
+
+// returns a single stream, as if all records came from the same Kinesis stream
+func noopReadKinesisRecordsFunc(records []events.KinesisEventRecord) ([]*common.DataStream, error) {
+	return []*common.DataStream{{}}, nil
+}
+
+// simulated error decoding kinesis records
+func failReadKinesisRecordsFunc(records []events.KinesisEventRecord) ([]*common.DataStream, error) {
+	return nil, fmt.Errorf("readEventError")
+}
@@ -39,6 +39,7 @@ const (
 	// oplog keys
 	operationName = "parse"
 	statsKey      = "stats"
+	prefixKey     = "logTypePrefix"
 )
 
 var (
@@ -163,6 +164,11 @@ func (p *Processor) logStats(err error) {
 	p.operation.Stop()
 	p.operation.Log(err, zap.Any(statsKey, *p.classifier.Stats()))
 	for _, parserStats := range p.classifier.ParserStats() {
+		if p.input.Hints.S3 != nil && p.input.Hints.S3.LogTypePrefix != "" {
+			// the stats of streams routed by a source rule are reported per prefix
+			p.operation.Log(err, zap.Any(statsKey, *parserStats), zap.String(prefixKey, p.input.Hints.S3.LogTypePrefix))
+			continue
+		}
 		p.operation.Log(err, zap.Any(statsKey, *parserStats))
 	}
 }
@@ -176,7 +182,21 @@ type Processor struct {
 func NewProcessor(input *common.DataStream, parsers map[string]parsers.Interface) *Processor {
 	return &Processor{
 		input:      input,
-		classifier: classification.NewClassifier(parsers),
+		classifier: newClassifier(input, parsers),
 		operation:  common.OpLogManager.Start(operationName),
 	}
 }
+
+// newClassifier skips classification of streams with a known log type
+func newClassifier(input *common.DataStream, parsers map[string]parsers.Interface) classification.ClassifierAPI {
+	if input.LogType == nil {
+		return classification.NewClassifier(parsers)
+	}
+	parser, ok := parsers[*input.LogType]
+	if !ok {
+		// the log type may have been removed since the source was configured, fall back to classifying the logs
+		zap.L().Warn("no parser found for log type of stream, classifying logs", zap.String("logType", *input.LogType))
+		return classification.NewClassifier(parsers)
+	}
+	return classification.NewLogTypeClassifier(*input.LogType, parser)
+}
@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -36,6 +37,7 @@ import (
 	"github.com/panther-labs/panther/internal/log_analysis/log_processor/common"
 	"github.com/panther-labs/panther/internal/log_analysis/log_processor/destinations"
 	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/testutil"
 	"github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/timestamp"
 	"github.com/panther-labs/panther/internal/log_analysis/log_processor/registry"
 	"github.com/panther-labs/panther/pkg/oplog"
@@ -336,6 +338,54 @@ func TestProcessClassifyFailure(t *testing.T) {
 	}
 }
 
+// test that streams with a known log type are parsed only by its parser and report stats per prefix
+func TestProcessKnownLogType(t *testing.T) {
+	logs := mockLogger()
+
+	destination := (&testDestination{}).standardMock()
+	dataStream := makeDataStream()
+	dataStream.LogType = &testLogType
+	dataStream.Hints.S3 = &common.S3DataStreamHints{
+		Bucket:        testBucket,
+		Key:           testKey,
+		LogTypePrefix: "logs/",
+	}
+	otherParser := testutil.ParserConfig{}.Parser()
+	p := NewProcessor(dataStream, map[string]parsers.Interface{
+		testLogType:    testutil.ParserConfig{testLogLine: newTestLog()}.Parser(),
+		"otherLogType": otherParser,
+	})
+	require.IsType(t, &classification.LogTypeClassifier{}, p.classifier)
+
+	newProcessorFunc := func(*common.DataStream) *Processor { return p }
+	streamChan := make(chan *common.DataStream, 1)
+	streamChan <- dataStream
+	close(streamChan)
+	err := process(streamChan, destination, newProcessorFunc)
+	require.NoError(t, err)
+	require.Equal(t, testLogEvents, destination.nEvents)
+	otherParser.AssertNotCalled(t, "Parse", mock.Anything)
+
+	parserStats := p.classifier.ParserStats()[testLogType]
+	require.NotNil(t, parserStats)
+	require.Equal(t, testLogLines, parserStats.LogLineCount)
+	require.Equal(t, uint64(0), parserStats.ParseFailureCount)
+
+	parserLogs := logs.FilterField(zap.String(prefixKey, "logs/")).AllUntimed()
+	require.Len(t, parserLogs, 1)
+	require.Equal(t, *parserStats, parserLogs[0].ContextMap()[statsKey])
+}
+
+func TestNewProcessorUnknownLogType(t *testing.T) {
+	dataStream := makeDataStream()
+	dataStream.LogType = aws.String("missingLogType")
+	p := NewProcessor(dataStream, map[string]parsers.Interface{
+		testLogType: testutil.ParserConfig{}.Parser(),
+	})
+	// falls back to classifying the logs
+	require.IsType(t, &classification.Classifier{}, p.classifier)
+}
+
 // deals with the error package inserting line numbers into errors
 func assertLogEqual(t *testing.T, expected, actual observer.LoggedEntry) {
 	for k, v := range expected.ContextMap() {
@@ -409,9 +459,8 @@ func makeDataStream() (dataStream *common.DataStream) {
 		testData[i] = testLogLine
 	}
 	dataStream = &common.DataStream{
-		Reader:  strings.NewReader(strings.Join(testData, "\n")),
-		LogType: &testLogType,
-		Hints:   common.DataStreamHints{S3: s3Hint},
+		Reader: strings.NewReader(strings.Join(testData, "\n")),
+		Hints:  common.DataStreamHints{S3: s3Hint},
 	}
 	return
 }
@@ -426,10 +475,8 @@ func (fr *failingReader) Read(_ []byte) (int, error) {
 
 // returns a dataStream that will cause the parse to fail
 func makeBadDataStream() (dataStream *common.DataStream) {
-	testLogType := "testLogType"
 	dataStream = &common.DataStream{
-		Reader:  &failingReader{},
-		LogType: &testLogType,
+		Reader: &failingReader{},
 	}
 	return
 }
@@ -58,7 +58,7 @@ func StreamEvents(sqsClient sqsiface.SQSAPI, deadlineTime time.Time, event event
 // entry point for unit testing, pass in read/process functions
 func streamEvents(sqsClient sqsiface.SQSAPI, deadlineTime time.Time, event events.SQSEvent,
 	processFunc func(chan *common.DataStream, destinations.Destination) error,
-	generateDataStreamsFunc func([]string) ([]*common.DataStream, error)) (int, error) {
+	generateDataStreamsFunc func([]string, func(*common.DataStream)) error) (int, error) {
 
 	// these cannot be named return vars because it would cause a data race
 	var sqsMessageCount int
@@ -69,6 +69,12 @@ func streamEvents(sqsClient sqsiface.SQSAPI, deadlineTime time.Time, event event
 
 	var accumulatedMessageReceipts []*string // accumulate message receipts for delete at the end
 
+	// the streams are processed as they are read, archive members are read one after the other
+	emit := func(dataStream *common.DataStream) {
+		sqsMessageCount++
+		streamChan <- dataStream
+	}
+
 	readEventErrorChan := make(chan error, 1) // below go routine closes over this for errors, 1 deep buffer
 	go func() {
 		defer func() {
@@ -76,19 +82,12 @@ func streamEvents(sqsClient sqsiface.SQSAPI, deadlineTime time.Time, event event
 			close(readEventErrorChan) // no more writes on err chan
 		}()
 
-		// extract first set of messages from the lambda call, lambda handles delete of these
-		dataStreams, err := lambdaDataStreams(event, generateDataStreamsFunc)
-		if err != nil {
+		// extract and process first set of messages from the lambda call, lambda handles delete of these
+		if err := lambdaDataStreams(event, emit, generateDataStreamsFunc); err != nil {
 			readEventErrorChan <- err
 			return
 		}
 
-		// process lambda events
-		sqsMessageCount += len(dataStreams)
-		for _, dataStream := range dataStreams {
-			streamChan <- dataStream
-		}
-
 		// continue to read until either there are no sqs messages or we have exceeded the processing time/file limit
 		highMemoryCounter := 0
 		for isProcessingTimeRemaining(processingDeadlineTime) && len(accumulatedMessageReceipts) < processingMaxFilesLimit {
@@ -129,18 +128,11 @@ func streamEvents(sqsClient sqsiface.SQSAPI, deadlineTime time.Time, event event
 			// remember so we can delete when done
 			accumulatedMessageReceipts = append(accumulatedMessageReceipts, messageReceipts...)
 
-			// extract from sqs read responses
-			dataStreams, err = sqsDataStreams(messages, generateDataStreamsFunc)
-			if err != nil {
+			// extract and process sqs read responses
+			if err = sqsDataStreams(messages, emit, generateDataStreamsFunc); err != nil {
 				readEventErrorChan <- err
 				return
 			}
-
-			// process sqs messages
-			sqsMessageCount += len(dataStreams)
-			for _, dataStream := range dataStreams {
-				streamChan <- dataStream
-			}
 		}
 	}()
 
@@ -159,28 +151,28 @@ func streamEvents(sqsClient sqsiface.SQSAPI, deadlineTime time.Time, event event
 	return sqsMessageCount, nil
 }
 
-func lambdaDataStreams(event events.SQSEvent,
-	readSnsMessagesFunc func([]string) ([]*common.DataStream, error)) ([]*common.DataStream, error) {
+func lambdaDataStreams(event events.SQSEvent, emit func(*common.DataStream),
+	readSnsMessagesFunc func([]string, func(*common.DataStream)) error) error {
 
 	eventMessages := make([]string, len(event.Records))
 	for i, record := range event.Records {
 		eventMessages[i] = record.Body
 	}
-	return readSnsMessagesFunc(eventMessages)
+	return readSnsMessagesFunc(eventMessages, emit)
 }
 
 func isProcessingTimeRemaining(deadline time.Time) bool {
 	return time.Since(deadline) < 0 // deadline is in future, will be positive once passed
 }
 
-func sqsDataStreams(messages []*sqs.Message,
-	readSnsMessagesFunc func([]string) ([]*common.DataStream, error)) ([]*common.DataStream, error) {
+func sqsDataStreams(messages []*sqs.Message, emit func(*common.DataStream),
+	readSnsMessagesFunc func([]string, func(*common.DataStream)) error) error {
 
 	eventMessages := make([]string, len(messages))
 	for i, message := range messages {
 		eventMessages[i] = *message.Body
 	}
-	return readSnsMessagesFunc(eventMessages)
+	return readSnsMessagesFunc(eventMessages, emit)
 }
 
 func queueDepth(sqsClient sqsiface.SQSAPI) (numberOfQueuedMessages int, err error) {
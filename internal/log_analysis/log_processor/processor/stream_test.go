@@ -234,11 +234,14 @@ func failProcessorFunc(streamChan chan *common.DataStream, dest destinations.Des
 	return fmt.Errorf("processError")
 }
 
-func noopReadSnsMessagesFunc(messages []string) ([]*common.DataStream, error) {
-	return make([]*common.DataStream, len(messages)), nil
+func noopReadSnsMessagesFunc(messages []string, emit func(*common.DataStream)) error {
+	for range messages {
+		emit(&common.DataStream{})
+	}
+	return nil
 }
 
 // simulated error parsing sqs message or reading s3 object
-func failReadSnsMessagesFunc(messages []string) ([]*common.DataStream, error) {
-	return nil, fmt.Errorf("readEventError")
+func failReadSnsMessagesFunc(messages []string, emit func(*common.DataStream)) error {
+	return fmt.Errorf("readEventError")
 }
@@ -29,6 +29,7 @@ import (
 	_ "github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/apachelogs"
 	_ "github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/awslogs"
 	_ "github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/fluentdsyslogs"
+	_ "github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/gcplogs"
 	_ "github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/gitlablogs"
 	_ "github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/juniperlogs"
 	_ "github.com/panther-labs/panther/internal/log_analysis/log_processor/parsers/nginxlogs"
@@ -0,0 +1,60 @@
+package sources
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/panther-labs/panther/api/lambda/source/models"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/common"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/customlogs"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/registry"
+	"github.com/panther-labs/panther/pkg/genericapi"
+)
+
+// How frequently to query the sources_api for changes to custom log types
+const customLogsCacheDuration = 5 * time.Minute
+
+var customLogsUpdateTime = time.Unix(0, 0)
+
+// LoadCustomLogTypes syncs the custom log types defined in the sources_api into the default registry.
+// The custom log types are only fetched if more than customLogsCacheDuration has passed since the last sync.
+func LoadCustomLogTypes(now time.Time) error {
+	if customLogsUpdateTime.Add(customLogsCacheDuration).After(now) {
+		return nil
+	}
+	input := &models.LambdaInput{
+		ListCustomLogs: &models.ListCustomLogsInput{},
+	}
+	var output []*models.CustomLog
+	if err := genericapi.Invoke(common.LambdaClient, sourceAPIFunctionName, input, &output); err != nil {
+		return errors.Wrap(err, "failed to list custom log types")
+	}
+	schemas, err := customlogs.ParseCustomLogs(output)
+	if err != nil {
+		return err
+	}
+	if err := customlogs.Sync(registry.Default(), schemas...); err != nil {
+		return err
+	}
+	customLogsUpdateTime = now
+	return nil
+}
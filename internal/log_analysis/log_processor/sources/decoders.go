@@ -0,0 +1,428 @@
+package sources
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/common"
+)
+
+const (
+	// http.DetectContentType only uses up to the first 512 bytes, tar headers are also 512 bytes long
+	headerPeekSize = 512
+	// maxDecodeDepth limits how many layers of compression/archives we unwrap (e.g. a .tar.gz is 2 layers)
+	maxDecodeDepth = 3
+	// maxZipArchiveSize limits the size of zip archives, which have to be buffered in memory to be read
+	maxZipArchiveSize = 64 * 1024 * 1024
+	// maxDecodedSize limits the size of each decompressed stream or archive member to guard against zip bombs
+	maxDecodedSize = 4 * 1024 * 1024 * 1024
+)
+
+// ErrDataStreamTooLarge is returned when reading a stream that exceeds one of the size limits
+var ErrDataStreamTooLarge = errors.New("data stream exceeds size limit")
+
+// Format describes a compression or container format that can be unwrapped into plain log data.
+// A format either decompresses a single stream (Decompress) or extracts the members of an archive (Unarchive).
+type Format struct {
+	Name string
+	// Match returns true if the header of a stream (up to 512 bytes) is of this format
+	Match func(header []byte) bool
+	// Decompress wraps a compressed stream with a reader of the decompressed data, closed once it has been read
+	Decompress func(r io.Reader) (io.ReadCloser, error)
+	// Unarchive returns a reader over the members of an archive
+	Unarchive func(r io.Reader) (ArchiveReader, error)
+}
+
+// ArchiveReader iterates over the members of an archive.
+// Next returns io.EOF when there are no more members. The reader of a member is only valid until the next call
+// and is closed once it has been read.
+type ArchiveReader interface {
+	Next() (*ArchiveMember, error)
+}
+
+// ArchiveMember is a file contained in an archive
+type ArchiveMember struct {
+	Name   string
+	Reader io.ReadCloser
+}
+
+// Validate verifies a format is valid
+func (f *Format) Validate() error {
+	if f == nil {
+		return errors.New("nil format")
+	}
+	if f.Name == "" {
+		return errors.New("missing format name")
+	}
+	if f.Match == nil {
+		return errors.Errorf("missing match function for format %q", f.Name)
+	}
+	if (f.Decompress == nil) == (f.Unarchive == nil) {
+		return errors.Errorf("format %q must define exactly one of Decompress or Unarchive", f.Name)
+	}
+	return nil
+}
+
+var (
+	formatsMu sync.RWMutex
+	formats   []*Format
+)
+
+// RegisterFormat adds a format to the formats recognized when reading data streams.
+// Formats are matched in the order they were registered.
+func RegisterFormat(format *Format) error {
+	if err := format.Validate(); err != nil {
+		return err
+	}
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+	for _, f := range formats {
+		if f.Name == format.Name {
+			return errors.Errorf("duplicate format %q", format.Name)
+		}
+	}
+	formats = append(formats, format)
+	return nil
+}
+
+// MustRegisterFormat registers a format panicking if an error occurs
+func MustRegisterFormat(formats ...*Format) {
+	for _, format := range formats {
+		if err := RegisterFormat(format); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func matchFormat(header []byte) *Format {
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+	for _, f := range formats {
+		if f.Match(header) {
+			return f
+		}
+	}
+	return nil
+}
+
+func init() {
+	MustRegisterFormat(
+		&Format{
+			Name:  "gzip",
+			Match: matchPrefix("\x1f\x8b"),
+			Decompress: func(r io.Reader) (io.ReadCloser, error) {
+				return gzip.NewReader(r)
+			},
+		},
+		&Format{
+			Name:  "bzip2",
+			Match: matchPrefix("BZh"),
+			Decompress: func(r io.Reader) (io.ReadCloser, error) {
+				return ioutil.NopCloser(bzip2.NewReader(r)), nil
+			},
+		},
+		&Format{
+			Name:  "zstd",
+			Match: matchPrefix("\x28\xb5\x2f\xfd"),
+			Decompress: func(r io.Reader) (io.ReadCloser, error) {
+				decoder, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+				if err != nil {
+					return nil, err
+				}
+				// Closing stops the decoder goroutines
+				return decoder.IOReadCloser(), nil
+			},
+		},
+		&Format{
+			Name:      "zip",
+			Match:     matchPrefix("PK\x03\x04", "PK\x05\x06"),
+			Unarchive: unzip,
+		},
+		&Format{
+			Name: "tar",
+			Match: func(header []byte) bool {
+				// POSIX and GNU tar files have the "ustar" magic at offset 257
+				const magicOffset = 257
+				return len(header) > magicOffset+5 && string(header[magicOffset:magicOffset+5]) == "ustar"
+			},
+			Unarchive: untar,
+		},
+	)
+}
+
+func matchPrefix(prefixes ...string) func([]byte) bool {
+	return func(header []byte) bool {
+		for _, prefix := range prefixes {
+			if bytes.HasPrefix(header, []byte(prefix)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Zip archives keep their directory at the end of the file so the archive is read in memory, up to maxZipArchiveSize.
+// Members are decompressed as they are read.
+func unzip(r io.Reader) (ArchiveReader, error) {
+	data, err := ioutil.ReadAll(newLimitedReader(r, maxZipArchiveSize))
+	if err != nil {
+		return nil, err
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	return &zipReader{files: archive.File}, nil
+}
+
+type zipReader struct {
+	files []*zip.File
+}
+
+func (z *zipReader) Next() (*ArchiveMember, error) {
+	for len(z.files) > 0 {
+		file := z.files[0]
+		z.files = z.files[1:]
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open zip member %q", file.Name)
+		}
+		return &ArchiveMember{
+			Name:   file.Name,
+			Reader: rc,
+		}, nil
+	}
+	return nil, io.EOF
+}
+
+// Tar members are read sequentially straight from the archive stream
+func untar(r io.Reader) (ArchiveReader, error) {
+	return &tarReader{archive: tar.NewReader(r)}, nil
+}
+
+type tarReader struct {
+	archive *tar.Reader
+}
+
+func (t *tarReader) Next() (*ArchiveMember, error) {
+	for {
+		header, err := t.archive.Next()
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		return &ArchiveMember{
+			Name:   header.Name,
+			Reader: ioutil.NopCloser(t.archive),
+		}, nil
+	}
+}
+
+// limitedReader fails with ErrDataStreamTooLarge instead of silently truncating like io.LimitReader
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func newLimitedReader(r io.Reader, limit int64) io.Reader {
+	return &limitedReader{
+		r:         r,
+		remaining: limit,
+	}
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, ErrDataStreamTooLarge
+	}
+	// read one byte past the limit to tell a stream of exactly the limit from a larger one
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n + int(l.remaining), ErrDataStreamTooLarge
+	}
+	return n, err
+}
+
+// decodeDataStream unwraps any registered compression and archive formats of a stream.
+// It calls emit with one data stream for each plain text file found, in the order they are found.
+// Archive members are read straight from the archive, so emit must hand off each stream to be read:
+// decodeDataStream only moves on to the next member once the streams of the current one have been read.
+func decodeDataStream(r io.Reader, hints common.DataStreamHints, emit func(*common.DataStream)) error {
+	return decodeDataStreamDepth(r, hints, 0, emit)
+}
+
+func decodeDataStreamDepth(r io.Reader, hints common.DataStreamHints, depth int, emit func(*common.DataStream)) error {
+	bufferedReader := bufio.NewReader(r)
+	header, err := bufferedReader.Peek(headerPeekSize)
+	if err != nil && err != bufio.ErrBufferFull && err != io.EOF { // EOF or ErrBufferFull means stream is shorter than n
+		return errors.Wrap(err, "failed to Peek() stream header")
+	}
+
+	if depth == 0 && hints.S3 != nil {
+		s3Hints := *hints.S3
+		s3Hints.ContentType = http.DetectContentType(header)
+		hints.S3 = &s3Hints
+	}
+
+	format := matchFormat(header)
+	if format == nil {
+		// Checking for prefix because the returned type can have also charset used
+		if contentType := http.DetectContentType(header); !strings.HasPrefix(contentType, "text/plain") {
+			return &ErrUnsupportedFileType{Type: contentType}
+		}
+		emit(&common.DataStream{
+			Reader: bufferedReader,
+			Hints:  hints,
+		})
+		return nil
+	}
+
+	if depth >= maxDecodeDepth {
+		return &ErrUnsupportedFileType{Type: format.Name + " nested too deep"}
+	}
+
+	if format.Decompress != nil {
+		decompressed, err := format.Decompress(bufferedReader)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create %s reader", format.Name)
+		}
+		reader := newClosingReader(newLimitedReader(decompressed, maxDecodedSize), decompressed)
+		if err := decodeDataStreamDepth(reader, hints, depth+1, emit); err != nil {
+			reader.Close()
+			return err
+		}
+		return nil
+	}
+
+	archive, err := format.Unarchive(bufferedReader)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s archive", format.Name)
+	}
+	for {
+		member, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s archive", format.Name)
+		}
+		if err := decodeArchiveMember(format.Name, member, hints, depth+1, emit); err != nil {
+			return err
+		}
+	}
+	// Read what is left after the last member so that the readers of the archive stream reach EOF and are closed
+	if _, err := io.Copy(ioutil.Discard, bufferedReader); err != nil {
+		return errors.Wrapf(err, "failed to read %s archive", format.Name)
+	}
+	return nil
+}
+
+// decodeArchiveMember emits the streams of an archive member with their own hints and waits for them to be read
+func decodeArchiveMember(format string, member *ArchiveMember, hints common.DataStreamHints, depth int,
+	emit func(*common.DataStream)) error {
+
+	reader := newClosingReader(newLimitedReader(member.Reader, maxDecodedSize), member.Reader)
+	defer reader.Close()
+
+	hints.Archive = &common.ArchiveDataStreamHints{
+		Format: format,
+		Member: member.Name,
+	}
+	var streams []*closingReader
+	err := decodeDataStreamDepth(reader, hints, depth, func(stream *common.DataStream) {
+		streamReader := newClosingReader(stream.Reader, nil)
+		streams = append(streams, streamReader)
+		stream.Reader = streamReader
+		emit(stream)
+	})
+	if err != nil {
+		if _, ok := err.(*ErrUnsupportedFileType); ok {
+			// Skip unsupported archive members (e.g. a README.pdf) but keep the rest
+			return nil
+		}
+		return errors.Wrapf(err, "failed to read %s member %q", format, member.Name)
+	}
+	for _, stream := range streams {
+		<-stream.done
+	}
+	return nil
+}
+
+// closingReader closes the underlying reader once a read fails or reaches EOF, and then closes done.
+// The error is kept and returned by later reads, so the closed reader is never read again.
+type closingReader struct {
+	r      io.Reader
+	closer io.Closer
+	err    error
+	once   sync.Once
+	done   chan struct{}
+}
+
+func newClosingReader(r io.Reader, closer io.Closer) *closingReader {
+	return &closingReader{
+		r:      r,
+		closer: closer,
+		done:   make(chan struct{}),
+	}
+}
+
+func (c *closingReader) Read(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.r.Read(p)
+	if err != nil {
+		c.err = err
+		c.Close()
+	}
+	return n, err
+}
+
+func (c *closingReader) Close() (err error) {
+	c.once.Do(func() {
+		if c.closer != nil {
+			err = c.closer.Close()
+		}
+		close(c.done)
+	})
+	return err
+}
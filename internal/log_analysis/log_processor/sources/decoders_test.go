@@ -0,0 +1,265 @@
+package sources
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/common"
+)
+
+const testLines = "line1\nline2\n"
+
+var testHints = common.DataStreamHints{
+	S3: &common.S3DataStreamHints{
+		Bucket: "mybucket",
+		Key:    "mykey",
+	},
+}
+
+// decodedStream is a data stream read as soon as it is emitted, like the log processor does
+type decodedStream struct {
+	Data  string
+	Hints common.DataStreamHints
+}
+
+func decodeAll(t *testing.T, r io.Reader) ([]*decodedStream, error) {
+	t.Helper()
+	var streams []*decodedStream
+	err := decodeDataStream(r, testHints, func(stream *common.DataStream) {
+		data, err := ioutil.ReadAll(stream.Reader)
+		require.NoError(t, err)
+		streams = append(streams, &decodedStream{Data: string(data), Hints: stream.Hints})
+	})
+	return streams, err
+}
+
+func TestDecodePlainText(t *testing.T) {
+	streams, err := decodeAll(t, bytes.NewReader([]byte(testLines)))
+	require.NoError(t, err)
+	require.Len(t, streams, 1)
+	require.Equal(t, testLines, streams[0].Data)
+	require.Equal(t, "text/plain; charset=utf-8", streams[0].Hints.S3.ContentType)
+	require.Nil(t, streams[0].Hints.Archive)
+}
+
+func TestDecodeGzip(t *testing.T) {
+	streams, err := decodeAll(t, bytes.NewReader(gzipData(t, []byte(testLines))))
+	require.NoError(t, err)
+	require.Len(t, streams, 1)
+	require.Equal(t, testLines, streams[0].Data)
+	require.Equal(t, "application/x-gzip", streams[0].Hints.S3.ContentType)
+}
+
+func TestDecodeBzip2(t *testing.T) {
+	f, err := os.Open("testdata/lines.txt.bz2")
+	require.NoError(t, err)
+	defer f.Close()
+	streams, err := decodeAll(t, f)
+	require.NoError(t, err)
+	require.Len(t, streams, 1)
+	require.Equal(t, testLines, streams[0].Data)
+}
+
+func TestDecodeZstd(t *testing.T) {
+	var buffer bytes.Buffer
+	w, err := zstd.NewWriter(&buffer)
+	require.NoError(t, err)
+	_, err = w.Write([]byte(testLines))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	streams, err := decodeAll(t, &buffer)
+	require.NoError(t, err)
+	require.Len(t, streams, 1)
+	require.Equal(t, testLines, streams[0].Data)
+}
+
+func TestDecodeZip(t *testing.T) {
+	var buffer bytes.Buffer
+	w := zip.NewWriter(&buffer)
+	writeZipMember(t, w, "a.log", []byte("a1\na2\n"))
+	writeZipMember(t, w, "b.log.gz", gzipData(t, []byte("b1\n")))
+	writeZipMember(t, w, "image.png", []byte("\x89PNG\x0D\x0A\x1A\x0A"))
+	require.NoError(t, w.Close())
+
+	streams, err := decodeAll(t, &buffer)
+	require.NoError(t, err)
+	// unsupported members are skipped
+	require.Len(t, streams, 2)
+	require.Equal(t, "a1\na2\n", streams[0].Data)
+	require.Equal(t, &common.ArchiveDataStreamHints{Format: "zip", Member: "a.log"}, streams[0].Hints.Archive)
+	require.Equal(t, "b1\n", streams[1].Data)
+	require.Equal(t, &common.ArchiveDataStreamHints{Format: "zip", Member: "b.log.gz"}, streams[1].Hints.Archive)
+	// every member keeps the hints of the S3 object
+	require.Equal(t, "mykey", streams[1].Hints.S3.Key)
+	require.Equal(t, "application/zip", streams[1].Hints.S3.ContentType)
+}
+
+func TestDecodeTarGzip(t *testing.T) {
+	var buffer bytes.Buffer
+	w := tar.NewWriter(&buffer)
+	writeTarMember(t, w, "logs/a.log", []byte("a1\n"))
+	writeTarMember(t, w, "logs/b.log", []byte("b1\nb2\n"))
+	require.NoError(t, w.Close())
+
+	streams, err := decodeAll(t, bytes.NewReader(gzipData(t, buffer.Bytes())))
+	require.NoError(t, err)
+	require.Len(t, streams, 2)
+	require.Equal(t, "a1\n", streams[0].Data)
+	require.Equal(t, &common.ArchiveDataStreamHints{Format: "tar", Member: "logs/a.log"}, streams[0].Hints.Archive)
+	require.Equal(t, "b1\nb2\n", streams[1].Data)
+	require.Equal(t, &common.ArchiveDataStreamHints{Format: "tar", Member: "logs/b.log"}, streams[1].Hints.Archive)
+}
+
+func TestDecodeArchiveMembersAreReadInOrder(t *testing.T) {
+	var buffer bytes.Buffer
+	w := tar.NewWriter(&buffer)
+	writeTarMember(t, w, "a.log", []byte("a1\n"))
+	writeTarMember(t, w, "b.log", []byte("b1\n"))
+	require.NoError(t, w.Close())
+
+	// the streams are handed off to be read elsewhere, as the log processor does
+	streamChan := make(chan *common.DataStream, 2)
+	errChan := make(chan error, 1)
+	go func() {
+		defer close(streamChan)
+		errChan <- decodeDataStream(&buffer, testHints, func(stream *common.DataStream) {
+			streamChan <- stream
+		})
+	}()
+
+	var data []string
+	for stream := range streamChan {
+		// the next member is only emitted once the current one has been read
+		require.Len(t, streamChan, 0)
+		read, err := ioutil.ReadAll(stream.Reader)
+		require.NoError(t, err)
+		data = append(data, string(read))
+	}
+	require.NoError(t, <-errChan)
+	require.Equal(t, []string{"a1\n", "b1\n"}, data)
+}
+
+func TestClosingReader(t *testing.T) {
+	closer := &countingCloser{}
+	r := newClosingReader(bytes.NewReader([]byte(testLines)), closer)
+	_, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, 1, closer.closed)
+	<-r.done
+	// closing again is a no-op
+	require.NoError(t, r.Close())
+	require.Equal(t, 1, closer.closed)
+}
+
+func TestDecodeUnsupported(t *testing.T) {
+	_, err := decodeAll(t, bytes.NewReader([]byte("\x89PNG\x0D\x0A\x1A\x0A")))
+	require.Error(t, err)
+	require.IsType(t, &ErrUnsupportedFileType{}, err)
+}
+
+func TestDecodeNestedTooDeep(t *testing.T) {
+	data := []byte(testLines)
+	for i := 0; i <= maxDecodeDepth; i++ {
+		data = gzipData(t, data)
+	}
+	_, err := decodeAll(t, bytes.NewReader(data))
+	require.Error(t, err)
+	require.IsType(t, &ErrUnsupportedFileType{}, err)
+}
+
+func TestDecodeTooLarge(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 10)
+	r := newLimitedReader(bytes.NewReader(data), 10)
+	read, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, read)
+
+	r = newLimitedReader(bytes.NewReader(data), 9)
+	read, err = ioutil.ReadAll(r)
+	require.Equal(t, ErrDataStreamTooLarge, err)
+	require.Equal(t, data[:9], read)
+}
+
+func TestRegisterFormat(t *testing.T) {
+	require.Error(t, RegisterFormat(&Format{Name: "gzip", Match: matchPrefix("x"), Decompress: nopDecompress}))
+	require.Error(t, RegisterFormat(&Format{Name: "nomatch", Decompress: nopDecompress}))
+	require.Error(t, RegisterFormat(&Format{Name: "nodecoder", Match: matchPrefix("x")}))
+}
+
+func nopDecompress(r io.Reader) (io.ReadCloser, error) {
+	return ioutil.NopCloser(r), nil
+}
+
+func requireStreamData(t *testing.T, expect string, stream *common.DataStream) {
+	t.Helper()
+	data, err := ioutil.ReadAll(stream.Reader)
+	require.NoError(t, err)
+	require.Equal(t, expect, string(data))
+}
+
+type countingCloser struct {
+	closed int
+}
+
+func (c *countingCloser) Close() error {
+	c.closed++
+	return nil
+}
+
+func gzipData(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	w := gzip.NewWriter(&buffer)
+	_, err := w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buffer.Bytes()
+}
+
+func writeZipMember(t *testing.T, w *zip.Writer, name string, data []byte) {
+	t.Helper()
+	f, err := w.Create(name)
+	require.NoError(t, err)
+	_, err = f.Write(data)
+	require.NoError(t, err)
+}
+
+func writeTarMember(t *testing.T, w *tar.Writer, name string, data []byte) {
+	t.Helper()
+	require.NoError(t, w.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0600,
+		Size:     int64(len(data)),
+		Typeflag: tar.TypeReg,
+	}))
+	_, err := w.Write(data)
+	require.NoError(t, err)
+}
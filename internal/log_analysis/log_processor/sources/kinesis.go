@@ -0,0 +1,152 @@
+package sources
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+
+	"github.com/aws/aws-lambda-go/events"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/common"
+)
+
+const (
+	// CloudWatch Logs subscription filters deliver gzipped JSON envelopes of these types
+	cloudWatchLogsDataMessage    = "DATA_MESSAGE"
+	cloudWatchLogsControlMessage = "CONTROL_MESSAGE"
+)
+
+// ReadKinesisRecords reads incoming Kinesis records and returns one DataStream per source stream.
+// Records that carry CloudWatch Logs subscription payloads are unwrapped to their log events.
+// Records that cannot be decoded are logged and skipped, so a single bad record does not block the shard.
+func ReadKinesisRecords(records []events.KinesisEventRecord) (result []*common.DataStream, err error) {
+	zap.L().Debug("reading data from kinesis records", zap.Int("numRecords", len(records)))
+
+	// keep the order in which streams first appear in the batch
+	var streamArns []string
+	buffers := make(map[string]*bytes.Buffer)
+	for i := range records {
+		record := &records[i]
+		buffer, ok := buffers[record.EventSourceArn]
+		if !ok {
+			buffer = &bytes.Buffer{}
+			buffers[record.EventSourceArn] = buffer
+			streamArns = append(streamArns, record.EventSourceArn)
+		}
+		if err := readKinesisRecord(record, buffer); err != nil {
+			zap.L().Warn("skipping kinesis record",
+				zap.String("streamArn", record.EventSourceArn),
+				zap.String("eventId", record.EventID),
+				zap.Error(err))
+		}
+	}
+
+	for _, streamArn := range streamArns {
+		recordKinesisSourceStatus(streamArn)
+		result = append(result, &common.DataStream{
+			Reader: buffers[streamArn],
+			Hints: common.DataStreamHints{
+				Kinesis: &common.KinesisDataStreamHints{
+					StreamARN: streamArn,
+				},
+			},
+		})
+	}
+	return result, nil
+}
+
+// readKinesisRecord appends the log lines contained in a record to the buffer
+func readKinesisRecord(record *events.KinesisEventRecord, buffer *bytes.Buffer) error {
+	data := record.Kinesis.Data
+	if !isGzip(data) {
+		writeLine(buffer, data)
+		return nil
+	}
+
+	payload, err := gunzip(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decompress kinesis record %s", record.EventID)
+	}
+
+	var logsData events.CloudwatchLogsData
+	if err := jsoniter.Unmarshal(payload, &logsData); err != nil || logsData.MessageType == "" {
+		// Not a CloudWatch Logs envelope, the record is just compressed
+		writeLine(buffer, payload)
+		return nil
+	}
+
+	switch logsData.MessageType {
+	case cloudWatchLogsDataMessage:
+		for _, logEvent := range logsData.LogEvents {
+			writeLine(buffer, []byte(logEvent.Message))
+		}
+	case cloudWatchLogsControlMessage:
+		// CloudWatch Logs sends these to check that the destination is reachable
+		zap.L().Debug("skipping cloudwatch logs control message", zap.String("eventId", record.EventID))
+	default:
+		return errors.Errorf("unexpected cloudwatch logs message type %q in kinesis record %s",
+			logsData.MessageType, record.EventID)
+	}
+	return nil
+}
+
+// writeLine writes data to the buffer making sure it is terminated by the event delimiter
+func writeLine(buffer *bytes.Buffer, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	buffer.Write(data)
+	if data[len(data)-1] != common.EventDelimiter {
+		buffer.WriteByte(common.EventDelimiter)
+	}
+}
+
+func isGzip(data []byte) bool {
+	return len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b
+}
+
+func gunzip(data []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+// recordKinesisSourceStatus updates the status of the source the stream belongs to, if one is configured.
+// Records are processed even if no source is found, since the source cache might not yet include new sources.
+func recordKinesisSourceStatus(streamArn string) {
+	source, err := getKinesisSourceInfo(streamArn)
+	if err != nil {
+		// best effort - if we fail to find the source, just log a warning
+		zap.L().Warn("failed to fetch source for kinesis stream",
+			zap.String("streamArn", streamArn),
+			zap.Error(err))
+		return
+	}
+	if source == nil {
+		zap.L().Warn("there is no source configured for kinesis stream", zap.String("streamArn", streamArn))
+	}
+}
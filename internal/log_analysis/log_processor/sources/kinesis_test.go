@@ -0,0 +1,144 @@
+package sources
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/api/lambda/source/models"
+	"github.com/panther-labs/panther/internal/log_analysis/log_processor/common"
+	"github.com/panther-labs/panther/pkg/testutils"
+)
+
+const (
+	testStreamArn      = "arn:aws:kinesis:us-west-2:123456789012:stream/test-stream"
+	testOtherStreamArn = "arn:aws:kinesis:us-west-2:123456789012:stream/other-stream"
+)
+
+var kinesisIntegration = &models.SourceIntegration{
+	SourceIntegrationMetadata: models.SourceIntegrationMetadata{
+		AWSAccountID:     aws.String("123456789012"),
+		IntegrationType:  aws.String(models.IntegrationTypeAWSKinesis),
+		KinesisStreamArn: aws.String(testStreamArn),
+		IntegrationID:    aws.String("0e4ba5ac-5dc5-4ed5-a2c1-9d2c3e0bff55"),
+	},
+}
+
+func TestReadKinesisRecords(t *testing.T) {
+	resetCaches()
+	lambdaMock := mockKinesisSources(t)
+
+	cloudWatchLogsData := gzipJSON(t, events.CloudwatchLogsData{
+		MessageType: "DATA_MESSAGE",
+		LogGroup:    "test-group",
+		LogEvents: []events.CloudwatchLogsLogEvent{
+			{ID: "1", Message: "cwl line 1"},
+			{ID: "2", Message: "cwl line 2"},
+		},
+	})
+	controlData := gzipJSON(t, events.CloudwatchLogsData{
+		MessageType: "CONTROL_MESSAGE",
+		LogEvents: []events.CloudwatchLogsLogEvent{
+			{ID: "1", Message: "CWL CONTROL MESSAGE: Checking health of destination Kinesis stream."},
+		},
+	})
+
+	records := []events.KinesisEventRecord{
+		kinesisRecord(testStreamArn, []byte("raw line 1")),
+		kinesisRecord(testOtherStreamArn, []byte("other line\n")),
+		kinesisRecord(testStreamArn, cloudWatchLogsData),
+		kinesisRecord(testStreamArn, controlData),
+		kinesisRecord(testStreamArn, gzipData(t, []byte("gzipped line"))),
+	}
+
+	dataStreams, err := ReadKinesisRecords(records)
+	require.NoError(t, err)
+	require.Len(t, dataStreams, 2)
+
+	require.Equal(t, testStreamArn, dataStreams[0].Hints.Kinesis.StreamARN)
+	requireStreamData(t, "raw line 1\ncwl line 1\ncwl line 2\ngzipped line\n", dataStreams[0])
+	require.Nil(t, dataStreams[0].LogType)
+
+	require.Equal(t, testOtherStreamArn, dataStreams[1].Hints.Kinesis.StreamARN)
+	requireStreamData(t, "other line\n", dataStreams[1])
+
+	// verify that we have updated the status of the configured source
+	updateStatusInvokeInput := lambdaMock.Calls[1].Arguments.Get(0).(*lambda.InvokeInput)
+	var updateStatusInput models.LambdaInput
+	require.NoError(t, jsoniter.Unmarshal(updateStatusInvokeInput.Payload, &updateStatusInput))
+	require.Equal(t, *kinesisIntegration.IntegrationID, updateStatusInput.UpdateStatus.IntegrationID)
+
+	lambdaMock.AssertExpectations(t)
+}
+
+func TestReadKinesisRecordsSkipsBadRecords(t *testing.T) {
+	resetCaches()
+	lambdaMock := &testutils.LambdaMock{}
+	common.LambdaClient = lambdaMock
+	// the stream has no configured source, so only the sources are listed
+	lambdaMock.On("Invoke", mock.Anything).Return(&lambda.InvokeOutput{Payload: []byte("[]")}, nil).Once()
+
+	records := []events.KinesisEventRecord{
+		kinesisRecord(testOtherStreamArn, gzipJSON(t, events.CloudwatchLogsData{MessageType: "UNKNOWN"})),
+		kinesisRecord(testOtherStreamArn, []byte("\x1f\x8bnot really gzip")),
+		kinesisRecord(testOtherStreamArn, []byte("good line")),
+	}
+	dataStreams, err := ReadKinesisRecords(records)
+	require.NoError(t, err)
+	require.Len(t, dataStreams, 1)
+	requireStreamData(t, "good line\n", dataStreams[0])
+
+	lambdaMock.AssertExpectations(t)
+}
+
+func mockKinesisSources(t *testing.T) *testutils.LambdaMock {
+	lambdaMock := &testutils.LambdaMock{}
+	common.LambdaClient = lambdaMock
+
+	marshaledResult, err := jsoniter.Marshal([]*models.SourceIntegration{kinesisIntegration})
+	require.NoError(t, err)
+	// First invocation should be to get the list of available sources
+	lambdaMock.On("Invoke", mock.Anything).Return(&lambda.InvokeOutput{Payload: marshaledResult}, nil).Once()
+	// Second invocation would be to update the status
+	lambdaMock.On("Invoke", mock.Anything).Return(&lambda.InvokeOutput{}, nil).Once()
+	return lambdaMock
+}
+
+func kinesisRecord(streamArn string, data []byte) events.KinesisEventRecord {
+	return events.KinesisEventRecord{
+		EventSource:    "aws:kinesis",
+		EventSourceArn: streamArn,
+		Kinesis: events.KinesisRecord{
+			Data: data,
+		},
+	}
+}
+
+func gzipJSON(t *testing.T, value interface{}) []byte {
+	data, err := jsoniter.Marshal(value)
+	require.NoError(t, err)
+	return gzipData(t, data)
+}
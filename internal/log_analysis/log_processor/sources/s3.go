@@ -19,10 +19,6 @@ package sources
  */
 
 import (
-	"bufio"
-	"compress/gzip"
-	"io"
-	"net/http"
 	"net/url"
 	"strings"
 
@@ -35,6 +31,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/panther-labs/panther/api/lambda/source/models"
 	"github.com/panther-labs/panther/internal/log_analysis/log_processor/common"
 )
 
@@ -43,32 +40,31 @@ const (
 	cloudTrailValidationMessage = "CloudTrail validation message."
 )
 
-// ReadSnsMessages reads incoming messages containing SNS notifications and returns a slice of DataStream items
-func ReadSnsMessages(messages []string) (result []*common.DataStream, err error) {
+// ReadSnsMessages reads incoming messages containing SNS notifications and calls emit with each DataStream found.
+// The streams of archive members must be read in the order they are emitted, see decodeDataStream.
+func ReadSnsMessages(messages []string, emit func(*common.DataStream)) error {
 	zap.L().Debug("reading data from messages", zap.Int("numMessages", len(messages)))
 	for _, message := range messages {
 		snsNotificationMessage := &SnsNotification{}
 		if err := jsoniter.UnmarshalFromString(message, snsNotificationMessage); err != nil {
-			return nil, err
+			return err
 		}
 
 		switch snsNotificationMessage.Type {
 		case "Notification":
-			streams, err := handleNotificationMessage(snsNotificationMessage)
-			if err != nil {
-				return nil, err
+			if err := handleNotificationMessage(snsNotificationMessage, emit); err != nil {
+				return err
 			}
-			result = append(result, streams...)
 		case "SubscriptionConfirmation":
 			err := ConfirmSubscription(snsNotificationMessage)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		default:
-			return nil, errors.New("received unexpected message in SQS queue")
+			return errors.New("received unexpected message in SQS queue")
 		}
 	}
-	return result, nil
+	return nil
 }
 
 // ConfirmSubscription will confirm the SNS->SQS subscription
@@ -97,42 +93,42 @@ func ConfirmSubscription(notification *SnsNotification) (err error) {
 	return nil
 }
 
-func handleNotificationMessage(notification *SnsNotification) (result []*common.DataStream, err error) {
+func handleNotificationMessage(notification *SnsNotification, emit func(*common.DataStream)) error {
 	s3Objects, err := ParseNotification(notification.Message)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	for _, s3Object := range s3Objects {
-		var dataStream *common.DataStream
-		dataStream, err = readS3Object(s3Object)
-		if err != nil {
+		if err := readS3Object(s3Object, emit); err != nil {
 			if _, ok := err.(*ErrUnsupportedFileType); ok {
 				// If the incoming message is not of a supported type, just skip it
-				err = nil
 				continue
 			}
-			return
+			return err
 		}
-		result = append(result, dataStream)
 	}
-	return result, err
+	return nil
 }
 
-func readS3Object(s3Object *S3ObjectInfo) (dataStream *common.DataStream, err error) {
+// readS3Object emits one data stream for each file contained in an S3 object.
+// Compressed objects and archives are unwrapped using the registered formats.
+func readS3Object(s3Object *S3ObjectInfo, emit func(*common.DataStream)) (err error) {
+	numStreams := 0
 	operation := common.OpLogManager.Start("readS3Object", common.OpLogS3ServiceDim)
 	defer func() {
 		operation.Stop()
 		operation.Log(err,
 			// s3 dim info
 			zap.String("bucket", s3Object.S3Bucket),
-			zap.String("key", s3Object.S3ObjectKey))
+			zap.String("key", s3Object.S3ObjectKey),
+			zap.Int("numStreams", numStreams))
 	}()
 
-	s3Client, _, err := getS3Client(s3Object)
+	s3Client, source, err := getS3Client(s3Object)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to get S3 client for s3://%s/%s",
 			s3Object.S3Bucket, s3Object.S3ObjectKey)
-		return nil, err
+		return err
 	}
 
 	getObjectInput := &s3.GetObjectInput{
@@ -143,55 +139,51 @@ func readS3Object(s3Object *S3ObjectInfo) (dataStream *common.DataStream, err er
 	if err != nil {
 		err = errors.Wrapf(err, "GetObject() failed for s3://%s/%s",
 			s3Object.S3Bucket, s3Object.S3ObjectKey)
-		return nil, err
+		return err
 	}
 
-	bufferedReader := bufio.NewReader(output.Body)
-
-	// We peek into the file header to identify the content type
-	// http.DetectContentType only uses up to the first 512 bytes
-	headerBytes, err := bufferedReader.Peek(512)
+	hints := common.DataStreamHints{
+		S3: &common.S3DataStreamHints{
+			Bucket: s3Object.S3Bucket,
+			Key:    s3Object.S3ObjectKey,
+		},
+	}
+	rule := matchPrefixLogType(source, s3Object.S3ObjectKey)
+	if rule != nil {
+		hints.S3.LogTypePrefix = aws.StringValue(rule.Prefix)
+	}
+	err = decodeDataStream(output.Body, hints, func(dataStream *common.DataStream) {
+		if rule != nil {
+			// The log type is known, so the stream will not go through classification
+			dataStream.LogType = rule.LogType
+		}
+		numStreams++
+		emit(dataStream)
+	})
 	if err != nil {
-		if err != bufio.ErrBufferFull && err != io.EOF { // EOF or ErrBufferFull means file is shorter than n
-			err = errors.Wrapf(err, "failed to Peek() in S3 payload for s3://%s/%s",
-				s3Object.S3Bucket, s3Object.S3ObjectKey)
-			return nil, err
+		if _, ok := err.(*ErrUnsupportedFileType); ok {
+			return err
 		}
-		err = nil // not really an error
+		err = errors.Wrapf(err, "failed to read s3://%s/%s",
+			s3Object.S3Bucket, s3Object.S3ObjectKey)
+		return err
 	}
-	contentType := http.DetectContentType(headerBytes)
-
-	var streamReader io.Reader
+	return nil
+}
 
-	// Checking for prefix because the returned type can have also charset used
-	if strings.HasPrefix(contentType, "text/plain") {
-		// if it's plain text, just return the buffered reader
-		streamReader = bufferedReader
-	} else if strings.HasPrefix(contentType, "application/x-gzip") {
-		var gzipReader *gzip.Reader
-		gzipReader, err = gzip.NewReader(bufferedReader)
-		if err != nil {
-			err = errors.Wrapf(err, "failed to created gzip reader for s3://%s/%s",
-				s3Object.S3Bucket, s3Object.S3ObjectKey)
-			return nil, err
+// matchPrefixLogType returns the log type routing rule of the source matching the S3 object key.
+// If more than one rule matches, the rule with the longest prefix wins. It returns nil if no rule matches.
+func matchPrefixLogType(source *models.SourceIntegration, key string) (result *models.S3PrefixLogType) {
+	for _, rule := range source.S3PrefixLogTypes {
+		prefix := aws.StringValue(rule.Prefix)
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if result == nil || len(prefix) > len(aws.StringValue(result.Prefix)) {
+			result = rule
 		}
-		streamReader = gzipReader
-	} else {
-		err = &ErrUnsupportedFileType{Type: contentType}
-		return nil, err
-	}
-
-	dataStream = &common.DataStream{
-		Reader: streamReader,
-		Hints: common.DataStreamHints{
-			S3: &common.S3DataStreamHints{
-				Bucket:      s3Object.S3Bucket,
-				Key:         s3Object.S3ObjectKey,
-				ContentType: contentType,
-			},
-		},
 	}
-	return dataStream, err
+	return result
 }
 
 // ParseNotification parses a message received
@@ -95,15 +95,15 @@ func init() {
 
 // getS3Client Fetches
 // 1. S3 client with permissions to read data from the account that contains the event
-// 2. The type of the integration
-func getS3Client(s3Object *S3ObjectInfo) (s3iface.S3API, string, error) {
+// 2. The source integration of the object
+func getS3Client(s3Object *S3ObjectInfo) (s3iface.S3API, *models.SourceIntegration, error) {
 	sourceInfo, err := getSourceInfo(s3Object)
 	if err != nil {
-		return nil, "", errors.Wrapf(err, "failed to fetch the appropriate role arn to retrieve S3 object %#v", s3Object)
+		return nil, nil, errors.Wrapf(err, "failed to fetch the appropriate role arn to retrieve S3 object %#v", s3Object)
 	}
 
 	if sourceInfo == nil {
-		return nil, "", errors.Errorf("there is no source configured for S3 object %#v", s3Object)
+		return nil, nil, errors.Errorf("there is no source configured for S3 object %#v", s3Object)
 	}
 	var awsCreds *credentials.Credentials // lazy create below
 	roleArn := getSourceLogProcessingRole(sourceInfo)
@@ -113,12 +113,12 @@ func getS3Client(s3Object *S3ObjectInfo) (s3iface.S3API, string, error) {
 		zap.L().Debug("bucket region was not cached, fetching it", zap.String("bucket", s3Object.S3Bucket))
 		awsCreds = getAwsCredentials(roleArn)
 		if awsCreds == nil {
-			return nil, "", errors.Errorf("failed to fetch credentials for assumed role %s to read %#v",
+			return nil, nil, errors.Errorf("failed to fetch credentials for assumed role %s to read %#v",
 				roleArn, s3Object)
 		}
 		bucketRegion, err = getBucketRegion(s3Object.S3Bucket, awsCreds)
 		if err != nil {
-			return nil, "", err
+			return nil, nil, err
 		}
 		bucketCache.Add(s3Object.S3Bucket, bucketRegion)
 	}
@@ -135,14 +135,14 @@ func getS3Client(s3Object *S3ObjectInfo) (s3iface.S3API, string, error) {
 		if awsCreds == nil {
 			awsCreds = getAwsCredentials(roleArn)
 			if awsCreds == nil {
-				return nil, "", errors.Errorf("failed to fetch credentials for assumed role %s to read %#v",
+				return nil, nil, errors.Errorf("failed to fetch credentials for assumed role %s to read %#v",
 					roleArn, s3Object)
 			}
 		}
 		client = newS3ClientFunc(box.String(cacheKey.awsRegion), awsCreds)
 		s3ClientCache.Add(cacheKey, client)
 	}
-	return client.(s3iface.S3API), *sourceInfo.IntegrationType, nil
+	return client.(s3iface.S3API), sourceInfo, nil
 }
 
 func getBucketRegion(s3Bucket string, awsCreds *credentials.Credentials) (string, error) {
@@ -177,21 +177,12 @@ func getAwsCredentials(roleArn string) *credentials.Credentials {
 // It will return nil result if no source exists for this object.
 func getSourceInfo(s3Object *S3ObjectInfo) (result *models.SourceIntegration, err error) {
 	now := time.Now() // No need to be UTC. We care about relative time
-	if sourceCache.cacheUpdateTime.Add(sourceCacheDuration).Before(now) {
-		// we need to update the cache
-		input := &models.LambdaInput{
-			ListIntegrations: &models.ListIntegrationsInput{},
-		}
-		var output []*models.SourceIntegration
-		err = genericapi.Invoke(common.LambdaClient, sourceAPIFunctionName, input, &output)
-		if err != nil {
-			return nil, err
-		}
-		sourceCache.cacheUpdateTime = now
-		sourceCache.sources = output
+	sources, err := listSources(now)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, source := range sourceCache.sources {
+	for _, source := range sources {
 		integrationBucket, integrationPrefix := getSourceS3Info(source)
 		if aws.StringValue(integrationBucket) == s3Object.S3Bucket {
 			if strings.HasPrefix(s3Object.S3ObjectKey, aws.StringValue(integrationPrefix)) {
@@ -203,17 +194,65 @@ func getSourceInfo(s3Object *S3ObjectInfo) (result *models.SourceIntegration, er
 
 	// If the incoming notification maps to a known source, update the source information
 	if result != nil {
-		deadline := lastEventReceived[*result.IntegrationID].Add(statusUpdateFrequency)
-		// if more than 'statusUpdateFrequency' time has passed, update status
-		if now.After(deadline) {
-			updateIntegrationStatus(*result.IntegrationID, now)
-			lastEventReceived[*result.IntegrationID] = now
+		updateSourceStatus(result, now)
+	}
+
+	return result, nil
+}
+
+// Returns the source configuration for this Kinesis stream.
+// It will return nil result if no source exists for this stream.
+func getKinesisSourceInfo(streamArn string) (result *models.SourceIntegration, err error) {
+	now := time.Now() // No need to be UTC. We care about relative time
+	sources, err := listSources(now)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, source := range sources {
+		if aws.StringValue(source.IntegrationType) == models.IntegrationTypeAWSKinesis &&
+			aws.StringValue(source.KinesisStreamArn) == streamArn {
+
+			result = source
+			break
 		}
 	}
 
+	// If the incoming records map to a known source, update the source information
+	if result != nil {
+		updateSourceStatus(result, now)
+	}
+
 	return result, nil
 }
 
+// Returns the configured sources, refreshing them from the sources_api if the cache has expired
+func listSources(now time.Time) ([]*models.SourceIntegration, error) {
+	if sourceCache.cacheUpdateTime.Add(sourceCacheDuration).Before(now) {
+		// we need to update the cache
+		input := &models.LambdaInput{
+			ListIntegrations: &models.ListIntegrationsInput{},
+		}
+		var output []*models.SourceIntegration
+		err := genericapi.Invoke(common.LambdaClient, sourceAPIFunctionName, input, &output)
+		if err != nil {
+			return nil, err
+		}
+		sourceCache.cacheUpdateTime = now
+		sourceCache.sources = output
+	}
+	return sourceCache.sources, nil
+}
+
+func updateSourceStatus(source *models.SourceIntegration, now time.Time) {
+	deadline := lastEventReceived[*source.IntegrationID].Add(statusUpdateFrequency)
+	// if more than 'statusUpdateFrequency' time has passed, update status
+	if now.After(deadline) {
+		updateIntegrationStatus(*source.IntegrationID, now)
+		lastEventReceived[*source.IntegrationID] = now
+	}
+}
+
 func updateIntegrationStatus(integrationID string, timestamp time.Time) {
 	input := &models.LambdaInput{
 		UpdateStatus: &models.UpdateStatusInput{
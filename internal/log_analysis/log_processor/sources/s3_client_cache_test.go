@@ -86,16 +86,16 @@ func TestGetS3Client(t *testing.T) {
 		S3Bucket:    "test-bucket",
 		S3ObjectKey: "prefix/key",
 	}
-	result, sourceType, err := getS3Client(s3Object)
+	result, source, err := getS3Client(s3Object)
 	require.NoError(t, err)
 	require.NotNil(t, result)
-	require.Equal(t, models.IntegrationTypeAWS3, sourceType)
+	require.Equal(t, models.IntegrationTypeAWS3, *source.IntegrationType)
 
 	// Subsequent calls should use cache
-	result, sourceType, err = getS3Client(s3Object)
+	result, source, err = getS3Client(s3Object)
 	require.NoError(t, err)
 	require.NotNil(t, result)
-	require.Equal(t, models.IntegrationTypeAWS3, sourceType)
+	require.Equal(t, models.IntegrationTypeAWS3, *source.IntegrationType)
 
 	// verify that we have updated the source with the last time scanned status
 	updateStatusInvokeInput := lambdaMock.Calls[1].Arguments.Get(0).(*lambda.InvokeInput)
@@ -137,10 +137,10 @@ func TestGetS3ClientUnknownBucket(t *testing.T) {
 		S3ObjectKey: "prefix/key",
 	}
 
-	result, sourceType, err := getS3Client(s3Object)
+	result, source, err := getS3Client(s3Object)
 	require.Error(t, err)
 	require.Nil(t, result)
-	require.Equal(t, "", sourceType)
+	require.Nil(t, source)
 
 	s3Mock.AssertExpectations(t)
 	lambdaMock.AssertExpectations(t)
@@ -191,10 +191,10 @@ func TestGetS3ClientSourceNoPrefix(t *testing.T) {
 		S3ObjectKey: "test",
 	}
 
-	result, sourceType, err := getS3Client(s3Object)
+	result, source, err := getS3Client(s3Object)
 	require.NoError(t, err)
 	require.NotNil(t, result)
-	require.Equal(t, models.IntegrationTypeAWS3, sourceType)
+	require.Equal(t, models.IntegrationTypeAWS3, *source.IntegrationType)
 
 	s3Mock.AssertExpectations(t)
 	lambdaMock.AssertExpectations(t)
@@ -157,9 +157,30 @@ func TestHandleUnsupportedFileType(t *testing.T) {
 	getObjectOutput := &s3.GetObjectOutput{Body: ioutil.NopCloser(bytes.NewReader(objectData))}
 	s3Mock.On("GetObject", mock.Anything).Return(getObjectOutput, nil)
 
-	dataStreams, err := ReadSnsMessages([]string{marshaledNotification})
+	var dataStreams []*common.DataStream
+	err = ReadSnsMessages([]string{marshaledNotification}, func(dataStream *common.DataStream) {
+		dataStreams = append(dataStreams, dataStream)
+	})
 	// Method shouldn't return error
 	require.NoError(t, err)
-	// Method should not return data stream
+	// Method should not emit data stream
 	require.Equal(t, 0, len(dataStreams))
 }
+
+func TestMatchPrefixLogType(t *testing.T) {
+	source := &models.SourceIntegration{
+		SourceIntegrationMetadata: models.SourceIntegrationMetadata{
+			S3PrefixLogTypes: []*models.S3PrefixLogType{
+				{Prefix: aws.String("logs/"), LogType: aws.String("Syslog.RFC5424")},
+				{Prefix: aws.String("logs/fluentd/"), LogType: aws.String("Fluentd.Syslog3164")},
+				{Prefix: aws.String("logs/rfc3164/"), LogType: aws.String("Syslog.RFC3164")},
+			},
+		},
+	}
+	require.Equal(t, "Syslog.RFC5424", *matchPrefixLogType(source, "logs/app.log").LogType)
+	// longest prefix wins
+	require.Equal(t, "Fluentd.Syslog3164", *matchPrefixLogType(source, "logs/fluentd/app.log").LogType)
+	require.Equal(t, "Syslog.RFC3164", *matchPrefixLogType(source, "logs/rfc3164/app.log").LogType)
+	require.Nil(t, matchPrefixLogType(source, "other/app.log"))
+	require.Nil(t, matchPrefixLogType(&models.SourceIntegration{}, "logs/app.log"))
+}
@@ -0,0 +1,310 @@
+package backtester
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	enginemodels "github.com/panther-labs/panther/api/gateway/analysis"
+	"github.com/panther-labs/panther/api/gateway/analysis/models"
+)
+
+const (
+	// Number of matched events returned with the backtest results.
+	// The samples are saved with the job, and DynamoDB items are limited to 400KB.
+	maxSampleMatches      = 10
+	maxSampleMatchesBytes = 128 * 1024
+
+	// The rules engine is invoked synchronously, so its payload is limited to 6MB
+	maxBatchBytes  = 4 * 1024 * 1024
+	maxBatchEvents = 1000
+
+	// Stop replaying events this long before the Lambda times out so the results can still be saved
+	deadlineMargin = time.Minute
+)
+
+// A rule match, used to work out how many alerts dedup would have produced
+type match struct {
+	dedup     string
+	eventTime time.Time
+}
+
+// Run replays the rule of a backtest job and saves the results.
+func Run(ctx context.Context, jobID string) error {
+	job, err := getJob(jobID)
+	if err != nil {
+		return err
+	}
+	if job == nil {
+		return errors.Errorf("backtest %s does not exist", jobID)
+	}
+	if job.Status != string(models.BacktestStatusRUNNING) {
+		// Asynchronous invocations can be delivered more than once
+		zap.L().Warn("backtest already finished", zap.String("jobId", jobID), zap.String("status", job.Status))
+		return nil
+	}
+
+	if err = backtest(ctx, job); err != nil {
+		zap.L().Error("backtest failed", zap.String("jobId", jobID), zap.Error(err))
+		job.Status = string(models.BacktestStatusFAILED)
+		job.ErrorMessage = err.Error()
+	} else {
+		job.Status = string(models.BacktestStatusSUCCEEDED)
+	}
+
+	finishedAt := time.Now().UTC()
+	job.FinishedAt = &finishedAt
+	return putJob(job)
+}
+
+func backtest(ctx context.Context, job *enginemodels.BacktestJob) error {
+	rows, err := findEvents(job)
+	if err != nil {
+		return err
+	}
+	if len(rows) > job.MaxEvents {
+		rows = rows[:job.MaxEvents]
+		job.Truncated = true
+	}
+
+	// Group the wanted events by the S3 object holding them, keeping the objects in event time order
+	var paths []string
+	wanted := make(map[string]map[string]time.Time)
+	for _, row := range rows {
+		if _, ok := wanted[row.path]; !ok {
+			paths = append(paths, row.path)
+			wanted[row.path] = make(map[string]time.Time)
+		}
+		wanted[row.path][row.rowID] = row.eventTime
+	}
+
+	runner := &ruleRunner{job: job}
+	for _, path := range paths {
+		if outOfTime(ctx) {
+			job.Truncated = true
+			break
+		}
+		eventTimes := wanted[path]
+		err = readEvents(path, func(rowID string, data []byte) error {
+			eventTime, ok := eventTimes[rowID]
+			if !ok {
+				return nil
+			}
+			return runner.add(rowID, eventTime, data)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err = runner.flush(); err != nil {
+		return err
+	}
+
+	job.AlertCount = countAlerts(runner.matches, time.Duration(job.DedupPeriodMinutes)*time.Minute, job.Threshold)
+	return nil
+}
+
+// Returns true if the Lambda function is about to time out.
+func outOfTime(ctx context.Context) bool {
+	deadline, ok := ctx.Deadline()
+	return ok && time.Until(deadline) < deadlineMargin
+}
+
+// ruleRunner sends events to the rules engine in batches and collects the results in the job.
+type ruleRunner struct {
+	job     *enginemodels.BacktestJob
+	matches []match
+
+	batch       []enginemodels.Event
+	batchBytes  int
+	pending     map[string]pendingEvent // batched events by row id
+	sampleBytes int
+}
+
+type pendingEvent struct {
+	data      []byte
+	eventTime time.Time
+}
+
+func (r *ruleRunner) add(rowID string, eventTime time.Time, data []byte) error {
+	if len(r.batch) >= maxBatchEvents || (len(r.batch) > 0 && r.batchBytes+len(data) > maxBatchBytes) {
+		if err := r.flush(); err != nil {
+			return err
+		}
+	}
+
+	if r.pending == nil {
+		r.pending = make(map[string]pendingEvent)
+	}
+	r.batch = append(r.batch, enginemodels.Event{
+		Data: jsoniter.RawMessage(data),
+		ID:   rowID,
+		Type: r.job.LogType,
+	})
+	r.batchBytes += len(data)
+	r.pending[rowID] = pendingEvent{data: data, eventTime: eventTime}
+	return nil
+}
+
+func (r *ruleRunner) flush() error {
+	if len(r.batch) == 0 {
+		return nil
+	}
+
+	output, err := runRule(r.job, r.batch)
+	if err != nil {
+		return err
+	}
+
+	for _, result := range output.Events {
+		r.job.EventsScanned++
+		switch {
+		case len(result.Errored) > 0:
+			r.job.ErrorCount++
+		case len(result.Matched) > 0:
+			r.job.MatchCount++
+			event := r.pending[result.ID]
+			r.matches = append(r.matches, match{dedup: result.Dedup, eventTime: event.eventTime})
+			r.addSample(event.data)
+		}
+	}
+
+	r.batch, r.batchBytes, r.pending = nil, 0, nil
+	return nil
+}
+
+// Keep a matched event as a sample, unless there are enough samples or the event does not fit.
+func (r *ruleRunner) addSample(data []byte) {
+	if len(r.job.SampleMatches) >= maxSampleMatches || r.sampleBytes+len(data) > maxSampleMatchesBytes {
+		return
+	}
+	r.job.SampleMatches = append(r.job.SampleMatches, string(data))
+	r.sampleBytes += len(data)
+}
+
+// Run the rule of the job against a batch of events in the rules engine.
+func runRule(job *enginemodels.BacktestJob, events []enginemodels.Event) (*enginemodels.RulesEngineOutput, error) {
+	input := enginemodels.RulesEngineInput{
+		Rules: []enginemodels.Rule{
+			{
+				Body:     job.Body,
+				ID:       job.RuleID,
+				LogTypes: []string{job.LogType},
+			},
+		},
+		Events: events,
+	}
+	payload, err := jsoniter.Marshal(&input)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal rules engine input")
+	}
+
+	response, err := lambdaClient.Invoke(&lambda.InvokeInput{FunctionName: &env.RulesEngine, Payload: payload})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to invoke rules engine")
+	}
+	if response.FunctionError != nil {
+		return nil, errors.Errorf("rules engine failed: %s", string(response.Payload))
+	}
+
+	var output enginemodels.RulesEngineOutput
+	if err = jsoniter.Unmarshal(response.Payload, &output); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal rules engine output")
+	}
+	return &output, nil
+}
+
+// countAlerts returns how many alerts the matches would have produced.
+//
+// Like the rules engine, matches with the same dedup string are grouped into one alert
+// until the dedup period after the first event of the alert has passed.
+// The alert is only sent once the number of events in the group reaches the rule threshold.
+func countAlerts(matches []match, dedupPeriod time.Duration, threshold int) int {
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].eventTime.Before(matches[j].eventTime)
+	})
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	type alertGroup struct {
+		start  time.Time
+		events int
+	}
+	groups := make(map[string]*alertGroup)
+	alerts := 0
+	for _, m := range matches {
+		group := groups[m.dedup]
+		if group == nil || !m.eventTime.Before(group.start.Add(dedupPeriod)) {
+			group = &alertGroup{start: m.eventTime}
+			groups[m.dedup] = group
+		}
+		group.events++
+		if group.events == threshold {
+			alerts++
+		}
+	}
+	return alerts
+}
+
+// Load a backtest job from the Dynamo table.
+//
+// Returns (nil, nil) if the job doesn't exist.
+func getJob(jobID string) (*enginemodels.BacktestJob, error) {
+	response, err := dynamoClient.GetItem(&dynamodb.GetItemInput{
+		ConsistentRead: aws.Bool(true),
+		Key: map[string]*dynamodb.AttributeValue{
+			"jobId": {S: &jobID},
+		},
+		TableName: &env.BacktestTable,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get backtest")
+	}
+	if len(response.Item) == 0 {
+		return nil, nil
+	}
+
+	var job enginemodels.BacktestJob
+	if err = dynamodbattribute.UnmarshalMap(response.Item, &job); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal backtest")
+	}
+	return &job, nil
+}
+
+func putJob(job *enginemodels.BacktestJob) error {
+	item, err := dynamodbattribute.MarshalMap(job)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal backtest")
+	}
+	if _, err = dynamoClient.PutItem(&dynamodb.PutItemInput{Item: item, TableName: &env.BacktestTable}); err != nil {
+		return errors.Wrap(err, "failed to save backtest")
+	}
+	return nil
+}
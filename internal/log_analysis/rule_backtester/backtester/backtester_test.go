@@ -0,0 +1,242 @@
+package backtester
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	enginemodels "github.com/panther-labs/panther/api/gateway/analysis"
+	"github.com/panther-labs/panther/api/gateway/analysis/models"
+	"github.com/panther-labs/panther/pkg/testutils"
+)
+
+var testStart = time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func TestCountAlerts(t *testing.T) {
+	matches := []match{
+		{dedup: "a", eventTime: testStart.Add(70 * time.Minute)}, // new alert, the first one is more than 1h old
+		{dedup: "a", eventTime: testStart},
+		{dedup: "b", eventTime: testStart.Add(time.Minute)},
+		{dedup: "a", eventTime: testStart.Add(30 * time.Minute)},
+	}
+	assert.Equal(t, 3, countAlerts(matches, time.Hour, 0))
+}
+
+func TestCountAlertsThreshold(t *testing.T) {
+	matches := []match{
+		{dedup: "a", eventTime: testStart},
+		{dedup: "a", eventTime: testStart.Add(time.Minute)},
+		{dedup: "b", eventTime: testStart.Add(time.Minute)},
+		{dedup: "a", eventTime: testStart.Add(2 * time.Minute)},
+	}
+	// Only "a" reaches the threshold, and it alerts once
+	assert.Equal(t, 1, countAlerts(matches, time.Hour, 2))
+	assert.Equal(t, 0, countAlerts(matches, time.Hour, 4))
+}
+
+func TestEventsQuery(t *testing.T) {
+	job := &enginemodels.BacktestJob{
+		LogType:   "AWS.CloudTrail",
+		StartTime: testStart,
+		EndTime:   testStart.Add(36 * time.Hour),
+		MaxEvents: 100,
+	}
+	assert.Equal(t, `SELECT "$path", p_row_id, p_event_time FROM panther_logs.aws_cloudtrail`+
+		` WHERE year*1000000 + month*10000 + day*100 + hour BETWEEN 2020050112 AND 2020050300`+
+		` AND p_event_time >= TIMESTAMP '2020-05-01 12:00:00.000' AND p_event_time < TIMESTAMP '2020-05-03 00:00:00.000'`+
+		` ORDER BY p_event_time LIMIT 101`, eventsQuery(job))
+}
+
+func TestParseS3Path(t *testing.T) {
+	bucket, key, err := parseS3Path("s3://panther-processed/logs/aws_cloudtrail/year=2020/file.json.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "panther-processed", bucket)
+	assert.Equal(t, "logs/aws_cloudtrail/year=2020/file.json.gz", key)
+
+	_, _, err = parseS3Path("panther-processed/logs")
+	assert.Error(t, err)
+	_, _, err = parseS3Path("s3://panther-processed")
+	assert.Error(t, err)
+}
+
+func TestAddSampleLimits(t *testing.T) {
+	runner := &ruleRunner{job: &enginemodels.BacktestJob{}}
+	large := bytes.Repeat([]byte("x"), maxSampleMatchesBytes/3)
+
+	runner.addSample(large)
+	runner.addSample(large)
+	// Samples which would exceed the size limit are skipped, smaller ones still fit
+	runner.addSample(large)
+	runner.addSample(large)
+	runner.addSample([]byte(`{}`))
+	assert.Len(t, runner.job.SampleMatches, 4)
+
+	runner = &ruleRunner{job: &enginemodels.BacktestJob{}}
+	for i := 0; i < maxSampleMatches+1; i++ {
+		runner.addSample([]byte(`{}`))
+	}
+	assert.Len(t, runner.job.SampleMatches, maxSampleMatches)
+}
+
+func TestRun(t *testing.T) {
+	env.BacktestTable = "panther-rule-backtests"
+	env.RulesEngine = "panther-rules-engine"
+	job := &enginemodels.BacktestJob{
+		JobID:              "a58cf7a4-4a1e-4cc6-b2e4-8b2a3e34e7b8",
+		RuleID:             "Test.Rule",
+		Body:               "def rule(event): return True",
+		DedupPeriodMinutes: 60,
+		LogType:            "AWS.CloudTrail",
+		StartTime:          testStart,
+		EndTime:            testStart.Add(time.Hour),
+		MaxEvents:          3,
+		Status:             string(models.BacktestStatusRUNNING),
+	}
+
+	mockDynamo := &testutils.DynamoDBMock{}
+	dynamoClient = mockDynamo
+	item, err := dynamodbattribute.MarshalMap(job)
+	require.NoError(t, err)
+	mockDynamo.On("GetItem", mock.Anything).Return(&dynamodb.GetItemOutput{Item: item}, nil).Once()
+	var saved enginemodels.BacktestJob
+	mockDynamo.On("PutItem", mock.Anything).Return(&dynamodb.PutItemOutput{}, nil).Once().Run(func(args mock.Arguments) {
+		require.NoError(t, dynamodbattribute.UnmarshalMap(args.Get(0).(*dynamodb.PutItemInput).Item, &saved))
+	})
+
+	// Athena finds one more event than the limit
+	path := "s3://panther-processed/logs/aws_cloudtrail/year=2020/month=05/day=01/hour=12/file.json.gz"
+	mockAthena := &testutils.AthenaMock{}
+	athenaClient = mockAthena
+	mockAthena.On("StartQueryExecution", mock.Anything).Return(
+		&athena.StartQueryExecutionOutput{QueryExecutionId: aws.String("query")}, nil).Once()
+	mockAthena.On("GetQueryExecution", mock.Anything).Return(&athena.GetQueryExecutionOutput{
+		QueryExecution: &athena.QueryExecution{
+			QueryExecutionId: aws.String("query"),
+			Status:           &athena.QueryExecutionStatus{State: aws.String(athena.QueryExecutionStateSucceeded)},
+		},
+	}, nil).Once()
+	mockAthena.On("GetQueryResults", mock.Anything).Return(&athena.GetQueryResultsOutput{
+		ResultSet: &athena.ResultSet{
+			Rows: []*athena.Row{
+				athenaRow("$path", "p_row_id", "p_event_time"),
+				athenaRow(path, "1", "2020-05-01 12:00:00.000"),
+				athenaRow(path, "2", "2020-05-01 12:01:00.000"),
+				athenaRow(path, "3", "2020-05-01 12:02:00.000"),
+				athenaRow(path, "4", "2020-05-01 12:03:00.000"),
+			},
+		},
+	}, nil).Once()
+
+	mockS3 := &testutils.S3Mock{}
+	s3Client = mockS3
+	lines := []string{
+		`{"eventName":"ConsoleLogin","p_row_id":"1"}`,
+		`{"eventName":"ConsoleLogin","p_row_id":"2"}`,
+		`{"eventName":"AssumeRole","p_row_id":"3"}`,
+		`{"eventName":"AssumeRole","p_row_id":"4"}`,
+	}
+	mockS3.On("GetObject", &s3.GetObjectInput{
+		Bucket: aws.String("panther-processed"),
+		Key:    aws.String("logs/aws_cloudtrail/year=2020/month=05/day=01/hour=12/file.json.gz"),
+	}).Return(&s3.GetObjectOutput{Body: gzipLines(t, lines)}, nil).Once()
+
+	mockLambda := &testutils.LambdaMock{}
+	lambdaClient = mockLambda
+	output, err := jsoniter.Marshal(&enginemodels.RulesEngineOutput{
+		Events: []enginemodels.EventAnalysis{
+			{ID: "1", Matched: []string{"Test.Rule"}, Dedup: "ConsoleLogin"},
+			{ID: "2", Matched: []string{"Test.Rule"}, Dedup: "ConsoleLogin"},
+			{ID: "3", Errored: []enginemodels.PolicyError{{ID: "Test.Rule", Message: "KeyError"}}},
+		},
+	})
+	require.NoError(t, err)
+	mockLambda.On("Invoke", mock.Anything).Return(&lambda.InvokeOutput{Payload: output}, nil).Once().Run(
+		func(args mock.Arguments) {
+			var input enginemodels.RulesEngineInput
+			require.NoError(t, jsoniter.Unmarshal(args.Get(0).(*lambda.InvokeInput).Payload, &input))
+			assert.Len(t, input.Events, 3)
+			assert.Equal(t, job.Body, input.Rules[0].Body)
+		})
+
+	require.NoError(t, Run(context.Background(), job.JobID))
+	mockDynamo.AssertExpectations(t)
+	mockAthena.AssertExpectations(t)
+	mockS3.AssertExpectations(t)
+	mockLambda.AssertExpectations(t)
+
+	assert.Equal(t, string(models.BacktestStatusSUCCEEDED), saved.Status)
+	assert.NotNil(t, saved.FinishedAt)
+	assert.Equal(t, 3, saved.EventsScanned)
+	assert.Equal(t, 2, saved.MatchCount)
+	assert.Equal(t, 1, saved.ErrorCount)
+	assert.Equal(t, 1, saved.AlertCount)
+	assert.Equal(t, lines[:2], saved.SampleMatches)
+	assert.True(t, saved.Truncated)
+}
+
+func TestRunAlreadyFinished(t *testing.T) {
+	env.BacktestTable = "panther-rule-backtests"
+	mockDynamo := &testutils.DynamoDBMock{}
+	dynamoClient = mockDynamo
+	item, err := dynamodbattribute.MarshalMap(&enginemodels.BacktestJob{
+		JobID:  "a58cf7a4-4a1e-4cc6-b2e4-8b2a3e34e7b8",
+		Status: string(models.BacktestStatusSUCCEEDED),
+	})
+	require.NoError(t, err)
+	mockDynamo.On("GetItem", mock.Anything).Return(&dynamodb.GetItemOutput{Item: item}, nil).Once()
+
+	require.NoError(t, Run(context.Background(), "a58cf7a4-4a1e-4cc6-b2e4-8b2a3e34e7b8"))
+	mockDynamo.AssertExpectations(t)
+}
+
+func athenaRow(values ...string) *athena.Row {
+	row := &athena.Row{}
+	for _, value := range values {
+		row.Data = append(row.Data, &athena.Datum{VarCharValue: aws.String(value)})
+	}
+	return row
+}
+
+func gzipLines(t *testing.T, lines []string) io.ReadCloser {
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	for _, line := range lines {
+		_, err := writer.Write([]byte(line + "\n"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	return ioutil.NopCloser(&buffer)
+}
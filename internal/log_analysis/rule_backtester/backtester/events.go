@@ -0,0 +1,174 @@
+package backtester
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/s3"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+
+	enginemodels "github.com/panther-labs/panther/api/gateway/analysis"
+	"github.com/panther-labs/panther/internal/log_analysis/awsglue"
+	"github.com/panther-labs/panther/pkg/awsathena"
+)
+
+const (
+	// Athena timestamp literals
+	athenaTimeLayout = "2006-01-02 15:04:05.000"
+	// Athena returns timestamps with a variable number of fractional digits
+	athenaResultLayout = "2006-01-02 15:04:05.999999999"
+)
+
+// An event in the data lake, found by Athena
+type eventRow struct {
+	path      string // S3 object holding the event
+	rowID     string
+	eventTime time.Time
+}
+
+// Find the events in the time range of the job, ordered by event time.
+//
+// Athena only returns where the events are stored: Glue lowercases column names,
+// so the events themselves are read from the processed data in S3 with their original field names.
+// One event more than the limit is returned, to detect when the time range holds more events.
+func findEvents(job *enginemodels.BacktestJob) ([]eventRow, error) {
+	startOutput, err := awsathena.StartQuery(athenaClient, awsglue.LogProcessingDatabaseName, eventsQuery(job), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to start athena query")
+	}
+	queryID := *startOutput.QueryExecutionId
+
+	results, err := awsathena.WaitForResults(athenaClient, queryID)
+	if err != nil {
+		return nil, errors.Wrap(err, "athena query failed")
+	}
+
+	var rows []eventRow
+	for header := true; ; header = false {
+		pageRows := results.ResultSet.Rows
+		if header && len(pageRows) > 0 {
+			// The first row of the first page holds the column names
+			pageRows = pageRows[1:]
+		}
+		for _, row := range pageRows {
+			event, err := parseEventRow(row)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, *event)
+		}
+
+		if results.NextToken == nil {
+			return rows, nil
+		}
+		if results, err = awsathena.Results(athenaClient, queryID, results.NextToken, nil); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func eventsQuery(job *enginemodels.BacktestJob) string {
+	return fmt.Sprintf(`SELECT "$path", p_row_id, p_event_time FROM %s.%s`+
+		` WHERE %s AND p_event_time >= TIMESTAMP '%s' AND p_event_time < TIMESTAMP '%s'`+
+		` ORDER BY p_event_time LIMIT %d`,
+		awsglue.LogProcessingDatabaseName, awsglue.GetTableName(job.LogType),
+		partitionFilter(job.StartTime, job.EndTime),
+		job.StartTime.UTC().Format(athenaTimeLayout), job.EndTime.UTC().Format(athenaTimeLayout),
+		job.MaxEvents+1)
+}
+
+// Restrict the query to the hourly partitions of the time range so Athena does not scan the whole table.
+func partitionFilter(start, end time.Time) string {
+	hour := func(t time.Time) int {
+		t = t.UTC()
+		return t.Year()*1000000 + int(t.Month())*10000 + t.Day()*100 + t.Hour()
+	}
+	return fmt.Sprintf("year*1000000 + month*10000 + day*100 + hour BETWEEN %d AND %d", hour(start), hour(end))
+}
+
+func parseEventRow(row *athena.Row) (*eventRow, error) {
+	if len(row.Data) != 3 {
+		return nil, errors.Errorf("expected 3 columns in athena results, found %d", len(row.Data))
+	}
+	eventTime, err := time.Parse(athenaResultLayout, aws.StringValue(row.Data[2].VarCharValue))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid p_event_time in athena results")
+	}
+	return &eventRow{
+		path:      aws.StringValue(row.Data[0].VarCharValue),
+		rowID:     aws.StringValue(row.Data[1].VarCharValue),
+		eventTime: eventTime.UTC(),
+	}, nil
+}
+
+// Read the gzipped JSON lines of a processed data object, calling handler with the row id and JSON of each event.
+func readEvents(path string, handler func(rowID string, data []byte) error) error {
+	bucket, key, err := parseS3Path(path)
+	if err != nil {
+		return err
+	}
+
+	object, err := s3Client.GetObject(&s3.GetObjectInput{Bucket: &bucket, Key: &key})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %s", path)
+	}
+	defer object.Body.Close()
+
+	gzipReader, err := gzip.NewReader(object.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s", path)
+	}
+	defer gzipReader.Close()
+
+	// Events can be larger than the default bufio.Scanner buffer, so read whole lines
+	reader := bufio.NewReader(gzipReader)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if handlerErr := handler(jsoniter.Get(line, "p_row_id").ToString(), line); handlerErr != nil {
+				return handlerErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s", path)
+		}
+	}
+}
+
+// Split an s3://bucket/key path
+func parseS3Path(path string) (bucket, key string, err error) {
+	parts := strings.SplitN(strings.TrimPrefix(path, "s3://"), "/", 2)
+	if !strings.HasPrefix(path, "s3://") || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.Errorf("invalid s3 path %s", path)
+	}
+	return parts[0], parts[1], nil
+}
@@ -16,6 +16,10 @@
 
 import collections
 import json
+import os
+import shutil
+import sys
+import tempfile
 from gzip import GzipFile
 from io import TextIOWrapper
 from timeit import default_timer
@@ -30,6 +34,7 @@ from .output import MatchedEventsBuffer
 from .rule import Rule
 
 _S3_CLIENT = boto3.client('s3')
+_GLOBALS = os.path.join(tempfile.gettempdir(), 'globals')
 _LOGGER = get_logger()
 _RULES_ENGINE = Engine(AnalysisAPIClient())
 
@@ -45,8 +50,19 @@ def lambda_handler(event: Dict[str, Any], unused_context: Any) -> Optional[Dict[
 
 def direct_analysis(request: Dict[str, Any]) -> Dict[str, Any]:
     """
-    Evaluates a single rule against a set of events, and returns the results. Currently used for testing policies directly.
+    Evaluates a single rule against a set of events, and returns the results. Currently used for testing and backtesting rules directly.
+
+    The optional globals of the request replace the deployed globals with the same id.
     """
+    global_names = _install_globals(request.get('globals') or [])
+    try:
+        return _analyze_directly(request)
+    finally:
+        _remove_globals(global_names)
+
+
+def _analyze_directly(request: Dict[str, Any]) -> Dict[str, Any]:
+    """Runs the rule of a direct analysis request"""
     # Since this is used for testing single rules, it should only ever have one rule
     if len(request['rules']) != 1:
         raise RuntimeError('exactly one rule expected, found {}'.format(len(request['rules'])))
@@ -84,6 +100,7 @@ def direct_analysis(request: Dict[str, Any]) -> Dict[str, Any]:
                 }]
             elif rule_result.matched:
                 result['matched'] = [raw_rule['id']]
+                result['dedup'] = rule_result.dedup_string
             else:
                 result['notMatched'] = [raw_rule['id']]
 
@@ -91,6 +108,45 @@ def direct_analysis(request: Dict[str, Any]) -> Dict[str, Any]:
     return response
 
 
+def _install_globals(raw_globals: List[Dict[str, str]]) -> List[str]:
+    """Save globals to /tmp so they are imported instead of the deployed globals with the same name.
+
+    Returns the module names, which are passed to _remove_globals once the analysis is done.
+    """
+    if not raw_globals:
+        return []
+
+    shutil.rmtree(_GLOBALS, ignore_errors=True)
+    os.makedirs(_GLOBALS)
+    names = []
+    for raw_global in raw_globals:
+        # Globals are imported by their id, any other id could never be imported
+        if not raw_global['id'].isidentifier():
+            continue
+        with open(os.path.join(_GLOBALS, raw_global['id'] + '.py'), 'w') as py_file:
+            py_file.write(raw_global['body'])
+        names.append(raw_global['id'])
+
+    if names:
+        sys.path.insert(0, _GLOBALS)
+        _unload_modules(names)
+    return names
+
+
+def _remove_globals(names: List[str]) -> None:
+    """Restore the deployed globals after _install_globals."""
+    if not names:
+        return
+    sys.path.remove(_GLOBALS)
+    _unload_modules(names)
+
+
+def _unload_modules(names: List[str]) -> None:
+    """Forget imported modules, so the next import loads them from the current sys.path."""
+    for name in names:
+        sys.modules.pop(name, None)
+
+
 # pylint: disable=too-many-locals
 def log_analysis(event: Dict[str, Any]) -> None:
     """Runs log analysis"""
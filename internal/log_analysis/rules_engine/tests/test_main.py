@@ -45,7 +45,29 @@ class TestMainDirectAnalysis(TestCase):
     def test_direct_analysis_event_matching(self) -> None:
         rule_body = 'def rule(event):\n\treturn True'
         payload = {'rules': [{'id': 'rule_id', 'body': rule_body}], 'events': [{'id': 'event_id', 'data': 'data'}]}
-        expected_response = {'events': [{'id': 'event_id', 'matched': ['rule_id'], 'notMatched': [], 'errored': []}]}
+        expected_response = {
+            'events': [{
+                'id': 'event_id',
+                'matched': ['rule_id'],
+                'notMatched': [],
+                'errored': [],
+                'dedup': 'defaultDedupString:rule_id'
+            }]
+        }
+        self.assertEqual(expected_response, lambda_handler(payload, None))
+
+    def test_direct_analysis_event_matching_with_dedup(self) -> None:
+        rule_body = 'def rule(event):\n\treturn True\ndef dedup(event):\n\treturn event["user"]'
+        payload = {'rules': [{'id': 'rule_id', 'body': rule_body}], 'events': [{'id': 'event_id', 'data': {'user': 'alice'}}]}
+        expected_response = {
+            'events': [{
+                'id': 'event_id',
+                'matched': ['rule_id'],
+                'notMatched': [],
+                'errored': [],
+                'dedup': 'alice'
+            }]
+        }
         self.assertEqual(expected_response, lambda_handler(payload, None))
 
     def test_direct_analysis_event_not_matching(self) -> None:
@@ -96,6 +118,30 @@ class TestMainDirectAnalysis(TestCase):
         }
         self.assertEqual(expected_response, lambda_handler(payload, None))
 
+    def test_direct_analysis_with_globals(self) -> None:
+        rule_body = 'from test_rules_global import helper\ndef rule(event):\n\treturn helper()'
+        payload = {
+            'globals': [{
+                'id': 'test_rules_global',
+                'body': 'def helper():\n\treturn False'
+            }],
+            'rules': [{
+                'id': 'rule_id',
+                'body': rule_body
+            }],
+            'events': [{
+                'id': 'event_id',
+                'data': 'data'
+            }]
+        }
+        expected_response = {'events': [{'id': 'event_id', 'matched': [], 'notMatched': ['rule_id'], 'errored': []}]}
+        self.assertEqual(expected_response, lambda_handler(payload, None))
+
+        # The globals are only importable during the request
+        del payload['globals']
+        response = lambda_handler(payload, None)
+        self.assertEqual('ModuleNotFoundError: No module named \'test_rules_global\'', response['events'][0]['errored'][0]['message'])
+
 
 class TestMainLoadS3Notifications(TestCase):
 
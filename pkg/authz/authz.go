@@ -0,0 +1,139 @@
+// Package authz implements role-based access control for Panther users.
+package authz
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Permission grants a user the ability to modify one area of Panther.
+//
+// Every authenticated user can read everything; permissions only gate changes.
+type Permission string
+
+const (
+	// DestinationModify allows creating, updating and deleting alert destinations (outputs).
+	DestinationModify Permission = "DestinationModify"
+	// PolicyModify allows creating, updating, suppressing and deleting policies, and remediating their failures.
+	PolicyModify Permission = "PolicyModify"
+	// RuleModify allows creating, updating and deleting rules.
+	RuleModify Permission = "RuleModify"
+	// SettingsModify allows changing the general settings of the organization.
+	SettingsModify Permission = "SettingsModify"
+	// SourceModify allows onboarding, updating and deleting source integrations.
+	SourceModify Permission = "SourceModify"
+	// UserModify allows inviting, updating and removing users and managing their roles.
+	UserModify Permission = "UserModify"
+)
+
+// AllPermissions lists every permission, in alphabetical order.
+var AllPermissions = []Permission{
+	DestinationModify,
+	PolicyModify,
+	RuleModify,
+	SettingsModify,
+	SourceModify,
+	UserModify,
+}
+
+// Names of the built-in roles, which can not be modified or deleted.
+const (
+	RoleAdmin    = "Admin"
+	RoleAnalyst  = "Analyst"
+	RoleReadOnly = "ReadOnly"
+)
+
+// DefaultRole applies to users without an assigned role, e.g. users created outside of Panther.
+//
+// Users who were created before roles existed are assigned Admin when Panther is upgraded.
+const DefaultRole = RoleReadOnly
+
+// BuiltInRoles maps each built-in role to its permissions.
+var BuiltInRoles = map[string][]Permission{
+	RoleAdmin:    AllPermissions,
+	RoleAnalyst:  {PolicyModify, RuleModify},
+	RoleReadOnly: {},
+}
+
+// IsBuiltInRole returns true if the role name is reserved for a built-in role.
+func IsBuiltInRole(name string) bool {
+	_, ok := BuiltInRoles[name]
+	return ok
+}
+
+// Checker looks up the permissions held by a Panther user.
+type Checker interface {
+	UserPermissions(userID string) ([]Permission, error)
+}
+
+// DeniedError is returned by Check when a user lacks a required permission.
+type DeniedError struct {
+	UserID     string
+	Permission Permission
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("user %s does not have the %s permission", e.UserID, e.Permission)
+}
+
+// Check verifies that the user holds all of the required permissions.
+//
+// Returns *DeniedError if a permission is missing, or the error from the Checker if the
+// permissions could not be looked up.
+func Check(checker Checker, userID string, required ...Permission) error {
+	if len(required) == 0 {
+		return nil
+	}
+
+	held, err := checker.UserPermissions(userID)
+	if err != nil {
+		return err
+	}
+
+	for _, permission := range required {
+		if !Has(held, permission) {
+			return &DeniedError{UserID: userID, Permission: permission}
+		}
+	}
+	return nil
+}
+
+// Has returns true if the permission is in the list.
+func Has(permissions []Permission, permission Permission) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Normalize sorts and de-duplicates a list of permissions.
+func Normalize(permissions []Permission) []Permission {
+	result := make([]Permission, 0, len(permissions))
+	for _, p := range permissions {
+		if !Has(result, p) {
+			result = append(result, p)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
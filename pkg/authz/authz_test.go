@@ -0,0 +1,66 @@
+package authz
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticChecker map[string][]Permission
+
+func (c staticChecker) UserPermissions(userID string) ([]Permission, error) {
+	if userID == "broken" {
+		return nil, errors.New("lookup failed")
+	}
+	return c[userID], nil
+}
+
+func TestCheck(t *testing.T) {
+	checker := staticChecker{
+		"admin":   BuiltInRoles[RoleAdmin],
+		"analyst": BuiltInRoles[RoleAnalyst],
+	}
+
+	assert.NoError(t, Check(checker, "admin", DestinationModify, RuleModify))
+	assert.NoError(t, Check(checker, "analyst", RuleModify, PolicyModify))
+	assert.NoError(t, Check(checker, "broken"))
+
+	err := Check(checker, "analyst", DestinationModify)
+	require.Error(t, err)
+	assert.Equal(t, &DeniedError{UserID: "analyst", Permission: DestinationModify}, err)
+	assert.Equal(t, "user analyst does not have the DestinationModify permission", err.Error())
+
+	assert.EqualError(t, Check(checker, "broken", RuleModify), "lookup failed")
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t,
+		[]Permission{RuleModify, UserModify},
+		Normalize([]Permission{UserModify, RuleModify, UserModify}))
+	assert.Equal(t, []Permission{}, Normalize(nil))
+}
+
+func TestIsBuiltInRole(t *testing.T) {
+	assert.True(t, IsBuiltInRole(RoleReadOnly))
+	assert.False(t, IsBuiltInRole("Responder"))
+}
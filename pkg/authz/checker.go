@@ -0,0 +1,163 @@
+package authz
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/pkg/errors"
+)
+
+// How long user permissions are cached before being read from Dynamo again.
+//
+// Every API Lambda keeps its own cache and only the users-api invalidates it when roles change,
+// so this is how long a revoked permission can still be used through the other APIs.
+// It is kept short: a cache hit only saves two consistent reads per request.
+const cacheTTL = 10 * time.Second
+
+// UserRoleItem is the Dynamo row assigning a role to a user.
+type UserRoleItem struct {
+	UserID string `json:"userId"`
+	Role   string `json:"role"`
+}
+
+// RoleItem is the Dynamo row describing a custom role (permission set).
+type RoleItem struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Permissions []Permission `json:"permissions" dynamodbav:"permissions,stringset,omitempty"`
+}
+
+type cacheEntry struct {
+	permissions []Permission
+	expiresAt   time.Time
+}
+
+// TableChecker resolves user permissions from the user roles and custom roles tables.
+type TableChecker struct {
+	client     dynamodbiface.DynamoDBAPI
+	usersTable string
+	rolesTable string
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+// NewTableChecker returns a Checker which reads the given Dynamo tables.
+func NewTableChecker(client dynamodbiface.DynamoDBAPI, usersTable, rolesTable string) *TableChecker {
+	return &TableChecker{
+		client:     client,
+		usersTable: usersTable,
+		rolesTable: rolesTable,
+		cache:      make(map[string]cacheEntry),
+	}
+}
+
+// UserPermissions returns the permissions granted by the user's role.
+func (c *TableChecker) UserPermissions(userID string) ([]Permission, error) {
+	c.mu.Lock()
+	entry, ok := c.cache[userID]
+	c.mu.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.permissions, nil
+	}
+
+	role, err := c.UserRole(userID)
+	if err != nil {
+		return nil, err
+	}
+	permissions, err := c.RolePermissions(role)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.cache[userID] = cacheEntry{permissions: permissions, expiresAt: time.Now().Add(cacheTTL)}
+	c.mu.Unlock()
+	return permissions, nil
+}
+
+// UserRole returns the name of the role assigned to the user, or DefaultRole if there is none.
+func (c *TableChecker) UserRole(userID string) (string, error) {
+	var item UserRoleItem
+	found, err := c.getItem(c.usersTable, map[string]*dynamodb.AttributeValue{
+		"userId": {S: &userID},
+	}, &item)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return DefaultRole, nil
+	}
+	return item.Role, nil
+}
+
+// RolePermissions returns the permissions granted by a built-in or custom role.
+//
+// A custom role which no longer exists grants no permissions.
+func (c *TableChecker) RolePermissions(role string) ([]Permission, error) {
+	if permissions, ok := BuiltInRoles[role]; ok {
+		return permissions, nil
+	}
+
+	var item RoleItem
+	if _, err := c.getItem(c.rolesTable, map[string]*dynamodb.AttributeValue{
+		"name": {S: &role},
+	}, &item); err != nil {
+		return nil, err
+	}
+	return item.Permissions, nil
+}
+
+// Invalidate drops the cached permissions for a user, e.g. after their role changed.
+func (c *TableChecker) Invalidate(userID string) {
+	c.mu.Lock()
+	delete(c.cache, userID)
+	c.mu.Unlock()
+}
+
+// InvalidateAll drops all cached permissions, e.g. after a custom role changed.
+func (c *TableChecker) InvalidateAll() {
+	c.mu.Lock()
+	c.cache = make(map[string]cacheEntry)
+	c.mu.Unlock()
+}
+
+func (c *TableChecker) getItem(table string, key map[string]*dynamodb.AttributeValue, item interface{}) (bool, error) {
+	response, err := c.client.GetItem(&dynamodb.GetItemInput{
+		ConsistentRead: aws.Bool(true),
+		Key:            key,
+		TableName:      &table,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get item from %s", table)
+	}
+	if len(response.Item) == 0 {
+		return false, nil
+	}
+	if err := dynamodbattribute.UnmarshalMap(response.Item, item); err != nil {
+		return false, errors.Wrapf(err, "failed to unmarshal item from %s", table)
+	}
+	return true, nil
+}
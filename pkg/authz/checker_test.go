@@ -0,0 +1,127 @@
+package authz
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/panther-labs/panther/pkg/testutils"
+)
+
+func userRoleRequest(userID string) *dynamodb.GetItemInput {
+	return &dynamodb.GetItemInput{
+		ConsistentRead: aws.Bool(true),
+		Key:            map[string]*dynamodb.AttributeValue{"userId": {S: aws.String(userID)}},
+		TableName:      aws.String("users"),
+	}
+}
+
+func roleRequest(name string) *dynamodb.GetItemInput {
+	return &dynamodb.GetItemInput{
+		ConsistentRead: aws.Bool(true),
+		Key:            map[string]*dynamodb.AttributeValue{"name": {S: aws.String(name)}},
+		TableName:      aws.String("roles"),
+	}
+}
+
+func TestUserPermissionsBuiltInRole(t *testing.T) {
+	client := &testutils.DynamoDBMock{}
+	client.On("GetItem", userRoleRequest("user-1")).Return(&dynamodb.GetItemOutput{
+		Item: map[string]*dynamodb.AttributeValue{
+			"userId": {S: aws.String("user-1")},
+			"role":   {S: aws.String(RoleAnalyst)},
+		},
+	}, nil).Once()
+
+	checker := NewTableChecker(client, "users", "roles")
+	permissions, err := checker.UserPermissions("user-1")
+	require.NoError(t, err)
+	assert.Equal(t, []Permission{PolicyModify, RuleModify}, permissions)
+
+	// The second lookup is served from the cache
+	permissions, err = checker.UserPermissions("user-1")
+	require.NoError(t, err)
+	assert.Equal(t, []Permission{PolicyModify, RuleModify}, permissions)
+	client.AssertExpectations(t)
+}
+
+func TestUserPermissionsCustomRole(t *testing.T) {
+	client := &testutils.DynamoDBMock{}
+	client.On("GetItem", userRoleRequest("user-1")).Return(&dynamodb.GetItemOutput{
+		Item: map[string]*dynamodb.AttributeValue{
+			"userId": {S: aws.String("user-1")},
+			"role":   {S: aws.String("Responder")},
+		},
+	}, nil)
+	client.On("GetItem", roleRequest("Responder")).Return(&dynamodb.GetItemOutput{
+		Item: map[string]*dynamodb.AttributeValue{
+			"name":        {S: aws.String("Responder")},
+			"permissions": {SS: aws.StringSlice([]string{"DestinationModify", "RuleModify"})},
+		},
+	}, nil)
+
+	permissions, err := NewTableChecker(client, "users", "roles").UserPermissions("user-1")
+	require.NoError(t, err)
+	assert.Equal(t, []Permission{DestinationModify, RuleModify}, permissions)
+	client.AssertExpectations(t)
+}
+
+func TestUserPermissionsDefaultRole(t *testing.T) {
+	client := &testutils.DynamoDBMock{}
+	client.On("GetItem", userRoleRequest("user-1")).Return(&dynamodb.GetItemOutput{}, nil)
+
+	permissions, err := NewTableChecker(client, "users", "roles").UserPermissions("user-1")
+	require.NoError(t, err)
+	assert.Empty(t, permissions)
+	client.AssertExpectations(t)
+}
+
+func TestUserPermissionsDeletedRole(t *testing.T) {
+	client := &testutils.DynamoDBMock{}
+	client.On("GetItem", userRoleRequest("user-1")).Return(&dynamodb.GetItemOutput{
+		Item: map[string]*dynamodb.AttributeValue{"role": {S: aws.String("Responder")}},
+	}, nil)
+	client.On("GetItem", roleRequest("Responder")).Return(&dynamodb.GetItemOutput{}, nil)
+
+	permissions, err := NewTableChecker(client, "users", "roles").UserPermissions("user-1")
+	require.NoError(t, err)
+	assert.Empty(t, permissions)
+	client.AssertExpectations(t)
+}
+
+func TestUserPermissionsError(t *testing.T) {
+	client := &testutils.DynamoDBMock{}
+	client.On("GetItem", mock.Anything).Return(&dynamodb.GetItemOutput{}, errors.New("throttled"))
+
+	checker := NewTableChecker(client, "users", "roles")
+	_, err := checker.UserPermissions("user-1")
+	assert.EqualError(t, err, "failed to get item from users: throttled")
+
+	// Errors are not cached
+	_, err = checker.UserPermissions("user-1")
+	assert.Error(t, err)
+	client.AssertNumberOfCalls(t, "GetItem", 2)
+}
@@ -0,0 +1,104 @@
+package gatewayapi
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+	"go.uber.org/zap"
+
+	"github.com/panther-labs/panther/pkg/authz"
+)
+
+// UserIDHeader identifies the Panther user on whose behalf AppSync calls a Gateway API.
+//
+// Requests from other Panther services do not set it.
+const UserIDHeader = "X-Panther-User-Id"
+
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+// RequirePermissions restricts method/resource pairs to callers who hold the given permissions.
+//
+// permissions maps method keys (e.g. "POST /delete") to the permissions required to invoke them.
+// The returned handlers reject callers without them with 403 Forbidden.
+//
+// Requests without a caller are rejected as well, unless the method key is one of serviceMethods:
+// the methods which other Panther services are allowed to invoke on their own behalf.
+func RequirePermissions(
+	checker authz.Checker,
+	permissions map[string][]authz.Permission,
+	methodHandlers map[string]RequestHandler,
+	serviceMethods ...string,
+) map[string]RequestHandler {
+
+	services := make(map[string]bool, len(serviceMethods))
+	for _, methodKey := range serviceMethods {
+		services[methodKey] = true
+	}
+
+	result := make(map[string]RequestHandler, len(methodHandlers))
+	for methodKey, handler := range methodHandlers {
+		required, ok := permissions[methodKey]
+		if !ok {
+			result[methodKey] = handler
+			continue
+		}
+		result[methodKey] = authorize(checker, required, services[methodKey], handler)
+	}
+	return result
+}
+
+func authorize(
+	checker authz.Checker, required []authz.Permission, allowServices bool, handler RequestHandler) RequestHandler {
+
+	return func(request *events.APIGatewayProxyRequest) *events.APIGatewayProxyResponse {
+		callerID := CallerID(request)
+		if callerID == "" {
+			if allowServices {
+				return handler(request)
+			}
+			return MarshalResponse(&errorResponse{Message: "missing " + UserIDHeader + " header"}, http.StatusForbidden)
+		}
+
+		if err := authz.Check(checker, callerID, required...); err != nil {
+			var denied *authz.DeniedError
+			if errors.As(err, &denied) {
+				return MarshalResponse(&errorResponse{Message: err.Error()}, http.StatusForbidden)
+			}
+			zap.L().Error("failed to check permissions", zap.String("userId", callerID), zap.Error(err))
+			return &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}
+		}
+		return handler(request)
+	}
+}
+
+// CallerID returns the ID of the Panther user who made the request, if any.
+func CallerID(request *events.APIGatewayProxyRequest) string {
+	for name, value := range request.Headers {
+		if strings.EqualFold(name, UserIDHeader) {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,97 @@
+package gatewayapi
+
+/**
+ * Panther is a Cloud-Native SIEM for the Modern Security Team.
+ * Copyright (C) 2020 Panther Labs Inc
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/panther-labs/panther/pkg/authz"
+)
+
+type mockChecker map[string][]authz.Permission
+
+func (m mockChecker) UserPermissions(userID string) ([]authz.Permission, error) {
+	if userID == "broken" {
+		return nil, errors.New("throttled")
+	}
+	return m[userID], nil
+}
+
+var protectedHandlers = RequirePermissions(
+	mockChecker{"admin": {authz.RuleModify}},
+	map[string][]authz.Permission{
+		"DELETE /panthers/{catId}": {authz.RuleModify},
+		"PUT /panthers/{catId}":    {authz.RuleModify},
+	},
+	map[string]RequestHandler{
+		"GET /panthers":            listPanthers,
+		"DELETE /panthers/{catId}": deletePanther,
+		"PUT /panthers/{catId}":    deletePanther,
+	},
+	"PUT /panthers/{catId}",
+)
+
+func requestFrom(userID string) *events.APIGatewayProxyRequest {
+	return &events.APIGatewayProxyRequest{Headers: map[string]string{"x-panther-user-id": userID}}
+}
+
+func TestRequirePermissionsPermitted(t *testing.T) {
+	result := protectedHandlers["DELETE /panthers/{catId}"](requestFrom("admin"))
+	assert.Equal(t, http.StatusNotFound, result.StatusCode)
+}
+
+func TestRequirePermissionsDenied(t *testing.T) {
+	result := protectedHandlers["DELETE /panthers/{catId}"](requestFrom("analyst"))
+	assert.Equal(t, &events.APIGatewayProxyResponse{
+		Body:       `{"message":"user analyst does not have the RuleModify permission"}`,
+		StatusCode: http.StatusForbidden,
+	}, result)
+}
+
+func TestRequirePermissionsCheckFailed(t *testing.T) {
+	result := protectedHandlers["DELETE /panthers/{catId}"](requestFrom("broken"))
+	assert.Equal(t, &events.APIGatewayProxyResponse{StatusCode: http.StatusInternalServerError}, result)
+}
+
+func TestRequirePermissionsUnprotected(t *testing.T) {
+	result := protectedHandlers["GET /panthers"](requestFrom("analyst"))
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+}
+
+func TestRequirePermissionsNoCaller(t *testing.T) {
+	result := protectedHandlers["DELETE /panthers/{catId}"](&events.APIGatewayProxyRequest{})
+	assert.Equal(t, &events.APIGatewayProxyResponse{
+		Body:       `{"message":"missing X-Panther-User-Id header"}`,
+		StatusCode: http.StatusForbidden,
+	}, result)
+}
+
+func TestRequirePermissionsServiceMethod(t *testing.T) {
+	// Requests from other services are not checked
+	result := protectedHandlers["PUT /panthers/{catId}"](&events.APIGatewayProxyRequest{})
+	assert.Equal(t, http.StatusNotFound, result.StatusCode)
+
+	result = protectedHandlers["PUT /panthers/{catId}"](requestFrom("analyst"))
+	assert.Equal(t, http.StatusForbidden, result.StatusCode)
+}
@@ -88,6 +88,16 @@ func (e *InvalidInputError) Error() string {
 	return e.Message
 }
 
+// PermissionDeniedError is raised if the caller does not hold the permissions required by the route.
+type PermissionDeniedError struct {
+	Route   string
+	Message string
+}
+
+func (e *PermissionDeniedError) Error() string {
+	return e.Message
+}
+
 // LambdaError wraps the error structure returned by a Golang Lambda function.
 //
 // This applies to all errors - returned errors, panics, time outs, etc.
@@ -56,6 +56,11 @@ func TestInvalidInputError(t *testing.T) {
 	assert.Equal(t, "you forgot something", err.Error())
 }
 
+func TestPermissionDeniedError(t *testing.T) {
+	err := &PermissionDeniedError{Route: "Do", Message: "user does not have the RuleModify permission"}
+	assert.Equal(t, "user does not have the RuleModify permission", err.Error())
+}
+
 func TestLambdaErrorEmpty(t *testing.T) {
 	err := &LambdaError{}
 	assert.Equal(t, "lambda error returned: (nil)", err.Error())
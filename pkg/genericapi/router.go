@@ -20,6 +20,7 @@ package genericapi
  */
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -27,6 +28,7 @@ import (
 	"go.uber.org/zap"
 	"gopkg.in/go-playground/validator.v9"
 
+	"github.com/panther-labs/panther/pkg/authz"
 	"github.com/panther-labs/panther/pkg/gatewayapi"
 	"github.com/panther-labs/panther/pkg/oplog"
 )
@@ -38,6 +40,10 @@ type Router struct {
 	validate     *validator.Validate      // input validation
 	routes       reflect.Value            // handler functions
 	routesByName map[string]reflect.Value // cache routeName => handler function
+
+	checker       authz.Checker                 // resolves the permissions of the caller
+	permissions   map[string][]authz.Permission // routeName => permissions the caller must hold
+	serviceRoutes map[string]bool               // protected routes which can be invoked without a caller
 }
 
 // NewRouter initializes a Router with the handler functions and validator.
@@ -58,12 +64,44 @@ func NewRouter(namespace, component string, validate *validator.Validate, routes
 	}
 }
 
+// RequirePermissions restricts routes to callers who hold the given permissions.
+//
+// permissions maps route names (e.g. "DeleteOutput") to the permissions required to invoke them.
+// Routes which are not listed can be invoked by anyone.
+//
+// Requests to a listed route without a caller are denied, unless the route is one of serviceRoutes:
+// the routes which other Panther services are allowed to invoke on their own behalf.
+func (r *Router) RequirePermissions(
+	checker authz.Checker, permissions map[string][]authz.Permission, serviceRoutes ...string) *Router {
+
+	r.checker = checker
+	r.permissions = permissions
+	r.serviceRoutes = make(map[string]bool, len(serviceRoutes))
+	for _, route := range serviceRoutes {
+		r.serviceRoutes[route] = true
+	}
+	return r
+}
+
 // Handle validates the Lambda input and invokes the appropriate handler.
 //
+// Handle does not check permissions: it is meant for requests from other Panther services.
+func (r *Router) Handle(input interface{}) (output interface{}, err error) {
+	return r.handle(input, false, "")
+}
+
+// HandleAs is Handle for a request made on behalf of a Panther user.
+//
+// The caller must hold the permissions registered for the route with RequirePermissions.
+// An empty callerID can only invoke the service routes and the routes which require no permissions.
+func (r *Router) HandleAs(callerID string, input interface{}) (output interface{}, err error) {
+	return r.handle(input, true, callerID)
+}
+
 // For the sake of efficiency, no attempt is made to validate the routes or function signatures.
 // As a result, this function will panic if a handler does not exist or is invalid.
 // Be sure to VerifyHandlers as part of the unit tests for your function!
-func (r *Router) Handle(input interface{}) (output interface{}, err error) {
+func (r *Router) handle(input interface{}, checkPermissions bool, callerID string) (output interface{}, err error) {
 	req, err := findRequest(input)
 	if err != nil {
 		// we do not have the route yet, special case, use oplog to keep logging standard
@@ -99,6 +137,12 @@ func (r *Router) Handle(input interface{}) (output interface{}, err error) {
 		return nil, &InvalidInputError{Route: req.route, Message: msg}
 	}
 
+	if checkPermissions {
+		if err = r.authorize(callerID, req.route); err != nil {
+			return nil, err
+		}
+	}
+
 	// Find the handler function, either cached or reflected.
 	var handler reflect.Value
 	var ok bool
@@ -121,6 +165,29 @@ func (r *Router) Handle(input interface{}) (output interface{}, err error) {
 	return result, err
 }
 
+// authorize returns an error unless the caller holds the permissions required by the route
+func (r *Router) authorize(callerID, route string) error {
+	required, ok := r.permissions[route]
+	if r.checker == nil || !ok {
+		return nil
+	}
+	if callerID == "" {
+		if r.serviceRoutes[route] {
+			return nil
+		}
+		return &PermissionDeniedError{Route: route, Message: "missing caller"}
+	}
+
+	if err := authz.Check(r.checker, callerID, required...); err != nil {
+		var denied *authz.DeniedError
+		if errors.As(err, &denied) {
+			return &PermissionDeniedError{Route: route, Message: err.Error()}
+		}
+		return &InternalError{Route: route, Message: "failed to check permissions: " + err.Error()}
+	}
+	return nil
+}
+
 type request struct {
 	route string        // name of the route, e.g. "AddRule"
 	input reflect.Value // input for the route handler, e.g. &AddRuleInput{}
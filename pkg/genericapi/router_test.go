@@ -25,6 +25,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/panther-labs/panther/pkg/authz"
 )
 
 const mockID = "825488f4-10d7-4c29-a4c4-51d85d30c1ce"
@@ -127,6 +129,81 @@ func TestHandleTwoReturnValuesError(t *testing.T) {
 	assert.Equal(t, &AlreadyExistsError{Route: "AddRule"}, err) // route name was injected
 }
 
+type mockChecker map[string][]authz.Permission
+
+func (m mockChecker) UserPermissions(userID string) ([]authz.Permission, error) {
+	if userID == "broken" {
+		return nil, errors.New("throttled")
+	}
+	return m[userID], nil
+}
+
+var protectedRouter = NewRouter("testNamespace", "testComponent", nil, &routes{}).RequirePermissions(
+	mockChecker{"admin": {authz.RuleModify}},
+	map[string][]authz.Permission{
+		"DeleteRule": {authz.RuleModify},
+		"UpdateRule": {authz.RuleModify},
+	},
+	"UpdateRule",
+)
+
+func TestHandleAsPermitted(t *testing.T) {
+	input := &lambdaInput{DeleteRule: &deleteRuleInput{RuleID: aws.String(mockID)}}
+	result, err := protectedRouter.HandleAs("admin", input)
+	assert.Nil(t, result)
+	assert.NoError(t, err)
+}
+
+func TestHandleAsDenied(t *testing.T) {
+	input := &lambdaInput{DeleteRule: &deleteRuleInput{RuleID: aws.String(mockID)}}
+	result, err := protectedRouter.HandleAs("analyst", input)
+	assert.Nil(t, result)
+	errExpected := &PermissionDeniedError{
+		Route:   "DeleteRule",
+		Message: "user analyst does not have the RuleModify permission",
+	}
+	assert.Equal(t, errExpected, err)
+}
+
+func TestHandleAsCheckFailed(t *testing.T) {
+	input := &lambdaInput{DeleteRule: &deleteRuleInput{RuleID: aws.String(mockID)}}
+	result, err := protectedRouter.HandleAs("broken", input)
+	assert.Nil(t, result)
+	assert.Equal(t, &InternalError{Route: "DeleteRule", Message: "failed to check permissions: throttled"}, err)
+}
+
+func TestHandleAsUnprotectedRoute(t *testing.T) {
+	input := &lambdaInput{AddRule: &addRuleInput{Name: aws.String("MyRule")}}
+	result, err := protectedRouter.HandleAs("analyst", input)
+	assert.Equal(t, &addRuleOutput{RuleID: aws.String(mockID)}, result)
+	assert.NoError(t, err)
+}
+
+func TestHandleWithoutCaller(t *testing.T) {
+	// Requests from other services are not checked
+	input := &lambdaInput{DeleteRule: &deleteRuleInput{RuleID: aws.String(mockID)}}
+	result, err := protectedRouter.Handle(input)
+	assert.Nil(t, result)
+	assert.NoError(t, err)
+}
+
+func TestHandleAsWithoutCaller(t *testing.T) {
+	input := &lambdaInput{DeleteRule: &deleteRuleInput{RuleID: aws.String(mockID)}}
+	result, err := protectedRouter.HandleAs("", input)
+	assert.Nil(t, result)
+	assert.Equal(t, &PermissionDeniedError{Route: "DeleteRule", Message: "missing caller"}, err)
+}
+
+func TestHandleAsServiceRoute(t *testing.T) {
+	// Other services can invoke service routes without a caller
+	input := &lambdaInput{UpdateRule: &updateRuleInput{Name: aws.String("MyRule")}}
+	_, err := protectedRouter.HandleAs("", input)
+	assert.EqualError(t, err, "manual error") // the handler was invoked
+
+	_, err = protectedRouter.HandleAs("analyst", input)
+	assert.IsType(t, &PermissionDeniedError{}, err)
+}
+
 // How expensive is it to look up a method by name?
 // 385 ns/op
 func BenchmarkNameFinding(b *testing.B) {
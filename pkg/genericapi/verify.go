@@ -23,7 +23,8 @@ import (
 	"reflect"
 )
 
-// VerifyHandlers returns an error if the route handlers don't match the Lambda input struct.
+// VerifyHandlers returns an error if the route handlers don't match the Lambda input struct
+// or if a permission is required for a route which does not exist.
 //
 // This should be part of the unit tests for your Lambda function.
 func (r *Router) VerifyHandlers(lambdaInput interface{}) error {
@@ -50,6 +51,12 @@ func (r *Router) VerifyHandlers(lambdaInput interface{}) error {
 		}
 	}
 
+	for route := range r.permissions {
+		if _, ok := inputType.FieldByName(route); !ok {
+			return &InternalError{Message: "permissions required for unknown route " + route}
+		}
+	}
+
 	return nil
 }
 
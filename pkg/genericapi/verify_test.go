@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/panther-labs/panther/pkg/authz"
 )
 
 func TestVerifyNoHandlers(t *testing.T) {
@@ -105,3 +107,14 @@ func TestVerifyNoReturns(t *testing.T) {
 func TestVerifyValid(t *testing.T) {
 	assert.Nil(t, testRouter.VerifyHandlers(&lambdaInput{}))
 }
+
+func TestVerifyUnknownPermissionRoute(t *testing.T) {
+	router := NewRouter("testNamespace", "testComponent", nil, &routes{}).RequirePermissions(
+		mockChecker{}, map[string][]authz.Permission{"RemoveRule": {authz.RuleModify}})
+	err := router.VerifyHandlers(&lambdaInput{})
+	assert.Equal(t, "permissions required for unknown route RemoveRule", err.(*InternalError).Message)
+}
+
+func TestVerifyValidPermissions(t *testing.T) {
+	assert.Nil(t, protectedRouter.VerifyHandlers(&lambdaInput{}))
+}
@@ -81,6 +81,28 @@ func (m *LambdaMock) Invoke(input *lambda.InvokeInput) (*lambda.InvokeOutput, er
 	return args.Get(0).(*lambda.InvokeOutput), args.Error(1)
 }
 
+func (m *LambdaMock) CreateEventSourceMapping(
+	input *lambda.CreateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
+
+	args := m.Called(input)
+	return args.Get(0).(*lambda.EventSourceMappingConfiguration), args.Error(1)
+}
+
+func (m *LambdaMock) UpdateEventSourceMapping(
+	input *lambda.UpdateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
+
+	args := m.Called(input)
+	return args.Get(0).(*lambda.EventSourceMappingConfiguration), args.Error(1)
+}
+
+func (m *LambdaMock) ListEventSourceMappingsPages(
+	input *lambda.ListEventSourceMappingsInput, f func(page *lambda.ListEventSourceMappingsOutput, morePages bool) bool) error {
+
+	args := m.Called(input, f)
+	f(args.Get(0).(*lambda.ListEventSourceMappingsOutput), false)
+	return args.Error(1)
+}
+
 type DynamoDBMock struct {
 	dynamodbiface.DynamoDBAPI
 	mock.Mock
@@ -111,6 +133,11 @@ func (m *DynamoDBMock) Scan(input *dynamodb.ScanInput) (*dynamodb.ScanOutput, er
 	return args.Get(0).(*dynamodb.ScanOutput), args.Error(1)
 }
 
+func (m *DynamoDBMock) TransactWriteItems(input *dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(*dynamodb.TransactWriteItemsOutput), args.Error(1)
+}
+
 type SqsMock struct {
 	sqsiface.SQSAPI
 	mock.Mock
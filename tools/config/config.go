@@ -49,16 +49,27 @@ type Monitoring struct {
 }
 
 type Setup struct {
-	Company               Company          `yaml:"Company"`
-	FirstUser             FirstUser        `yaml:"FirstUser"`
-	OnboardSelf           bool             `yaml:"OnboardSelf"`
-	EnableS3AccessLogs    bool             `yaml:"EnableS3AccessLogs"`
-	EnableCloudTrail      bool             `yaml:"EnableCloudTrail"`
-	EnableGuardDuty       bool             `yaml:"EnableGuardDuty"`
-	S3AccessLogsBucket    string           `yaml:"S3AccessLogsBucket"`
-	DataReplicationBucket string           `yaml:"DataReplicationBucket"`
-	InitialAnalysisSets   []string         `yaml:"InitialAnalysisSets"`
-	LogSubscriptions      LogSubscriptions `yaml:"LogSubscriptions"`
+	AnalysisSync               AnalysisSync     `yaml:"AnalysisSync"`
+	Company                    Company          `yaml:"Company"`
+	FirstUser                  FirstUser        `yaml:"FirstUser"`
+	OnboardSelf                bool             `yaml:"OnboardSelf"`
+	EnableS3AccessLogs         bool             `yaml:"EnableS3AccessLogs"`
+	EnableCloudTrail           bool             `yaml:"EnableCloudTrail"`
+	EnableGuardDuty            bool             `yaml:"EnableGuardDuty"`
+	S3AccessLogsBucket         string           `yaml:"S3AccessLogsBucket"`
+	DataReplicationBucket      string           `yaml:"DataReplicationBucket"`
+	InitialAnalysisSets        []string         `yaml:"InitialAnalysisSets"`
+	LogSubscriptions           LogSubscriptions `yaml:"LogSubscriptions"`
+	RequireRemediationApproval bool             `yaml:"RequireRemediationApproval"`
+}
+
+type AnalysisSync struct {
+	RepositoryURL        string `yaml:"RepositoryUrl"`
+	CredentialsSecretArn string `yaml:"CredentialsSecretArn"`
+	Branch               string `yaml:"Branch"`
+	Path                 string `yaml:"Path"`
+	Schedule             string `yaml:"Schedule"`
+	GitLayerVersionArn   string `yaml:"GitLayerVersionArn"`
 }
 
 type Company struct {
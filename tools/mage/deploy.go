@@ -70,7 +70,9 @@ const (
 )
 
 // Not all AWS services are available in every region. In particular, Panther will currently NOT work in:
-//     n. california, us-gov, china, paris, stockholm, brazil, osaka, or bahrain
+//
+//	n. california, us-gov, china, paris, stockholm, brazil, osaka, or bahrain
+//
 // These regions are missing combinations of AppSync, Cognito, Athena, and/or Glue.
 // https://aws.amazon.com/about-aws/global-infrastructure/regional-product-services
 var supportedRegions = map[string]bool{
@@ -439,6 +441,7 @@ func deployCloudSecurityStack(settings *config.PantherConfig, outputs map[string
 		"ProcessedDataTopicArn":      outputs["ProcessedDataTopicArn"],
 		"PythonLayerVersionArn":      outputs["PythonLayerVersionArn"],
 		"RemediationApiId":           outputs["RemediationApiId"],
+		"RequireRemediationApproval": strconv.FormatBool(settings.Setup.RequireRemediationApproval),
 		"ResourcesApiId":             outputs["ResourcesApiId"],
 		"SqsKeyId":                   outputs["QueueEncryptionKeyId"],
 		"TracingMode":                settings.Monitoring.TracingMode,
@@ -448,26 +451,32 @@ func deployCloudSecurityStack(settings *config.PantherConfig, outputs map[string
 
 func deployCoreStack(settings *config.PantherConfig, outputs map[string]string) error {
 	_, err := deployTemplate(coreTemplate, outputs["SourceBucket"], coreStack, map[string]string{
-		"AlarmTopicArn":              outputs["AlarmTopicArn"],
-		"AnalysisApiEndpoint":        outputs["AnalysisApiEndpoint"],
-		"AnalysisApiId":              outputs["AnalysisApiId"],
-		"AnalysisVersionsBucket":     outputs["AnalysisVersionsBucket"],
-		"AppDomainURL":               outputs["LoadBalancerUrl"],
-		"AthenaResultsBucket":        outputs["AthenaResultsBucket"],
-		"CloudWatchLogRetentionDays": strconv.Itoa(settings.Monitoring.CloudWatchLogRetentionDays),
-		"CompanyDisplayName":         settings.Setup.Company.DisplayName,
-		"CompanyEmail":               settings.Setup.Company.Email,
-		"ComplianceApiId":            outputs["ComplianceApiId"],
-		"CustomResourceVersion":      customResourceVersion(),
-		"Debug":                      strconv.FormatBool(settings.Monitoring.Debug),
-		"DynamoScalingRoleArn":       outputs["DynamoScalingRoleArn"],
-		"InitialAnalysisPackUrls":    strings.Join(settings.Setup.InitialAnalysisSets, ","),
-		"LayerVersionArns":           settings.Infra.BaseLayerVersionArns,
-		"OutputsKeyId":               outputs["OutputsEncryptionKeyId"],
-		"ProcessedDataBucket":        outputs["ProcessedDataBucket"],
-		"SqsKeyId":                   outputs["QueueEncryptionKeyId"],
-		"TracingMode":                settings.Monitoring.TracingMode,
-		"UserPoolId":                 outputs["UserPoolId"],
+		"AlarmTopicArn":                    outputs["AlarmTopicArn"],
+		"AnalysisApiEndpoint":              outputs["AnalysisApiEndpoint"],
+		"AnalysisApiId":                    outputs["AnalysisApiId"],
+		"AnalysisSyncBranch":               settings.Setup.AnalysisSync.Branch,
+		"AnalysisSyncCredentialsSecretArn": settings.Setup.AnalysisSync.CredentialsSecretArn,
+		"AnalysisSyncPath":                 settings.Setup.AnalysisSync.Path,
+		"AnalysisSyncRepositoryUrl":        settings.Setup.AnalysisSync.RepositoryURL,
+		"AnalysisSyncSchedule":             settings.Setup.AnalysisSync.Schedule,
+		"AnalysisVersionsBucket":           outputs["AnalysisVersionsBucket"],
+		"AppDomainURL":                     outputs["LoadBalancerUrl"],
+		"AthenaResultsBucket":              outputs["AthenaResultsBucket"],
+		"CloudWatchLogRetentionDays":       strconv.Itoa(settings.Monitoring.CloudWatchLogRetentionDays),
+		"CompanyDisplayName":               settings.Setup.Company.DisplayName,
+		"CompanyEmail":                     settings.Setup.Company.Email,
+		"ComplianceApiId":                  outputs["ComplianceApiId"],
+		"CustomResourceVersion":            customResourceVersion(),
+		"Debug":                            strconv.FormatBool(settings.Monitoring.Debug),
+		"DynamoScalingRoleArn":             outputs["DynamoScalingRoleArn"],
+		"GitLayerVersionArn":               settings.Setup.AnalysisSync.GitLayerVersionArn,
+		"InitialAnalysisPackUrls":          strings.Join(settings.Setup.InitialAnalysisSets, ","),
+		"LayerVersionArns":                 settings.Infra.BaseLayerVersionArns,
+		"OutputsKeyId":                     outputs["OutputsEncryptionKeyId"],
+		"ProcessedDataBucket":              outputs["ProcessedDataBucket"],
+		"SqsKeyId":                         outputs["QueueEncryptionKeyId"],
+		"TracingMode":                      settings.Monitoring.TracingMode,
+		"UserPoolId":                       outputs["UserPoolId"],
 	})
 	return err
 }
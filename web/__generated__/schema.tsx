@@ -552,6 +552,7 @@ export type Mutation = {
   inviteUser: User;
   remediateResource?: Maybe<Scalars['Boolean']>;
   resetUserPassword: User;
+  reviewRemediation?: Maybe<Scalars['AWSJSON']>;
   suppressPolicies?: Maybe<Scalars['Boolean']>;
   testPolicy?: Maybe<TestPolicyResponse>;
   updateDestination?: Maybe<Destination>;
@@ -629,6 +630,10 @@ export type MutationResetUserPasswordArgs = {
   id: Scalars['ID'];
 };
 
+export type MutationReviewRemediationArgs = {
+  input: ReviewRemediationInput;
+};
+
 export type MutationSuppressPoliciesArgs = {
   input: SuppressPoliciesInput;
 };
@@ -923,6 +928,11 @@ export type ResourceSummary = {
   type?: Maybe<Scalars['String']>;
 };
 
+export type ReviewRemediationInput = {
+  id: Scalars['ID'];
+  approve: Scalars['Boolean'];
+};
+
 export type RuleDetails = {
   __typename?: 'RuleDetails';
   body?: Maybe<Scalars['String']>;
@@ -1331,6 +1341,7 @@ export type ResolversTypes = {
   DeleteGlobalPythonInputItem: DeleteGlobalPythonInputItem;
   InviteUserInput: InviteUserInput;
   RemediateResourceInput: RemediateResourceInput;
+  ReviewRemediationInput: ReviewRemediationInput;
   SuppressPoliciesInput: SuppressPoliciesInput;
   TestPolicyInput: TestPolicyInput;
   AnalysisTypeEnum: AnalysisTypeEnum;
@@ -1455,6 +1466,7 @@ export type ResolversParentTypes = {
   DeleteGlobalPythonInputItem: DeleteGlobalPythonInputItem;
   InviteUserInput: InviteUserInput;
   RemediateResourceInput: RemediateResourceInput;
+  ReviewRemediationInput: ReviewRemediationInput;
   SuppressPoliciesInput: SuppressPoliciesInput;
   TestPolicyInput: TestPolicyInput;
   AnalysisTypeEnum: AnalysisTypeEnum;
@@ -1891,6 +1903,12 @@ export type MutationResolvers<
     ContextType,
     RequireFields<MutationResetUserPasswordArgs, 'id'>
   >;
+  reviewRemediation?: Resolver<
+    Maybe<ResolversTypes['AWSJSON']>,
+    ParentType,
+    ContextType,
+    RequireFields<MutationReviewRemediationArgs, 'input'>
+  >;
   suppressPolicies?: Resolver<
     Maybe<ResolversTypes['Boolean']>,
     ParentType,
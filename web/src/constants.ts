@@ -58,6 +58,7 @@ export const DEFAULT_DEDUP_FUNCTION =
 export const RESOURCE_TYPES = [
   'AWS.ACM.Certificate',
   'AWS.CloudFormation.Stack',
+  'AWS.CloudFront.Distribution',
   'AWS.CloudTrail',
   'AWS.CloudTrail.Meta',
   'AWS.CloudWatch.LogGroup',
@@ -70,7 +71,9 @@ export const RESOURCE_TYPES = [
   'AWS.EC2.SecurityGroup',
   'AWS.EC2.Volume',
   'AWS.EC2.VPC',
+  'AWS.ECR.Repository',
   'AWS.ECS.Cluster',
+  'AWS.EKS.Cluster',
   'AWS.ELBV2.ApplicationLoadBalancer',
   'AWS.GuardDuty.Detector',
   'AWS.IAM.Group',
@@ -84,8 +87,16 @@ export const RESOURCE_TYPES = [
   'AWS.RDS.Instance',
   'AWS.Redshift.Cluster',
   'AWS.S3.Bucket',
+  'AWS.SecretsManager.Secret',
+  'AWS.SNS.Topic',
+  'AWS.SQS.Queue',
   'AWS.WAF.Regional.WebACL',
   'AWS.WAF.WebACL',
+  'GCP.Compute.Firewall',
+  'GCP.Compute.Instance',
+  'GCP.GCS.Bucket',
+  'GCP.IAM.Policy',
+  'GCP.SQL.Instance',
 ] as const;
 
 export const LOG_TYPES = [
@@ -101,6 +112,10 @@ export const LOG_TYPES = [
   'AWS.VPCFlow',
   'Fluentd.Syslog3164',
   'Fluentd.Syslog5424',
+  'GCP.AuditLog',
+  'GCP.Firewall',
+  'GCP.HTTPLoadBalancer',
+  'GCP.VPCFlow',
   'GitLab.API',
   'GitLab.Audit',
   'GitLab.Exceptions',